@@ -29,4 +29,18 @@ nats stream add orders \
     --defaults
 
 echo "Successfully added 'orders' stream."
+
+# messages consumers gave up on (dlq.<service>.<subject>) and the ones replayed from there (replay.<service>.<subject>)
+echo "Attempting to add 'dlq' stream..."
+nats stream add dlq \
+    -s "$NATS_URL" \
+    --subjects "dlq.>" \
+    --subjects "replay.>" \
+    --storage file \
+    --retention limits \
+    --max-age 30d \
+    --max-bytes 100MB \
+    --defaults
+
+echo "Successfully added 'dlq' stream."
 echo "NATS setup complete."
\ No newline at end of file
@@ -19,12 +19,15 @@ import (
 type MutationResolver interface {
 	CreateOrder(ctx context.Context, request model.CreateOrderRequest) (*model.CreateOrderResponse, error)
 	CancelOrder(ctx context.Context, orderID string) (*model.CancelOrderResponse, error)
+	ModifyOrder(ctx context.Context, orderID string, quantity *float64, price *float64) (*model.ModifyOrderResponse, error)
 	CreateAccount(ctx context.Context, request model.CreateAccountRequest) (*model.CreateAccountResponse, error)
 	AddToWatchlist(ctx context.Context, request model.AddToWatchlistRequest) (*model.AddToWatchlistResponse, error)
 	RemoveFromWatchlist(ctx context.Context, request model.RemoveFromWatchlistRequest) (*model.RemoveFromWatchlistResponse, error)
 	DeleteAccount(ctx context.Context, accountID string) (bool, error)
 	Deposit(ctx context.Context, request model.DepositRequest) (*model.DepositResponse, error)
 	Transfer(ctx context.Context, request model.TransferRequest) (*model.TransferResponse, error)
+	SetLotMethod(ctx context.Context, request model.SetLotMethodRequest) (*model.SetLotMethodResponse, error)
+	SelectTaxLots(ctx context.Context, request model.SelectTaxLotsRequest) (*model.SelectTaxLotsResponse, error)
 }
 type QueryResolver interface {
 	Health(ctx context.Context) (string, error)
@@ -35,6 +38,10 @@ type QueryResolver interface {
 	GetHolding(ctx context.Context, request model.GetHoldingRequest) (*model.GetHoldingResponse, error)
 	GetWatchlist(ctx context.Context) (*model.GetWatchlistResponse, error)
 	GetTransactions(ctx context.Context, request model.GetTransactionsRequest) (*model.GetTransactionsResponse, error)
+	GetTaxLots(ctx context.Context, request model.GetTaxLotsRequest) (*model.GetTaxLotsResponse, error)
+	GetRealizedGains(ctx context.Context, request model.GetRealizedGainsRequest) (*model.GetRealizedGainsResponse, error)
+	GetPortfolioValuation(ctx context.Context) (*model.GetPortfolioValuationResponse, error)
+	GetPortfolioPerformance(ctx context.Context, period string) (*model.GetPortfolioPerformanceResponse, error)
 	CheckPermission(ctx context.Context, request model.HasPermissionRequest) (*model.HasPermissionResponse, error)
 	SearchStocks(ctx context.Context, query string, limit *int32) ([]*model.StockSearchResult, error)
 	GetStockMetadata(ctx context.Context, symbol string) (*model.StockMetadataResponse, error)
@@ -114,6 +121,27 @@ func (ec *executionContext) field_Mutation_deposit_args(ctx context.Context, raw
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_modifyOrder_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "orderId", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["orderId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "quantity", ec.unmarshalOFloat2ᚖfloat64)
+	if err != nil {
+		return nil, err
+	}
+	args["quantity"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "price", ec.unmarshalOFloat2ᚖfloat64)
+	if err != nil {
+		return nil, err
+	}
+	args["price"] = arg2
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_removeFromWatchlist_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -125,6 +153,28 @@ func (ec *executionContext) field_Mutation_removeFromWatchlist_args(ctx context.
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_selectTaxLots_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "request", ec.unmarshalNSelectTaxLotsRequest2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐSelectTaxLotsRequest)
+	if err != nil {
+		return nil, err
+	}
+	args["request"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_setLotMethod_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "request", ec.unmarshalNSetLotMethodRequest2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐSetLotMethodRequest)
+	if err != nil {
+		return nil, err
+	}
+	args["request"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_transfer_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -191,6 +241,28 @@ func (ec *executionContext) field_Query_getOrderByOrderID_args(ctx context.Conte
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_getPortfolioPerformance_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "period", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["period"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getRealizedGains_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "request", ec.unmarshalNGetRealizedGainsRequest2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetRealizedGainsRequest)
+	if err != nil {
+		return nil, err
+	}
+	args["request"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_getStockHistoricalData_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -240,6 +312,17 @@ func (ec *executionContext) field_Query_getStockQuote_args(ctx context.Context,
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_getTaxLots_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "request", ec.unmarshalNGetTaxLotsRequest2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetTaxLotsRequest)
+	if err != nil {
+		return nil, err
+	}
+	args["request"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_getTransactions_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -302,6 +385,8 @@ func (ec *executionContext) fieldContext_Mutation_createOrder(ctx context.Contex
 			switch field.Name {
 			case "data":
 				return ec.fieldContext_CreateOrderResponse_data(ctx, field)
+			case "attachedOrders":
+				return ec.fieldContext_CreateOrderResponse_attachedOrders(ctx, field)
 			case "code":
 				return ec.fieldContext_CreateOrderResponse_code(ctx, field)
 			}
@@ -369,6 +454,55 @@ func (ec *executionContext) fieldContext_Mutation_cancelOrder(ctx context.Contex
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_modifyOrder(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_modifyOrder,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ModifyOrder(ctx, fc.Args["orderId"].(string), fc.Args["quantity"].(*float64), fc.Args["price"].(*float64))
+		},
+		nil,
+		ec.marshalNModifyOrderResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐModifyOrderResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_modifyOrder(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "data":
+				return ec.fieldContext_ModifyOrderResponse_data(ctx, field)
+			case "amendment":
+				return ec.fieldContext_ModifyOrderResponse_amendment(ctx, field)
+			case "code":
+				return ec.fieldContext_ModifyOrderResponse_code(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ModifyOrderResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_modifyOrder_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_createAccount(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -639,6 +773,98 @@ func (ec *executionContext) fieldContext_Mutation_transfer(ctx context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_setLotMethod(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_setLotMethod,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().SetLotMethod(ctx, fc.Args["request"].(model.SetLotMethodRequest))
+		},
+		nil,
+		ec.marshalNSetLotMethodResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐSetLotMethodResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_setLotMethod(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_SetLotMethodResponse_code(ctx, field)
+			case "data":
+				return ec.fieldContext_SetLotMethodResponse_data(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SetLotMethodResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setLotMethod_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_selectTaxLots(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_selectTaxLots,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().SelectTaxLots(ctx, fc.Args["request"].(model.SelectTaxLotsRequest))
+		},
+		nil,
+		ec.marshalNSelectTaxLotsResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐSelectTaxLotsResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_selectTaxLots(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_SelectTaxLotsResponse_code(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SelectTaxLotsResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_selectTaxLots_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_health(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -732,6 +958,10 @@ func (ec *executionContext) fieldContext_Query_getOrderByOrderID(ctx context.Con
 			switch field.Name {
 			case "data":
 				return ec.fieldContext_GetOrderByIDResponse_data(ctx, field)
+			case "fills":
+				return ec.fieldContext_GetOrderByIDResponse_fills(ctx, field)
+			case "amendments":
+				return ec.fieldContext_GetOrderByIDResponse_amendments(ctx, field)
 			case "code":
 				return ec.fieldContext_GetOrderByIDResponse_code(ctx, field)
 			}
@@ -780,6 +1010,8 @@ func (ec *executionContext) fieldContext_Query_getPortfolioSummary(_ context.Con
 				return ec.fieldContext_GetPortfolioSummaryResponse_accounts(ctx, field)
 			case "totalBalance":
 				return ec.fieldContext_GetPortfolioSummaryResponse_totalBalance(ctx, field)
+			case "totalFees":
+				return ec.fieldContext_GetPortfolioSummaryResponse_totalFees(ctx, field)
 			case "code":
 				return ec.fieldContext_GetPortfolioSummaryResponse_code(ctx, field)
 			}
@@ -947,6 +1179,8 @@ func (ec *executionContext) fieldContext_Query_getTransactions(ctx context.Conte
 				return ec.fieldContext_GetTransactionsResponse_code(ctx, field)
 			case "data":
 				return ec.fieldContext_GetTransactionsResponse_data(ctx, field)
+			case "totalFees":
+				return ec.fieldContext_GetTransactionsResponse_totalFees(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type GetTransactionsResponse", field.Name)
 		},
@@ -965,6 +1199,188 @@ func (ec *executionContext) fieldContext_Query_getTransactions(ctx context.Conte
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_getTaxLots(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getTaxLots,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetTaxLots(ctx, fc.Args["request"].(model.GetTaxLotsRequest))
+		},
+		nil,
+		ec.marshalNGetTaxLotsResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetTaxLotsResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getTaxLots(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_GetTaxLotsResponse_code(ctx, field)
+			case "data":
+				return ec.fieldContext_GetTaxLotsResponse_data(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GetTaxLotsResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getTaxLots_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getRealizedGains(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getRealizedGains,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetRealizedGains(ctx, fc.Args["request"].(model.GetRealizedGainsRequest))
+		},
+		nil,
+		ec.marshalNGetRealizedGainsResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetRealizedGainsResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getRealizedGains(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_GetRealizedGainsResponse_code(ctx, field)
+			case "data":
+				return ec.fieldContext_GetRealizedGainsResponse_data(ctx, field)
+			case "bySymbol":
+				return ec.fieldContext_GetRealizedGainsResponse_bySymbol(ctx, field)
+			case "totalRealizedPnl":
+				return ec.fieldContext_GetRealizedGainsResponse_totalRealizedPnl(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GetRealizedGainsResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getRealizedGains_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getPortfolioValuation(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getPortfolioValuation,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().GetPortfolioValuation(ctx)
+		},
+		nil,
+		ec.marshalNGetPortfolioValuationResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetPortfolioValuationResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getPortfolioValuation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_GetPortfolioValuationResponse_code(ctx, field)
+			case "data":
+				return ec.fieldContext_GetPortfolioValuationResponse_data(ctx, field)
+			case "valuedAt":
+				return ec.fieldContext_GetPortfolioValuationResponse_valuedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GetPortfolioValuationResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getPortfolioPerformance(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getPortfolioPerformance,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetPortfolioPerformance(ctx, fc.Args["period"].(string))
+		},
+		nil,
+		ec.marshalNGetPortfolioPerformanceResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetPortfolioPerformanceResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getPortfolioPerformance(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_GetPortfolioPerformanceResponse_code(ctx, field)
+			case "data":
+				return ec.fieldContext_GetPortfolioPerformanceResponse_data(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GetPortfolioPerformanceResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getPortfolioPerformance_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_checkPermission(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -1441,6 +1857,13 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "modifyOrder":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_modifyOrder(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "createAccount":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_createAccount(ctx, field)
@@ -1483,6 +1906,20 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "setLotMethod":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setLotMethod(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "selectTaxLots":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_selectTaxLots(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -1700,6 +2137,94 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getTaxLots":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getTaxLots(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getRealizedGains":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getRealizedGains(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getPortfolioValuation":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getPortfolioValuation(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getPortfolioPerformance":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getPortfolioPerformance(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "checkPermission":
 			field := field
@@ -79,6 +79,24 @@ func (ec *executionContext) fieldContext_CancelOrderResponse_data(_ context.Cont
 				return ec.fieldContext_Order_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Order_updatedAt(ctx, field)
+			case "timeInForce":
+				return ec.fieldContext_Order_timeInForce(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_Order_expiresAt(ctx, field)
+			case "trailAmount":
+				return ec.fieldContext_Order_trailAmount(ctx, field)
+			case "trailPercent":
+				return ec.fieldContext_Order_trailPercent(ctx, field)
+			case "groupId":
+				return ec.fieldContext_Order_groupId(ctx, field)
+			case "groupType":
+				return ec.fieldContext_Order_groupType(ctx, field)
+			case "parentOrderId":
+				return ec.fieldContext_Order_parentOrderId(ctx, field)
+			case "rejectCode":
+				return ec.fieldContext_Order_rejectCode(ctx, field)
+			case "rejectReason":
+				return ec.fieldContext_Order_rejectReason(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Order", field.Name)
 		},
@@ -165,6 +183,24 @@ func (ec *executionContext) fieldContext_CreateOrderResponse_data(_ context.Cont
 				return ec.fieldContext_Order_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Order_updatedAt(ctx, field)
+			case "timeInForce":
+				return ec.fieldContext_Order_timeInForce(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_Order_expiresAt(ctx, field)
+			case "trailAmount":
+				return ec.fieldContext_Order_trailAmount(ctx, field)
+			case "trailPercent":
+				return ec.fieldContext_Order_trailPercent(ctx, field)
+			case "groupId":
+				return ec.fieldContext_Order_groupId(ctx, field)
+			case "groupType":
+				return ec.fieldContext_Order_groupType(ctx, field)
+			case "parentOrderId":
+				return ec.fieldContext_Order_parentOrderId(ctx, field)
+			case "rejectCode":
+				return ec.fieldContext_Order_rejectCode(ctx, field)
+			case "rejectReason":
+				return ec.fieldContext_Order_rejectReason(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Order", field.Name)
 		},
@@ -172,54 +208,25 @@ func (ec *executionContext) fieldContext_CreateOrderResponse_data(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _CreateOrderResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.CreateOrderResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CreateOrderResponse_code,
-		func(ctx context.Context) (any, error) {
-			return obj.Code, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
-
-func (ec *executionContext) fieldContext_CreateOrderResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CreateOrderResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _GetOrderByIDResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.GetOrderByIDResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CreateOrderResponse_attachedOrders(ctx context.Context, field graphql.CollectedField, obj *model.CreateOrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GetOrderByIDResponse_data,
+		ec.fieldContext_CreateOrderResponse_attachedOrders,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			return obj.AttachedOrders, nil
 		},
 		nil,
-		ec.marshalOOrder2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐOrder,
+		ec.marshalOOrder2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐOrderᚄ,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GetOrderByIDResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CreateOrderResponse_attachedOrders(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GetOrderByIDResponse",
+		Object:     "CreateOrderResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -251,6 +258,24 @@ func (ec *executionContext) fieldContext_GetOrderByIDResponse_data(_ context.Con
 				return ec.fieldContext_Order_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Order_updatedAt(ctx, field)
+			case "timeInForce":
+				return ec.fieldContext_Order_timeInForce(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_Order_expiresAt(ctx, field)
+			case "trailAmount":
+				return ec.fieldContext_Order_trailAmount(ctx, field)
+			case "trailPercent":
+				return ec.fieldContext_Order_trailPercent(ctx, field)
+			case "groupId":
+				return ec.fieldContext_Order_groupId(ctx, field)
+			case "groupType":
+				return ec.fieldContext_Order_groupType(ctx, field)
+			case "parentOrderId":
+				return ec.fieldContext_Order_parentOrderId(ctx, field)
+			case "rejectCode":
+				return ec.fieldContext_Order_rejectCode(ctx, field)
+			case "rejectReason":
+				return ec.fieldContext_Order_rejectReason(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Order", field.Name)
 		},
@@ -258,12 +283,12 @@ func (ec *executionContext) fieldContext_GetOrderByIDResponse_data(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _GetOrderByIDResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.GetOrderByIDResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CreateOrderResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.CreateOrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GetOrderByIDResponse_code,
+		ec.fieldContext_CreateOrderResponse_code,
 		func(ctx context.Context) (any, error) {
 			return obj.Code, nil
 		},
@@ -274,38 +299,9 @@ func (ec *executionContext) _GetOrderByIDResponse_code(ctx context.Context, fiel
 	)
 }
 
-func (ec *executionContext) fieldContext_GetOrderByIDResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "GetOrderByIDResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _Order_id(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Order_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
-
-func (ec *executionContext) fieldContext_Order_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CreateOrderResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "CreateOrderResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -316,14 +312,14 @@ func (ec *executionContext) fieldContext_Order_id(_ context.Context, field graph
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_userId(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExecutionPricing_model(ctx context.Context, field graphql.CollectedField, obj *model.ExecutionPricing) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_userId,
+		ec.fieldContext_ExecutionPricing_model,
 		func(ctx context.Context) (any, error) {
-			return obj.UserID, nil
+			return obj.Model, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -332,9 +328,9 @@ func (ec *executionContext) _Order_userId(ctx context.Context, field graphql.Col
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_userId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExecutionPricing_model(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "ExecutionPricing",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -345,130 +341,130 @@ func (ec *executionContext) fieldContext_Order_userId(_ context.Context, field g
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_symbol(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExecutionPricing_referencePrice(ctx context.Context, field graphql.CollectedField, obj *model.ExecutionPricing) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_symbol,
+		ec.fieldContext_ExecutionPricing_referencePrice,
 		func(ctx context.Context) (any, error) {
-			return obj.Symbol, nil
+			return obj.ReferencePrice, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_symbol(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExecutionPricing_referencePrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "ExecutionPricing",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_side(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExecutionPricing_spread(ctx context.Context, field graphql.CollectedField, obj *model.ExecutionPricing) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_side,
+		ec.fieldContext_ExecutionPricing_spread,
 		func(ctx context.Context) (any, error) {
-			return obj.Side, nil
+			return obj.Spread, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_side(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExecutionPricing_spread(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "ExecutionPricing",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_type(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExecutionPricing_impact(ctx context.Context, field graphql.CollectedField, obj *model.ExecutionPricing) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_type,
+		ec.fieldContext_ExecutionPricing_impact,
 		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
+			return obj.Impact, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExecutionPricing_impact(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "ExecutionPricing",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_status(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExecutionPricing_participation(ctx context.Context, field graphql.CollectedField, obj *model.ExecutionPricing) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_status,
+		ec.fieldContext_ExecutionPricing_participation,
 		func(ctx context.Context) (any, error) {
-			return obj.Status, nil
+			return obj.Participation, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExecutionPricing_participation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "ExecutionPricing",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_quantity(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExecutionPricing_minSpread(ctx context.Context, field graphql.CollectedField, obj *model.ExecutionPricing) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_quantity,
+		ec.fieldContext_ExecutionPricing_minSpread,
 		func(ctx context.Context) (any, error) {
-			return obj.Quantity, nil
+			return obj.MinSpread, nil
 		},
 		nil,
 		ec.marshalNFloat2float64,
@@ -477,9 +473,9 @@ func (ec *executionContext) _Order_quantity(ctx context.Context, field graphql.C
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExecutionPricing_minSpread(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "ExecutionPricing",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -490,14 +486,14 @@ func (ec *executionContext) fieldContext_Order_quantity(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_price(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExecutionPricing_spreadRangeShare(ctx context.Context, field graphql.CollectedField, obj *model.ExecutionPricing) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_price,
+		ec.fieldContext_ExecutionPricing_spreadRangeShare,
 		func(ctx context.Context) (any, error) {
-			return obj.Price, nil
+			return obj.SpreadRangeShare, nil
 		},
 		nil,
 		ec.marshalNFloat2float64,
@@ -506,9 +502,9 @@ func (ec *executionContext) _Order_price(ctx context.Context, field graphql.Coll
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_price(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExecutionPricing_spreadRangeShare(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "ExecutionPricing",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -519,14 +515,14 @@ func (ec *executionContext) fieldContext_Order_price(_ context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_stopPrice(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExecutionPricing_impactCoefficient(ctx context.Context, field graphql.CollectedField, obj *model.ExecutionPricing) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_stopPrice,
+		ec.fieldContext_ExecutionPricing_impactCoefficient,
 		func(ctx context.Context) (any, error) {
-			return obj.StopPrice, nil
+			return obj.ImpactCoefficient, nil
 		},
 		nil,
 		ec.marshalNFloat2float64,
@@ -535,9 +531,9 @@ func (ec *executionContext) _Order_stopPrice(ctx context.Context, field graphql.
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_stopPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExecutionPricing_impactCoefficient(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "ExecutionPricing",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -548,101 +544,179 @@ func (ec *executionContext) fieldContext_Order_stopPrice(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_filledQuantity(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _GetOrderByIDResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.GetOrderByIDResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_filledQuantity,
+		ec.fieldContext_GetOrderByIDResponse_data,
 		func(ctx context.Context) (any, error) {
-			return obj.FilledQuantity, nil
+			return obj.Data, nil
 		},
 		nil,
-		ec.marshalNFloat2float64,
-		true,
+		ec.marshalOOrder2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐOrder,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_filledQuantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GetOrderByIDResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "GetOrderByIDResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Order_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_Order_userId(ctx, field)
+			case "symbol":
+				return ec.fieldContext_Order_symbol(ctx, field)
+			case "side":
+				return ec.fieldContext_Order_side(ctx, field)
+			case "type":
+				return ec.fieldContext_Order_type(ctx, field)
+			case "status":
+				return ec.fieldContext_Order_status(ctx, field)
+			case "quantity":
+				return ec.fieldContext_Order_quantity(ctx, field)
+			case "price":
+				return ec.fieldContext_Order_price(ctx, field)
+			case "stopPrice":
+				return ec.fieldContext_Order_stopPrice(ctx, field)
+			case "filledQuantity":
+				return ec.fieldContext_Order_filledQuantity(ctx, field)
+			case "avgFillPrice":
+				return ec.fieldContext_Order_avgFillPrice(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Order_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Order_updatedAt(ctx, field)
+			case "timeInForce":
+				return ec.fieldContext_Order_timeInForce(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_Order_expiresAt(ctx, field)
+			case "trailAmount":
+				return ec.fieldContext_Order_trailAmount(ctx, field)
+			case "trailPercent":
+				return ec.fieldContext_Order_trailPercent(ctx, field)
+			case "groupId":
+				return ec.fieldContext_Order_groupId(ctx, field)
+			case "groupType":
+				return ec.fieldContext_Order_groupType(ctx, field)
+			case "parentOrderId":
+				return ec.fieldContext_Order_parentOrderId(ctx, field)
+			case "rejectCode":
+				return ec.fieldContext_Order_rejectCode(ctx, field)
+			case "rejectReason":
+				return ec.fieldContext_Order_rejectReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Order", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_avgFillPrice(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _GetOrderByIDResponse_fills(ctx context.Context, field graphql.CollectedField, obj *model.GetOrderByIDResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_avgFillPrice,
+		ec.fieldContext_GetOrderByIDResponse_fills,
 		func(ctx context.Context) (any, error) {
-			return obj.AvgFillPrice, nil
+			return obj.Fills, nil
 		},
 		nil,
-		ec.marshalNFloat2float64,
-		true,
+		ec.marshalOOrderFill2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐOrderFillᚄ,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_avgFillPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GetOrderByIDResponse_fills(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "GetOrderByIDResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			switch field.Name {
+			case "sequence":
+				return ec.fieldContext_OrderFill_sequence(ctx, field)
+			case "quantity":
+				return ec.fieldContext_OrderFill_quantity(ctx, field)
+			case "price":
+				return ec.fieldContext_OrderFill_price(ctx, field)
+			case "filledAt":
+				return ec.fieldContext_OrderFill_filledAt(ctx, field)
+			case "pricing":
+				return ec.fieldContext_OrderFill_pricing(ctx, field)
+			case "quoteSource":
+				return ec.fieldContext_OrderFill_quoteSource(ctx, field)
+			case "quoteAsOf":
+				return ec.fieldContext_OrderFill_quoteAsOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OrderFill", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _GetOrderByIDResponse_amendments(ctx context.Context, field graphql.CollectedField, obj *model.GetOrderByIDResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_createdAt,
+		ec.fieldContext_GetOrderByIDResponse_amendments,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.Amendments, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOOrderAmendment2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐOrderAmendmentᚄ,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GetOrderByIDResponse_amendments(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "GetOrderByIDResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_OrderAmendment_id(ctx, field)
+			case "quantity":
+				return ec.fieldContext_OrderAmendment_quantity(ctx, field)
+			case "price":
+				return ec.fieldContext_OrderAmendment_price(ctx, field)
+			case "status":
+				return ec.fieldContext_OrderAmendment_status(ctx, field)
+			case "reason":
+				return ec.fieldContext_OrderAmendment_reason(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_OrderAmendment_createdAt(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_OrderAmendment_resolvedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OrderAmendment", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _GetOrderByIDResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.GetOrderByIDResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_updatedAt,
+		ec.fieldContext_GetOrderByIDResponse_code,
 		func(ctx context.Context) (any, error) {
-			return obj.UpdatedAt, nil
+			return obj.Code, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -651,9 +725,9 @@ func (ec *executionContext) _Order_updatedAt(ctx context.Context, field graphql.
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GetOrderByIDResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "GetOrderByIDResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -664,25 +738,25 @@ func (ec *executionContext) fieldContext_Order_updatedAt(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _OrdersResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.OrdersResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _ModifyOrderResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.ModifyOrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrdersResponse_data,
+		ec.fieldContext_ModifyOrderResponse_data,
 		func(ctx context.Context) (any, error) {
 			return obj.Data, nil
 		},
 		nil,
-		ec.marshalOOrder2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐOrderᚄ,
+		ec.marshalOOrder2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐOrder,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrdersResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ModifyOrderResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrdersResponse",
+		Object:     "ModifyOrderResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -714,6 +788,24 @@ func (ec *executionContext) fieldContext_OrdersResponse_data(_ context.Context,
 				return ec.fieldContext_Order_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Order_updatedAt(ctx, field)
+			case "timeInForce":
+				return ec.fieldContext_Order_timeInForce(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_Order_expiresAt(ctx, field)
+			case "trailAmount":
+				return ec.fieldContext_Order_trailAmount(ctx, field)
+			case "trailPercent":
+				return ec.fieldContext_Order_trailPercent(ctx, field)
+			case "groupId":
+				return ec.fieldContext_Order_groupId(ctx, field)
+			case "groupType":
+				return ec.fieldContext_Order_groupType(ctx, field)
+			case "parentOrderId":
+				return ec.fieldContext_Order_parentOrderId(ctx, field)
+			case "rejectCode":
+				return ec.fieldContext_Order_rejectCode(ctx, field)
+			case "rejectReason":
+				return ec.fieldContext_Order_rejectReason(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Order", field.Name)
 		},
@@ -721,41 +813,57 @@ func (ec *executionContext) fieldContext_OrdersResponse_data(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _OrdersResponse_count(ctx context.Context, field graphql.CollectedField, obj *model.OrdersResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _ModifyOrderResponse_amendment(ctx context.Context, field graphql.CollectedField, obj *model.ModifyOrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrdersResponse_count,
+		ec.fieldContext_ModifyOrderResponse_amendment,
 		func(ctx context.Context) (any, error) {
-			return obj.Count, nil
+			return obj.Amendment, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOOrderAmendment2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐOrderAmendment,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrdersResponse_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ModifyOrderResponse_amendment(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrdersResponse",
+		Object:     "ModifyOrderResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_OrderAmendment_id(ctx, field)
+			case "quantity":
+				return ec.fieldContext_OrderAmendment_quantity(ctx, field)
+			case "price":
+				return ec.fieldContext_OrderAmendment_price(ctx, field)
+			case "status":
+				return ec.fieldContext_OrderAmendment_status(ctx, field)
+			case "reason":
+				return ec.fieldContext_OrderAmendment_reason(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_OrderAmendment_createdAt(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_OrderAmendment_resolvedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OrderAmendment", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrdersResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.OrdersResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _ModifyOrderResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.ModifyOrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrdersResponse_code,
+		ec.fieldContext_ModifyOrderResponse_code,
 		func(ctx context.Context) (any, error) {
 			return obj.Code, nil
 		},
@@ -766,9 +874,9 @@ func (ec *executionContext) _OrdersResponse_code(ctx context.Context, field grap
 	)
 }
 
-func (ec *executionContext) fieldContext_OrdersResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ModifyOrderResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrdersResponse",
+		Object:     "ModifyOrderResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -779,22 +887,1272 @@ func (ec *executionContext) fieldContext_OrdersResponse_code(_ context.Context,
 	return fc, nil
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
-
-func (ec *executionContext) unmarshalInputCreateOrderRequest(ctx context.Context, obj any) (model.CreateOrderRequest, error) {
-	var it model.CreateOrderRequest
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
-
-	fieldsInOrder := [...]string{"symbol", "side", "type", "quantity", "price"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
+func (ec *executionContext) _Order_id(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_userId(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_userId,
+		func(ctx context.Context) (any, error) {
+			return obj.UserID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_userId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_symbol(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_symbol,
+		func(ctx context.Context) (any, error) {
+			return obj.Symbol, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_symbol(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_side(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_side,
+		func(ctx context.Context) (any, error) {
+			return obj.Side, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_side(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_type(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_status(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_quantity(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_quantity,
+		func(ctx context.Context) (any, error) {
+			return obj.Quantity, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_price(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_price,
+		func(ctx context.Context) (any, error) {
+			return obj.Price, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_price(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_stopPrice(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_stopPrice,
+		func(ctx context.Context) (any, error) {
+			return obj.StopPrice, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_stopPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_filledQuantity(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_filledQuantity,
+		func(ctx context.Context) (any, error) {
+			return obj.FilledQuantity, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_filledQuantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_avgFillPrice(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_avgFillPrice,
+		func(ctx context.Context) (any, error) {
+			return obj.AvgFillPrice, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_avgFillPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_updatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_timeInForce(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_timeInForce,
+		func(ctx context.Context) (any, error) {
+			return obj.TimeInForce, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_timeInForce(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_expiresAt(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_expiresAt,
+		func(ctx context.Context) (any, error) {
+			return obj.ExpiresAt, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_trailAmount(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_trailAmount,
+		func(ctx context.Context) (any, error) {
+			return obj.TrailAmount, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_trailAmount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_trailPercent(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_trailPercent,
+		func(ctx context.Context) (any, error) {
+			return obj.TrailPercent, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_trailPercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_groupId(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_groupId,
+		func(ctx context.Context) (any, error) {
+			return obj.GroupID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_groupId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_groupType(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_groupType,
+		func(ctx context.Context) (any, error) {
+			return obj.GroupType, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_groupType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_parentOrderId(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_parentOrderId,
+		func(ctx context.Context) (any, error) {
+			return obj.ParentOrderID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_parentOrderId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_rejectCode(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_rejectCode,
+		func(ctx context.Context) (any, error) {
+			return obj.RejectCode, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_rejectCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Order_rejectReason(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_rejectReason,
+		func(ctx context.Context) (any, error) {
+			return obj.RejectReason, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Order_rejectReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderAmendment_id(ctx context.Context, field graphql.CollectedField, obj *model.OrderAmendment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderAmendment_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderAmendment_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderAmendment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderAmendment_quantity(ctx context.Context, field graphql.CollectedField, obj *model.OrderAmendment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderAmendment_quantity,
+		func(ctx context.Context) (any, error) {
+			return obj.Quantity, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderAmendment_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderAmendment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderAmendment_price(ctx context.Context, field graphql.CollectedField, obj *model.OrderAmendment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderAmendment_price,
+		func(ctx context.Context) (any, error) {
+			return obj.Price, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderAmendment_price(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderAmendment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderAmendment_status(ctx context.Context, field graphql.CollectedField, obj *model.OrderAmendment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderAmendment_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderAmendment_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderAmendment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderAmendment_reason(ctx context.Context, field graphql.CollectedField, obj *model.OrderAmendment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderAmendment_reason,
+		func(ctx context.Context) (any, error) {
+			return obj.Reason, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderAmendment_reason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderAmendment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderAmendment_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.OrderAmendment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderAmendment_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderAmendment_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderAmendment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderAmendment_resolvedAt(ctx context.Context, field graphql.CollectedField, obj *model.OrderAmendment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderAmendment_resolvedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.ResolvedAt, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderAmendment_resolvedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderAmendment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderFill_sequence(ctx context.Context, field graphql.CollectedField, obj *model.OrderFill) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderFill_sequence,
+		func(ctx context.Context) (any, error) {
+			return obj.Sequence, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderFill_sequence(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderFill",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderFill_quantity(ctx context.Context, field graphql.CollectedField, obj *model.OrderFill) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderFill_quantity,
+		func(ctx context.Context) (any, error) {
+			return obj.Quantity, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderFill_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderFill",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderFill_price(ctx context.Context, field graphql.CollectedField, obj *model.OrderFill) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderFill_price,
+		func(ctx context.Context) (any, error) {
+			return obj.Price, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderFill_price(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderFill",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderFill_filledAt(ctx context.Context, field graphql.CollectedField, obj *model.OrderFill) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderFill_filledAt,
+		func(ctx context.Context) (any, error) {
+			return obj.FilledAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderFill_filledAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderFill",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderFill_pricing(ctx context.Context, field graphql.CollectedField, obj *model.OrderFill) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderFill_pricing,
+		func(ctx context.Context) (any, error) {
+			return obj.Pricing, nil
+		},
+		nil,
+		ec.marshalOExecutionPricing2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐExecutionPricing,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderFill_pricing(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderFill",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "model":
+				return ec.fieldContext_ExecutionPricing_model(ctx, field)
+			case "referencePrice":
+				return ec.fieldContext_ExecutionPricing_referencePrice(ctx, field)
+			case "spread":
+				return ec.fieldContext_ExecutionPricing_spread(ctx, field)
+			case "impact":
+				return ec.fieldContext_ExecutionPricing_impact(ctx, field)
+			case "participation":
+				return ec.fieldContext_ExecutionPricing_participation(ctx, field)
+			case "minSpread":
+				return ec.fieldContext_ExecutionPricing_minSpread(ctx, field)
+			case "spreadRangeShare":
+				return ec.fieldContext_ExecutionPricing_spreadRangeShare(ctx, field)
+			case "impactCoefficient":
+				return ec.fieldContext_ExecutionPricing_impactCoefficient(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ExecutionPricing", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderFill_quoteSource(ctx context.Context, field graphql.CollectedField, obj *model.OrderFill) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderFill_quoteSource,
+		func(ctx context.Context) (any, error) {
+			return obj.QuoteSource, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderFill_quoteSource(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderFill",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderFill_quoteAsOf(ctx context.Context, field graphql.CollectedField, obj *model.OrderFill) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderFill_quoteAsOf,
+		func(ctx context.Context) (any, error) {
+			return obj.QuoteAsOf, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderFill_quoteAsOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderFill",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrdersResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.OrdersResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrdersResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalOOrder2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐOrderᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrdersResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrdersResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Order_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_Order_userId(ctx, field)
+			case "symbol":
+				return ec.fieldContext_Order_symbol(ctx, field)
+			case "side":
+				return ec.fieldContext_Order_side(ctx, field)
+			case "type":
+				return ec.fieldContext_Order_type(ctx, field)
+			case "status":
+				return ec.fieldContext_Order_status(ctx, field)
+			case "quantity":
+				return ec.fieldContext_Order_quantity(ctx, field)
+			case "price":
+				return ec.fieldContext_Order_price(ctx, field)
+			case "stopPrice":
+				return ec.fieldContext_Order_stopPrice(ctx, field)
+			case "filledQuantity":
+				return ec.fieldContext_Order_filledQuantity(ctx, field)
+			case "avgFillPrice":
+				return ec.fieldContext_Order_avgFillPrice(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Order_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Order_updatedAt(ctx, field)
+			case "timeInForce":
+				return ec.fieldContext_Order_timeInForce(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_Order_expiresAt(ctx, field)
+			case "trailAmount":
+				return ec.fieldContext_Order_trailAmount(ctx, field)
+			case "trailPercent":
+				return ec.fieldContext_Order_trailPercent(ctx, field)
+			case "groupId":
+				return ec.fieldContext_Order_groupId(ctx, field)
+			case "groupType":
+				return ec.fieldContext_Order_groupType(ctx, field)
+			case "parentOrderId":
+				return ec.fieldContext_Order_parentOrderId(ctx, field)
+			case "rejectCode":
+				return ec.fieldContext_Order_rejectCode(ctx, field)
+			case "rejectReason":
+				return ec.fieldContext_Order_rejectReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Order", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrdersResponse_count(ctx context.Context, field graphql.CollectedField, obj *model.OrdersResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrdersResponse_count,
+		func(ctx context.Context) (any, error) {
+			return obj.Count, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrdersResponse_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrdersResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrdersResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.OrdersResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrdersResponse_code,
+		func(ctx context.Context) (any, error) {
+			return obj.Code, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrdersResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrdersResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputAttachedOrderInput(ctx context.Context, obj any) (model.AttachedOrderInput, error) {
+	var it model.AttachedOrderInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"type", "price", "stopPrice", "trailAmount", "trailPercent"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Type = data
+		case "price":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("price"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Price = data
+		case "stopPrice":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stopPrice"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.StopPrice = data
+		case "trailAmount":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("trailAmount"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TrailAmount = data
+		case "trailPercent":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("trailPercent"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TrailPercent = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateOrderRequest(ctx context.Context, obj any) (model.CreateOrderRequest, error) {
+	var it model.CreateOrderRequest
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"symbol", "side", "type", "quantity", "price", "stopPrice", "timeInForce", "trailAmount", "trailPercent", "groupType", "attachedOrders"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
 		}
 		switch k {
 		case "symbol":
@@ -832,6 +2190,48 @@ func (ec *executionContext) unmarshalInputCreateOrderRequest(ctx context.Context
 				return it, err
 			}
 			it.Price = data
+		case "stopPrice":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stopPrice"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.StopPrice = data
+		case "timeInForce":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("timeInForce"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TimeInForce = data
+		case "trailAmount":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("trailAmount"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TrailAmount = data
+		case "trailPercent":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("trailPercent"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TrailPercent = data
+		case "groupType":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groupType"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GroupType = data
+		case "attachedOrders":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("attachedOrders"))
+			data, err := ec.unmarshalOAttachedOrderInput2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐAttachedOrderInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AttachedOrders = data
 		}
 	}
 
@@ -916,19 +2316,140 @@ func (ec *executionContext) _CancelOrderResponse(ctx context.Context, sel ast.Se
 
 var createOrderResponseImplementors = []string{"CreateOrderResponse"}
 
-func (ec *executionContext) _CreateOrderResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CreateOrderResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, createOrderResponseImplementors)
+func (ec *executionContext) _CreateOrderResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CreateOrderResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, createOrderResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CreateOrderResponse")
+		case "data":
+			out.Values[i] = ec._CreateOrderResponse_data(ctx, field, obj)
+		case "attachedOrders":
+			out.Values[i] = ec._CreateOrderResponse_attachedOrders(ctx, field, obj)
+		case "code":
+			out.Values[i] = ec._CreateOrderResponse_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var executionPricingImplementors = []string{"ExecutionPricing"}
+
+func (ec *executionContext) _ExecutionPricing(ctx context.Context, sel ast.SelectionSet, obj *model.ExecutionPricing) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, executionPricingImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ExecutionPricing")
+		case "model":
+			out.Values[i] = ec._ExecutionPricing_model(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "referencePrice":
+			out.Values[i] = ec._ExecutionPricing_referencePrice(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "spread":
+			out.Values[i] = ec._ExecutionPricing_spread(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "impact":
+			out.Values[i] = ec._ExecutionPricing_impact(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "participation":
+			out.Values[i] = ec._ExecutionPricing_participation(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "minSpread":
+			out.Values[i] = ec._ExecutionPricing_minSpread(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "spreadRangeShare":
+			out.Values[i] = ec._ExecutionPricing_spreadRangeShare(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "impactCoefficient":
+			out.Values[i] = ec._ExecutionPricing_impactCoefficient(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var getOrderByIDResponseImplementors = []string{"GetOrderByIDResponse"}
+
+func (ec *executionContext) _GetOrderByIDResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GetOrderByIDResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, getOrderByIDResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CreateOrderResponse")
+			out.Values[i] = graphql.MarshalString("GetOrderByIDResponse")
 		case "data":
-			out.Values[i] = ec._CreateOrderResponse_data(ctx, field, obj)
+			out.Values[i] = ec._GetOrderByIDResponse_data(ctx, field, obj)
+		case "fills":
+			out.Values[i] = ec._GetOrderByIDResponse_fills(ctx, field, obj)
+		case "amendments":
+			out.Values[i] = ec._GetOrderByIDResponse_amendments(ctx, field, obj)
 		case "code":
-			out.Values[i] = ec._CreateOrderResponse_code(ctx, field, obj)
+			out.Values[i] = ec._GetOrderByIDResponse_code(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -955,21 +2476,23 @@ func (ec *executionContext) _CreateOrderResponse(ctx context.Context, sel ast.Se
 	return out
 }
 
-var getOrderByIDResponseImplementors = []string{"GetOrderByIDResponse"}
+var modifyOrderResponseImplementors = []string{"ModifyOrderResponse"}
 
-func (ec *executionContext) _GetOrderByIDResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GetOrderByIDResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, getOrderByIDResponseImplementors)
+func (ec *executionContext) _ModifyOrderResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ModifyOrderResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, modifyOrderResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("GetOrderByIDResponse")
+			out.Values[i] = graphql.MarshalString("ModifyOrderResponse")
 		case "data":
-			out.Values[i] = ec._GetOrderByIDResponse_data(ctx, field, obj)
+			out.Values[i] = ec._ModifyOrderResponse_data(ctx, field, obj)
+		case "amendment":
+			out.Values[i] = ec._ModifyOrderResponse_amendment(ctx, field, obj)
 		case "code":
-			out.Values[i] = ec._GetOrderByIDResponse_code(ctx, field, obj)
+			out.Values[i] = ec._ModifyOrderResponse_code(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -1072,6 +2595,159 @@ func (ec *executionContext) _Order(ctx context.Context, sel ast.SelectionSet, ob
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "timeInForce":
+			out.Values[i] = ec._Order_timeInForce(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "expiresAt":
+			out.Values[i] = ec._Order_expiresAt(ctx, field, obj)
+		case "trailAmount":
+			out.Values[i] = ec._Order_trailAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "trailPercent":
+			out.Values[i] = ec._Order_trailPercent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "groupId":
+			out.Values[i] = ec._Order_groupId(ctx, field, obj)
+		case "groupType":
+			out.Values[i] = ec._Order_groupType(ctx, field, obj)
+		case "parentOrderId":
+			out.Values[i] = ec._Order_parentOrderId(ctx, field, obj)
+		case "rejectCode":
+			out.Values[i] = ec._Order_rejectCode(ctx, field, obj)
+		case "rejectReason":
+			out.Values[i] = ec._Order_rejectReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var orderAmendmentImplementors = []string{"OrderAmendment"}
+
+func (ec *executionContext) _OrderAmendment(ctx context.Context, sel ast.SelectionSet, obj *model.OrderAmendment) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderAmendmentImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderAmendment")
+		case "id":
+			out.Values[i] = ec._OrderAmendment_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "quantity":
+			out.Values[i] = ec._OrderAmendment_quantity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "price":
+			out.Values[i] = ec._OrderAmendment_price(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._OrderAmendment_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reason":
+			out.Values[i] = ec._OrderAmendment_reason(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._OrderAmendment_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "resolvedAt":
+			out.Values[i] = ec._OrderAmendment_resolvedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var orderFillImplementors = []string{"OrderFill"}
+
+func (ec *executionContext) _OrderFill(ctx context.Context, sel ast.SelectionSet, obj *model.OrderFill) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderFillImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderFill")
+		case "sequence":
+			out.Values[i] = ec._OrderFill_sequence(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "quantity":
+			out.Values[i] = ec._OrderFill_quantity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "price":
+			out.Values[i] = ec._OrderFill_price(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "filledAt":
+			out.Values[i] = ec._OrderFill_filledAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pricing":
+			out.Values[i] = ec._OrderFill_pricing(ctx, field, obj)
+		case "quoteSource":
+			out.Values[i] = ec._OrderFill_quoteSource(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "quoteAsOf":
+			out.Values[i] = ec._OrderFill_quoteAsOf(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -1145,6 +2821,11 @@ func (ec *executionContext) _OrdersResponse(ctx context.Context, sel ast.Selecti
 
 // region    ***************************** type.gotpl *****************************
 
+func (ec *executionContext) unmarshalNAttachedOrderInput2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐAttachedOrderInput(ctx context.Context, v any) (*model.AttachedOrderInput, error) {
+	res, err := ec.unmarshalInputAttachedOrderInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalNCancelOrderResponse2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐCancelOrderResponse(ctx context.Context, sel ast.SelectionSet, v model.CancelOrderResponse) graphql.Marshaler {
 	return ec._CancelOrderResponse(ctx, sel, &v)
 }
@@ -1197,6 +2878,20 @@ func (ec *executionContext) marshalNGetOrderByIDResponse2ᚖfafnirᚋapiᚑgatew
 	return ec._GetOrderByIDResponse(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNModifyOrderResponse2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐModifyOrderResponse(ctx context.Context, sel ast.SelectionSet, v model.ModifyOrderResponse) graphql.Marshaler {
+	return ec._ModifyOrderResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNModifyOrderResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐModifyOrderResponse(ctx context.Context, sel ast.SelectionSet, v *model.ModifyOrderResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ModifyOrderResponse(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNOrder2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐOrder(ctx context.Context, sel ast.SelectionSet, v *model.Order) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -1207,6 +2902,26 @@ func (ec *executionContext) marshalNOrder2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmo
 	return ec._Order(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNOrderAmendment2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐOrderAmendment(ctx context.Context, sel ast.SelectionSet, v *model.OrderAmendment) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._OrderAmendment(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNOrderFill2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐOrderFill(ctx context.Context, sel ast.SelectionSet, v *model.OrderFill) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._OrderFill(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNOrdersResponse2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐOrdersResponse(ctx context.Context, sel ast.SelectionSet, v model.OrdersResponse) graphql.Marshaler {
 	return ec._OrdersResponse(ctx, sel, &v)
 }
@@ -1221,6 +2936,31 @@ func (ec *executionContext) marshalNOrdersResponse2ᚖfafnirᚋapiᚑgatewayᚋg
 	return ec._OrdersResponse(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOAttachedOrderInput2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐAttachedOrderInputᚄ(ctx context.Context, v any) ([]*model.AttachedOrderInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.AttachedOrderInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNAttachedOrderInput2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐAttachedOrderInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOExecutionPricing2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐExecutionPricing(ctx context.Context, sel ast.SelectionSet, v *model.ExecutionPricing) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ExecutionPricing(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOOrder2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐOrderᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Order) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -1275,4 +3015,105 @@ func (ec *executionContext) marshalOOrder2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmo
 	return ec._Order(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOOrderAmendment2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐOrderAmendmentᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.OrderAmendment) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNOrderAmendment2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐOrderAmendment(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalOOrderAmendment2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐOrderAmendment(ctx context.Context, sel ast.SelectionSet, v *model.OrderAmendment) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._OrderAmendment(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOOrderFill2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐOrderFillᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.OrderFill) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNOrderFill2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐOrderFill(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 // endregion ***************************** type.gotpl *****************************
@@ -203,43 +203,43 @@ func (ec *executionContext) fieldContext_Account_balance(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _Account_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+func (ec *executionContext) _Account_availableBalance(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Account_createdAt,
+		ec.fieldContext_Account_availableBalance,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.AvailableBalance, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Account_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Account_availableBalance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Account",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Account_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+func (ec *executionContext) _Account_lotMethod(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Account_updatedAt,
+		ec.fieldContext_Account_lotMethod,
 		func(ctx context.Context) (any, error) {
-			return obj.UpdatedAt, nil
+			return obj.LotMethod, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -248,7 +248,7 @@ func (ec *executionContext) _Account_updatedAt(ctx context.Context, field graphq
 	)
 }
 
-func (ec *executionContext) fieldContext_Account_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Account_lotMethod(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Account",
 		Field:      field,
@@ -261,14 +261,14 @@ func (ec *executionContext) fieldContext_Account_updatedAt(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _AddToWatchlistResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.AddToWatchlistResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Account_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AddToWatchlistResponse_code,
+		ec.fieldContext_Account_createdAt,
 		func(ctx context.Context) (any, error) {
-			return obj.Code, nil
+			return obj.CreatedAt, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -277,9 +277,9 @@ func (ec *executionContext) _AddToWatchlistResponse_code(ctx context.Context, fi
 	)
 }
 
-func (ec *executionContext) fieldContext_AddToWatchlistResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Account_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AddToWatchlistResponse",
+		Object:     "Account",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -290,61 +290,43 @@ func (ec *executionContext) fieldContext_AddToWatchlistResponse_code(_ context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _CreateAccountResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.CreateAccountResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Account_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CreateAccountResponse_data,
+		ec.fieldContext_Account_updatedAt,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			return obj.UpdatedAt, nil
 		},
 		nil,
-		ec.marshalOAccount2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐAccount,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_CreateAccountResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Account_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CreateAccountResponse",
+		Object:     "Account",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Account_id(ctx, field)
-			case "userId":
-				return ec.fieldContext_Account_userId(ctx, field)
-			case "accountNumber":
-				return ec.fieldContext_Account_accountNumber(ctx, field)
-			case "type":
-				return ec.fieldContext_Account_type(ctx, field)
-			case "currency":
-				return ec.fieldContext_Account_currency(ctx, field)
-			case "balance":
-				return ec.fieldContext_Account_balance(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Account_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Account_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Account", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CreateAccountResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.CreateAccountResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountPerformance_accountId(ctx context.Context, field graphql.CollectedField, obj *model.AccountPerformance) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CreateAccountResponse_code,
+		ec.fieldContext_AccountPerformance_accountId,
 		func(ctx context.Context) (any, error) {
-			return obj.Code, nil
+			return obj.AccountID, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -353,9 +335,9 @@ func (ec *executionContext) _CreateAccountResponse_code(ctx context.Context, fie
 	)
 }
 
-func (ec *executionContext) fieldContext_CreateAccountResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountPerformance_accountId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CreateAccountResponse",
+		Object:     "AccountPerformance",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -366,14 +348,14 @@ func (ec *executionContext) fieldContext_CreateAccountResponse_code(_ context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _DepositResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.DepositResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountPerformance_currency(ctx context.Context, field graphql.CollectedField, obj *model.AccountPerformance) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DepositResponse_code,
+		ec.fieldContext_AccountPerformance_currency,
 		func(ctx context.Context) (any, error) {
-			return obj.Code, nil
+			return obj.Currency, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -382,9 +364,9 @@ func (ec *executionContext) _DepositResponse_code(ctx context.Context, field gra
 	)
 }
 
-func (ec *executionContext) fieldContext_DepositResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountPerformance_currency(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DepositResponse",
+		Object:     "AccountPerformance",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -395,238 +377,188 @@ func (ec *executionContext) fieldContext_DepositResponse_code(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _DepositResponse_newBalance(ctx context.Context, field graphql.CollectedField, obj *model.DepositResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountPerformance_startDate(ctx context.Context, field graphql.CollectedField, obj *model.AccountPerformance) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DepositResponse_newBalance,
+		ec.fieldContext_AccountPerformance_startDate,
 		func(ctx context.Context) (any, error) {
-			return obj.NewBalance, nil
+			return obj.StartDate, nil
 		},
 		nil,
-		ec.marshalNFloat2float64,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_DepositResponse_newBalance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountPerformance_startDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DepositResponse",
+		Object:     "AccountPerformance",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GetHoldingResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.GetHoldingResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountPerformance_endDate(ctx context.Context, field graphql.CollectedField, obj *model.AccountPerformance) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GetHoldingResponse_data,
+		ec.fieldContext_AccountPerformance_endDate,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			return obj.EndDate, nil
 		},
 		nil,
-		ec.marshalOHolding2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐHolding,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GetHoldingResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountPerformance_endDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GetHoldingResponse",
+		Object:     "AccountPerformance",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Holding_id(ctx, field)
-			case "accountId":
-				return ec.fieldContext_Holding_accountId(ctx, field)
-			case "symbol":
-				return ec.fieldContext_Holding_symbol(ctx, field)
-			case "quantity":
-				return ec.fieldContext_Holding_quantity(ctx, field)
-			case "avgCost":
-				return ec.fieldContext_Holding_avgCost(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Holding_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Holding_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Holding", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GetHoldingResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.GetHoldingResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountPerformance_startEquity(ctx context.Context, field graphql.CollectedField, obj *model.AccountPerformance) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GetHoldingResponse_code,
+		ec.fieldContext_AccountPerformance_startEquity,
 		func(ctx context.Context) (any, error) {
-			return obj.Code, nil
+			return obj.StartEquity, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_GetHoldingResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountPerformance_startEquity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GetHoldingResponse",
+		Object:     "AccountPerformance",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GetHoldingsResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.GetHoldingsResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountPerformance_endEquity(ctx context.Context, field graphql.CollectedField, obj *model.AccountPerformance) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GetHoldingsResponse_data,
+		ec.fieldContext_AccountPerformance_endEquity,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			return obj.EndEquity, nil
 		},
 		nil,
-		ec.marshalOHolding2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐHoldingᚄ,
+		ec.marshalNFloat2float64,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GetHoldingsResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountPerformance_endEquity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GetHoldingsResponse",
+		Object:     "AccountPerformance",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Holding_id(ctx, field)
-			case "accountId":
-				return ec.fieldContext_Holding_accountId(ctx, field)
-			case "symbol":
-				return ec.fieldContext_Holding_symbol(ctx, field)
-			case "quantity":
-				return ec.fieldContext_Holding_quantity(ctx, field)
-			case "avgCost":
-				return ec.fieldContext_Holding_avgCost(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Holding_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Holding_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Holding", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GetHoldingsResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.GetHoldingsResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountPerformance_netFlows(ctx context.Context, field graphql.CollectedField, obj *model.AccountPerformance) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GetHoldingsResponse_code,
+		ec.fieldContext_AccountPerformance_netFlows,
 		func(ctx context.Context) (any, error) {
-			return obj.Code, nil
+			return obj.NetFlows, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_GetHoldingsResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountPerformance_netFlows(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GetHoldingsResponse",
+		Object:     "AccountPerformance",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GetPortfolioSummaryResponse_accounts(ctx context.Context, field graphql.CollectedField, obj *model.GetPortfolioSummaryResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountPerformance_gain(ctx context.Context, field graphql.CollectedField, obj *model.AccountPerformance) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GetPortfolioSummaryResponse_accounts,
+		ec.fieldContext_AccountPerformance_gain,
 		func(ctx context.Context) (any, error) {
-			return obj.Accounts, nil
+			return obj.Gain, nil
 		},
 		nil,
-		ec.marshalOAccount2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐAccountᚄ,
+		ec.marshalNFloat2float64,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GetPortfolioSummaryResponse_accounts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountPerformance_gain(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GetPortfolioSummaryResponse",
+		Object:     "AccountPerformance",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Account_id(ctx, field)
-			case "userId":
-				return ec.fieldContext_Account_userId(ctx, field)
-			case "accountNumber":
-				return ec.fieldContext_Account_accountNumber(ctx, field)
-			case "type":
-				return ec.fieldContext_Account_type(ctx, field)
-			case "currency":
-				return ec.fieldContext_Account_currency(ctx, field)
-			case "balance":
-				return ec.fieldContext_Account_balance(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Account_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Account_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Account", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GetPortfolioSummaryResponse_totalBalance(ctx context.Context, field graphql.CollectedField, obj *model.GetPortfolioSummaryResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountPerformance_timeWeightedReturnPercent(ctx context.Context, field graphql.CollectedField, obj *model.AccountPerformance) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GetPortfolioSummaryResponse_totalBalance,
+		ec.fieldContext_AccountPerformance_timeWeightedReturnPercent,
 		func(ctx context.Context) (any, error) {
-			return obj.TotalBalance, nil
+			return obj.TimeWeightedReturnPercent, nil
 		},
 		nil,
 		ec.marshalNFloat2float64,
@@ -635,9 +567,9 @@ func (ec *executionContext) _GetPortfolioSummaryResponse_totalBalance(ctx contex
 	)
 }
 
-func (ec *executionContext) fieldContext_GetPortfolioSummaryResponse_totalBalance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountPerformance_timeWeightedReturnPercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GetPortfolioSummaryResponse",
+		Object:     "AccountPerformance",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -648,268 +580,260 @@ func (ec *executionContext) fieldContext_GetPortfolioSummaryResponse_totalBalanc
 	return fc, nil
 }
 
-func (ec *executionContext) _GetPortfolioSummaryResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.GetPortfolioSummaryResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountPerformance_moneyWeightedReturnPercent(ctx context.Context, field graphql.CollectedField, obj *model.AccountPerformance) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GetPortfolioSummaryResponse_code,
+		ec.fieldContext_AccountPerformance_moneyWeightedReturnPercent,
 		func(ctx context.Context) (any, error) {
-			return obj.Code, nil
+			return obj.MoneyWeightedReturnPercent, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_GetPortfolioSummaryResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountPerformance_moneyWeightedReturnPercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GetPortfolioSummaryResponse",
+		Object:     "AccountPerformance",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GetTransactionsResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.GetTransactionsResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountPerformance_points(ctx context.Context, field graphql.CollectedField, obj *model.AccountPerformance) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GetTransactionsResponse_code,
+		ec.fieldContext_AccountPerformance_points,
 		func(ctx context.Context) (any, error) {
-			return obj.Code, nil
+			return obj.Points, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNPerformancePoint2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐPerformancePointᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_GetTransactionsResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountPerformance_points(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GetTransactionsResponse",
+		Object:     "AccountPerformance",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "date":
+				return ec.fieldContext_PerformancePoint_date(ctx, field)
+			case "cashBalance":
+				return ec.fieldContext_PerformancePoint_cashBalance(ctx, field)
+			case "marketValue":
+				return ec.fieldContext_PerformancePoint_marketValue(ctx, field)
+			case "totalEquity":
+				return ec.fieldContext_PerformancePoint_totalEquity(ctx, field)
+			case "netFlow":
+				return ec.fieldContext_PerformancePoint_netFlow(ctx, field)
+			case "cumulativeReturnPercent":
+				return ec.fieldContext_PerformancePoint_cumulativeReturnPercent(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PerformancePoint", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GetTransactionsResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.GetTransactionsResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountValuation_accountId(ctx context.Context, field graphql.CollectedField, obj *model.AccountValuation) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GetTransactionsResponse_data,
+		ec.fieldContext_AccountValuation_accountId,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			return obj.AccountID, nil
 		},
 		nil,
-		ec.marshalOTransaction2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐTransactionᚄ,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GetTransactionsResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountValuation_accountId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GetTransactionsResponse",
+		Object:     "AccountValuation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Transaction_id(ctx, field)
-			case "accountId":
-				return ec.fieldContext_Transaction_accountId(ctx, field)
-			case "type":
-				return ec.fieldContext_Transaction_type(ctx, field)
-			case "amount":
-				return ec.fieldContext_Transaction_amount(ctx, field)
-			case "description":
-				return ec.fieldContext_Transaction_description(ctx, field)
-			case "referenceId":
-				return ec.fieldContext_Transaction_referenceId(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Transaction_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Transaction", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GetWatchlistResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.GetWatchlistResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountValuation_currency(ctx context.Context, field graphql.CollectedField, obj *model.AccountValuation) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GetWatchlistResponse_data,
+		ec.fieldContext_AccountValuation_currency,
 		func(ctx context.Context) (any, error) {
-			return obj.Data, nil
+			return obj.Currency, nil
 		},
 		nil,
-		ec.marshalOWatchlistItem2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐWatchlistItemᚄ,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_GetWatchlistResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountValuation_currency(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GetWatchlistResponse",
+		Object:     "AccountValuation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "symbol":
-				return ec.fieldContext_WatchlistItem_symbol(ctx, field)
-			case "addedAt":
-				return ec.fieldContext_WatchlistItem_addedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type WatchlistItem", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _GetWatchlistResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.GetWatchlistResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountValuation_cashBalance(ctx context.Context, field graphql.CollectedField, obj *model.AccountValuation) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_GetWatchlistResponse_code,
+		ec.fieldContext_AccountValuation_cashBalance,
 		func(ctx context.Context) (any, error) {
-			return obj.Code, nil
+			return obj.CashBalance, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_GetWatchlistResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountValuation_cashBalance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "GetWatchlistResponse",
+		Object:     "AccountValuation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Holding_id(ctx context.Context, field graphql.CollectedField, obj *model.Holding) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountValuation_marketValue(ctx context.Context, field graphql.CollectedField, obj *model.AccountValuation) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Holding_id,
+		ec.fieldContext_AccountValuation_marketValue,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.MarketValue, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Holding_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountValuation_marketValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Holding",
+		Object:     "AccountValuation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Holding_accountId(ctx context.Context, field graphql.CollectedField, obj *model.Holding) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountValuation_costBasis(ctx context.Context, field graphql.CollectedField, obj *model.AccountValuation) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Holding_accountId,
+		ec.fieldContext_AccountValuation_costBasis,
 		func(ctx context.Context) (any, error) {
-			return obj.AccountID, nil
+			return obj.CostBasis, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Holding_accountId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountValuation_costBasis(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Holding",
+		Object:     "AccountValuation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Holding_symbol(ctx context.Context, field graphql.CollectedField, obj *model.Holding) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountValuation_unrealizedPnl(ctx context.Context, field graphql.CollectedField, obj *model.AccountValuation) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Holding_symbol,
+		ec.fieldContext_AccountValuation_unrealizedPnl,
 		func(ctx context.Context) (any, error) {
-			return obj.Symbol, nil
+			return obj.UnrealizedPnl, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Holding_symbol(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountValuation_unrealizedPnl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Holding",
+		Object:     "AccountValuation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Holding_quantity(ctx context.Context, field graphql.CollectedField, obj *model.Holding) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountValuation_unrealizedPnlPercent(ctx context.Context, field graphql.CollectedField, obj *model.AccountValuation) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Holding_quantity,
+		ec.fieldContext_AccountValuation_unrealizedPnlPercent,
 		func(ctx context.Context) (any, error) {
-			return obj.Quantity, nil
+			return obj.UnrealizedPnlPercent, nil
 		},
 		nil,
 		ec.marshalNFloat2float64,
@@ -918,9 +842,9 @@ func (ec *executionContext) _Holding_quantity(ctx context.Context, field graphql
 	)
 }
 
-func (ec *executionContext) fieldContext_Holding_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountValuation_unrealizedPnlPercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Holding",
+		Object:     "AccountValuation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -931,14 +855,14 @@ func (ec *executionContext) fieldContext_Holding_quantity(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Holding_avgCost(ctx context.Context, field graphql.CollectedField, obj *model.Holding) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountValuation_dayChange(ctx context.Context, field graphql.CollectedField, obj *model.AccountValuation) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Holding_avgCost,
+		ec.fieldContext_AccountValuation_dayChange,
 		func(ctx context.Context) (any, error) {
-			return obj.AvgCost, nil
+			return obj.DayChange, nil
 		},
 		nil,
 		ec.marshalNFloat2float64,
@@ -947,9 +871,9 @@ func (ec *executionContext) _Holding_avgCost(ctx context.Context, field graphql.
 	)
 }
 
-func (ec *executionContext) fieldContext_Holding_avgCost(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountValuation_dayChange(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Holding",
+		Object:     "AccountValuation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -960,101 +884,131 @@ func (ec *executionContext) fieldContext_Holding_avgCost(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _Holding_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Holding) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountValuation_dayChangePercent(ctx context.Context, field graphql.CollectedField, obj *model.AccountValuation) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Holding_createdAt,
+		ec.fieldContext_AccountValuation_dayChangePercent,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.DayChangePercent, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Holding_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountValuation_dayChangePercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Holding",
+		Object:     "AccountValuation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Holding_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Holding) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountValuation_totalEquity(ctx context.Context, field graphql.CollectedField, obj *model.AccountValuation) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Holding_updatedAt,
+		ec.fieldContext_AccountValuation_totalEquity,
 		func(ctx context.Context) (any, error) {
-			return obj.UpdatedAt, nil
+			return obj.TotalEquity, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Holding_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountValuation_totalEquity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Holding",
+		Object:     "AccountValuation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RemoveFromWatchlistResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.RemoveFromWatchlistResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _AccountValuation_holdings(ctx context.Context, field graphql.CollectedField, obj *model.AccountValuation) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RemoveFromWatchlistResponse_code,
+		ec.fieldContext_AccountValuation_holdings,
 		func(ctx context.Context) (any, error) {
-			return obj.Code, nil
+			return obj.Holdings, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNHoldingValuation2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐHoldingValuationᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RemoveFromWatchlistResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AccountValuation_holdings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RemoveFromWatchlistResponse",
+		Object:     "AccountValuation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "symbol":
+				return ec.fieldContext_HoldingValuation_symbol(ctx, field)
+			case "quantity":
+				return ec.fieldContext_HoldingValuation_quantity(ctx, field)
+			case "avgCost":
+				return ec.fieldContext_HoldingValuation_avgCost(ctx, field)
+			case "quoteCurrency":
+				return ec.fieldContext_HoldingValuation_quoteCurrency(ctx, field)
+			case "exchangeRate":
+				return ec.fieldContext_HoldingValuation_exchangeRate(ctx, field)
+			case "lastPrice":
+				return ec.fieldContext_HoldingValuation_lastPrice(ctx, field)
+			case "marketValue":
+				return ec.fieldContext_HoldingValuation_marketValue(ctx, field)
+			case "costBasis":
+				return ec.fieldContext_HoldingValuation_costBasis(ctx, field)
+			case "unrealizedPnl":
+				return ec.fieldContext_HoldingValuation_unrealizedPnl(ctx, field)
+			case "unrealizedPnlPercent":
+				return ec.fieldContext_HoldingValuation_unrealizedPnlPercent(ctx, field)
+			case "dayChange":
+				return ec.fieldContext_HoldingValuation_dayChange(ctx, field)
+			case "dayChangePercent":
+				return ec.fieldContext_HoldingValuation_dayChangePercent(ctx, field)
+			case "priced":
+				return ec.fieldContext_HoldingValuation_priced(ctx, field)
+			case "quoteAsOf":
+				return ec.fieldContext_HoldingValuation_quoteAsOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type HoldingValuation", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Transaction_id(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _AddToWatchlistResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.AddToWatchlistResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Transaction_id,
+		ec.fieldContext_AddToWatchlistResponse_code,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.Code, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1063,9 +1017,9 @@ func (ec *executionContext) _Transaction_id(ctx context.Context, field graphql.C
 	)
 }
 
-func (ec *executionContext) fieldContext_Transaction_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AddToWatchlistResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transaction",
+		Object:     "AddToWatchlistResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1076,43 +1030,65 @@ func (ec *executionContext) fieldContext_Transaction_id(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Transaction_accountId(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _CreateAccountResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.CreateAccountResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Transaction_accountId,
+		ec.fieldContext_CreateAccountResponse_data,
 		func(ctx context.Context) (any, error) {
-			return obj.AccountID, nil
+			return obj.Data, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOAccount2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐAccount,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Transaction_accountId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CreateAccountResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transaction",
+		Object:     "CreateAccountResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Account_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_Account_userId(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Account_accountNumber(ctx, field)
+			case "type":
+				return ec.fieldContext_Account_type(ctx, field)
+			case "currency":
+				return ec.fieldContext_Account_currency(ctx, field)
+			case "balance":
+				return ec.fieldContext_Account_balance(ctx, field)
+			case "availableBalance":
+				return ec.fieldContext_Account_availableBalance(ctx, field)
+			case "lotMethod":
+				return ec.fieldContext_Account_lotMethod(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Account_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Account_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Account", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Transaction_type(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _CreateAccountResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.CreateAccountResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Transaction_type,
+		ec.fieldContext_CreateAccountResponse_code,
 		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
+			return obj.Code, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1121,9 +1097,9 @@ func (ec *executionContext) _Transaction_type(ctx context.Context, field graphql
 	)
 }
 
-func (ec *executionContext) fieldContext_Transaction_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CreateAccountResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transaction",
+		Object:     "CreateAccountResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1134,101 +1110,119 @@ func (ec *executionContext) fieldContext_Transaction_type(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Transaction_amount(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _DepositResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.DepositResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Transaction_amount,
+		ec.fieldContext_DepositResponse_code,
 		func(ctx context.Context) (any, error) {
-			return obj.Amount, nil
+			return obj.Code, nil
 		},
 		nil,
-		ec.marshalNFloat2float64,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Transaction_amount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DepositResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transaction",
+		Object:     "DepositResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Transaction_description(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _DepositResponse_newBalance(ctx context.Context, field graphql.CollectedField, obj *model.DepositResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Transaction_description,
+		ec.fieldContext_DepositResponse_newBalance,
 		func(ctx context.Context) (any, error) {
-			return obj.Description, nil
+			return obj.NewBalance, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Transaction_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DepositResponse_newBalance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transaction",
+		Object:     "DepositResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Transaction_referenceId(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _GetHoldingResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.GetHoldingResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Transaction_referenceId,
+		ec.fieldContext_GetHoldingResponse_data,
 		func(ctx context.Context) (any, error) {
-			return obj.ReferenceID, nil
+			return obj.Data, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOHolding2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐHolding,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Transaction_referenceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GetHoldingResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transaction",
+		Object:     "GetHoldingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Holding_id(ctx, field)
+			case "accountId":
+				return ec.fieldContext_Holding_accountId(ctx, field)
+			case "symbol":
+				return ec.fieldContext_Holding_symbol(ctx, field)
+			case "quantity":
+				return ec.fieldContext_Holding_quantity(ctx, field)
+			case "avgCost":
+				return ec.fieldContext_Holding_avgCost(ctx, field)
+			case "availableQuantity":
+				return ec.fieldContext_Holding_availableQuantity(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Holding_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Holding_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Holding", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Transaction_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _GetHoldingResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.GetHoldingResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Transaction_createdAt,
+		ec.fieldContext_GetHoldingResponse_code,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.Code, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1237,9 +1231,9 @@ func (ec *executionContext) _Transaction_createdAt(ctx context.Context, field gr
 	)
 }
 
-func (ec *executionContext) fieldContext_Transaction_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GetHoldingResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Transaction",
+		Object:     "GetHoldingResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1250,43 +1244,61 @@ func (ec *executionContext) fieldContext_Transaction_createdAt(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _TransferResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.TransferResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _GetHoldingsResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.GetHoldingsResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_TransferResponse_code,
+		ec.fieldContext_GetHoldingsResponse_data,
 		func(ctx context.Context) (any, error) {
-			return obj.Code, nil
+			return obj.Data, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOHolding2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐHoldingᚄ,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_TransferResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GetHoldingsResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TransferResponse",
+		Object:     "GetHoldingsResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Holding_id(ctx, field)
+			case "accountId":
+				return ec.fieldContext_Holding_accountId(ctx, field)
+			case "symbol":
+				return ec.fieldContext_Holding_symbol(ctx, field)
+			case "quantity":
+				return ec.fieldContext_Holding_quantity(ctx, field)
+			case "avgCost":
+				return ec.fieldContext_Holding_avgCost(ctx, field)
+			case "availableQuantity":
+				return ec.fieldContext_Holding_availableQuantity(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Holding_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Holding_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Holding", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _WatchlistItem_symbol(ctx context.Context, field graphql.CollectedField, obj *model.WatchlistItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _GetHoldingsResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.GetHoldingsResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_WatchlistItem_symbol,
+		ec.fieldContext_GetHoldingsResponse_code,
 		func(ctx context.Context) (any, error) {
-			return obj.Symbol, nil
+			return obj.Code, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1295,9 +1307,9 @@ func (ec *executionContext) _WatchlistItem_symbol(ctx context.Context, field gra
 	)
 }
 
-func (ec *executionContext) fieldContext_WatchlistItem_symbol(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GetHoldingsResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WatchlistItem",
+		Object:     "GetHoldingsResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1308,14 +1320,14 @@ func (ec *executionContext) fieldContext_WatchlistItem_symbol(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _WatchlistItem_addedAt(ctx context.Context, field graphql.CollectedField, obj *model.WatchlistItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _GetPortfolioPerformanceResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.GetPortfolioPerformanceResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_WatchlistItem_addedAt,
+		ec.fieldContext_GetPortfolioPerformanceResponse_code,
 		func(ctx context.Context) (any, error) {
-			return obj.AddedAt, nil
+			return obj.Code, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1324,9 +1336,9 @@ func (ec *executionContext) _WatchlistItem_addedAt(ctx context.Context, field gr
 	)
 }
 
-func (ec *executionContext) fieldContext_WatchlistItem_addedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_GetPortfolioPerformanceResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WatchlistItem",
+		Object:     "GetPortfolioPerformanceResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1337,334 +1349,3818 @@ func (ec *executionContext) fieldContext_WatchlistItem_addedAt(_ context.Context
 	return fc, nil
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
+func (ec *executionContext) _GetPortfolioPerformanceResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.GetPortfolioPerformanceResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GetPortfolioPerformanceResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalOAccountPerformance2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐAccountPerformanceᚄ,
+		true,
+		false,
+	)
+}
 
-func (ec *executionContext) unmarshalInputAddToWatchlistRequest(ctx context.Context, obj any) (model.AddToWatchlistRequest, error) {
-	var it model.AddToWatchlistRequest
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
+func (ec *executionContext) fieldContext_GetPortfolioPerformanceResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetPortfolioPerformanceResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "accountId":
+				return ec.fieldContext_AccountPerformance_accountId(ctx, field)
+			case "currency":
+				return ec.fieldContext_AccountPerformance_currency(ctx, field)
+			case "startDate":
+				return ec.fieldContext_AccountPerformance_startDate(ctx, field)
+			case "endDate":
+				return ec.fieldContext_AccountPerformance_endDate(ctx, field)
+			case "startEquity":
+				return ec.fieldContext_AccountPerformance_startEquity(ctx, field)
+			case "endEquity":
+				return ec.fieldContext_AccountPerformance_endEquity(ctx, field)
+			case "netFlows":
+				return ec.fieldContext_AccountPerformance_netFlows(ctx, field)
+			case "gain":
+				return ec.fieldContext_AccountPerformance_gain(ctx, field)
+			case "timeWeightedReturnPercent":
+				return ec.fieldContext_AccountPerformance_timeWeightedReturnPercent(ctx, field)
+			case "moneyWeightedReturnPercent":
+				return ec.fieldContext_AccountPerformance_moneyWeightedReturnPercent(ctx, field)
+			case "points":
+				return ec.fieldContext_AccountPerformance_points(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AccountPerformance", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GetPortfolioSummaryResponse_accounts(ctx context.Context, field graphql.CollectedField, obj *model.GetPortfolioSummaryResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GetPortfolioSummaryResponse_accounts,
+		func(ctx context.Context) (any, error) {
+			return obj.Accounts, nil
+		},
+		nil,
+		ec.marshalOAccount2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐAccountᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GetPortfolioSummaryResponse_accounts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetPortfolioSummaryResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Account_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_Account_userId(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Account_accountNumber(ctx, field)
+			case "type":
+				return ec.fieldContext_Account_type(ctx, field)
+			case "currency":
+				return ec.fieldContext_Account_currency(ctx, field)
+			case "balance":
+				return ec.fieldContext_Account_balance(ctx, field)
+			case "availableBalance":
+				return ec.fieldContext_Account_availableBalance(ctx, field)
+			case "lotMethod":
+				return ec.fieldContext_Account_lotMethod(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Account_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Account_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Account", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GetPortfolioSummaryResponse_totalBalance(ctx context.Context, field graphql.CollectedField, obj *model.GetPortfolioSummaryResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GetPortfolioSummaryResponse_totalBalance,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalBalance, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GetPortfolioSummaryResponse_totalBalance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetPortfolioSummaryResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GetPortfolioSummaryResponse_totalFees(ctx context.Context, field graphql.CollectedField, obj *model.GetPortfolioSummaryResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GetPortfolioSummaryResponse_totalFees,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalFees, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GetPortfolioSummaryResponse_totalFees(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetPortfolioSummaryResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GetPortfolioSummaryResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.GetPortfolioSummaryResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GetPortfolioSummaryResponse_code,
+		func(ctx context.Context) (any, error) {
+			return obj.Code, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GetPortfolioSummaryResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetPortfolioSummaryResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GetPortfolioValuationResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.GetPortfolioValuationResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GetPortfolioValuationResponse_code,
+		func(ctx context.Context) (any, error) {
+			return obj.Code, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GetPortfolioValuationResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetPortfolioValuationResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GetPortfolioValuationResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.GetPortfolioValuationResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GetPortfolioValuationResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalOAccountValuation2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐAccountValuationᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GetPortfolioValuationResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetPortfolioValuationResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "accountId":
+				return ec.fieldContext_AccountValuation_accountId(ctx, field)
+			case "currency":
+				return ec.fieldContext_AccountValuation_currency(ctx, field)
+			case "cashBalance":
+				return ec.fieldContext_AccountValuation_cashBalance(ctx, field)
+			case "marketValue":
+				return ec.fieldContext_AccountValuation_marketValue(ctx, field)
+			case "costBasis":
+				return ec.fieldContext_AccountValuation_costBasis(ctx, field)
+			case "unrealizedPnl":
+				return ec.fieldContext_AccountValuation_unrealizedPnl(ctx, field)
+			case "unrealizedPnlPercent":
+				return ec.fieldContext_AccountValuation_unrealizedPnlPercent(ctx, field)
+			case "dayChange":
+				return ec.fieldContext_AccountValuation_dayChange(ctx, field)
+			case "dayChangePercent":
+				return ec.fieldContext_AccountValuation_dayChangePercent(ctx, field)
+			case "totalEquity":
+				return ec.fieldContext_AccountValuation_totalEquity(ctx, field)
+			case "holdings":
+				return ec.fieldContext_AccountValuation_holdings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AccountValuation", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GetPortfolioValuationResponse_valuedAt(ctx context.Context, field graphql.CollectedField, obj *model.GetPortfolioValuationResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GetPortfolioValuationResponse_valuedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.ValuedAt, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GetPortfolioValuationResponse_valuedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetPortfolioValuationResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GetRealizedGainsResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.GetRealizedGainsResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GetRealizedGainsResponse_code,
+		func(ctx context.Context) (any, error) {
+			return obj.Code, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GetRealizedGainsResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetRealizedGainsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GetRealizedGainsResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.GetRealizedGainsResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GetRealizedGainsResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalORealizedGain2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐRealizedGainᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GetRealizedGainsResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetRealizedGainsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RealizedGain_id(ctx, field)
+			case "accountId":
+				return ec.fieldContext_RealizedGain_accountId(ctx, field)
+			case "symbol":
+				return ec.fieldContext_RealizedGain_symbol(ctx, field)
+			case "lotId":
+				return ec.fieldContext_RealizedGain_lotId(ctx, field)
+			case "orderId":
+				return ec.fieldContext_RealizedGain_orderId(ctx, field)
+			case "quantity":
+				return ec.fieldContext_RealizedGain_quantity(ctx, field)
+			case "proceeds":
+				return ec.fieldContext_RealizedGain_proceeds(ctx, field)
+			case "costBasis":
+				return ec.fieldContext_RealizedGain_costBasis(ctx, field)
+			case "realizedPnl":
+				return ec.fieldContext_RealizedGain_realizedPnl(ctx, field)
+			case "method":
+				return ec.fieldContext_RealizedGain_method(ctx, field)
+			case "openedAt":
+				return ec.fieldContext_RealizedGain_openedAt(ctx, field)
+			case "realizedAt":
+				return ec.fieldContext_RealizedGain_realizedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RealizedGain", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GetRealizedGainsResponse_bySymbol(ctx context.Context, field graphql.CollectedField, obj *model.GetRealizedGainsResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GetRealizedGainsResponse_bySymbol,
+		func(ctx context.Context) (any, error) {
+			return obj.BySymbol, nil
+		},
+		nil,
+		ec.marshalOSymbolRealizedGain2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐSymbolRealizedGainᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GetRealizedGainsResponse_bySymbol(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetRealizedGainsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "symbol":
+				return ec.fieldContext_SymbolRealizedGain_symbol(ctx, field)
+			case "quantity":
+				return ec.fieldContext_SymbolRealizedGain_quantity(ctx, field)
+			case "proceeds":
+				return ec.fieldContext_SymbolRealizedGain_proceeds(ctx, field)
+			case "costBasis":
+				return ec.fieldContext_SymbolRealizedGain_costBasis(ctx, field)
+			case "realizedPnl":
+				return ec.fieldContext_SymbolRealizedGain_realizedPnl(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SymbolRealizedGain", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GetRealizedGainsResponse_totalRealizedPnl(ctx context.Context, field graphql.CollectedField, obj *model.GetRealizedGainsResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GetRealizedGainsResponse_totalRealizedPnl,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalRealizedPnl, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GetRealizedGainsResponse_totalRealizedPnl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetRealizedGainsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GetTaxLotsResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.GetTaxLotsResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GetTaxLotsResponse_code,
+		func(ctx context.Context) (any, error) {
+			return obj.Code, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GetTaxLotsResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetTaxLotsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GetTaxLotsResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.GetTaxLotsResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GetTaxLotsResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalOTaxLot2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐTaxLotᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GetTaxLotsResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetTaxLotsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TaxLot_id(ctx, field)
+			case "accountId":
+				return ec.fieldContext_TaxLot_accountId(ctx, field)
+			case "symbol":
+				return ec.fieldContext_TaxLot_symbol(ctx, field)
+			case "orderId":
+				return ec.fieldContext_TaxLot_orderId(ctx, field)
+			case "quantity":
+				return ec.fieldContext_TaxLot_quantity(ctx, field)
+			case "remainingQuantity":
+				return ec.fieldContext_TaxLot_remainingQuantity(ctx, field)
+			case "costBasis":
+				return ec.fieldContext_TaxLot_costBasis(ctx, field)
+			case "openedAt":
+				return ec.fieldContext_TaxLot_openedAt(ctx, field)
+			case "closedAt":
+				return ec.fieldContext_TaxLot_closedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TaxLot", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GetTransactionsResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.GetTransactionsResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GetTransactionsResponse_code,
+		func(ctx context.Context) (any, error) {
+			return obj.Code, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GetTransactionsResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetTransactionsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GetTransactionsResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.GetTransactionsResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GetTransactionsResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalOTransaction2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐTransactionᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GetTransactionsResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetTransactionsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Transaction_id(ctx, field)
+			case "accountId":
+				return ec.fieldContext_Transaction_accountId(ctx, field)
+			case "type":
+				return ec.fieldContext_Transaction_type(ctx, field)
+			case "amount":
+				return ec.fieldContext_Transaction_amount(ctx, field)
+			case "description":
+				return ec.fieldContext_Transaction_description(ctx, field)
+			case "referenceId":
+				return ec.fieldContext_Transaction_referenceId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Transaction_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Transaction", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GetTransactionsResponse_totalFees(ctx context.Context, field graphql.CollectedField, obj *model.GetTransactionsResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GetTransactionsResponse_totalFees,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalFees, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GetTransactionsResponse_totalFees(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetTransactionsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GetWatchlistResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.GetWatchlistResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GetWatchlistResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalOWatchlistItem2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐWatchlistItemᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GetWatchlistResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetWatchlistResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "symbol":
+				return ec.fieldContext_WatchlistItem_symbol(ctx, field)
+			case "addedAt":
+				return ec.fieldContext_WatchlistItem_addedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WatchlistItem", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GetWatchlistResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.GetWatchlistResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GetWatchlistResponse_code,
+		func(ctx context.Context) (any, error) {
+			return obj.Code, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GetWatchlistResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GetWatchlistResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Holding_id(ctx context.Context, field graphql.CollectedField, obj *model.Holding) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Holding_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Holding_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Holding",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Holding_accountId(ctx context.Context, field graphql.CollectedField, obj *model.Holding) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Holding_accountId,
+		func(ctx context.Context) (any, error) {
+			return obj.AccountID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Holding_accountId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Holding",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Holding_symbol(ctx context.Context, field graphql.CollectedField, obj *model.Holding) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Holding_symbol,
+		func(ctx context.Context) (any, error) {
+			return obj.Symbol, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Holding_symbol(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Holding",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Holding_quantity(ctx context.Context, field graphql.CollectedField, obj *model.Holding) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Holding_quantity,
+		func(ctx context.Context) (any, error) {
+			return obj.Quantity, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Holding_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Holding",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Holding_avgCost(ctx context.Context, field graphql.CollectedField, obj *model.Holding) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Holding_avgCost,
+		func(ctx context.Context) (any, error) {
+			return obj.AvgCost, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Holding_avgCost(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Holding",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Holding_availableQuantity(ctx context.Context, field graphql.CollectedField, obj *model.Holding) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Holding_availableQuantity,
+		func(ctx context.Context) (any, error) {
+			return obj.AvailableQuantity, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Holding_availableQuantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Holding",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Holding_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Holding) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Holding_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Holding_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Holding",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Holding_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Holding) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Holding_updatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Holding_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Holding",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HoldingValuation_symbol(ctx context.Context, field graphql.CollectedField, obj *model.HoldingValuation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HoldingValuation_symbol,
+		func(ctx context.Context) (any, error) {
+			return obj.Symbol, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HoldingValuation_symbol(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HoldingValuation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HoldingValuation_quantity(ctx context.Context, field graphql.CollectedField, obj *model.HoldingValuation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HoldingValuation_quantity,
+		func(ctx context.Context) (any, error) {
+			return obj.Quantity, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HoldingValuation_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HoldingValuation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HoldingValuation_avgCost(ctx context.Context, field graphql.CollectedField, obj *model.HoldingValuation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HoldingValuation_avgCost,
+		func(ctx context.Context) (any, error) {
+			return obj.AvgCost, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HoldingValuation_avgCost(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HoldingValuation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HoldingValuation_quoteCurrency(ctx context.Context, field graphql.CollectedField, obj *model.HoldingValuation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HoldingValuation_quoteCurrency,
+		func(ctx context.Context) (any, error) {
+			return obj.QuoteCurrency, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_HoldingValuation_quoteCurrency(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HoldingValuation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HoldingValuation_exchangeRate(ctx context.Context, field graphql.CollectedField, obj *model.HoldingValuation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HoldingValuation_exchangeRate,
+		func(ctx context.Context) (any, error) {
+			return obj.ExchangeRate, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HoldingValuation_exchangeRate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HoldingValuation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HoldingValuation_lastPrice(ctx context.Context, field graphql.CollectedField, obj *model.HoldingValuation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HoldingValuation_lastPrice,
+		func(ctx context.Context) (any, error) {
+			return obj.LastPrice, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HoldingValuation_lastPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HoldingValuation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HoldingValuation_marketValue(ctx context.Context, field graphql.CollectedField, obj *model.HoldingValuation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HoldingValuation_marketValue,
+		func(ctx context.Context) (any, error) {
+			return obj.MarketValue, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HoldingValuation_marketValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HoldingValuation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HoldingValuation_costBasis(ctx context.Context, field graphql.CollectedField, obj *model.HoldingValuation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HoldingValuation_costBasis,
+		func(ctx context.Context) (any, error) {
+			return obj.CostBasis, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HoldingValuation_costBasis(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HoldingValuation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HoldingValuation_unrealizedPnl(ctx context.Context, field graphql.CollectedField, obj *model.HoldingValuation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HoldingValuation_unrealizedPnl,
+		func(ctx context.Context) (any, error) {
+			return obj.UnrealizedPnl, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HoldingValuation_unrealizedPnl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HoldingValuation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HoldingValuation_unrealizedPnlPercent(ctx context.Context, field graphql.CollectedField, obj *model.HoldingValuation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HoldingValuation_unrealizedPnlPercent,
+		func(ctx context.Context) (any, error) {
+			return obj.UnrealizedPnlPercent, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HoldingValuation_unrealizedPnlPercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HoldingValuation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HoldingValuation_dayChange(ctx context.Context, field graphql.CollectedField, obj *model.HoldingValuation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HoldingValuation_dayChange,
+		func(ctx context.Context) (any, error) {
+			return obj.DayChange, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HoldingValuation_dayChange(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HoldingValuation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HoldingValuation_dayChangePercent(ctx context.Context, field graphql.CollectedField, obj *model.HoldingValuation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HoldingValuation_dayChangePercent,
+		func(ctx context.Context) (any, error) {
+			return obj.DayChangePercent, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HoldingValuation_dayChangePercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HoldingValuation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HoldingValuation_priced(ctx context.Context, field graphql.CollectedField, obj *model.HoldingValuation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HoldingValuation_priced,
+		func(ctx context.Context) (any, error) {
+			return obj.Priced, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HoldingValuation_priced(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HoldingValuation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HoldingValuation_quoteAsOf(ctx context.Context, field graphql.CollectedField, obj *model.HoldingValuation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HoldingValuation_quoteAsOf,
+		func(ctx context.Context) (any, error) {
+			return obj.QuoteAsOf, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_HoldingValuation_quoteAsOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HoldingValuation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerformancePoint_date(ctx context.Context, field graphql.CollectedField, obj *model.PerformancePoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PerformancePoint_date,
+		func(ctx context.Context) (any, error) {
+			return obj.Date, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PerformancePoint_date(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerformancePoint",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerformancePoint_cashBalance(ctx context.Context, field graphql.CollectedField, obj *model.PerformancePoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PerformancePoint_cashBalance,
+		func(ctx context.Context) (any, error) {
+			return obj.CashBalance, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PerformancePoint_cashBalance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerformancePoint",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerformancePoint_marketValue(ctx context.Context, field graphql.CollectedField, obj *model.PerformancePoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PerformancePoint_marketValue,
+		func(ctx context.Context) (any, error) {
+			return obj.MarketValue, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PerformancePoint_marketValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerformancePoint",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerformancePoint_totalEquity(ctx context.Context, field graphql.CollectedField, obj *model.PerformancePoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PerformancePoint_totalEquity,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalEquity, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PerformancePoint_totalEquity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerformancePoint",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerformancePoint_netFlow(ctx context.Context, field graphql.CollectedField, obj *model.PerformancePoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PerformancePoint_netFlow,
+		func(ctx context.Context) (any, error) {
+			return obj.NetFlow, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PerformancePoint_netFlow(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerformancePoint",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerformancePoint_cumulativeReturnPercent(ctx context.Context, field graphql.CollectedField, obj *model.PerformancePoint) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PerformancePoint_cumulativeReturnPercent,
+		func(ctx context.Context) (any, error) {
+			return obj.CumulativeReturnPercent, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PerformancePoint_cumulativeReturnPercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerformancePoint",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RealizedGain_id(ctx context.Context, field graphql.CollectedField, obj *model.RealizedGain) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RealizedGain_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RealizedGain_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RealizedGain",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RealizedGain_accountId(ctx context.Context, field graphql.CollectedField, obj *model.RealizedGain) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RealizedGain_accountId,
+		func(ctx context.Context) (any, error) {
+			return obj.AccountID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RealizedGain_accountId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RealizedGain",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RealizedGain_symbol(ctx context.Context, field graphql.CollectedField, obj *model.RealizedGain) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RealizedGain_symbol,
+		func(ctx context.Context) (any, error) {
+			return obj.Symbol, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RealizedGain_symbol(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RealizedGain",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RealizedGain_lotId(ctx context.Context, field graphql.CollectedField, obj *model.RealizedGain) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RealizedGain_lotId,
+		func(ctx context.Context) (any, error) {
+			return obj.LotID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RealizedGain_lotId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RealizedGain",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RealizedGain_orderId(ctx context.Context, field graphql.CollectedField, obj *model.RealizedGain) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RealizedGain_orderId,
+		func(ctx context.Context) (any, error) {
+			return obj.OrderID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RealizedGain_orderId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RealizedGain",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RealizedGain_quantity(ctx context.Context, field graphql.CollectedField, obj *model.RealizedGain) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RealizedGain_quantity,
+		func(ctx context.Context) (any, error) {
+			return obj.Quantity, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RealizedGain_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RealizedGain",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RealizedGain_proceeds(ctx context.Context, field graphql.CollectedField, obj *model.RealizedGain) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RealizedGain_proceeds,
+		func(ctx context.Context) (any, error) {
+			return obj.Proceeds, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RealizedGain_proceeds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RealizedGain",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RealizedGain_costBasis(ctx context.Context, field graphql.CollectedField, obj *model.RealizedGain) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RealizedGain_costBasis,
+		func(ctx context.Context) (any, error) {
+			return obj.CostBasis, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RealizedGain_costBasis(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RealizedGain",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RealizedGain_realizedPnl(ctx context.Context, field graphql.CollectedField, obj *model.RealizedGain) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RealizedGain_realizedPnl,
+		func(ctx context.Context) (any, error) {
+			return obj.RealizedPnl, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RealizedGain_realizedPnl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RealizedGain",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RealizedGain_method(ctx context.Context, field graphql.CollectedField, obj *model.RealizedGain) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RealizedGain_method,
+		func(ctx context.Context) (any, error) {
+			return obj.Method, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RealizedGain_method(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RealizedGain",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RealizedGain_openedAt(ctx context.Context, field graphql.CollectedField, obj *model.RealizedGain) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RealizedGain_openedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.OpenedAt, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RealizedGain_openedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RealizedGain",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RealizedGain_realizedAt(ctx context.Context, field graphql.CollectedField, obj *model.RealizedGain) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RealizedGain_realizedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.RealizedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RealizedGain_realizedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RealizedGain",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RemoveFromWatchlistResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.RemoveFromWatchlistResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RemoveFromWatchlistResponse_code,
+		func(ctx context.Context) (any, error) {
+			return obj.Code, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RemoveFromWatchlistResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RemoveFromWatchlistResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SelectTaxLotsResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.SelectTaxLotsResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SelectTaxLotsResponse_code,
+		func(ctx context.Context) (any, error) {
+			return obj.Code, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SelectTaxLotsResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SelectTaxLotsResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SetLotMethodResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.SetLotMethodResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SetLotMethodResponse_code,
+		func(ctx context.Context) (any, error) {
+			return obj.Code, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SetLotMethodResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SetLotMethodResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SetLotMethodResponse_data(ctx context.Context, field graphql.CollectedField, obj *model.SetLotMethodResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SetLotMethodResponse_data,
+		func(ctx context.Context) (any, error) {
+			return obj.Data, nil
+		},
+		nil,
+		ec.marshalOAccount2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐAccount,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SetLotMethodResponse_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SetLotMethodResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Account_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_Account_userId(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Account_accountNumber(ctx, field)
+			case "type":
+				return ec.fieldContext_Account_type(ctx, field)
+			case "currency":
+				return ec.fieldContext_Account_currency(ctx, field)
+			case "balance":
+				return ec.fieldContext_Account_balance(ctx, field)
+			case "availableBalance":
+				return ec.fieldContext_Account_availableBalance(ctx, field)
+			case "lotMethod":
+				return ec.fieldContext_Account_lotMethod(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Account_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Account_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Account", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SymbolRealizedGain_symbol(ctx context.Context, field graphql.CollectedField, obj *model.SymbolRealizedGain) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SymbolRealizedGain_symbol,
+		func(ctx context.Context) (any, error) {
+			return obj.Symbol, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SymbolRealizedGain_symbol(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SymbolRealizedGain",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SymbolRealizedGain_quantity(ctx context.Context, field graphql.CollectedField, obj *model.SymbolRealizedGain) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SymbolRealizedGain_quantity,
+		func(ctx context.Context) (any, error) {
+			return obj.Quantity, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SymbolRealizedGain_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SymbolRealizedGain",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SymbolRealizedGain_proceeds(ctx context.Context, field graphql.CollectedField, obj *model.SymbolRealizedGain) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SymbolRealizedGain_proceeds,
+		func(ctx context.Context) (any, error) {
+			return obj.Proceeds, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SymbolRealizedGain_proceeds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SymbolRealizedGain",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SymbolRealizedGain_costBasis(ctx context.Context, field graphql.CollectedField, obj *model.SymbolRealizedGain) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SymbolRealizedGain_costBasis,
+		func(ctx context.Context) (any, error) {
+			return obj.CostBasis, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SymbolRealizedGain_costBasis(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SymbolRealizedGain",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SymbolRealizedGain_realizedPnl(ctx context.Context, field graphql.CollectedField, obj *model.SymbolRealizedGain) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SymbolRealizedGain_realizedPnl,
+		func(ctx context.Context) (any, error) {
+			return obj.RealizedPnl, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SymbolRealizedGain_realizedPnl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SymbolRealizedGain",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaxLot_id(ctx context.Context, field graphql.CollectedField, obj *model.TaxLot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaxLot_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaxLot_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaxLot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaxLot_accountId(ctx context.Context, field graphql.CollectedField, obj *model.TaxLot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaxLot_accountId,
+		func(ctx context.Context) (any, error) {
+			return obj.AccountID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaxLot_accountId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaxLot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaxLot_symbol(ctx context.Context, field graphql.CollectedField, obj *model.TaxLot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaxLot_symbol,
+		func(ctx context.Context) (any, error) {
+			return obj.Symbol, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaxLot_symbol(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaxLot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaxLot_orderId(ctx context.Context, field graphql.CollectedField, obj *model.TaxLot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaxLot_orderId,
+		func(ctx context.Context) (any, error) {
+			return obj.OrderID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaxLot_orderId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaxLot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaxLot_quantity(ctx context.Context, field graphql.CollectedField, obj *model.TaxLot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaxLot_quantity,
+		func(ctx context.Context) (any, error) {
+			return obj.Quantity, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaxLot_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaxLot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaxLot_remainingQuantity(ctx context.Context, field graphql.CollectedField, obj *model.TaxLot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaxLot_remainingQuantity,
+		func(ctx context.Context) (any, error) {
+			return obj.RemainingQuantity, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaxLot_remainingQuantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaxLot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaxLot_costBasis(ctx context.Context, field graphql.CollectedField, obj *model.TaxLot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaxLot_costBasis,
+		func(ctx context.Context) (any, error) {
+			return obj.CostBasis, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaxLot_costBasis(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaxLot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaxLot_openedAt(ctx context.Context, field graphql.CollectedField, obj *model.TaxLot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaxLot_openedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.OpenedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaxLot_openedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaxLot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaxLot_closedAt(ctx context.Context, field graphql.CollectedField, obj *model.TaxLot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaxLot_closedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.ClosedAt, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaxLot_closedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaxLot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Transaction_id(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Transaction_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Transaction_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Transaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Transaction_accountId(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Transaction_accountId,
+		func(ctx context.Context) (any, error) {
+			return obj.AccountID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Transaction_accountId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Transaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Transaction_type(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Transaction_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Transaction_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Transaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Transaction_amount(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Transaction_amount,
+		func(ctx context.Context) (any, error) {
+			return obj.Amount, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Transaction_amount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Transaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Transaction_description(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Transaction_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Transaction_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Transaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Transaction_referenceId(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Transaction_referenceId,
+		func(ctx context.Context) (any, error) {
+			return obj.ReferenceID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Transaction_referenceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Transaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Transaction_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Transaction_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Transaction_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Transaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TransferResponse_code(ctx context.Context, field graphql.CollectedField, obj *model.TransferResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TransferResponse_code,
+		func(ctx context.Context) (any, error) {
+			return obj.Code, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TransferResponse_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TransferResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WatchlistItem_symbol(ctx context.Context, field graphql.CollectedField, obj *model.WatchlistItem) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WatchlistItem_symbol,
+		func(ctx context.Context) (any, error) {
+			return obj.Symbol, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WatchlistItem_symbol(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WatchlistItem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WatchlistItem_addedAt(ctx context.Context, field graphql.CollectedField, obj *model.WatchlistItem) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WatchlistItem_addedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.AddedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WatchlistItem_addedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WatchlistItem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputAddToWatchlistRequest(ctx context.Context, obj any) (model.AddToWatchlistRequest, error) {
+	var it model.AddToWatchlistRequest
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"symbol"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "symbol":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("symbol"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Symbol = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateAccountRequest(ctx context.Context, obj any) (model.CreateAccountRequest, error) {
+	var it model.CreateAccountRequest
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"type", "currency", "lotMethod"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Type = data
+		case "currency":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("currency"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Currency = data
+		case "lotMethod":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lotMethod"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.LotMethod = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDepositRequest(ctx context.Context, obj any) (model.DepositRequest, error) {
+	var it model.DepositRequest
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"accountId", "amount", "currency"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "accountId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accountId"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AccountID = data
+		case "amount":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amount"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Amount = data
+		case "currency":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("currency"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Currency = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputGetHoldingRequest(ctx context.Context, obj any) (model.GetHoldingRequest, error) {
+	var it model.GetHoldingRequest
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"accountId", "symbol"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "accountId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accountId"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AccountID = data
+		case "symbol":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("symbol"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Symbol = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputGetHoldingsRequest(ctx context.Context, obj any) (model.GetHoldingsRequest, error) {
+	var it model.GetHoldingsRequest
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"accountId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "accountId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accountId"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AccountID = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputGetRealizedGainsRequest(ctx context.Context, obj any) (model.GetRealizedGainsRequest, error) {
+	var it model.GetRealizedGainsRequest
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"accountId", "symbol", "from", "to"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "accountId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accountId"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AccountID = data
+		case "symbol":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("symbol"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Symbol = data
+		case "from":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.From = data
+		case "to":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.To = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputGetTaxLotsRequest(ctx context.Context, obj any) (model.GetTaxLotsRequest, error) {
+	var it model.GetTaxLotsRequest
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"accountId", "symbol", "includeClosed"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "accountId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accountId"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AccountID = data
+		case "symbol":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("symbol"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Symbol = data
+		case "includeClosed":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeClosed"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IncludeClosed = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputGetTransactionsRequest(ctx context.Context, obj any) (model.GetTransactionsRequest, error) {
+	var it model.GetTransactionsRequest
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"accountId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "accountId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accountId"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AccountID = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRemoveFromWatchlistRequest(ctx context.Context, obj any) (model.RemoveFromWatchlistRequest, error) {
+	var it model.RemoveFromWatchlistRequest
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"symbol"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "symbol":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("symbol"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Symbol = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSelectTaxLotsRequest(ctx context.Context, obj any) (model.SelectTaxLotsRequest, error) {
+	var it model.SelectTaxLotsRequest
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"accountId", "orderId", "lotIds"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "accountId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accountId"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AccountID = data
+		case "orderId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderId"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OrderID = data
+		case "lotIds":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lotIds"))
+			data, err := ec.unmarshalNString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.LotIds = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSetLotMethodRequest(ctx context.Context, obj any) (model.SetLotMethodRequest, error) {
+	var it model.SetLotMethodRequest
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"symbol"}
+	fieldsInOrder := [...]string{"accountId", "method"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "symbol":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("symbol"))
+		case "accountId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accountId"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Symbol = data
+			it.AccountID = data
+		case "method":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("method"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Method = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputCreateAccountRequest(ctx context.Context, obj any) (model.CreateAccountRequest, error) {
-	var it model.CreateAccountRequest
+func (ec *executionContext) unmarshalInputTransferRequest(ctx context.Context, obj any) (model.TransferRequest, error) {
+	var it model.TransferRequest
 	asMap := map[string]any{}
 	for k, v := range obj.(map[string]any) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"type", "currency"}
+	fieldsInOrder := [...]string{"fromAccountId", "toAccountId", "amount", "currency"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "type":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+		case "fromAccountId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fromAccountId"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Type = data
+			it.FromAccountID = data
+		case "toAccountId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("toAccountId"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ToAccountID = data
+		case "amount":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amount"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Amount = data
 		case "currency":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("currency"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Currency = data
+			it.Currency = data
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var accountImplementors = []string{"Account"}
+
+func (ec *executionContext) _Account(ctx context.Context, sel ast.SelectionSet, obj *model.Account) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, accountImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Account")
+		case "id":
+			out.Values[i] = ec._Account_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "userId":
+			out.Values[i] = ec._Account_userId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "accountNumber":
+			out.Values[i] = ec._Account_accountNumber(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "type":
+			out.Values[i] = ec._Account_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "currency":
+			out.Values[i] = ec._Account_currency(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "balance":
+			out.Values[i] = ec._Account_balance(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "availableBalance":
+			out.Values[i] = ec._Account_availableBalance(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "lotMethod":
+			out.Values[i] = ec._Account_lotMethod(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._Account_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatedAt":
+			out.Values[i] = ec._Account_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var accountPerformanceImplementors = []string{"AccountPerformance"}
+
+func (ec *executionContext) _AccountPerformance(ctx context.Context, sel ast.SelectionSet, obj *model.AccountPerformance) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, accountPerformanceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AccountPerformance")
+		case "accountId":
+			out.Values[i] = ec._AccountPerformance_accountId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "currency":
+			out.Values[i] = ec._AccountPerformance_currency(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "startDate":
+			out.Values[i] = ec._AccountPerformance_startDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "endDate":
+			out.Values[i] = ec._AccountPerformance_endDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "startEquity":
+			out.Values[i] = ec._AccountPerformance_startEquity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "endEquity":
+			out.Values[i] = ec._AccountPerformance_endEquity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "netFlows":
+			out.Values[i] = ec._AccountPerformance_netFlows(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "gain":
+			out.Values[i] = ec._AccountPerformance_gain(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timeWeightedReturnPercent":
+			out.Values[i] = ec._AccountPerformance_timeWeightedReturnPercent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "moneyWeightedReturnPercent":
+			out.Values[i] = ec._AccountPerformance_moneyWeightedReturnPercent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "points":
+			out.Values[i] = ec._AccountPerformance_points(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var accountValuationImplementors = []string{"AccountValuation"}
+
+func (ec *executionContext) _AccountValuation(ctx context.Context, sel ast.SelectionSet, obj *model.AccountValuation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, accountValuationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AccountValuation")
+		case "accountId":
+			out.Values[i] = ec._AccountValuation_accountId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "currency":
+			out.Values[i] = ec._AccountValuation_currency(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cashBalance":
+			out.Values[i] = ec._AccountValuation_cashBalance(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "marketValue":
+			out.Values[i] = ec._AccountValuation_marketValue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "costBasis":
+			out.Values[i] = ec._AccountValuation_costBasis(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "unrealizedPnl":
+			out.Values[i] = ec._AccountValuation_unrealizedPnl(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "unrealizedPnlPercent":
+			out.Values[i] = ec._AccountValuation_unrealizedPnlPercent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dayChange":
+			out.Values[i] = ec._AccountValuation_dayChange(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dayChangePercent":
+			out.Values[i] = ec._AccountValuation_dayChangePercent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalEquity":
+			out.Values[i] = ec._AccountValuation_totalEquity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "holdings":
+			out.Values[i] = ec._AccountValuation_holdings(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputDepositRequest(ctx context.Context, obj any) (model.DepositRequest, error) {
-	var it model.DepositRequest
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"accountId", "amount", "currency"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "accountId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accountId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.AccountID = data
-		case "amount":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amount"))
-			data, err := ec.unmarshalNFloat2float64(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Amount = data
-		case "currency":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("currency"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var addToWatchlistResponseImplementors = []string{"AddToWatchlistResponse"}
+
+func (ec *executionContext) _AddToWatchlistResponse(ctx context.Context, sel ast.SelectionSet, obj *model.AddToWatchlistResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, addToWatchlistResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AddToWatchlistResponse")
+		case "code":
+			out.Values[i] = ec._AddToWatchlistResponse_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Currency = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputGetHoldingRequest(ctx context.Context, obj any) (model.GetHoldingRequest, error) {
-	var it model.GetHoldingRequest
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"accountId", "symbol"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "accountId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accountId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.AccountID = data
-		case "symbol":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("symbol"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var createAccountResponseImplementors = []string{"CreateAccountResponse"}
+
+func (ec *executionContext) _CreateAccountResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CreateAccountResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, createAccountResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CreateAccountResponse")
+		case "data":
+			out.Values[i] = ec._CreateAccountResponse_data(ctx, field, obj)
+		case "code":
+			out.Values[i] = ec._CreateAccountResponse_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Symbol = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputGetHoldingsRequest(ctx context.Context, obj any) (model.GetHoldingsRequest, error) {
-	var it model.GetHoldingsRequest
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"accountId"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "accountId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accountId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var depositResponseImplementors = []string{"DepositResponse"}
+
+func (ec *executionContext) _DepositResponse(ctx context.Context, sel ast.SelectionSet, obj *model.DepositResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, depositResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DepositResponse")
+		case "code":
+			out.Values[i] = ec._DepositResponse_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.AccountID = data
+		case "newBalance":
+			out.Values[i] = ec._DepositResponse_newBalance(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputGetTransactionsRequest(ctx context.Context, obj any) (model.GetTransactionsRequest, error) {
-	var it model.GetTransactionsRequest
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"accountId"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "accountId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accountId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var getHoldingResponseImplementors = []string{"GetHoldingResponse"}
+
+func (ec *executionContext) _GetHoldingResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GetHoldingResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, getHoldingResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GetHoldingResponse")
+		case "data":
+			out.Values[i] = ec._GetHoldingResponse_data(ctx, field, obj)
+		case "code":
+			out.Values[i] = ec._GetHoldingResponse_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.AccountID = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-	return it, nil
+	return out
 }
 
-func (ec *executionContext) unmarshalInputRemoveFromWatchlistRequest(ctx context.Context, obj any) (model.RemoveFromWatchlistRequest, error) {
-	var it model.RemoveFromWatchlistRequest
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+var getHoldingsResponseImplementors = []string{"GetHoldingsResponse"}
 
-	fieldsInOrder := [...]string{"symbol"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "symbol":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("symbol"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) _GetHoldingsResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GetHoldingsResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, getHoldingsResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GetHoldingsResponse")
+		case "data":
+			out.Values[i] = ec._GetHoldingsResponse_data(ctx, field, obj)
+		case "code":
+			out.Values[i] = ec._GetHoldingsResponse_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Symbol = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputTransferRequest(ctx context.Context, obj any) (model.TransferRequest, error) {
-	var it model.TransferRequest
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"fromAccountId", "toAccountId", "amount", "currency"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "fromAccountId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fromAccountId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.FromAccountID = data
-		case "toAccountId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("toAccountId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.ToAccountID = data
-		case "amount":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amount"))
-			data, err := ec.unmarshalNFloat2float64(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Amount = data
-		case "currency":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("currency"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var getPortfolioPerformanceResponseImplementors = []string{"GetPortfolioPerformanceResponse"}
+
+func (ec *executionContext) _GetPortfolioPerformanceResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GetPortfolioPerformanceResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, getPortfolioPerformanceResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GetPortfolioPerformanceResponse")
+		case "code":
+			out.Values[i] = ec._GetPortfolioPerformanceResponse_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Currency = data
+		case "data":
+			out.Values[i] = ec._GetPortfolioPerformanceResponse_data(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
-
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-// endregion ************************** interface.gotpl ***************************
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-// region    **************************** object.gotpl ****************************
+	return out
+}
 
-var accountImplementors = []string{"Account"}
+var getPortfolioSummaryResponseImplementors = []string{"GetPortfolioSummaryResponse"}
 
-func (ec *executionContext) _Account(ctx context.Context, sel ast.SelectionSet, obj *model.Account) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, accountImplementors)
+func (ec *executionContext) _GetPortfolioSummaryResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GetPortfolioSummaryResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, getPortfolioSummaryResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Account")
-		case "id":
-			out.Values[i] = ec._Account_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "userId":
-			out.Values[i] = ec._Account_userId(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("GetPortfolioSummaryResponse")
+		case "accounts":
+			out.Values[i] = ec._GetPortfolioSummaryResponse_accounts(ctx, field, obj)
+		case "totalBalance":
+			out.Values[i] = ec._GetPortfolioSummaryResponse_totalBalance(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "accountNumber":
-			out.Values[i] = ec._Account_accountNumber(ctx, field, obj)
+		case "totalFees":
+			out.Values[i] = ec._GetPortfolioSummaryResponse_totalFees(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "type":
-			out.Values[i] = ec._Account_type(ctx, field, obj)
+		case "code":
+			out.Values[i] = ec._GetPortfolioSummaryResponse_code(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "currency":
-			out.Values[i] = ec._Account_currency(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var getPortfolioValuationResponseImplementors = []string{"GetPortfolioValuationResponse"}
+
+func (ec *executionContext) _GetPortfolioValuationResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GetPortfolioValuationResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, getPortfolioValuationResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GetPortfolioValuationResponse")
+		case "code":
+			out.Values[i] = ec._GetPortfolioValuationResponse_code(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "balance":
-			out.Values[i] = ec._Account_balance(ctx, field, obj)
+		case "data":
+			out.Values[i] = ec._GetPortfolioValuationResponse_data(ctx, field, obj)
+		case "valuedAt":
+			out.Values[i] = ec._GetPortfolioValuationResponse_valuedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var getRealizedGainsResponseImplementors = []string{"GetRealizedGainsResponse"}
+
+func (ec *executionContext) _GetRealizedGainsResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GetRealizedGainsResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, getRealizedGainsResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GetRealizedGainsResponse")
+		case "code":
+			out.Values[i] = ec._GetRealizedGainsResponse_code(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "createdAt":
-			out.Values[i] = ec._Account_createdAt(ctx, field, obj)
+		case "data":
+			out.Values[i] = ec._GetRealizedGainsResponse_data(ctx, field, obj)
+		case "bySymbol":
+			out.Values[i] = ec._GetRealizedGainsResponse_bySymbol(ctx, field, obj)
+		case "totalRealizedPnl":
+			out.Values[i] = ec._GetRealizedGainsResponse_totalRealizedPnl(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "updatedAt":
-			out.Values[i] = ec._Account_updatedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var getTaxLotsResponseImplementors = []string{"GetTaxLotsResponse"}
+
+func (ec *executionContext) _GetTaxLotsResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GetTaxLotsResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, getTaxLotsResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GetTaxLotsResponse")
+		case "code":
+			out.Values[i] = ec._GetTaxLotsResponse_code(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "data":
+			out.Values[i] = ec._GetTaxLotsResponse_data(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -1688,19 +5184,26 @@ func (ec *executionContext) _Account(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var addToWatchlistResponseImplementors = []string{"AddToWatchlistResponse"}
+var getTransactionsResponseImplementors = []string{"GetTransactionsResponse"}
 
-func (ec *executionContext) _AddToWatchlistResponse(ctx context.Context, sel ast.SelectionSet, obj *model.AddToWatchlistResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, addToWatchlistResponseImplementors)
+func (ec *executionContext) _GetTransactionsResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GetTransactionsResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, getTransactionsResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AddToWatchlistResponse")
+			out.Values[i] = graphql.MarshalString("GetTransactionsResponse")
 		case "code":
-			out.Values[i] = ec._AddToWatchlistResponse_code(ctx, field, obj)
+			out.Values[i] = ec._GetTransactionsResponse_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "data":
+			out.Values[i] = ec._GetTransactionsResponse_data(ctx, field, obj)
+		case "totalFees":
+			out.Values[i] = ec._GetTransactionsResponse_totalFees(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -1727,21 +5230,21 @@ func (ec *executionContext) _AddToWatchlistResponse(ctx context.Context, sel ast
 	return out
 }
 
-var createAccountResponseImplementors = []string{"CreateAccountResponse"}
+var getWatchlistResponseImplementors = []string{"GetWatchlistResponse"}
 
-func (ec *executionContext) _CreateAccountResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CreateAccountResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, createAccountResponseImplementors)
+func (ec *executionContext) _GetWatchlistResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GetWatchlistResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, getWatchlistResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CreateAccountResponse")
+			out.Values[i] = graphql.MarshalString("GetWatchlistResponse")
 		case "data":
-			out.Values[i] = ec._CreateAccountResponse_data(ctx, field, obj)
+			out.Values[i] = ec._GetWatchlistResponse_data(ctx, field, obj)
 		case "code":
-			out.Values[i] = ec._CreateAccountResponse_code(ctx, field, obj)
+			out.Values[i] = ec._GetWatchlistResponse_code(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -1768,24 +5271,54 @@ func (ec *executionContext) _CreateAccountResponse(ctx context.Context, sel ast.
 	return out
 }
 
-var depositResponseImplementors = []string{"DepositResponse"}
+var holdingImplementors = []string{"Holding"}
 
-func (ec *executionContext) _DepositResponse(ctx context.Context, sel ast.SelectionSet, obj *model.DepositResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, depositResponseImplementors)
+func (ec *executionContext) _Holding(ctx context.Context, sel ast.SelectionSet, obj *model.Holding) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, holdingImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("DepositResponse")
-		case "code":
-			out.Values[i] = ec._DepositResponse_code(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Holding")
+		case "id":
+			out.Values[i] = ec._Holding_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "newBalance":
-			out.Values[i] = ec._DepositResponse_newBalance(ctx, field, obj)
+		case "accountId":
+			out.Values[i] = ec._Holding_accountId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "symbol":
+			out.Values[i] = ec._Holding_symbol(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "quantity":
+			out.Values[i] = ec._Holding_quantity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "avgCost":
+			out.Values[i] = ec._Holding_avgCost(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "availableQuantity":
+			out.Values[i] = ec._Holding_availableQuantity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._Holding_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatedAt":
+			out.Values[i] = ec._Holding_updatedAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -1812,24 +5345,81 @@ func (ec *executionContext) _DepositResponse(ctx context.Context, sel ast.Select
 	return out
 }
 
-var getHoldingResponseImplementors = []string{"GetHoldingResponse"}
+var holdingValuationImplementors = []string{"HoldingValuation"}
 
-func (ec *executionContext) _GetHoldingResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GetHoldingResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, getHoldingResponseImplementors)
+func (ec *executionContext) _HoldingValuation(ctx context.Context, sel ast.SelectionSet, obj *model.HoldingValuation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, holdingValuationImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("GetHoldingResponse")
-		case "data":
-			out.Values[i] = ec._GetHoldingResponse_data(ctx, field, obj)
-		case "code":
-			out.Values[i] = ec._GetHoldingResponse_code(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("HoldingValuation")
+		case "symbol":
+			out.Values[i] = ec._HoldingValuation_symbol(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "quantity":
+			out.Values[i] = ec._HoldingValuation_quantity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "avgCost":
+			out.Values[i] = ec._HoldingValuation_avgCost(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "quoteCurrency":
+			out.Values[i] = ec._HoldingValuation_quoteCurrency(ctx, field, obj)
+		case "exchangeRate":
+			out.Values[i] = ec._HoldingValuation_exchangeRate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "lastPrice":
+			out.Values[i] = ec._HoldingValuation_lastPrice(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "marketValue":
+			out.Values[i] = ec._HoldingValuation_marketValue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "costBasis":
+			out.Values[i] = ec._HoldingValuation_costBasis(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "unrealizedPnl":
+			out.Values[i] = ec._HoldingValuation_unrealizedPnl(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "unrealizedPnlPercent":
+			out.Values[i] = ec._HoldingValuation_unrealizedPnlPercent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dayChange":
+			out.Values[i] = ec._HoldingValuation_dayChange(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dayChangePercent":
+			out.Values[i] = ec._HoldingValuation_dayChangePercent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "priced":
+			out.Values[i] = ec._HoldingValuation_priced(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "quoteAsOf":
+			out.Values[i] = ec._HoldingValuation_quoteAsOf(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -1853,21 +5443,44 @@ func (ec *executionContext) _GetHoldingResponse(ctx context.Context, sel ast.Sel
 	return out
 }
 
-var getHoldingsResponseImplementors = []string{"GetHoldingsResponse"}
+var performancePointImplementors = []string{"PerformancePoint"}
 
-func (ec *executionContext) _GetHoldingsResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GetHoldingsResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, getHoldingsResponseImplementors)
+func (ec *executionContext) _PerformancePoint(ctx context.Context, sel ast.SelectionSet, obj *model.PerformancePoint) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, performancePointImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("GetHoldingsResponse")
-		case "data":
-			out.Values[i] = ec._GetHoldingsResponse_data(ctx, field, obj)
-		case "code":
-			out.Values[i] = ec._GetHoldingsResponse_code(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PerformancePoint")
+		case "date":
+			out.Values[i] = ec._PerformancePoint_date(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cashBalance":
+			out.Values[i] = ec._PerformancePoint_cashBalance(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "marketValue":
+			out.Values[i] = ec._PerformancePoint_marketValue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalEquity":
+			out.Values[i] = ec._PerformancePoint_totalEquity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "netFlow":
+			out.Values[i] = ec._PerformancePoint_netFlow(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cumulativeReturnPercent":
+			out.Values[i] = ec._PerformancePoint_cumulativeReturnPercent(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -1894,26 +5507,68 @@ func (ec *executionContext) _GetHoldingsResponse(ctx context.Context, sel ast.Se
 	return out
 }
 
-var getPortfolioSummaryResponseImplementors = []string{"GetPortfolioSummaryResponse"}
+var realizedGainImplementors = []string{"RealizedGain"}
 
-func (ec *executionContext) _GetPortfolioSummaryResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GetPortfolioSummaryResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, getPortfolioSummaryResponseImplementors)
+func (ec *executionContext) _RealizedGain(ctx context.Context, sel ast.SelectionSet, obj *model.RealizedGain) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, realizedGainImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("GetPortfolioSummaryResponse")
-		case "accounts":
-			out.Values[i] = ec._GetPortfolioSummaryResponse_accounts(ctx, field, obj)
-		case "totalBalance":
-			out.Values[i] = ec._GetPortfolioSummaryResponse_totalBalance(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("RealizedGain")
+		case "id":
+			out.Values[i] = ec._RealizedGain_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "code":
-			out.Values[i] = ec._GetPortfolioSummaryResponse_code(ctx, field, obj)
+		case "accountId":
+			out.Values[i] = ec._RealizedGain_accountId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "symbol":
+			out.Values[i] = ec._RealizedGain_symbol(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "lotId":
+			out.Values[i] = ec._RealizedGain_lotId(ctx, field, obj)
+		case "orderId":
+			out.Values[i] = ec._RealizedGain_orderId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "quantity":
+			out.Values[i] = ec._RealizedGain_quantity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "proceeds":
+			out.Values[i] = ec._RealizedGain_proceeds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "costBasis":
+			out.Values[i] = ec._RealizedGain_costBasis(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "realizedPnl":
+			out.Values[i] = ec._RealizedGain_realizedPnl(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "method":
+			out.Values[i] = ec._RealizedGain_method(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "openedAt":
+			out.Values[i] = ec._RealizedGain_openedAt(ctx, field, obj)
+		case "realizedAt":
+			out.Values[i] = ec._RealizedGain_realizedAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -1940,24 +5595,22 @@ func (ec *executionContext) _GetPortfolioSummaryResponse(ctx context.Context, se
 	return out
 }
 
-var getTransactionsResponseImplementors = []string{"GetTransactionsResponse"}
+var removeFromWatchlistResponseImplementors = []string{"RemoveFromWatchlistResponse"}
 
-func (ec *executionContext) _GetTransactionsResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GetTransactionsResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, getTransactionsResponseImplementors)
+func (ec *executionContext) _RemoveFromWatchlistResponse(ctx context.Context, sel ast.SelectionSet, obj *model.RemoveFromWatchlistResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, removeFromWatchlistResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("GetTransactionsResponse")
+			out.Values[i] = graphql.MarshalString("RemoveFromWatchlistResponse")
 		case "code":
-			out.Values[i] = ec._GetTransactionsResponse_code(ctx, field, obj)
+			out.Values[i] = ec._RemoveFromWatchlistResponse_code(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "data":
-			out.Values[i] = ec._GetTransactionsResponse_data(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -1981,21 +5634,19 @@ func (ec *executionContext) _GetTransactionsResponse(ctx context.Context, sel as
 	return out
 }
 
-var getWatchlistResponseImplementors = []string{"GetWatchlistResponse"}
+var selectTaxLotsResponseImplementors = []string{"SelectTaxLotsResponse"}
 
-func (ec *executionContext) _GetWatchlistResponse(ctx context.Context, sel ast.SelectionSet, obj *model.GetWatchlistResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, getWatchlistResponseImplementors)
+func (ec *executionContext) _SelectTaxLotsResponse(ctx context.Context, sel ast.SelectionSet, obj *model.SelectTaxLotsResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, selectTaxLotsResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("GetWatchlistResponse")
-		case "data":
-			out.Values[i] = ec._GetWatchlistResponse_data(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("SelectTaxLotsResponse")
 		case "code":
-			out.Values[i] = ec._GetWatchlistResponse_code(ctx, field, obj)
+			out.Values[i] = ec._SelectTaxLotsResponse_code(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -2022,49 +5673,80 @@ func (ec *executionContext) _GetWatchlistResponse(ctx context.Context, sel ast.S
 	return out
 }
 
-var holdingImplementors = []string{"Holding"}
+var setLotMethodResponseImplementors = []string{"SetLotMethodResponse"}
 
-func (ec *executionContext) _Holding(ctx context.Context, sel ast.SelectionSet, obj *model.Holding) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, holdingImplementors)
+func (ec *executionContext) _SetLotMethodResponse(ctx context.Context, sel ast.SelectionSet, obj *model.SetLotMethodResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, setLotMethodResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Holding")
-		case "id":
-			out.Values[i] = ec._Holding_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "accountId":
-			out.Values[i] = ec._Holding_accountId(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("SetLotMethodResponse")
+		case "code":
+			out.Values[i] = ec._SetLotMethodResponse_code(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "data":
+			out.Values[i] = ec._SetLotMethodResponse_data(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var symbolRealizedGainImplementors = []string{"SymbolRealizedGain"}
+
+func (ec *executionContext) _SymbolRealizedGain(ctx context.Context, sel ast.SelectionSet, obj *model.SymbolRealizedGain) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, symbolRealizedGainImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SymbolRealizedGain")
 		case "symbol":
-			out.Values[i] = ec._Holding_symbol(ctx, field, obj)
+			out.Values[i] = ec._SymbolRealizedGain_symbol(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "quantity":
-			out.Values[i] = ec._Holding_quantity(ctx, field, obj)
+			out.Values[i] = ec._SymbolRealizedGain_quantity(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "avgCost":
-			out.Values[i] = ec._Holding_avgCost(ctx, field, obj)
+		case "proceeds":
+			out.Values[i] = ec._SymbolRealizedGain_proceeds(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "createdAt":
-			out.Values[i] = ec._Holding_createdAt(ctx, field, obj)
+		case "costBasis":
+			out.Values[i] = ec._SymbolRealizedGain_costBasis(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "updatedAt":
-			out.Values[i] = ec._Holding_updatedAt(ctx, field, obj)
+		case "realizedPnl":
+			out.Values[i] = ec._SymbolRealizedGain_realizedPnl(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -2091,22 +5773,56 @@ func (ec *executionContext) _Holding(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var removeFromWatchlistResponseImplementors = []string{"RemoveFromWatchlistResponse"}
+var taxLotImplementors = []string{"TaxLot"}
 
-func (ec *executionContext) _RemoveFromWatchlistResponse(ctx context.Context, sel ast.SelectionSet, obj *model.RemoveFromWatchlistResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, removeFromWatchlistResponseImplementors)
+func (ec *executionContext) _TaxLot(ctx context.Context, sel ast.SelectionSet, obj *model.TaxLot) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, taxLotImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RemoveFromWatchlistResponse")
-		case "code":
-			out.Values[i] = ec._RemoveFromWatchlistResponse_code(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("TaxLot")
+		case "id":
+			out.Values[i] = ec._TaxLot_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "accountId":
+			out.Values[i] = ec._TaxLot_accountId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "symbol":
+			out.Values[i] = ec._TaxLot_symbol(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "orderId":
+			out.Values[i] = ec._TaxLot_orderId(ctx, field, obj)
+		case "quantity":
+			out.Values[i] = ec._TaxLot_quantity(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "remainingQuantity":
+			out.Values[i] = ec._TaxLot_remainingQuantity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "costBasis":
+			out.Values[i] = ec._TaxLot_costBasis(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "openedAt":
+			out.Values[i] = ec._TaxLot_openedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "closedAt":
+			out.Values[i] = ec._TaxLot_closedAt(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -2293,6 +6009,26 @@ func (ec *executionContext) marshalNAccount2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋ
 	return ec._Account(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNAccountPerformance2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐAccountPerformance(ctx context.Context, sel ast.SelectionSet, v *model.AccountPerformance) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AccountPerformance(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAccountValuation2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐAccountValuation(ctx context.Context, sel ast.SelectionSet, v *model.AccountValuation) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AccountValuation(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNAddToWatchlistRequest2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐAddToWatchlistRequest(ctx context.Context, v any) (model.AddToWatchlistRequest, error) {
 	res, err := ec.unmarshalInputAddToWatchlistRequest(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -2388,80 +6124,322 @@ func (ec *executionContext) marshalNGetHoldingsResponse2ᚖfafnirᚋapiᚑgatewa
 	return ec._GetHoldingsResponse(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNGetPortfolioPerformanceResponse2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetPortfolioPerformanceResponse(ctx context.Context, sel ast.SelectionSet, v model.GetPortfolioPerformanceResponse) graphql.Marshaler {
+	return ec._GetPortfolioPerformanceResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNGetPortfolioPerformanceResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetPortfolioPerformanceResponse(ctx context.Context, sel ast.SelectionSet, v *model.GetPortfolioPerformanceResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._GetPortfolioPerformanceResponse(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNGetPortfolioSummaryResponse2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetPortfolioSummaryResponse(ctx context.Context, sel ast.SelectionSet, v model.GetPortfolioSummaryResponse) graphql.Marshaler {
 	return ec._GetPortfolioSummaryResponse(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNGetPortfolioSummaryResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetPortfolioSummaryResponse(ctx context.Context, sel ast.SelectionSet, v *model.GetPortfolioSummaryResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNGetPortfolioSummaryResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetPortfolioSummaryResponse(ctx context.Context, sel ast.SelectionSet, v *model.GetPortfolioSummaryResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._GetPortfolioSummaryResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNGetPortfolioValuationResponse2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetPortfolioValuationResponse(ctx context.Context, sel ast.SelectionSet, v model.GetPortfolioValuationResponse) graphql.Marshaler {
+	return ec._GetPortfolioValuationResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNGetPortfolioValuationResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetPortfolioValuationResponse(ctx context.Context, sel ast.SelectionSet, v *model.GetPortfolioValuationResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._GetPortfolioValuationResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNGetRealizedGainsRequest2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetRealizedGainsRequest(ctx context.Context, v any) (model.GetRealizedGainsRequest, error) {
+	res, err := ec.unmarshalInputGetRealizedGainsRequest(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNGetRealizedGainsResponse2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetRealizedGainsResponse(ctx context.Context, sel ast.SelectionSet, v model.GetRealizedGainsResponse) graphql.Marshaler {
+	return ec._GetRealizedGainsResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNGetRealizedGainsResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetRealizedGainsResponse(ctx context.Context, sel ast.SelectionSet, v *model.GetRealizedGainsResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._GetRealizedGainsResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNGetTaxLotsRequest2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetTaxLotsRequest(ctx context.Context, v any) (model.GetTaxLotsRequest, error) {
+	res, err := ec.unmarshalInputGetTaxLotsRequest(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNGetTaxLotsResponse2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetTaxLotsResponse(ctx context.Context, sel ast.SelectionSet, v model.GetTaxLotsResponse) graphql.Marshaler {
+	return ec._GetTaxLotsResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNGetTaxLotsResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetTaxLotsResponse(ctx context.Context, sel ast.SelectionSet, v *model.GetTaxLotsResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._GetTaxLotsResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNGetTransactionsRequest2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetTransactionsRequest(ctx context.Context, v any) (model.GetTransactionsRequest, error) {
+	res, err := ec.unmarshalInputGetTransactionsRequest(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNGetTransactionsResponse2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetTransactionsResponse(ctx context.Context, sel ast.SelectionSet, v model.GetTransactionsResponse) graphql.Marshaler {
+	return ec._GetTransactionsResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNGetTransactionsResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetTransactionsResponse(ctx context.Context, sel ast.SelectionSet, v *model.GetTransactionsResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._GetTransactionsResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNGetWatchlistResponse2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetWatchlistResponse(ctx context.Context, sel ast.SelectionSet, v model.GetWatchlistResponse) graphql.Marshaler {
+	return ec._GetWatchlistResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNGetWatchlistResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetWatchlistResponse(ctx context.Context, sel ast.SelectionSet, v *model.GetWatchlistResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._GetWatchlistResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNHolding2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐHolding(ctx context.Context, sel ast.SelectionSet, v *model.Holding) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Holding(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNHoldingValuation2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐHoldingValuationᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.HoldingValuation) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNHoldingValuation2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐHoldingValuation(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNHoldingValuation2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐHoldingValuation(ctx context.Context, sel ast.SelectionSet, v *model.HoldingValuation) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._HoldingValuation(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNPerformancePoint2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐPerformancePointᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PerformancePoint) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPerformancePoint2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐPerformancePoint(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNPerformancePoint2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐPerformancePoint(ctx context.Context, sel ast.SelectionSet, v *model.PerformancePoint) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PerformancePoint(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRealizedGain2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐRealizedGain(ctx context.Context, sel ast.SelectionSet, v *model.RealizedGain) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RealizedGain(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNRemoveFromWatchlistRequest2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐRemoveFromWatchlistRequest(ctx context.Context, v any) (model.RemoveFromWatchlistRequest, error) {
+	res, err := ec.unmarshalInputRemoveFromWatchlistRequest(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRemoveFromWatchlistResponse2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐRemoveFromWatchlistResponse(ctx context.Context, sel ast.SelectionSet, v model.RemoveFromWatchlistResponse) graphql.Marshaler {
+	return ec._RemoveFromWatchlistResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRemoveFromWatchlistResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐRemoveFromWatchlistResponse(ctx context.Context, sel ast.SelectionSet, v *model.RemoveFromWatchlistResponse) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._GetPortfolioSummaryResponse(ctx, sel, v)
+	return ec._RemoveFromWatchlistResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNGetTransactionsRequest2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetTransactionsRequest(ctx context.Context, v any) (model.GetTransactionsRequest, error) {
-	res, err := ec.unmarshalInputGetTransactionsRequest(ctx, v)
+func (ec *executionContext) unmarshalNSelectTaxLotsRequest2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐSelectTaxLotsRequest(ctx context.Context, v any) (model.SelectTaxLotsRequest, error) {
+	res, err := ec.unmarshalInputSelectTaxLotsRequest(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNGetTransactionsResponse2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetTransactionsResponse(ctx context.Context, sel ast.SelectionSet, v model.GetTransactionsResponse) graphql.Marshaler {
-	return ec._GetTransactionsResponse(ctx, sel, &v)
+func (ec *executionContext) marshalNSelectTaxLotsResponse2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐSelectTaxLotsResponse(ctx context.Context, sel ast.SelectionSet, v model.SelectTaxLotsResponse) graphql.Marshaler {
+	return ec._SelectTaxLotsResponse(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNGetTransactionsResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetTransactionsResponse(ctx context.Context, sel ast.SelectionSet, v *model.GetTransactionsResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNSelectTaxLotsResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐSelectTaxLotsResponse(ctx context.Context, sel ast.SelectionSet, v *model.SelectTaxLotsResponse) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._GetTransactionsResponse(ctx, sel, v)
+	return ec._SelectTaxLotsResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNGetWatchlistResponse2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetWatchlistResponse(ctx context.Context, sel ast.SelectionSet, v model.GetWatchlistResponse) graphql.Marshaler {
-	return ec._GetWatchlistResponse(ctx, sel, &v)
+func (ec *executionContext) unmarshalNSetLotMethodRequest2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐSetLotMethodRequest(ctx context.Context, v any) (model.SetLotMethodRequest, error) {
+	res, err := ec.unmarshalInputSetLotMethodRequest(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNGetWatchlistResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐGetWatchlistResponse(ctx context.Context, sel ast.SelectionSet, v *model.GetWatchlistResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNSetLotMethodResponse2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐSetLotMethodResponse(ctx context.Context, sel ast.SelectionSet, v model.SetLotMethodResponse) graphql.Marshaler {
+	return ec._SetLotMethodResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSetLotMethodResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐSetLotMethodResponse(ctx context.Context, sel ast.SelectionSet, v *model.SetLotMethodResponse) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._GetWatchlistResponse(ctx, sel, v)
+	return ec._SetLotMethodResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNHolding2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐHolding(ctx context.Context, sel ast.SelectionSet, v *model.Holding) graphql.Marshaler {
+func (ec *executionContext) marshalNSymbolRealizedGain2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐSymbolRealizedGain(ctx context.Context, sel ast.SelectionSet, v *model.SymbolRealizedGain) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Holding(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNRemoveFromWatchlistRequest2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐRemoveFromWatchlistRequest(ctx context.Context, v any) (model.RemoveFromWatchlistRequest, error) {
-	res, err := ec.unmarshalInputRemoveFromWatchlistRequest(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNRemoveFromWatchlistResponse2fafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐRemoveFromWatchlistResponse(ctx context.Context, sel ast.SelectionSet, v model.RemoveFromWatchlistResponse) graphql.Marshaler {
-	return ec._RemoveFromWatchlistResponse(ctx, sel, &v)
+	return ec._SymbolRealizedGain(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNRemoveFromWatchlistResponse2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐRemoveFromWatchlistResponse(ctx context.Context, sel ast.SelectionSet, v *model.RemoveFromWatchlistResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNTaxLot2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐTaxLot(ctx context.Context, sel ast.SelectionSet, v *model.TaxLot) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._RemoveFromWatchlistResponse(ctx, sel, v)
+	return ec._TaxLot(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalNTransaction2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐTransaction(ctx context.Context, sel ast.SelectionSet, v *model.Transaction) graphql.Marshaler {
@@ -2557,6 +6535,100 @@ func (ec *executionContext) marshalOAccount2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋ
 	return ec._Account(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOAccountPerformance2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐAccountPerformanceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.AccountPerformance) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAccountPerformance2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐAccountPerformance(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalOAccountValuation2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐAccountValuationᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.AccountValuation) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAccountValuation2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐAccountValuation(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalOHolding2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐHoldingᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Holding) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -2611,6 +6683,147 @@ func (ec *executionContext) marshalOHolding2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋ
 	return ec._Holding(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalORealizedGain2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐRealizedGainᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RealizedGain) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRealizedGain2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐRealizedGain(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalOSymbolRealizedGain2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐSymbolRealizedGainᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SymbolRealizedGain) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSymbolRealizedGain2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐSymbolRealizedGain(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalOTaxLot2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐTaxLotᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.TaxLot) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTaxLot2ᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐTaxLot(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalOTransaction2ᚕᚖfafnirᚋapiᚑgatewayᚋgraphᚋmodelᚐTransactionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Transaction) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -42,14 +42,44 @@ type DirectiveRoot struct {
 
 type ComplexityRoot struct {
 	Account struct {
-		AccountNumber func(childComplexity int) int
-		Balance       func(childComplexity int) int
-		CreatedAt     func(childComplexity int) int
-		Currency      func(childComplexity int) int
-		ID            func(childComplexity int) int
-		Type          func(childComplexity int) int
-		UpdatedAt     func(childComplexity int) int
-		UserID        func(childComplexity int) int
+		AccountNumber    func(childComplexity int) int
+		AvailableBalance func(childComplexity int) int
+		Balance          func(childComplexity int) int
+		CreatedAt        func(childComplexity int) int
+		Currency         func(childComplexity int) int
+		ID               func(childComplexity int) int
+		LotMethod        func(childComplexity int) int
+		Type             func(childComplexity int) int
+		UpdatedAt        func(childComplexity int) int
+		UserID           func(childComplexity int) int
+	}
+
+	AccountPerformance struct {
+		AccountID                  func(childComplexity int) int
+		Currency                   func(childComplexity int) int
+		EndDate                    func(childComplexity int) int
+		EndEquity                  func(childComplexity int) int
+		Gain                       func(childComplexity int) int
+		MoneyWeightedReturnPercent func(childComplexity int) int
+		NetFlows                   func(childComplexity int) int
+		Points                     func(childComplexity int) int
+		StartDate                  func(childComplexity int) int
+		StartEquity                func(childComplexity int) int
+		TimeWeightedReturnPercent  func(childComplexity int) int
+	}
+
+	AccountValuation struct {
+		AccountID            func(childComplexity int) int
+		CashBalance          func(childComplexity int) int
+		CostBasis            func(childComplexity int) int
+		Currency             func(childComplexity int) int
+		DayChange            func(childComplexity int) int
+		DayChangePercent     func(childComplexity int) int
+		Holdings             func(childComplexity int) int
+		MarketValue          func(childComplexity int) int
+		TotalEquity          func(childComplexity int) int
+		UnrealizedPnl        func(childComplexity int) int
+		UnrealizedPnlPercent func(childComplexity int) int
 	}
 
 	AddToWatchlistResponse struct {
@@ -67,8 +97,9 @@ type ComplexityRoot struct {
 	}
 
 	CreateOrderResponse struct {
-		Code func(childComplexity int) int
-		Data func(childComplexity int) int
+		AttachedOrders func(childComplexity int) int
+		Code           func(childComplexity int) int
+		Data           func(childComplexity int) int
 	}
 
 	DepositResponse struct {
@@ -76,6 +107,17 @@ type ComplexityRoot struct {
 		NewBalance func(childComplexity int) int
 	}
 
+	ExecutionPricing struct {
+		Impact            func(childComplexity int) int
+		ImpactCoefficient func(childComplexity int) int
+		MinSpread         func(childComplexity int) int
+		Model             func(childComplexity int) int
+		Participation     func(childComplexity int) int
+		ReferencePrice    func(childComplexity int) int
+		Spread            func(childComplexity int) int
+		SpreadRangeShare  func(childComplexity int) int
+	}
+
 	GetHoldingResponse struct {
 		Code func(childComplexity int) int
 		Data func(childComplexity int) int
@@ -87,6 +129,13 @@ type ComplexityRoot struct {
 	}
 
 	GetOrderByIDResponse struct {
+		Amendments func(childComplexity int) int
+		Code       func(childComplexity int) int
+		Data       func(childComplexity int) int
+		Fills      func(childComplexity int) int
+	}
+
+	GetPortfolioPerformanceResponse struct {
 		Code func(childComplexity int) int
 		Data func(childComplexity int) int
 	}
@@ -95,13 +144,33 @@ type ComplexityRoot struct {
 		Accounts     func(childComplexity int) int
 		Code         func(childComplexity int) int
 		TotalBalance func(childComplexity int) int
+		TotalFees    func(childComplexity int) int
 	}
 
-	GetTransactionsResponse struct {
+	GetPortfolioValuationResponse struct {
+		Code     func(childComplexity int) int
+		Data     func(childComplexity int) int
+		ValuedAt func(childComplexity int) int
+	}
+
+	GetRealizedGainsResponse struct {
+		BySymbol         func(childComplexity int) int
+		Code             func(childComplexity int) int
+		Data             func(childComplexity int) int
+		TotalRealizedPnl func(childComplexity int) int
+	}
+
+	GetTaxLotsResponse struct {
 		Code func(childComplexity int) int
 		Data func(childComplexity int) int
 	}
 
+	GetTransactionsResponse struct {
+		Code      func(childComplexity int) int
+		Data      func(childComplexity int) int
+		TotalFees func(childComplexity int) int
+	}
+
 	GetWatchlistResponse struct {
 		Code func(childComplexity int) int
 		Data func(childComplexity int) int
@@ -113,13 +182,37 @@ type ComplexityRoot struct {
 	}
 
 	Holding struct {
-		AccountID func(childComplexity int) int
-		AvgCost   func(childComplexity int) int
-		CreatedAt func(childComplexity int) int
-		ID        func(childComplexity int) int
-		Quantity  func(childComplexity int) int
-		Symbol    func(childComplexity int) int
-		UpdatedAt func(childComplexity int) int
+		AccountID         func(childComplexity int) int
+		AvailableQuantity func(childComplexity int) int
+		AvgCost           func(childComplexity int) int
+		CreatedAt         func(childComplexity int) int
+		ID                func(childComplexity int) int
+		Quantity          func(childComplexity int) int
+		Symbol            func(childComplexity int) int
+		UpdatedAt         func(childComplexity int) int
+	}
+
+	HoldingValuation struct {
+		AvgCost              func(childComplexity int) int
+		CostBasis            func(childComplexity int) int
+		DayChange            func(childComplexity int) int
+		DayChangePercent     func(childComplexity int) int
+		ExchangeRate         func(childComplexity int) int
+		LastPrice            func(childComplexity int) int
+		MarketValue          func(childComplexity int) int
+		Priced               func(childComplexity int) int
+		Quantity             func(childComplexity int) int
+		QuoteAsOf            func(childComplexity int) int
+		QuoteCurrency        func(childComplexity int) int
+		Symbol               func(childComplexity int) int
+		UnrealizedPnl        func(childComplexity int) int
+		UnrealizedPnlPercent func(childComplexity int) int
+	}
+
+	ModifyOrderResponse struct {
+		Amendment func(childComplexity int) int
+		Code      func(childComplexity int) int
+		Data      func(childComplexity int) int
 	}
 
 	Mutation struct {
@@ -129,32 +222,73 @@ type ComplexityRoot struct {
 		CreateOrder         func(childComplexity int, request model.CreateOrderRequest) int
 		DeleteAccount       func(childComplexity int, accountID string) int
 		Deposit             func(childComplexity int, request model.DepositRequest) int
+		ModifyOrder         func(childComplexity int, orderID string, quantity *float64, price *float64) int
 		RemoveFromWatchlist func(childComplexity int, request model.RemoveFromWatchlistRequest) int
+		SelectTaxLots       func(childComplexity int, request model.SelectTaxLotsRequest) int
+		SetLotMethod        func(childComplexity int, request model.SetLotMethodRequest) int
 		Transfer            func(childComplexity int, request model.TransferRequest) int
 	}
 
 	Order struct {
 		AvgFillPrice   func(childComplexity int) int
 		CreatedAt      func(childComplexity int) int
+		ExpiresAt      func(childComplexity int) int
 		FilledQuantity func(childComplexity int) int
+		GroupID        func(childComplexity int) int
+		GroupType      func(childComplexity int) int
 		ID             func(childComplexity int) int
+		ParentOrderID  func(childComplexity int) int
 		Price          func(childComplexity int) int
 		Quantity       func(childComplexity int) int
+		RejectCode     func(childComplexity int) int
+		RejectReason   func(childComplexity int) int
 		Side           func(childComplexity int) int
 		Status         func(childComplexity int) int
 		StopPrice      func(childComplexity int) int
 		Symbol         func(childComplexity int) int
+		TimeInForce    func(childComplexity int) int
+		TrailAmount    func(childComplexity int) int
+		TrailPercent   func(childComplexity int) int
 		Type           func(childComplexity int) int
 		UpdatedAt      func(childComplexity int) int
 		UserID         func(childComplexity int) int
 	}
 
+	OrderAmendment struct {
+		CreatedAt  func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Price      func(childComplexity int) int
+		Quantity   func(childComplexity int) int
+		Reason     func(childComplexity int) int
+		ResolvedAt func(childComplexity int) int
+		Status     func(childComplexity int) int
+	}
+
+	OrderFill struct {
+		FilledAt    func(childComplexity int) int
+		Price       func(childComplexity int) int
+		Pricing     func(childComplexity int) int
+		Quantity    func(childComplexity int) int
+		QuoteAsOf   func(childComplexity int) int
+		QuoteSource func(childComplexity int) int
+		Sequence    func(childComplexity int) int
+	}
+
 	OrdersResponse struct {
 		Code  func(childComplexity int) int
 		Count func(childComplexity int) int
 		Data  func(childComplexity int) int
 	}
 
+	PerformancePoint struct {
+		CashBalance             func(childComplexity int) int
+		CumulativeReturnPercent func(childComplexity int) int
+		Date                    func(childComplexity int) int
+		MarketValue             func(childComplexity int) int
+		NetFlow                 func(childComplexity int) int
+		TotalEquity             func(childComplexity int) int
+	}
+
 	ProfileData struct {
 		FirstName func(childComplexity int) int
 		LastName  func(childComplexity int) int
@@ -167,21 +301,40 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
-		CheckPermission        func(childComplexity int, request model.HasPermissionRequest) int
-		GetHolding             func(childComplexity int, request model.GetHoldingRequest) int
-		GetHoldings            func(childComplexity int, request model.GetHoldingsRequest) int
-		GetOrderByOrderID      func(childComplexity int, request model.GetOrderByIDRequest) int
-		GetOrders              func(childComplexity int) int
-		GetPortfolioSummary    func(childComplexity int) int
-		GetProfileData         func(childComplexity int) int
-		GetStockHistoricalData func(childComplexity int, symbol string, period *string) int
-		GetStockMetadata       func(childComplexity int, symbol string) int
-		GetStockQuote          func(childComplexity int, symbol string) int
-		GetStockQuoteBatch     func(childComplexity int, symbols []string) int
-		GetTransactions        func(childComplexity int, request model.GetTransactionsRequest) int
-		GetWatchlist           func(childComplexity int) int
-		Health                 func(childComplexity int) int
-		SearchStocks           func(childComplexity int, query string, limit *int32) int
+		CheckPermission         func(childComplexity int, request model.HasPermissionRequest) int
+		GetHolding              func(childComplexity int, request model.GetHoldingRequest) int
+		GetHoldings             func(childComplexity int, request model.GetHoldingsRequest) int
+		GetOrderByOrderID       func(childComplexity int, request model.GetOrderByIDRequest) int
+		GetOrders               func(childComplexity int) int
+		GetPortfolioPerformance func(childComplexity int, period string) int
+		GetPortfolioSummary     func(childComplexity int) int
+		GetPortfolioValuation   func(childComplexity int) int
+		GetProfileData          func(childComplexity int) int
+		GetRealizedGains        func(childComplexity int, request model.GetRealizedGainsRequest) int
+		GetStockHistoricalData  func(childComplexity int, symbol string, period *string) int
+		GetStockMetadata        func(childComplexity int, symbol string) int
+		GetStockQuote           func(childComplexity int, symbol string) int
+		GetStockQuoteBatch      func(childComplexity int, symbols []string) int
+		GetTaxLots              func(childComplexity int, request model.GetTaxLotsRequest) int
+		GetTransactions         func(childComplexity int, request model.GetTransactionsRequest) int
+		GetWatchlist            func(childComplexity int) int
+		Health                  func(childComplexity int) int
+		SearchStocks            func(childComplexity int, query string, limit *int32) int
+	}
+
+	RealizedGain struct {
+		AccountID   func(childComplexity int) int
+		CostBasis   func(childComplexity int) int
+		ID          func(childComplexity int) int
+		LotID       func(childComplexity int) int
+		Method      func(childComplexity int) int
+		OpenedAt    func(childComplexity int) int
+		OrderID     func(childComplexity int) int
+		Proceeds    func(childComplexity int) int
+		Quantity    func(childComplexity int) int
+		RealizedAt  func(childComplexity int) int
+		RealizedPnl func(childComplexity int) int
+		Symbol      func(childComplexity int) int
 	}
 
 	RemoveFromWatchlistResponse struct {
@@ -192,6 +345,15 @@ type ComplexityRoot struct {
 		HasPermission func(childComplexity int) int
 	}
 
+	SelectTaxLotsResponse struct {
+		Code func(childComplexity int) int
+	}
+
+	SetLotMethodResponse struct {
+		Code func(childComplexity int) int
+		Data func(childComplexity int) int
+	}
+
 	StockData struct {
 		Currency         func(childComplexity int) int
 		Exchange         func(childComplexity int) int
@@ -260,6 +422,26 @@ type ComplexityRoot struct {
 		Symbol           func(childComplexity int) int
 	}
 
+	SymbolRealizedGain struct {
+		CostBasis   func(childComplexity int) int
+		Proceeds    func(childComplexity int) int
+		Quantity    func(childComplexity int) int
+		RealizedPnl func(childComplexity int) int
+		Symbol      func(childComplexity int) int
+	}
+
+	TaxLot struct {
+		AccountID         func(childComplexity int) int
+		ClosedAt          func(childComplexity int) int
+		CostBasis         func(childComplexity int) int
+		ID                func(childComplexity int) int
+		OpenedAt          func(childComplexity int) int
+		OrderID           func(childComplexity int) int
+		Quantity          func(childComplexity int) int
+		RemainingQuantity func(childComplexity int) int
+		Symbol            func(childComplexity int) int
+	}
+
 	Transaction struct {
 		AccountID   func(childComplexity int) int
 		Amount      func(childComplexity int) int
@@ -306,6 +488,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Account.AccountNumber(childComplexity), true
 
+	case "Account.availableBalance":
+		if e.complexity.Account.AvailableBalance == nil {
+			break
+		}
+
+		return e.complexity.Account.AvailableBalance(childComplexity), true
+
 	case "Account.balance":
 		if e.complexity.Account.Balance == nil {
 			break
@@ -334,6 +523,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Account.ID(childComplexity), true
 
+	case "Account.lotMethod":
+		if e.complexity.Account.LotMethod == nil {
+			break
+		}
+
+		return e.complexity.Account.LotMethod(childComplexity), true
+
 	case "Account.type":
 		if e.complexity.Account.Type == nil {
 			break
@@ -355,6 +551,160 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Account.UserID(childComplexity), true
 
+	case "AccountPerformance.accountId":
+		if e.complexity.AccountPerformance.AccountID == nil {
+			break
+		}
+
+		return e.complexity.AccountPerformance.AccountID(childComplexity), true
+
+	case "AccountPerformance.currency":
+		if e.complexity.AccountPerformance.Currency == nil {
+			break
+		}
+
+		return e.complexity.AccountPerformance.Currency(childComplexity), true
+
+	case "AccountPerformance.endDate":
+		if e.complexity.AccountPerformance.EndDate == nil {
+			break
+		}
+
+		return e.complexity.AccountPerformance.EndDate(childComplexity), true
+
+	case "AccountPerformance.endEquity":
+		if e.complexity.AccountPerformance.EndEquity == nil {
+			break
+		}
+
+		return e.complexity.AccountPerformance.EndEquity(childComplexity), true
+
+	case "AccountPerformance.gain":
+		if e.complexity.AccountPerformance.Gain == nil {
+			break
+		}
+
+		return e.complexity.AccountPerformance.Gain(childComplexity), true
+
+	case "AccountPerformance.moneyWeightedReturnPercent":
+		if e.complexity.AccountPerformance.MoneyWeightedReturnPercent == nil {
+			break
+		}
+
+		return e.complexity.AccountPerformance.MoneyWeightedReturnPercent(childComplexity), true
+
+	case "AccountPerformance.netFlows":
+		if e.complexity.AccountPerformance.NetFlows == nil {
+			break
+		}
+
+		return e.complexity.AccountPerformance.NetFlows(childComplexity), true
+
+	case "AccountPerformance.points":
+		if e.complexity.AccountPerformance.Points == nil {
+			break
+		}
+
+		return e.complexity.AccountPerformance.Points(childComplexity), true
+
+	case "AccountPerformance.startDate":
+		if e.complexity.AccountPerformance.StartDate == nil {
+			break
+		}
+
+		return e.complexity.AccountPerformance.StartDate(childComplexity), true
+
+	case "AccountPerformance.startEquity":
+		if e.complexity.AccountPerformance.StartEquity == nil {
+			break
+		}
+
+		return e.complexity.AccountPerformance.StartEquity(childComplexity), true
+
+	case "AccountPerformance.timeWeightedReturnPercent":
+		if e.complexity.AccountPerformance.TimeWeightedReturnPercent == nil {
+			break
+		}
+
+		return e.complexity.AccountPerformance.TimeWeightedReturnPercent(childComplexity), true
+
+	case "AccountValuation.accountId":
+		if e.complexity.AccountValuation.AccountID == nil {
+			break
+		}
+
+		return e.complexity.AccountValuation.AccountID(childComplexity), true
+
+	case "AccountValuation.cashBalance":
+		if e.complexity.AccountValuation.CashBalance == nil {
+			break
+		}
+
+		return e.complexity.AccountValuation.CashBalance(childComplexity), true
+
+	case "AccountValuation.costBasis":
+		if e.complexity.AccountValuation.CostBasis == nil {
+			break
+		}
+
+		return e.complexity.AccountValuation.CostBasis(childComplexity), true
+
+	case "AccountValuation.currency":
+		if e.complexity.AccountValuation.Currency == nil {
+			break
+		}
+
+		return e.complexity.AccountValuation.Currency(childComplexity), true
+
+	case "AccountValuation.dayChange":
+		if e.complexity.AccountValuation.DayChange == nil {
+			break
+		}
+
+		return e.complexity.AccountValuation.DayChange(childComplexity), true
+
+	case "AccountValuation.dayChangePercent":
+		if e.complexity.AccountValuation.DayChangePercent == nil {
+			break
+		}
+
+		return e.complexity.AccountValuation.DayChangePercent(childComplexity), true
+
+	case "AccountValuation.holdings":
+		if e.complexity.AccountValuation.Holdings == nil {
+			break
+		}
+
+		return e.complexity.AccountValuation.Holdings(childComplexity), true
+
+	case "AccountValuation.marketValue":
+		if e.complexity.AccountValuation.MarketValue == nil {
+			break
+		}
+
+		return e.complexity.AccountValuation.MarketValue(childComplexity), true
+
+	case "AccountValuation.totalEquity":
+		if e.complexity.AccountValuation.TotalEquity == nil {
+			break
+		}
+
+		return e.complexity.AccountValuation.TotalEquity(childComplexity), true
+
+	case "AccountValuation.unrealizedPnl":
+		if e.complexity.AccountValuation.UnrealizedPnl == nil {
+			break
+		}
+
+		return e.complexity.AccountValuation.UnrealizedPnl(childComplexity), true
+
+	case "AccountValuation.unrealizedPnlPercent":
+		if e.complexity.AccountValuation.UnrealizedPnlPercent == nil {
+			break
+		}
+
+		return e.complexity.AccountValuation.UnrealizedPnlPercent(childComplexity), true
+
 	case "AddToWatchlistResponse.code":
 		if e.complexity.AddToWatchlistResponse.Code == nil {
 			break
@@ -390,6 +740,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.CreateAccountResponse.Data(childComplexity), true
 
+	case "CreateOrderResponse.attachedOrders":
+		if e.complexity.CreateOrderResponse.AttachedOrders == nil {
+			break
+		}
+
+		return e.complexity.CreateOrderResponse.AttachedOrders(childComplexity), true
+
 	case "CreateOrderResponse.code":
 		if e.complexity.CreateOrderResponse.Code == nil {
 			break
@@ -418,6 +775,62 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.DepositResponse.NewBalance(childComplexity), true
 
+	case "ExecutionPricing.impact":
+		if e.complexity.ExecutionPricing.Impact == nil {
+			break
+		}
+
+		return e.complexity.ExecutionPricing.Impact(childComplexity), true
+
+	case "ExecutionPricing.impactCoefficient":
+		if e.complexity.ExecutionPricing.ImpactCoefficient == nil {
+			break
+		}
+
+		return e.complexity.ExecutionPricing.ImpactCoefficient(childComplexity), true
+
+	case "ExecutionPricing.minSpread":
+		if e.complexity.ExecutionPricing.MinSpread == nil {
+			break
+		}
+
+		return e.complexity.ExecutionPricing.MinSpread(childComplexity), true
+
+	case "ExecutionPricing.model":
+		if e.complexity.ExecutionPricing.Model == nil {
+			break
+		}
+
+		return e.complexity.ExecutionPricing.Model(childComplexity), true
+
+	case "ExecutionPricing.participation":
+		if e.complexity.ExecutionPricing.Participation == nil {
+			break
+		}
+
+		return e.complexity.ExecutionPricing.Participation(childComplexity), true
+
+	case "ExecutionPricing.referencePrice":
+		if e.complexity.ExecutionPricing.ReferencePrice == nil {
+			break
+		}
+
+		return e.complexity.ExecutionPricing.ReferencePrice(childComplexity), true
+
+	case "ExecutionPricing.spread":
+		if e.complexity.ExecutionPricing.Spread == nil {
+			break
+		}
+
+		return e.complexity.ExecutionPricing.Spread(childComplexity), true
+
+	case "ExecutionPricing.spreadRangeShare":
+		if e.complexity.ExecutionPricing.SpreadRangeShare == nil {
+			break
+		}
+
+		return e.complexity.ExecutionPricing.SpreadRangeShare(childComplexity), true
+
 	case "GetHoldingResponse.code":
 		if e.complexity.GetHoldingResponse.Code == nil {
 			break
@@ -446,6 +859,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.GetHoldingsResponse.Data(childComplexity), true
 
+	case "GetOrderByIDResponse.amendments":
+		if e.complexity.GetOrderByIDResponse.Amendments == nil {
+			break
+		}
+
+		return e.complexity.GetOrderByIDResponse.Amendments(childComplexity), true
+
 	case "GetOrderByIDResponse.code":
 		if e.complexity.GetOrderByIDResponse.Code == nil {
 			break
@@ -460,6 +880,27 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.GetOrderByIDResponse.Data(childComplexity), true
 
+	case "GetOrderByIDResponse.fills":
+		if e.complexity.GetOrderByIDResponse.Fills == nil {
+			break
+		}
+
+		return e.complexity.GetOrderByIDResponse.Fills(childComplexity), true
+
+	case "GetPortfolioPerformanceResponse.code":
+		if e.complexity.GetPortfolioPerformanceResponse.Code == nil {
+			break
+		}
+
+		return e.complexity.GetPortfolioPerformanceResponse.Code(childComplexity), true
+
+	case "GetPortfolioPerformanceResponse.data":
+		if e.complexity.GetPortfolioPerformanceResponse.Data == nil {
+			break
+		}
+
+		return e.complexity.GetPortfolioPerformanceResponse.Data(childComplexity), true
+
 	case "GetPortfolioSummaryResponse.accounts":
 		if e.complexity.GetPortfolioSummaryResponse.Accounts == nil {
 			break
@@ -481,6 +922,76 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.GetPortfolioSummaryResponse.TotalBalance(childComplexity), true
 
+	case "GetPortfolioSummaryResponse.totalFees":
+		if e.complexity.GetPortfolioSummaryResponse.TotalFees == nil {
+			break
+		}
+
+		return e.complexity.GetPortfolioSummaryResponse.TotalFees(childComplexity), true
+
+	case "GetPortfolioValuationResponse.code":
+		if e.complexity.GetPortfolioValuationResponse.Code == nil {
+			break
+		}
+
+		return e.complexity.GetPortfolioValuationResponse.Code(childComplexity), true
+
+	case "GetPortfolioValuationResponse.data":
+		if e.complexity.GetPortfolioValuationResponse.Data == nil {
+			break
+		}
+
+		return e.complexity.GetPortfolioValuationResponse.Data(childComplexity), true
+
+	case "GetPortfolioValuationResponse.valuedAt":
+		if e.complexity.GetPortfolioValuationResponse.ValuedAt == nil {
+			break
+		}
+
+		return e.complexity.GetPortfolioValuationResponse.ValuedAt(childComplexity), true
+
+	case "GetRealizedGainsResponse.bySymbol":
+		if e.complexity.GetRealizedGainsResponse.BySymbol == nil {
+			break
+		}
+
+		return e.complexity.GetRealizedGainsResponse.BySymbol(childComplexity), true
+
+	case "GetRealizedGainsResponse.code":
+		if e.complexity.GetRealizedGainsResponse.Code == nil {
+			break
+		}
+
+		return e.complexity.GetRealizedGainsResponse.Code(childComplexity), true
+
+	case "GetRealizedGainsResponse.data":
+		if e.complexity.GetRealizedGainsResponse.Data == nil {
+			break
+		}
+
+		return e.complexity.GetRealizedGainsResponse.Data(childComplexity), true
+
+	case "GetRealizedGainsResponse.totalRealizedPnl":
+		if e.complexity.GetRealizedGainsResponse.TotalRealizedPnl == nil {
+			break
+		}
+
+		return e.complexity.GetRealizedGainsResponse.TotalRealizedPnl(childComplexity), true
+
+	case "GetTaxLotsResponse.code":
+		if e.complexity.GetTaxLotsResponse.Code == nil {
+			break
+		}
+
+		return e.complexity.GetTaxLotsResponse.Code(childComplexity), true
+
+	case "GetTaxLotsResponse.data":
+		if e.complexity.GetTaxLotsResponse.Data == nil {
+			break
+		}
+
+		return e.complexity.GetTaxLotsResponse.Data(childComplexity), true
+
 	case "GetTransactionsResponse.code":
 		if e.complexity.GetTransactionsResponse.Code == nil {
 			break
@@ -495,6 +1006,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.GetTransactionsResponse.Data(childComplexity), true
 
+	case "GetTransactionsResponse.totalFees":
+		if e.complexity.GetTransactionsResponse.TotalFees == nil {
+			break
+		}
+
+		return e.complexity.GetTransactionsResponse.TotalFees(childComplexity), true
+
 	case "GetWatchlistResponse.code":
 		if e.complexity.GetWatchlistResponse.Code == nil {
 			break
@@ -530,6 +1048,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Holding.AccountID(childComplexity), true
 
+	case "Holding.availableQuantity":
+		if e.complexity.Holding.AvailableQuantity == nil {
+			break
+		}
+
+		return e.complexity.Holding.AvailableQuantity(childComplexity), true
+
 	case "Holding.avgCost":
 		if e.complexity.Holding.AvgCost == nil {
 			break
@@ -572,6 +1097,125 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Holding.UpdatedAt(childComplexity), true
 
+	case "HoldingValuation.avgCost":
+		if e.complexity.HoldingValuation.AvgCost == nil {
+			break
+		}
+
+		return e.complexity.HoldingValuation.AvgCost(childComplexity), true
+
+	case "HoldingValuation.costBasis":
+		if e.complexity.HoldingValuation.CostBasis == nil {
+			break
+		}
+
+		return e.complexity.HoldingValuation.CostBasis(childComplexity), true
+
+	case "HoldingValuation.dayChange":
+		if e.complexity.HoldingValuation.DayChange == nil {
+			break
+		}
+
+		return e.complexity.HoldingValuation.DayChange(childComplexity), true
+
+	case "HoldingValuation.dayChangePercent":
+		if e.complexity.HoldingValuation.DayChangePercent == nil {
+			break
+		}
+
+		return e.complexity.HoldingValuation.DayChangePercent(childComplexity), true
+
+	case "HoldingValuation.exchangeRate":
+		if e.complexity.HoldingValuation.ExchangeRate == nil {
+			break
+		}
+
+		return e.complexity.HoldingValuation.ExchangeRate(childComplexity), true
+
+	case "HoldingValuation.lastPrice":
+		if e.complexity.HoldingValuation.LastPrice == nil {
+			break
+		}
+
+		return e.complexity.HoldingValuation.LastPrice(childComplexity), true
+
+	case "HoldingValuation.marketValue":
+		if e.complexity.HoldingValuation.MarketValue == nil {
+			break
+		}
+
+		return e.complexity.HoldingValuation.MarketValue(childComplexity), true
+
+	case "HoldingValuation.priced":
+		if e.complexity.HoldingValuation.Priced == nil {
+			break
+		}
+
+		return e.complexity.HoldingValuation.Priced(childComplexity), true
+
+	case "HoldingValuation.quantity":
+		if e.complexity.HoldingValuation.Quantity == nil {
+			break
+		}
+
+		return e.complexity.HoldingValuation.Quantity(childComplexity), true
+
+	case "HoldingValuation.quoteAsOf":
+		if e.complexity.HoldingValuation.QuoteAsOf == nil {
+			break
+		}
+
+		return e.complexity.HoldingValuation.QuoteAsOf(childComplexity), true
+
+	case "HoldingValuation.quoteCurrency":
+		if e.complexity.HoldingValuation.QuoteCurrency == nil {
+			break
+		}
+
+		return e.complexity.HoldingValuation.QuoteCurrency(childComplexity), true
+
+	case "HoldingValuation.symbol":
+		if e.complexity.HoldingValuation.Symbol == nil {
+			break
+		}
+
+		return e.complexity.HoldingValuation.Symbol(childComplexity), true
+
+	case "HoldingValuation.unrealizedPnl":
+		if e.complexity.HoldingValuation.UnrealizedPnl == nil {
+			break
+		}
+
+		return e.complexity.HoldingValuation.UnrealizedPnl(childComplexity), true
+
+	case "HoldingValuation.unrealizedPnlPercent":
+		if e.complexity.HoldingValuation.UnrealizedPnlPercent == nil {
+			break
+		}
+
+		return e.complexity.HoldingValuation.UnrealizedPnlPercent(childComplexity), true
+
+	case "ModifyOrderResponse.amendment":
+		if e.complexity.ModifyOrderResponse.Amendment == nil {
+			break
+		}
+
+		return e.complexity.ModifyOrderResponse.Amendment(childComplexity), true
+
+	case "ModifyOrderResponse.code":
+		if e.complexity.ModifyOrderResponse.Code == nil {
+			break
+		}
+
+		return e.complexity.ModifyOrderResponse.Code(childComplexity), true
+
+	case "ModifyOrderResponse.data":
+		if e.complexity.ModifyOrderResponse.Data == nil {
+			break
+		}
+
+		return e.complexity.ModifyOrderResponse.Data(childComplexity), true
+
 	case "Mutation.addToWatchlist":
 		if e.complexity.Mutation.AddToWatchlist == nil {
 			break
@@ -644,6 +1288,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.Deposit(childComplexity, args["request"].(model.DepositRequest)), true
 
+	case "Mutation.modifyOrder":
+		if e.complexity.Mutation.ModifyOrder == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_modifyOrder_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ModifyOrder(childComplexity, args["orderId"].(string), args["quantity"].(*float64), args["price"].(*float64)), true
+
 	case "Mutation.removeFromWatchlist":
 		if e.complexity.Mutation.RemoveFromWatchlist == nil {
 			break
@@ -656,6 +1312,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.RemoveFromWatchlist(childComplexity, args["request"].(model.RemoveFromWatchlistRequest)), true
 
+	case "Mutation.selectTaxLots":
+		if e.complexity.Mutation.SelectTaxLots == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_selectTaxLots_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SelectTaxLots(childComplexity, args["request"].(model.SelectTaxLotsRequest)), true
+
+	case "Mutation.setLotMethod":
+		if e.complexity.Mutation.SetLotMethod == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setLotMethod_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetLotMethod(childComplexity, args["request"].(model.SetLotMethodRequest)), true
+
 	case "Mutation.transfer":
 		if e.complexity.Mutation.Transfer == nil {
 			break
@@ -682,6 +1362,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Order.CreatedAt(childComplexity), true
 
+	case "Order.expiresAt":
+		if e.complexity.Order.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.Order.ExpiresAt(childComplexity), true
+
 	case "Order.filledQuantity":
 		if e.complexity.Order.FilledQuantity == nil {
 			break
@@ -689,6 +1376,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Order.FilledQuantity(childComplexity), true
 
+	case "Order.groupId":
+		if e.complexity.Order.GroupID == nil {
+			break
+		}
+
+		return e.complexity.Order.GroupID(childComplexity), true
+
+	case "Order.groupType":
+		if e.complexity.Order.GroupType == nil {
+			break
+		}
+
+		return e.complexity.Order.GroupType(childComplexity), true
+
 	case "Order.id":
 		if e.complexity.Order.ID == nil {
 			break
@@ -696,6 +1397,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Order.ID(childComplexity), true
 
+	case "Order.parentOrderId":
+		if e.complexity.Order.ParentOrderID == nil {
+			break
+		}
+
+		return e.complexity.Order.ParentOrderID(childComplexity), true
+
 	case "Order.price":
 		if e.complexity.Order.Price == nil {
 			break
@@ -710,6 +1418,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Order.Quantity(childComplexity), true
 
+	case "Order.rejectCode":
+		if e.complexity.Order.RejectCode == nil {
+			break
+		}
+
+		return e.complexity.Order.RejectCode(childComplexity), true
+
+	case "Order.rejectReason":
+		if e.complexity.Order.RejectReason == nil {
+			break
+		}
+
+		return e.complexity.Order.RejectReason(childComplexity), true
+
 	case "Order.side":
 		if e.complexity.Order.Side == nil {
 			break
@@ -736,28 +1458,147 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			break
 		}
 
-		return e.complexity.Order.Symbol(childComplexity), true
+		return e.complexity.Order.Symbol(childComplexity), true
+
+	case "Order.timeInForce":
+		if e.complexity.Order.TimeInForce == nil {
+			break
+		}
+
+		return e.complexity.Order.TimeInForce(childComplexity), true
+
+	case "Order.trailAmount":
+		if e.complexity.Order.TrailAmount == nil {
+			break
+		}
+
+		return e.complexity.Order.TrailAmount(childComplexity), true
+
+	case "Order.trailPercent":
+		if e.complexity.Order.TrailPercent == nil {
+			break
+		}
+
+		return e.complexity.Order.TrailPercent(childComplexity), true
+
+	case "Order.type":
+		if e.complexity.Order.Type == nil {
+			break
+		}
+
+		return e.complexity.Order.Type(childComplexity), true
+
+	case "Order.updatedAt":
+		if e.complexity.Order.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Order.UpdatedAt(childComplexity), true
+
+	case "Order.userId":
+		if e.complexity.Order.UserID == nil {
+			break
+		}
+
+		return e.complexity.Order.UserID(childComplexity), true
+
+	case "OrderAmendment.createdAt":
+		if e.complexity.OrderAmendment.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.OrderAmendment.CreatedAt(childComplexity), true
+
+	case "OrderAmendment.id":
+		if e.complexity.OrderAmendment.ID == nil {
+			break
+		}
+
+		return e.complexity.OrderAmendment.ID(childComplexity), true
+
+	case "OrderAmendment.price":
+		if e.complexity.OrderAmendment.Price == nil {
+			break
+		}
+
+		return e.complexity.OrderAmendment.Price(childComplexity), true
+
+	case "OrderAmendment.quantity":
+		if e.complexity.OrderAmendment.Quantity == nil {
+			break
+		}
+
+		return e.complexity.OrderAmendment.Quantity(childComplexity), true
+
+	case "OrderAmendment.reason":
+		if e.complexity.OrderAmendment.Reason == nil {
+			break
+		}
+
+		return e.complexity.OrderAmendment.Reason(childComplexity), true
+
+	case "OrderAmendment.resolvedAt":
+		if e.complexity.OrderAmendment.ResolvedAt == nil {
+			break
+		}
+
+		return e.complexity.OrderAmendment.ResolvedAt(childComplexity), true
+
+	case "OrderAmendment.status":
+		if e.complexity.OrderAmendment.Status == nil {
+			break
+		}
+
+		return e.complexity.OrderAmendment.Status(childComplexity), true
+
+	case "OrderFill.filledAt":
+		if e.complexity.OrderFill.FilledAt == nil {
+			break
+		}
+
+		return e.complexity.OrderFill.FilledAt(childComplexity), true
+
+	case "OrderFill.price":
+		if e.complexity.OrderFill.Price == nil {
+			break
+		}
+
+		return e.complexity.OrderFill.Price(childComplexity), true
+
+	case "OrderFill.pricing":
+		if e.complexity.OrderFill.Pricing == nil {
+			break
+		}
+
+		return e.complexity.OrderFill.Pricing(childComplexity), true
+
+	case "OrderFill.quantity":
+		if e.complexity.OrderFill.Quantity == nil {
+			break
+		}
+
+		return e.complexity.OrderFill.Quantity(childComplexity), true
 
-	case "Order.type":
-		if e.complexity.Order.Type == nil {
+	case "OrderFill.quoteAsOf":
+		if e.complexity.OrderFill.QuoteAsOf == nil {
 			break
 		}
 
-		return e.complexity.Order.Type(childComplexity), true
+		return e.complexity.OrderFill.QuoteAsOf(childComplexity), true
 
-	case "Order.updatedAt":
-		if e.complexity.Order.UpdatedAt == nil {
+	case "OrderFill.quoteSource":
+		if e.complexity.OrderFill.QuoteSource == nil {
 			break
 		}
 
-		return e.complexity.Order.UpdatedAt(childComplexity), true
+		return e.complexity.OrderFill.QuoteSource(childComplexity), true
 
-	case "Order.userId":
-		if e.complexity.Order.UserID == nil {
+	case "OrderFill.sequence":
+		if e.complexity.OrderFill.Sequence == nil {
 			break
 		}
 
-		return e.complexity.Order.UserID(childComplexity), true
+		return e.complexity.OrderFill.Sequence(childComplexity), true
 
 	case "OrdersResponse.code":
 		if e.complexity.OrdersResponse.Code == nil {
@@ -780,6 +1621,48 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.OrdersResponse.Data(childComplexity), true
 
+	case "PerformancePoint.cashBalance":
+		if e.complexity.PerformancePoint.CashBalance == nil {
+			break
+		}
+
+		return e.complexity.PerformancePoint.CashBalance(childComplexity), true
+
+	case "PerformancePoint.cumulativeReturnPercent":
+		if e.complexity.PerformancePoint.CumulativeReturnPercent == nil {
+			break
+		}
+
+		return e.complexity.PerformancePoint.CumulativeReturnPercent(childComplexity), true
+
+	case "PerformancePoint.date":
+		if e.complexity.PerformancePoint.Date == nil {
+			break
+		}
+
+		return e.complexity.PerformancePoint.Date(childComplexity), true
+
+	case "PerformancePoint.marketValue":
+		if e.complexity.PerformancePoint.MarketValue == nil {
+			break
+		}
+
+		return e.complexity.PerformancePoint.MarketValue(childComplexity), true
+
+	case "PerformancePoint.netFlow":
+		if e.complexity.PerformancePoint.NetFlow == nil {
+			break
+		}
+
+		return e.complexity.PerformancePoint.NetFlow(childComplexity), true
+
+	case "PerformancePoint.totalEquity":
+		if e.complexity.PerformancePoint.TotalEquity == nil {
+			break
+		}
+
+		return e.complexity.PerformancePoint.TotalEquity(childComplexity), true
+
 	case "ProfileData.firstName":
 		if e.complexity.ProfileData.FirstName == nil {
 			break
@@ -870,6 +1753,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.GetOrders(childComplexity), true
 
+	case "Query.getPortfolioPerformance":
+		if e.complexity.Query.GetPortfolioPerformance == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getPortfolioPerformance_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetPortfolioPerformance(childComplexity, args["period"].(string)), true
+
 	case "Query.getPortfolioSummary":
 		if e.complexity.Query.GetPortfolioSummary == nil {
 			break
@@ -877,6 +1772,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.GetPortfolioSummary(childComplexity), true
 
+	case "Query.getPortfolioValuation":
+		if e.complexity.Query.GetPortfolioValuation == nil {
+			break
+		}
+
+		return e.complexity.Query.GetPortfolioValuation(childComplexity), true
+
 	case "Query.getProfileData":
 		if e.complexity.Query.GetProfileData == nil {
 			break
@@ -884,6 +1786,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.GetProfileData(childComplexity), true
 
+	case "Query.getRealizedGains":
+		if e.complexity.Query.GetRealizedGains == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getRealizedGains_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetRealizedGains(childComplexity, args["request"].(model.GetRealizedGainsRequest)), true
+
 	case "Query.getStockHistoricalData":
 		if e.complexity.Query.GetStockHistoricalData == nil {
 			break
@@ -932,6 +1846,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.GetStockQuoteBatch(childComplexity, args["symbols"].([]string)), true
 
+	case "Query.getTaxLots":
+		if e.complexity.Query.GetTaxLots == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getTaxLots_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetTaxLots(childComplexity, args["request"].(model.GetTaxLotsRequest)), true
+
 	case "Query.getTransactions":
 		if e.complexity.Query.GetTransactions == nil {
 			break
@@ -970,6 +1896,90 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.SearchStocks(childComplexity, args["query"].(string), args["limit"].(*int32)), true
 
+	case "RealizedGain.accountId":
+		if e.complexity.RealizedGain.AccountID == nil {
+			break
+		}
+
+		return e.complexity.RealizedGain.AccountID(childComplexity), true
+
+	case "RealizedGain.costBasis":
+		if e.complexity.RealizedGain.CostBasis == nil {
+			break
+		}
+
+		return e.complexity.RealizedGain.CostBasis(childComplexity), true
+
+	case "RealizedGain.id":
+		if e.complexity.RealizedGain.ID == nil {
+			break
+		}
+
+		return e.complexity.RealizedGain.ID(childComplexity), true
+
+	case "RealizedGain.lotId":
+		if e.complexity.RealizedGain.LotID == nil {
+			break
+		}
+
+		return e.complexity.RealizedGain.LotID(childComplexity), true
+
+	case "RealizedGain.method":
+		if e.complexity.RealizedGain.Method == nil {
+			break
+		}
+
+		return e.complexity.RealizedGain.Method(childComplexity), true
+
+	case "RealizedGain.openedAt":
+		if e.complexity.RealizedGain.OpenedAt == nil {
+			break
+		}
+
+		return e.complexity.RealizedGain.OpenedAt(childComplexity), true
+
+	case "RealizedGain.orderId":
+		if e.complexity.RealizedGain.OrderID == nil {
+			break
+		}
+
+		return e.complexity.RealizedGain.OrderID(childComplexity), true
+
+	case "RealizedGain.proceeds":
+		if e.complexity.RealizedGain.Proceeds == nil {
+			break
+		}
+
+		return e.complexity.RealizedGain.Proceeds(childComplexity), true
+
+	case "RealizedGain.quantity":
+		if e.complexity.RealizedGain.Quantity == nil {
+			break
+		}
+
+		return e.complexity.RealizedGain.Quantity(childComplexity), true
+
+	case "RealizedGain.realizedAt":
+		if e.complexity.RealizedGain.RealizedAt == nil {
+			break
+		}
+
+		return e.complexity.RealizedGain.RealizedAt(childComplexity), true
+
+	case "RealizedGain.realizedPnl":
+		if e.complexity.RealizedGain.RealizedPnl == nil {
+			break
+		}
+
+		return e.complexity.RealizedGain.RealizedPnl(childComplexity), true
+
+	case "RealizedGain.symbol":
+		if e.complexity.RealizedGain.Symbol == nil {
+			break
+		}
+
+		return e.complexity.RealizedGain.Symbol(childComplexity), true
+
 	case "RemoveFromWatchlistResponse.code":
 		if e.complexity.RemoveFromWatchlistResponse.Code == nil {
 			break
@@ -984,6 +1994,27 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.SecurityPermission.HasPermission(childComplexity), true
 
+	case "SelectTaxLotsResponse.code":
+		if e.complexity.SelectTaxLotsResponse.Code == nil {
+			break
+		}
+
+		return e.complexity.SelectTaxLotsResponse.Code(childComplexity), true
+
+	case "SetLotMethodResponse.code":
+		if e.complexity.SetLotMethodResponse.Code == nil {
+			break
+		}
+
+		return e.complexity.SetLotMethodResponse.Code(childComplexity), true
+
+	case "SetLotMethodResponse.data":
+		if e.complexity.SetLotMethodResponse.Data == nil {
+			break
+		}
+
+		return e.complexity.SetLotMethodResponse.Data(childComplexity), true
+
 	case "StockData.currency":
 		if e.complexity.StockData.Currency == nil {
 			break
@@ -1292,6 +2323,104 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.StockSearchResult.Symbol(childComplexity), true
 
+	case "SymbolRealizedGain.costBasis":
+		if e.complexity.SymbolRealizedGain.CostBasis == nil {
+			break
+		}
+
+		return e.complexity.SymbolRealizedGain.CostBasis(childComplexity), true
+
+	case "SymbolRealizedGain.proceeds":
+		if e.complexity.SymbolRealizedGain.Proceeds == nil {
+			break
+		}
+
+		return e.complexity.SymbolRealizedGain.Proceeds(childComplexity), true
+
+	case "SymbolRealizedGain.quantity":
+		if e.complexity.SymbolRealizedGain.Quantity == nil {
+			break
+		}
+
+		return e.complexity.SymbolRealizedGain.Quantity(childComplexity), true
+
+	case "SymbolRealizedGain.realizedPnl":
+		if e.complexity.SymbolRealizedGain.RealizedPnl == nil {
+			break
+		}
+
+		return e.complexity.SymbolRealizedGain.RealizedPnl(childComplexity), true
+
+	case "SymbolRealizedGain.symbol":
+		if e.complexity.SymbolRealizedGain.Symbol == nil {
+			break
+		}
+
+		return e.complexity.SymbolRealizedGain.Symbol(childComplexity), true
+
+	case "TaxLot.accountId":
+		if e.complexity.TaxLot.AccountID == nil {
+			break
+		}
+
+		return e.complexity.TaxLot.AccountID(childComplexity), true
+
+	case "TaxLot.closedAt":
+		if e.complexity.TaxLot.ClosedAt == nil {
+			break
+		}
+
+		return e.complexity.TaxLot.ClosedAt(childComplexity), true
+
+	case "TaxLot.costBasis":
+		if e.complexity.TaxLot.CostBasis == nil {
+			break
+		}
+
+		return e.complexity.TaxLot.CostBasis(childComplexity), true
+
+	case "TaxLot.id":
+		if e.complexity.TaxLot.ID == nil {
+			break
+		}
+
+		return e.complexity.TaxLot.ID(childComplexity), true
+
+	case "TaxLot.openedAt":
+		if e.complexity.TaxLot.OpenedAt == nil {
+			break
+		}
+
+		return e.complexity.TaxLot.OpenedAt(childComplexity), true
+
+	case "TaxLot.orderId":
+		if e.complexity.TaxLot.OrderID == nil {
+			break
+		}
+
+		return e.complexity.TaxLot.OrderID(childComplexity), true
+
+	case "TaxLot.quantity":
+		if e.complexity.TaxLot.Quantity == nil {
+			break
+		}
+
+		return e.complexity.TaxLot.Quantity(childComplexity), true
+
+	case "TaxLot.remainingQuantity":
+		if e.complexity.TaxLot.RemainingQuantity == nil {
+			break
+		}
+
+		return e.complexity.TaxLot.RemainingQuantity(childComplexity), true
+
+	case "TaxLot.symbol":
+		if e.complexity.TaxLot.Symbol == nil {
+			break
+		}
+
+		return e.complexity.TaxLot.Symbol(childComplexity), true
+
 	case "Transaction.accountId":
 		if e.complexity.Transaction.AccountID == nil {
 			break
@@ -1371,15 +2500,20 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
 		ec.unmarshalInputAddToWatchlistRequest,
+		ec.unmarshalInputAttachedOrderInput,
 		ec.unmarshalInputCreateAccountRequest,
 		ec.unmarshalInputCreateOrderRequest,
 		ec.unmarshalInputDepositRequest,
 		ec.unmarshalInputGetHoldingRequest,
 		ec.unmarshalInputGetHoldingsRequest,
 		ec.unmarshalInputGetOrderByIDRequest,
+		ec.unmarshalInputGetRealizedGainsRequest,
+		ec.unmarshalInputGetTaxLotsRequest,
 		ec.unmarshalInputGetTransactionsRequest,
 		ec.unmarshalInputHasPermissionRequest,
 		ec.unmarshalInputRemoveFromWatchlistRequest,
+		ec.unmarshalInputSelectTaxLotsRequest,
+		ec.unmarshalInputSetLotMethodRequest,
 		ec.unmarshalInputTransferRequest,
 	)
 	first := true
@@ -1503,6 +2637,15 @@ type Mutation
     avgFillPrice: Float!
     createdAt: String!
     updatedAt: String!
+    timeInForce: String!
+    expiresAt: String
+    trailAmount: Float!
+    trailPercent: Float!
+    groupId: String
+    groupType: String
+    parentOrderId: String
+    rejectCode: String
+    rejectReason: String
 }
 
 input CreateOrderRequest {
@@ -1511,6 +2654,20 @@ input CreateOrderRequest {
     type: String!
     quantity: Float!
     price: Float
+    stopPrice: Float
+    timeInForce: String
+    trailAmount: Float
+    trailPercent: Float
+    groupType: String
+    attachedOrders: [AttachedOrderInput!]
+}
+
+input AttachedOrderInput {
+    type: String!
+    price: Float
+    stopPrice: Float
+    trailAmount: Float
+    trailPercent: Float
 }
 
 input GetOrderByIDRequest {
@@ -1519,11 +2676,47 @@ input GetOrderByIDRequest {
 
 type GetOrderByIDResponse {
     data: Order
+    fills: [OrderFill!]
+    amendments: [OrderAmendment!]
     code: String!
 }
 
+# a requested change to a resting order; it only takes effect once the trade engine applies it
+type OrderAmendment {
+    id: String!
+    quantity: Float!
+    price: Float!
+    status: String! # PENDING, APPLIED or REJECTED
+    reason: String
+    createdAt: String!
+    resolvedAt: String
+}
+
+type OrderFill {
+    sequence: Int!
+    quantity: Float!
+    price: Float!
+    filledAt: String!
+    pricing: ExecutionPricing # null for fills against another user's order
+    quoteSource: String! # provider of the quote filled against, "internal" for fills against another user's order
+    quoteAsOf: String
+}
+
+# how a fill was priced against the quote, and what was paid above or below the last price
+type ExecutionPricing {
+    model: String! # last, spread or spread_impact
+    referencePrice: Float!
+    spread: Float! # estimated bid/ask spread as a share of the reference price, half of it is paid
+    impact: Float! # market impact as a share of the reference price
+    participation: Float! # fill quantity as a share of the quote's volume
+    minSpread: Float!
+    spreadRangeShare: Float!
+    impactCoefficient: Float!
+}
+
 type CreateOrderResponse {
     data: Order
+    attachedOrders: [Order!]
     code: String!
 }
 
@@ -1532,6 +2725,12 @@ type CancelOrderResponse {
     code: String!
 }
 
+type ModifyOrderResponse {
+    data: Order # unchanged until the amendment is applied
+    amendment: OrderAmendment
+    code: String!
+}
+
 type OrdersResponse {
     data: [Order!]
     count: Int!
@@ -1546,6 +2745,8 @@ extend type Query {
 extend type Mutation {
     createOrder(request: CreateOrderRequest!): CreateOrderResponse!
     cancelOrder(orderId: String!): CancelOrderResponse!
+    # changes the quantity and/or limit price of a resting order; an omitted value stays as it is
+    modifyOrder(orderId: String!, quantity: Float, price: Float): ModifyOrderResponse!
 }
 `, BuiltIn: false},
 	{Name: "../schemas/portfolio.graphqls", Input: `type Account {
@@ -1555,6 +2756,8 @@ extend type Mutation {
     type: String!
     currency: String!
     balance: Float!
+    availableBalance: Float!
+    lotMethod: String! # FIFO, LIFO, SPECIFIC, or AVERAGE_COST
     createdAt: String!
     updatedAt: String!
 }
@@ -1565,10 +2768,46 @@ type Holding {
     symbol: String!
     quantity: Float!
     avgCost: Float!
+    availableQuantity: Float!
     createdAt: String!
     updatedAt: String!
 }
 
+type TaxLot {
+    id: String!
+    accountId: String!
+    symbol: String!
+    orderId: String
+    quantity: Float!
+    remainingQuantity: Float!
+    costBasis: Float! # per share, fees of the buy included
+    openedAt: String!
+    closedAt: String
+}
+
+type RealizedGain {
+    id: String!
+    accountId: String!
+    symbol: String!
+    lotId: String
+    orderId: String!
+    quantity: Float!
+    proceeds: Float! # after the sell's fees
+    costBasis: Float!
+    realizedPnl: Float!
+    method: String!
+    openedAt: String
+    realizedAt: String!
+}
+
+type SymbolRealizedGain {
+    symbol: String!
+    quantity: Float!
+    proceeds: Float!
+    costBasis: Float!
+    realizedPnl: Float!
+}
+
 type WatchlistItem {
     symbol: String!
     addedAt: String!
@@ -1587,6 +2826,7 @@ type Transaction {
 input CreateAccountRequest {
     type: String! # either SAVINGS, INVESTMENT, or CHEQUING
     currency: String! # either USD or CAD
+    lotMethod: String # FIFO (default), LIFO, SPECIFIC, or AVERAGE_COST
 }
 
 type CreateAccountResponse {
@@ -1597,6 +2837,7 @@ type CreateAccountResponse {
 type GetPortfolioSummaryResponse {
     accounts: [Account!]
     totalBalance: Float!
+    totalFees: Float! # trading fees charged across all accounts
     code: String!
 }
 
@@ -1647,6 +2888,7 @@ input GetTransactionsRequest {
 type GetTransactionsResponse {
     code: String!
     data: [Transaction!]
+    totalFees: Float! # trading fees charged to the account
 }
 
 input DepositRequest {
@@ -1671,12 +2913,127 @@ type TransferResponse {
     code: String!
 }
 
+# a holding marked to its latest quote; amounts are in the account currency unless noted
+type HoldingValuation {
+    symbol: String!
+    quantity: Float!
+    avgCost: Float!
+    quoteCurrency: String
+    exchangeRate: Float!
+    lastPrice: Float! # in the quote currency
+    marketValue: Float!
+    costBasis: Float!
+    unrealizedPnl: Float!
+    unrealizedPnlPercent: Float!
+    dayChange: Float!
+    dayChangePercent: Float!
+    priced: Boolean! # false when no quote or rate was available; the holding is then valued at cost
+    quoteAsOf: String
+}
+
+type AccountValuation {
+    accountId: String!
+    currency: String!
+    cashBalance: Float!
+    marketValue: Float!
+    costBasis: Float!
+    unrealizedPnl: Float!
+    unrealizedPnlPercent: Float!
+    dayChange: Float!
+    dayChangePercent: Float!
+    totalEquity: Float! # cash plus the market value of the holdings
+    holdings: [HoldingValuation!]!
+}
+
+type GetPortfolioValuationResponse {
+    code: String!
+    data: [AccountValuation!]
+    valuedAt: String
+}
+
+type PerformancePoint {
+    date: String!
+    cashBalance: Float!
+    marketValue: Float!
+    totalEquity: Float!
+    netFlow: Float! # deposits and transfers since the previous point
+    cumulativeReturnPercent: Float! # time-weighted, from the start of the period
+}
+
+type AccountPerformance {
+    accountId: String!
+    currency: String!
+    startDate: String!
+    endDate: String!
+    startEquity: Float!
+    endEquity: Float!
+    netFlows: Float!
+    gain: Float! # change in equity the flows do not account for
+    timeWeightedReturnPercent: Float!
+    moneyWeightedReturnPercent: Float!
+    points: [PerformancePoint!]!
+}
+
+type GetPortfolioPerformanceResponse {
+    code: String!
+    data: [AccountPerformance!]
+}
+
+input SetLotMethodRequest {
+    accountId: String!
+    method: String! # FIFO, LIFO, SPECIFIC, or AVERAGE_COST
+}
+
+type SetLotMethodResponse {
+    code: String!
+    data: Account
+}
+
+input SelectTaxLotsRequest {
+    accountId: String!
+    orderId: String! # the sell order
+    lotIds: [String!]! # closed in this order
+}
+
+type SelectTaxLotsResponse {
+    code: String!
+}
+
+input GetTaxLotsRequest {
+    accountId: String!
+    symbol: String
+    includeClosed: Boolean
+}
+
+type GetTaxLotsResponse {
+    code: String!
+    data: [TaxLot!]
+}
+
+input GetRealizedGainsRequest {
+    accountId: String!
+    symbol: String
+    from: String # RFC 3339, inclusive
+    to: String # RFC 3339, exclusive
+}
+
+type GetRealizedGainsResponse {
+    code: String!
+    data: [RealizedGain!]
+    bySymbol: [SymbolRealizedGain!]
+    totalRealizedPnl: Float!
+}
+
 extend type Query {
     getPortfolioSummary: GetPortfolioSummaryResponse!
     getHoldings(request: GetHoldingsRequest!): GetHoldingsResponse!
     getHolding(request: GetHoldingRequest!): GetHoldingResponse!
     getWatchlist: GetWatchlistResponse!
     getTransactions(request: GetTransactionsRequest!): GetTransactionsResponse!
+    getTaxLots(request: GetTaxLotsRequest!): GetTaxLotsResponse!
+    getRealizedGains(request: GetRealizedGainsRequest!): GetRealizedGainsResponse!
+    getPortfolioValuation: GetPortfolioValuationResponse!
+    getPortfolioPerformance(period: String!): GetPortfolioPerformanceResponse! # 1D, 1W, 1M, 3M, 6M, 1Y, 2Y, 5Y, or MAX
 }
 
 extend type Mutation {
@@ -1686,6 +3043,8 @@ extend type Mutation {
     deleteAccount(accountId: String!): Boolean!
     deposit(request: DepositRequest!): DepositResponse!
     transfer(request: TransferRequest!): TransferResponse!
+    setLotMethod(request: SetLotMethodRequest!): SetLotMethodResponse!
+    selectTaxLots(request: SelectTaxLotsRequest!): SelectTaxLotsResponse!
 }
 `, BuiltIn: false},
 	{Name: "../schemas/security.graphqls", Input: `input HasPermissionRequest {
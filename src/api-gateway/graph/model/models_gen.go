@@ -3,14 +3,44 @@
 package model
 
 type Account struct {
-	ID            string  `json:"id"`
-	UserID        string  `json:"userId"`
-	AccountNumber string  `json:"accountNumber"`
-	Type          string  `json:"type"`
-	Currency      string  `json:"currency"`
-	Balance       float64 `json:"balance"`
-	CreatedAt     string  `json:"createdAt"`
-	UpdatedAt     string  `json:"updatedAt"`
+	ID               string  `json:"id"`
+	UserID           string  `json:"userId"`
+	AccountNumber    string  `json:"accountNumber"`
+	Type             string  `json:"type"`
+	Currency         string  `json:"currency"`
+	Balance          float64 `json:"balance"`
+	AvailableBalance float64 `json:"availableBalance"`
+	LotMethod        string  `json:"lotMethod"`
+	CreatedAt        string  `json:"createdAt"`
+	UpdatedAt        string  `json:"updatedAt"`
+}
+
+type AccountPerformance struct {
+	AccountID                  string              `json:"accountId"`
+	Currency                   string              `json:"currency"`
+	StartDate                  string              `json:"startDate"`
+	EndDate                    string              `json:"endDate"`
+	StartEquity                float64             `json:"startEquity"`
+	EndEquity                  float64             `json:"endEquity"`
+	NetFlows                   float64             `json:"netFlows"`
+	Gain                       float64             `json:"gain"`
+	TimeWeightedReturnPercent  float64             `json:"timeWeightedReturnPercent"`
+	MoneyWeightedReturnPercent float64             `json:"moneyWeightedReturnPercent"`
+	Points                     []*PerformancePoint `json:"points"`
+}
+
+type AccountValuation struct {
+	AccountID            string              `json:"accountId"`
+	Currency             string              `json:"currency"`
+	CashBalance          float64             `json:"cashBalance"`
+	MarketValue          float64             `json:"marketValue"`
+	CostBasis            float64             `json:"costBasis"`
+	UnrealizedPnl        float64             `json:"unrealizedPnl"`
+	UnrealizedPnlPercent float64             `json:"unrealizedPnlPercent"`
+	DayChange            float64             `json:"dayChange"`
+	DayChangePercent     float64             `json:"dayChangePercent"`
+	TotalEquity          float64             `json:"totalEquity"`
+	Holdings             []*HoldingValuation `json:"holdings"`
 }
 
 type AddToWatchlistRequest struct {
@@ -21,14 +51,23 @@ type AddToWatchlistResponse struct {
 	Code string `json:"code"`
 }
 
+type AttachedOrderInput struct {
+	Type         string   `json:"type"`
+	Price        *float64 `json:"price,omitempty"`
+	StopPrice    *float64 `json:"stopPrice,omitempty"`
+	TrailAmount  *float64 `json:"trailAmount,omitempty"`
+	TrailPercent *float64 `json:"trailPercent,omitempty"`
+}
+
 type CancelOrderResponse struct {
 	Data *Order `json:"data,omitempty"`
 	Code string `json:"code"`
 }
 
 type CreateAccountRequest struct {
-	Type     string `json:"type"`
-	Currency string `json:"currency"`
+	Type      string  `json:"type"`
+	Currency  string  `json:"currency"`
+	LotMethod *string `json:"lotMethod,omitempty"`
 }
 
 type CreateAccountResponse struct {
@@ -37,16 +76,23 @@ type CreateAccountResponse struct {
 }
 
 type CreateOrderRequest struct {
-	Symbol   string   `json:"symbol"`
-	Side     string   `json:"side"`
-	Type     string   `json:"type"`
-	Quantity float64  `json:"quantity"`
-	Price    *float64 `json:"price,omitempty"`
+	Symbol         string                `json:"symbol"`
+	Side           string                `json:"side"`
+	Type           string                `json:"type"`
+	Quantity       float64               `json:"quantity"`
+	Price          *float64              `json:"price,omitempty"`
+	StopPrice      *float64              `json:"stopPrice,omitempty"`
+	TimeInForce    *string               `json:"timeInForce,omitempty"`
+	TrailAmount    *float64              `json:"trailAmount,omitempty"`
+	TrailPercent   *float64              `json:"trailPercent,omitempty"`
+	GroupType      *string               `json:"groupType,omitempty"`
+	AttachedOrders []*AttachedOrderInput `json:"attachedOrders,omitempty"`
 }
 
 type CreateOrderResponse struct {
-	Data *Order `json:"data,omitempty"`
-	Code string `json:"code"`
+	Data           *Order   `json:"data,omitempty"`
+	AttachedOrders []*Order `json:"attachedOrders,omitempty"`
+	Code           string   `json:"code"`
 }
 
 type DepositRequest struct {
@@ -60,6 +106,17 @@ type DepositResponse struct {
 	NewBalance float64 `json:"newBalance"`
 }
 
+type ExecutionPricing struct {
+	Model             string  `json:"model"`
+	ReferencePrice    float64 `json:"referencePrice"`
+	Spread            float64 `json:"spread"`
+	Impact            float64 `json:"impact"`
+	Participation     float64 `json:"participation"`
+	MinSpread         float64 `json:"minSpread"`
+	SpreadRangeShare  float64 `json:"spreadRangeShare"`
+	ImpactCoefficient float64 `json:"impactCoefficient"`
+}
+
 type GetHoldingRequest struct {
 	AccountID string `json:"accountId"`
 	Symbol    string `json:"symbol"`
@@ -84,23 +141,63 @@ type GetOrderByIDRequest struct {
 }
 
 type GetOrderByIDResponse struct {
-	Data *Order `json:"data,omitempty"`
-	Code string `json:"code"`
+	Data       *Order            `json:"data,omitempty"`
+	Fills      []*OrderFill      `json:"fills,omitempty"`
+	Amendments []*OrderAmendment `json:"amendments,omitempty"`
+	Code       string            `json:"code"`
+}
+
+type GetPortfolioPerformanceResponse struct {
+	Code string                `json:"code"`
+	Data []*AccountPerformance `json:"data,omitempty"`
 }
 
 type GetPortfolioSummaryResponse struct {
 	Accounts     []*Account `json:"accounts,omitempty"`
 	TotalBalance float64    `json:"totalBalance"`
+	TotalFees    float64    `json:"totalFees"`
 	Code         string     `json:"code"`
 }
 
+type GetPortfolioValuationResponse struct {
+	Code     string              `json:"code"`
+	Data     []*AccountValuation `json:"data,omitempty"`
+	ValuedAt *string             `json:"valuedAt,omitempty"`
+}
+
+type GetRealizedGainsRequest struct {
+	AccountID string  `json:"accountId"`
+	Symbol    *string `json:"symbol,omitempty"`
+	From      *string `json:"from,omitempty"`
+	To        *string `json:"to,omitempty"`
+}
+
+type GetRealizedGainsResponse struct {
+	Code             string                `json:"code"`
+	Data             []*RealizedGain       `json:"data,omitempty"`
+	BySymbol         []*SymbolRealizedGain `json:"bySymbol,omitempty"`
+	TotalRealizedPnl float64               `json:"totalRealizedPnl"`
+}
+
+type GetTaxLotsRequest struct {
+	AccountID     string  `json:"accountId"`
+	Symbol        *string `json:"symbol,omitempty"`
+	IncludeClosed *bool   `json:"includeClosed,omitempty"`
+}
+
+type GetTaxLotsResponse struct {
+	Code string    `json:"code"`
+	Data []*TaxLot `json:"data,omitempty"`
+}
+
 type GetTransactionsRequest struct {
 	AccountID string `json:"accountId"`
 }
 
 type GetTransactionsResponse struct {
-	Code string         `json:"code"`
-	Data []*Transaction `json:"data,omitempty"`
+	Code      string         `json:"code"`
+	Data      []*Transaction `json:"data,omitempty"`
+	TotalFees float64        `json:"totalFees"`
 }
 
 type GetWatchlistResponse struct {
@@ -118,13 +215,37 @@ type HasPermissionResponse struct {
 }
 
 type Holding struct {
-	ID        string  `json:"id"`
-	AccountID string  `json:"accountId"`
-	Symbol    string  `json:"symbol"`
-	Quantity  float64 `json:"quantity"`
-	AvgCost   float64 `json:"avgCost"`
-	CreatedAt string  `json:"createdAt"`
-	UpdatedAt string  `json:"updatedAt"`
+	ID                string  `json:"id"`
+	AccountID         string  `json:"accountId"`
+	Symbol            string  `json:"symbol"`
+	Quantity          float64 `json:"quantity"`
+	AvgCost           float64 `json:"avgCost"`
+	AvailableQuantity float64 `json:"availableQuantity"`
+	CreatedAt         string  `json:"createdAt"`
+	UpdatedAt         string  `json:"updatedAt"`
+}
+
+type HoldingValuation struct {
+	Symbol               string  `json:"symbol"`
+	Quantity             float64 `json:"quantity"`
+	AvgCost              float64 `json:"avgCost"`
+	QuoteCurrency        *string `json:"quoteCurrency,omitempty"`
+	ExchangeRate         float64 `json:"exchangeRate"`
+	LastPrice            float64 `json:"lastPrice"`
+	MarketValue          float64 `json:"marketValue"`
+	CostBasis            float64 `json:"costBasis"`
+	UnrealizedPnl        float64 `json:"unrealizedPnl"`
+	UnrealizedPnlPercent float64 `json:"unrealizedPnlPercent"`
+	DayChange            float64 `json:"dayChange"`
+	DayChangePercent     float64 `json:"dayChangePercent"`
+	Priced               bool    `json:"priced"`
+	QuoteAsOf            *string `json:"quoteAsOf,omitempty"`
+}
+
+type ModifyOrderResponse struct {
+	Data      *Order          `json:"data,omitempty"`
+	Amendment *OrderAmendment `json:"amendment,omitempty"`
+	Code      string          `json:"code"`
 }
 
 type Mutation struct {
@@ -144,6 +265,35 @@ type Order struct {
 	AvgFillPrice   float64 `json:"avgFillPrice"`
 	CreatedAt      string  `json:"createdAt"`
 	UpdatedAt      string  `json:"updatedAt"`
+	TimeInForce    string  `json:"timeInForce"`
+	ExpiresAt      *string `json:"expiresAt,omitempty"`
+	TrailAmount    float64 `json:"trailAmount"`
+	TrailPercent   float64 `json:"trailPercent"`
+	GroupID        *string `json:"groupId,omitempty"`
+	GroupType      *string `json:"groupType,omitempty"`
+	ParentOrderID  *string `json:"parentOrderId,omitempty"`
+	RejectCode     *string `json:"rejectCode,omitempty"`
+	RejectReason   *string `json:"rejectReason,omitempty"`
+}
+
+type OrderAmendment struct {
+	ID         string  `json:"id"`
+	Quantity   float64 `json:"quantity"`
+	Price      float64 `json:"price"`
+	Status     string  `json:"status"`
+	Reason     *string `json:"reason,omitempty"`
+	CreatedAt  string  `json:"createdAt"`
+	ResolvedAt *string `json:"resolvedAt,omitempty"`
+}
+
+type OrderFill struct {
+	Sequence    int32             `json:"sequence"`
+	Quantity    float64           `json:"quantity"`
+	Price       float64           `json:"price"`
+	FilledAt    string            `json:"filledAt"`
+	Pricing     *ExecutionPricing `json:"pricing,omitempty"`
+	QuoteSource string            `json:"quoteSource"`
+	QuoteAsOf   *string           `json:"quoteAsOf,omitempty"`
 }
 
 type OrdersResponse struct {
@@ -152,6 +302,15 @@ type OrdersResponse struct {
 	Code  string   `json:"code"`
 }
 
+type PerformancePoint struct {
+	Date                    string  `json:"date"`
+	CashBalance             float64 `json:"cashBalance"`
+	MarketValue             float64 `json:"marketValue"`
+	TotalEquity             float64 `json:"totalEquity"`
+	NetFlow                 float64 `json:"netFlow"`
+	CumulativeReturnPercent float64 `json:"cumulativeReturnPercent"`
+}
+
 type ProfileData struct {
 	UserID    string `json:"userId"`
 	FirstName string `json:"firstName"`
@@ -166,6 +325,21 @@ type ProfileDataResponse struct {
 type Query struct {
 }
 
+type RealizedGain struct {
+	ID          string  `json:"id"`
+	AccountID   string  `json:"accountId"`
+	Symbol      string  `json:"symbol"`
+	LotID       *string `json:"lotId,omitempty"`
+	OrderID     string  `json:"orderId"`
+	Quantity    float64 `json:"quantity"`
+	Proceeds    float64 `json:"proceeds"`
+	CostBasis   float64 `json:"costBasis"`
+	RealizedPnl float64 `json:"realizedPnl"`
+	Method      string  `json:"method"`
+	OpenedAt    *string `json:"openedAt,omitempty"`
+	RealizedAt  string  `json:"realizedAt"`
+}
+
 type RemoveFromWatchlistRequest struct {
 	Symbol string `json:"symbol"`
 }
@@ -178,6 +352,26 @@ type SecurityPermission struct {
 	HasPermission bool `json:"hasPermission"`
 }
 
+type SelectTaxLotsRequest struct {
+	AccountID string   `json:"accountId"`
+	OrderID   string   `json:"orderId"`
+	LotIds    []string `json:"lotIds"`
+}
+
+type SelectTaxLotsResponse struct {
+	Code string `json:"code"`
+}
+
+type SetLotMethodRequest struct {
+	AccountID string `json:"accountId"`
+	Method    string `json:"method"`
+}
+
+type SetLotMethodResponse struct {
+	Code string   `json:"code"`
+	Data *Account `json:"data,omitempty"`
+}
+
 type StockData struct {
 	Symbol           string `json:"symbol"`
 	Name             string `json:"name"`
@@ -246,6 +440,26 @@ type StockSearchResult struct {
 	InstrumentType   string `json:"instrumentType"`
 }
 
+type SymbolRealizedGain struct {
+	Symbol      string  `json:"symbol"`
+	Quantity    float64 `json:"quantity"`
+	Proceeds    float64 `json:"proceeds"`
+	CostBasis   float64 `json:"costBasis"`
+	RealizedPnl float64 `json:"realizedPnl"`
+}
+
+type TaxLot struct {
+	ID                string  `json:"id"`
+	AccountID         string  `json:"accountId"`
+	Symbol            string  `json:"symbol"`
+	OrderID           *string `json:"orderId,omitempty"`
+	Quantity          float64 `json:"quantity"`
+	RemainingQuantity float64 `json:"remainingQuantity"`
+	CostBasis         float64 `json:"costBasis"`
+	OpenedAt          string  `json:"openedAt"`
+	ClosedAt          *string `json:"closedAt,omitempty"`
+}
+
 type Transaction struct {
 	ID          string  `json:"id"`
 	AccountID   string  `json:"accountId"`
@@ -50,6 +50,25 @@ func (r *mutationResolver) CancelOrder(ctx context.Context, orderID string) (*mo
 	return &resp, nil
 }
 
+// ModifyOrder is the resolver for the modifyOrder field.
+func (r *mutationResolver) ModifyOrder(ctx context.Context, orderID string, quantity *float64, price *float64) (*model.ModifyOrderResponse, error) {
+	userID, err := middleware.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.SecurityClient.CheckPermission(ctx, userID.String(), rbac.OrderStocks)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.OrderClient.ModifyOrder(ctx, orderID, userID.String(), quantity, price)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // GetOrders is the resolver for the getOrders field.
 func (r *queryResolver) GetOrders(ctx context.Context) (*model.OrdersResponse, error) {
 	userID, err := middleware.GetUserIdFromContext(ctx)
@@ -131,6 +131,50 @@ func (r *mutationResolver) Transfer(ctx context.Context, request model.TransferR
 	return &resp, nil
 }
 
+// SetLotMethod is the resolver for the setLotMethod field.
+func (r *mutationResolver) SetLotMethod(ctx context.Context, request model.SetLotMethodRequest) (*model.SetLotMethodResponse, error) {
+	userID, err := middleware.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.requireOwnedAccounts(ctx, userID.String(), request.AccountID); err != nil {
+		return nil, err
+	}
+
+	_, err = r.SecurityClient.CheckPermission(ctx, userID.String(), rbac.ManageOwnAccounts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.PortfolioClient.SetLotMethod(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SelectTaxLots is the resolver for the selectTaxLots field.
+func (r *mutationResolver) SelectTaxLots(ctx context.Context, request model.SelectTaxLotsRequest) (*model.SelectTaxLotsResponse, error) {
+	userID, err := middleware.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.requireOwnedAccounts(ctx, userID.String(), request.AccountID); err != nil {
+		return nil, err
+	}
+
+	_, err = r.SecurityClient.CheckPermission(ctx, userID.String(), rbac.ManageOwnAccounts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.PortfolioClient.SelectTaxLots(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // GetPortfolioSummary is the resolver for the getPortfolioSummary field.
 func (r *queryResolver) GetPortfolioSummary(ctx context.Context) (*model.GetPortfolioSummaryResponse, error) {
 	userID, err := middleware.GetUserIdFromContext(ctx)
@@ -234,3 +278,85 @@ func (r *queryResolver) GetTransactions(ctx context.Context, request model.GetTr
 	}
 	return &resp, nil
 }
+
+// GetTaxLots is the resolver for the getTaxLots field.
+func (r *queryResolver) GetTaxLots(ctx context.Context, request model.GetTaxLotsRequest) (*model.GetTaxLotsResponse, error) {
+	userID, err := middleware.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.requireOwnedAccounts(ctx, userID.String(), request.AccountID); err != nil {
+		return nil, err
+	}
+
+	_, err = r.SecurityClient.CheckPermission(ctx, userID.String(), rbac.ManageOwnPortfolio)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.PortfolioClient.GetTaxLots(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetRealizedGains is the resolver for the getRealizedGains field.
+func (r *queryResolver) GetRealizedGains(ctx context.Context, request model.GetRealizedGainsRequest) (*model.GetRealizedGainsResponse, error) {
+	userID, err := middleware.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.requireOwnedAccounts(ctx, userID.String(), request.AccountID); err != nil {
+		return nil, err
+	}
+
+	_, err = r.SecurityClient.CheckPermission(ctx, userID.String(), rbac.ManageOwnPortfolio)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.PortfolioClient.GetRealizedGains(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetPortfolioValuation is the resolver for the getPortfolioValuation field.
+func (r *queryResolver) GetPortfolioValuation(ctx context.Context) (*model.GetPortfolioValuationResponse, error) {
+	userID, err := middleware.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.SecurityClient.CheckPermission(ctx, userID.String(), rbac.ManageOwnPortfolio)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.PortfolioClient.GetPortfolioValuation(ctx, userID.String())
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetPortfolioPerformance is the resolver for the getPortfolioPerformance field.
+func (r *queryResolver) GetPortfolioPerformance(ctx context.Context, period string) (*model.GetPortfolioPerformanceResponse, error) {
+	userID, err := middleware.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.SecurityClient.CheckPermission(ctx, userID.String(), rbac.ManageOwnPortfolio)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.PortfolioClient.GetPortfolioPerformance(ctx, userID.String(), period)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type OrderClient struct {
@@ -65,13 +66,58 @@ func (c *OrderClient) InsertOrder(ctx context.Context, userID string, input mode
 	}
 	type_ := pb.OrderType(typeVal)
 
+	timeInForce := pb.TimeInForce_TIME_IN_FORCE_UNSPECIFIED
+	if input.TimeInForce != nil && *input.TimeInForce != "" {
+		tifVal, ok := pb.TimeInForce_value["TIME_IN_FORCE_"+strings.ToUpper(*input.TimeInForce)]
+		if !ok {
+			return model.CreateOrderResponse{
+				Code: basepb.ErrorCode_INVALID_ARGUMENT.String(),
+			}, nil
+		}
+		timeInForce = pb.TimeInForce(tifVal)
+	}
+
+	groupType := pb.OrderGroupType_ORDER_GROUP_TYPE_UNSPECIFIED
+	if input.GroupType != nil && *input.GroupType != "" {
+		groupVal, ok := pb.OrderGroupType_value["ORDER_GROUP_TYPE_"+strings.ToUpper(*input.GroupType)]
+		if !ok {
+			return model.CreateOrderResponse{
+				Code: basepb.ErrorCode_INVALID_ARGUMENT.String(),
+			}, nil
+		}
+		groupType = pb.OrderGroupType(groupVal)
+	}
+
+	attached := make([]*pb.AttachedOrder, 0, len(input.AttachedOrders))
+	for _, leg := range input.AttachedOrders {
+		legType, ok := pb.OrderType_value["ORDER_TYPE_"+strings.ToUpper(leg.Type)]
+		if !ok {
+			return model.CreateOrderResponse{
+				Code: basepb.ErrorCode_INVALID_ARGUMENT.String(),
+			}, nil
+		}
+		attached = append(attached, &pb.AttachedOrder{
+			Type:         pb.OrderType(legType),
+			Price:        safeFloat(leg.Price),
+			StopPrice:    safeFloat(leg.StopPrice),
+			TrailAmount:  safeFloat(leg.TrailAmount),
+			TrailPercent: safeFloat(leg.TrailPercent),
+		})
+	}
+
 	req := &pb.InsertOrderRequest{
-		UserId:   userID,
-		Symbol:   input.Symbol,
-		Side:     side,
-		Type:     type_,
-		Quantity: input.Quantity,
-		Price:    safeFloat(input.Price),
+		UserId:         userID,
+		Symbol:         input.Symbol,
+		Side:           side,
+		Type:           type_,
+		Quantity:       input.Quantity,
+		Price:          safeFloat(input.Price),
+		StopPrice:      safeFloat(input.StopPrice),
+		TimeInForce:    timeInForce,
+		TrailAmount:    safeFloat(input.TrailAmount),
+		TrailPercent:   safeFloat(input.TrailPercent),
+		GroupType:      groupType,
+		AttachedOrders: attached,
 	}
 
 	resp, err := c.client.InsertOrder(ctx, req)
@@ -81,9 +127,15 @@ func (c *OrderClient) InsertOrder(ctx context.Context, userID string, input mode
 		}, err
 	}
 
+	var attachedOrders []*model.Order
+	for _, order := range resp.AttachedOrders {
+		attachedOrders = append(attachedOrders, mapProtoToModel(order))
+	}
+
 	return model.CreateOrderResponse{
-		Data: mapProtoToModel(resp.Order),
-		Code: resp.GetCode().String(),
+		Data:           mapProtoToModel(resp.Order),
+		AttachedOrders: attachedOrders,
+		Code:           resp.GetCode().String(),
 	}, nil
 }
 
@@ -106,6 +158,28 @@ func (c *OrderClient) CancelOrder(ctx context.Context, orderID, userID string) (
 	}, nil
 }
 
+func (c *OrderClient) ModifyOrder(ctx context.Context, orderID, userID string, quantity, price *float64) (model.ModifyOrderResponse, error) {
+	req := &pb.ModifyOrderRequest{
+		OrderId:  orderID,
+		UserId:   userID,
+		Quantity: safeFloat(quantity),
+		Price:    safeFloat(price),
+	}
+
+	resp, err := c.client.ModifyOrder(ctx, req)
+	if err != nil {
+		return model.ModifyOrderResponse{
+			Code: basepb.ErrorCode_INTERNAL.String(),
+		}, err
+	}
+
+	return model.ModifyOrderResponse{
+		Data:      mapProtoToModel(resp.Order),
+		Amendment: mapAmendmentToModel(resp.Amendment),
+		Code:      resp.GetCode().String(),
+	}, nil
+}
+
 func (c *OrderClient) GetOrders(ctx context.Context, userID string) (model.OrdersResponse, error) {
 	req := &pb.GetOrdersByUserIdRequest{
 		UserId: userID,
@@ -151,12 +225,67 @@ func (c *OrderClient) GetOrderByOrderID(ctx context.Context, orderID string, use
 		}, err
 	}
 
+	var fills []*model.OrderFill
+	for _, fill := range resp.Fills {
+		fills = append(fills, mapFillToModel(fill))
+	}
+
+	var amendments []*model.OrderAmendment
+	for _, amendment := range resp.Amendments {
+		amendments = append(amendments, mapAmendmentToModel(amendment))
+	}
+
 	return model.GetOrderByIDResponse{
-		Data: mapProtoToModel(resp.Order),
-		Code: resp.GetCode().String(),
+		Data:       mapProtoToModel(resp.Order),
+		Fills:      fills,
+		Amendments: amendments,
+		Code:       resp.GetCode().String(),
 	}, nil
 }
 
+func mapAmendmentToModel(a *pb.OrderAmendment) *model.OrderAmendment {
+	if a == nil {
+		return nil
+	}
+	return &model.OrderAmendment{
+		ID:         a.Id,
+		Quantity:   a.Quantity,
+		Price:      a.Price,
+		Status:     strings.TrimPrefix(a.Status.String(), "AMENDMENT_STATUS_"),
+		Reason:     optionalString(a.Reason),
+		CreatedAt:  a.CreatedAt.AsTime().String(),
+		ResolvedAt: optionalTime(a.ResolvedAt),
+	}
+}
+
+func mapFillToModel(f *pb.OrderFill) *model.OrderFill {
+	fill := &model.OrderFill{
+		Sequence:    f.Sequence,
+		Quantity:    f.FillQuantity,
+		Price:       f.FillPrice,
+		FilledAt:    f.FilledAt.AsTime().String(),
+		QuoteSource: f.QuoteSource,
+	}
+	if f.QuoteAsOf != nil {
+		quoteAsOf := f.QuoteAsOf.AsTime().String()
+		fill.QuoteAsOf = &quoteAsOf
+	}
+	if p := f.Pricing; p != nil {
+		fill.Pricing = &model.ExecutionPricing{
+			Model:             p.Model,
+			ReferencePrice:    p.ReferencePrice,
+			Spread:            p.Spread,
+			Impact:            p.Impact,
+			Participation:     p.Participation,
+			MinSpread:         p.MinSpread,
+			SpreadRangeShare:  p.SpreadRangeShare,
+			ImpactCoefficient: p.ImpactCoefficient,
+		}
+	}
+
+	return fill
+}
+
 func safeFloat(ptr *float64) float64 {
 	if ptr == nil {
 		return 0
@@ -182,5 +311,29 @@ func mapProtoToModel(o *pb.Order) *model.Order {
 		AvgFillPrice:   o.AvgFillPrice,
 		CreatedAt:      o.CreatedAt.AsTime().String(),
 		UpdatedAt:      o.UpdatedAt.AsTime().String(),
+		TimeInForce:    strings.TrimPrefix(o.TimeInForce.String(), "TIME_IN_FORCE_"),
+		ExpiresAt:      optionalTime(o.ExpiresAt),
+		TrailAmount:    o.TrailAmount,
+		TrailPercent:   o.TrailPercent,
+		GroupID:        optionalString(o.GroupId),
+		GroupType:      optionalString(strings.TrimPrefix(o.GroupType.String(), "ORDER_GROUP_TYPE_")),
+		ParentOrderID:  optionalString(o.ParentOrderId),
+		RejectCode:     optionalString(strings.TrimPrefix(o.RejectCode.String(), "REJECT_CODE_")),
+		RejectReason:   optionalString(o.RejectReason),
+	}
+}
+
+func optionalString(s string) *string {
+	if s == "" || s == "UNSPECIFIED" {
+		return nil
+	}
+	return &s
+}
+
+func optionalTime(t *timestamppb.Timestamp) *string {
+	if t == nil {
+		return nil
 	}
+	formatted := t.AsTime().String()
+	return &formatted
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"fafnir/api-gateway/graph/model"
 	basepb "fafnir/shared/pb/base"
@@ -11,6 +12,7 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type PortfolioClient struct {
@@ -72,10 +74,22 @@ func (c *PortfolioClient) CreateAccount(ctx context.Context, userID string, req
 		curr = pb.CurrencyType_CURRENCY_TYPE_CAD
 	}
 
+	lotMethod := pb.LotMethod_LOT_METHOD_UNSPECIFIED
+	if req.LotMethod != nil && *req.LotMethod != "" {
+		methodVal, ok := pb.LotMethod_value["LOT_METHOD_"+strings.ToUpper(*req.LotMethod)]
+		if !ok {
+			return model.CreateAccountResponse{
+				Code: basepb.ErrorCode_INVALID_ARGUMENT.String(),
+			}, nil
+		}
+		lotMethod = pb.LotMethod(methodVal)
+	}
+
 	resp, err := c.client.CreateAccount(ctx, &pb.CreateAccountRequest{
-		UserId:   userID,
-		Type:     accType,
-		Currency: curr,
+		UserId:    userID,
+		Type:      accType,
+		Currency:  curr,
+		LotMethod: lotMethod,
 	})
 	if err != nil {
 		return model.CreateAccountResponse{
@@ -107,6 +121,7 @@ func (c *PortfolioClient) GetPortfolioSummary(ctx context.Context, userID string
 	return model.GetPortfolioSummaryResponse{
 		Accounts:     accounts,
 		TotalBalance: resp.TotalBalance,
+		TotalFees:    resp.TotalFees,
 		Code:         resp.GetCode().String(),
 	}, nil
 }
@@ -245,8 +260,9 @@ func (c *PortfolioClient) GetTransactions(ctx context.Context, req model.GetTran
 	}
 
 	return model.GetTransactionsResponse{
-		Code: resp.Code.String(),
-		Data: txs,
+		Code:      resp.Code.String(),
+		Data:      txs,
+		TotalFees: resp.TotalFees,
 	}, nil
 }
 
@@ -303,19 +319,268 @@ func (c *PortfolioClient) Transfer(ctx context.Context, req model.TransferReques
 	}, nil
 }
 
+func (c *PortfolioClient) SetLotMethod(ctx context.Context, req model.SetLotMethodRequest) (model.SetLotMethodResponse, error) {
+	methodVal, ok := pb.LotMethod_value["LOT_METHOD_"+strings.ToUpper(req.Method)]
+	if !ok {
+		return model.SetLotMethodResponse{
+			Code: basepb.ErrorCode_INVALID_ARGUMENT.String(),
+		}, nil
+	}
+
+	resp, err := c.client.SetLotMethod(ctx, &pb.SetLotMethodRequest{
+		AccountId: req.AccountID,
+		Method:    pb.LotMethod(methodVal),
+	})
+	if err != nil {
+		return model.SetLotMethodResponse{
+			Code: basepb.ErrorCode_INTERNAL.String(),
+		}, err
+	}
+
+	return model.SetLotMethodResponse{
+		Code: resp.GetCode().String(),
+		Data: convertAccountToModel(resp.Account),
+	}, nil
+}
+
+func (c *PortfolioClient) SelectTaxLots(ctx context.Context, req model.SelectTaxLotsRequest) (model.SelectTaxLotsResponse, error) {
+	resp, err := c.client.SelectTaxLots(ctx, &pb.SelectTaxLotsRequest{
+		AccountId: req.AccountID,
+		OrderId:   req.OrderID,
+		LotIds:    req.LotIds,
+	})
+	if err != nil {
+		return model.SelectTaxLotsResponse{
+			Code: basepb.ErrorCode_INTERNAL.String(),
+		}, err
+	}
+
+	return model.SelectTaxLotsResponse{
+		Code: resp.GetCode().String(),
+	}, nil
+}
+
+func (c *PortfolioClient) GetTaxLots(ctx context.Context, req model.GetTaxLotsRequest) (model.GetTaxLotsResponse, error) {
+	pbReq := &pb.GetTaxLotsRequest{
+		AccountId: req.AccountID,
+	}
+	if req.Symbol != nil {
+		pbReq.Symbol = *req.Symbol
+	}
+	if req.IncludeClosed != nil {
+		pbReq.IncludeClosed = *req.IncludeClosed
+	}
+
+	resp, err := c.client.GetTaxLots(ctx, pbReq)
+	if err != nil {
+		return model.GetTaxLotsResponse{
+			Code: basepb.ErrorCode_INTERNAL.String(),
+		}, err
+	}
+
+	var lots []*model.TaxLot
+	for _, lot := range resp.Lots {
+		lots = append(lots, &model.TaxLot{
+			ID:                lot.Id,
+			AccountID:         lot.AccountId,
+			Symbol:            lot.Symbol,
+			OrderID:           optionalString(lot.OrderId),
+			Quantity:          lot.Quantity,
+			RemainingQuantity: lot.RemainingQuantity,
+			CostBasis:         lot.CostBasis,
+			OpenedAt:          lot.OpenedAt.AsTime().String(),
+			ClosedAt:          optionalTime(lot.ClosedAt),
+		})
+	}
+
+	return model.GetTaxLotsResponse{
+		Code: resp.GetCode().String(),
+		Data: lots,
+	}, nil
+}
+
+func (c *PortfolioClient) GetRealizedGains(ctx context.Context, req model.GetRealizedGainsRequest) (model.GetRealizedGainsResponse, error) {
+	pbReq := &pb.GetRealizedGainsRequest{
+		AccountId: req.AccountID,
+	}
+	if req.Symbol != nil {
+		pbReq.Symbol = *req.Symbol
+	}
+	for _, bound := range []struct {
+		value  *string
+		target **timestamppb.Timestamp
+	}{{req.From, &pbReq.From}, {req.To, &pbReq.To}} {
+		if bound.value == nil || *bound.value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, *bound.value)
+		if err != nil {
+			return model.GetRealizedGainsResponse{
+				Code: basepb.ErrorCode_INVALID_ARGUMENT.String(),
+			}, nil
+		}
+		*bound.target = timestamppb.New(t)
+	}
+
+	resp, err := c.client.GetRealizedGains(ctx, pbReq)
+	if err != nil {
+		return model.GetRealizedGainsResponse{
+			Code: basepb.ErrorCode_INTERNAL.String(),
+		}, err
+	}
+
+	var gains []*model.RealizedGain
+	for _, g := range resp.Gains {
+		gains = append(gains, &model.RealizedGain{
+			ID:          g.Id,
+			AccountID:   g.AccountId,
+			Symbol:      g.Symbol,
+			LotID:       optionalString(g.LotId),
+			OrderID:     g.OrderId,
+			Quantity:    g.Quantity,
+			Proceeds:    g.Proceeds,
+			CostBasis:   g.CostBasis,
+			RealizedPnl: g.RealizedPnl,
+			Method:      strings.TrimPrefix(g.Method.String(), "LOT_METHOD_"),
+			OpenedAt:    optionalTime(g.OpenedAt),
+			RealizedAt:  g.RealizedAt.AsTime().String(),
+		})
+	}
+
+	var bySymbol []*model.SymbolRealizedGain
+	for _, total := range resp.BySymbol {
+		bySymbol = append(bySymbol, &model.SymbolRealizedGain{
+			Symbol:      total.Symbol,
+			Quantity:    total.Quantity,
+			Proceeds:    total.Proceeds,
+			CostBasis:   total.CostBasis,
+			RealizedPnl: total.RealizedPnl,
+		})
+	}
+
+	return model.GetRealizedGainsResponse{
+		Code:             resp.GetCode().String(),
+		Data:             gains,
+		BySymbol:         bySymbol,
+		TotalRealizedPnl: resp.TotalRealizedPnl,
+	}, nil
+}
+
+func (c *PortfolioClient) GetPortfolioValuation(ctx context.Context, userID string) (model.GetPortfolioValuationResponse, error) {
+	resp, err := c.client.GetPortfolioValuation(ctx, &pb.GetPortfolioValuationRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		return model.GetPortfolioValuationResponse{
+			Code: basepb.ErrorCode_INTERNAL.String(),
+		}, err
+	}
+
+	var accounts []*model.AccountValuation
+	for _, acc := range resp.Accounts {
+		holdings := make([]*model.HoldingValuation, 0, len(acc.Holdings))
+		for _, h := range acc.Holdings {
+			holdings = append(holdings, &model.HoldingValuation{
+				Symbol:               h.Symbol,
+				Quantity:             h.Quantity,
+				AvgCost:              h.AvgCost,
+				QuoteCurrency:        optionalString(h.QuoteCurrency),
+				ExchangeRate:         h.ExchangeRate,
+				LastPrice:            h.LastPrice,
+				MarketValue:          h.MarketValue,
+				CostBasis:            h.CostBasis,
+				UnrealizedPnl:        h.UnrealizedPnl,
+				UnrealizedPnlPercent: h.UnrealizedPnlPct,
+				DayChange:            h.DayChange,
+				DayChangePercent:     h.DayChangePct,
+				Priced:               h.Priced,
+				QuoteAsOf:            optionalTime(h.QuoteAsOf),
+			})
+		}
+
+		accounts = append(accounts, &model.AccountValuation{
+			AccountID:            acc.AccountId,
+			Currency:             strings.TrimPrefix(acc.Currency.String(), "CURRENCY_TYPE_"),
+			CashBalance:          acc.CashBalance,
+			MarketValue:          acc.MarketValue,
+			CostBasis:            acc.CostBasis,
+			UnrealizedPnl:        acc.UnrealizedPnl,
+			UnrealizedPnlPercent: acc.UnrealizedPnlPct,
+			DayChange:            acc.DayChange,
+			DayChangePercent:     acc.DayChangePct,
+			TotalEquity:          acc.TotalEquity,
+			Holdings:             holdings,
+		})
+	}
+
+	return model.GetPortfolioValuationResponse{
+		Code:     resp.GetCode().String(),
+		Data:     accounts,
+		ValuedAt: optionalTime(resp.ValuedAt),
+	}, nil
+}
+
+func (c *PortfolioClient) GetPortfolioPerformance(ctx context.Context, userID, period string) (model.GetPortfolioPerformanceResponse, error) {
+	resp, err := c.client.GetPortfolioPerformance(ctx, &pb.GetPortfolioPerformanceRequest{
+		UserId: userID,
+		Period: period,
+	})
+	if err != nil {
+		return model.GetPortfolioPerformanceResponse{
+			Code: basepb.ErrorCode_INTERNAL.String(),
+		}, err
+	}
+
+	var accounts []*model.AccountPerformance
+	for _, acc := range resp.Accounts {
+		points := make([]*model.PerformancePoint, 0, len(acc.Points))
+		for _, p := range acc.Points {
+			points = append(points, &model.PerformancePoint{
+				Date:                    p.Date,
+				CashBalance:             p.CashBalance,
+				MarketValue:             p.MarketValue,
+				TotalEquity:             p.TotalEquity,
+				NetFlow:                 p.NetFlow,
+				CumulativeReturnPercent: p.CumulativeReturnPct,
+			})
+		}
+
+		accounts = append(accounts, &model.AccountPerformance{
+			AccountID:                  acc.AccountId,
+			Currency:                   strings.TrimPrefix(acc.Currency.String(), "CURRENCY_TYPE_"),
+			StartDate:                  acc.StartDate,
+			EndDate:                    acc.EndDate,
+			StartEquity:                acc.StartEquity,
+			EndEquity:                  acc.EndEquity,
+			NetFlows:                   acc.NetFlows,
+			Gain:                       acc.Gain,
+			TimeWeightedReturnPercent:  acc.TimeWeightedReturnPct,
+			MoneyWeightedReturnPercent: acc.MoneyWeightedReturnPct,
+			Points:                     points,
+		})
+	}
+
+	return model.GetPortfolioPerformanceResponse{
+		Code: resp.GetCode().String(),
+		Data: accounts,
+	}, nil
+}
+
 func convertAccountToModel(acc *pb.Account) *model.Account {
 	if acc == nil {
 		return nil
 	}
 	return &model.Account{
-		ID:            acc.Id,
-		UserID:        acc.UserId,
-		AccountNumber: acc.AccountNumber,
-		Type:          strings.TrimPrefix(acc.Type.String(), "ACCOUNT_TYPE_"),
-		Currency:      strings.TrimPrefix(acc.Currency.String(), "CURRENCY_TYPE_"),
-		Balance:       acc.Balance,
-		CreatedAt:     acc.CreatedAt.AsTime().String(),
-		UpdatedAt:     acc.UpdatedAt.AsTime().String(),
+		ID:               acc.Id,
+		UserID:           acc.UserId,
+		AccountNumber:    acc.AccountNumber,
+		Type:             strings.TrimPrefix(acc.Type.String(), "ACCOUNT_TYPE_"),
+		Currency:         strings.TrimPrefix(acc.Currency.String(), "CURRENCY_TYPE_"),
+		Balance:          acc.Balance,
+		AvailableBalance: acc.AvailableBalance,
+		LotMethod:        strings.TrimPrefix(acc.LotMethod.String(), "LOT_METHOD_"),
+		CreatedAt:        acc.CreatedAt.AsTime().String(),
+		UpdatedAt:        acc.UpdatedAt.AsTime().String(),
 	}
 }
 
@@ -324,12 +589,13 @@ func convertHoldingToModel(h *pb.Holding) *model.Holding {
 		return nil
 	}
 	return &model.Holding{
-		ID:        h.Id,
-		AccountID: h.AccountId,
-		Symbol:    h.Symbol,
-		Quantity:  h.Quantity,
-		AvgCost:   h.AvgCost,
-		CreatedAt: h.CreatedAt.AsTime().String(),
-		UpdatedAt: h.UpdatedAt.AsTime().String(),
+		ID:                h.Id,
+		AccountID:         h.AccountId,
+		Symbol:            h.Symbol,
+		Quantity:          h.Quantity,
+		AvgCost:           h.AvgCost,
+		AvailableQuantity: h.AvailableQuantity,
+		CreatedAt:         h.CreatedAt.AsTime().String(),
+		UpdatedAt:         h.UpdatedAt.AsTime().String(),
 	}
 }
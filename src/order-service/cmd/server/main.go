@@ -5,9 +5,14 @@ import (
 	"fafnir/order-service/internal/api"
 	"fafnir/order-service/internal/config"
 	"fafnir/order-service/internal/db"
+	portfoliopb "fafnir/shared/pb/portfolio"
+	securitypb "fafnir/shared/pb/security"
 	stockpb "fafnir/shared/pb/stock"
+	"fafnir/shared/pkg/fx"
 	"fafnir/shared/pkg/logger"
 	"fafnir/shared/pkg/nats"
+	"fafnir/shared/pkg/redis"
+	"fafnir/shared/pkg/risk"
 	"os"
 	"os/signal"
 	"syscall"
@@ -18,6 +23,9 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+// the Redis hash holding the last rate served per currency pair
+const fxRatesKey = "orders:v1:fx-rates"
+
 func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
@@ -50,7 +58,43 @@ func main() {
 	}
 	stockClient := stockpb.NewStockServiceClient(stockConn)
 
-	orderHandler := api.NewOrderHandler(db, natsClient, stockClient, logger)
+	// create portfolio service client, for the exposure the risk checks look at
+	portfolioConn, err := grpc.NewClient(cfg.PortfolioService.URL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Error(ctx, "Failed to connect to portfolio service", "error", err)
+		os.Exit(1)
+	}
+	portfolioClient := portfoliopb.NewPortfolioServiceClient(portfolioConn)
+
+	// create security service client, for the roles risk limits can be set by
+	securityConn, err := grpc.NewClient(cfg.SecurityService.URL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Error(ctx, "Failed to connect to security service", "error", err)
+		os.Exit(1)
+	}
+
+	riskPolicy, err := risk.LoadPolicy(cfg.RiskPolicyPath)
+	if err != nil {
+		logger.Error(ctx, "Failed to load risk policy", "error", err)
+		os.Exit(1)
+	}
+	riskChecker := risk.NewChecker(riskPolicy, risk.NewSecurityRoles(securitypb.NewSecurityServiceClient(securityConn)))
+
+	// create redis cache, for the last FX rates holds are sized with while the FX API is failing
+	redisCache, err := redis.New(cfg.Cache, logger)
+	if err != nil {
+		logger.Error(ctx, "Failed to initialize redis", "error", err)
+		os.Exit(1)
+	}
+	defer redisCache.Close()
+
+	fxProvider, err := fx.New(cfg.FX, fx.NewRedisStore(redisCache, fxRatesKey))
+	if err != nil {
+		logger.Error(ctx, "Failed to initialize FX provider", "error", err)
+		os.Exit(1)
+	}
+
+	orderHandler := api.NewOrderHandler(db, natsClient, stockClient, portfolioClient, riskChecker, fxProvider, cfg.Fees, logger)
 
 	server := api.NewServer(cfg, logger, orderHandler)
 
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 	"time"
 
@@ -12,22 +13,31 @@ import (
 	"fafnir/order-service/internal/db/generated"
 	basepb "fafnir/shared/pb/base"
 	orderpb "fafnir/shared/pb/order"
+	portfoliopb "fafnir/shared/pb/portfolio"
 	stockpb "fafnir/shared/pb/stock"
+	"fafnir/shared/pkg/fees"
+	"fafnir/shared/pkg/fx"
 	"fafnir/shared/pkg/logger"
 	natsC "fafnir/shared/pkg/nats"
+	"fafnir/shared/pkg/risk"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/nats-io/nats.go"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type OrderHandler struct {
-	db          *db.Database
-	natsClient  *natsC.NatsClient
-	stockClient stockpb.StockServiceClient
-	logger      *logger.Logger
+	db              *db.Database
+	natsClient      *natsC.NatsClient
+	stockClient     stockpb.StockServiceClient
+	portfolioClient portfoliopb.PortfolioServiceClient
+	risk            *risk.Checker
+	fx              fx.Provider
+	fees            fees.Schedule
+	logger          *logger.Logger
 	orderpb.UnimplementedOrderServiceServer
 }
 
@@ -38,14 +48,20 @@ const (
 	maxTradableSymbolLength = 10 // order and portfolio schemas currently use VARCHAR(10)
 	publishAttempts         = 3
 	publishRetryDelay       = 100 * time.Millisecond
+	fillQuantityTolerance   = 0.000001 // quantities are stored as NUMERIC(20, 6)
+	maxBracketExits         = 2        // a take-profit and a stop-loss
 )
 
-func NewOrderHandler(db *db.Database, natsClient *natsC.NatsClient, stockClient stockpb.StockServiceClient, logger *logger.Logger) *OrderHandler {
+func NewOrderHandler(db *db.Database, natsClient *natsC.NatsClient, stockClient stockpb.StockServiceClient, portfolioClient portfoliopb.PortfolioServiceClient, riskChecker *risk.Checker, fxProvider fx.Provider, feeSchedule fees.Schedule, logger *logger.Logger) *OrderHandler {
 	return &OrderHandler{
-		db:          db,
-		natsClient:  natsClient,
-		stockClient: stockClient,
-		logger:      logger,
+		db:              db,
+		natsClient:      natsClient,
+		stockClient:     stockClient,
+		portfolioClient: portfolioClient,
+		risk:            riskChecker,
+		fx:              fxProvider,
+		fees:            feeSchedule,
+		logger:          logger,
 	}
 }
 
@@ -66,6 +82,16 @@ func (h *OrderHandler) handleOrderEvents(msg *nats.Msg) {
 		err = h.handleOrderFilled(ctx, msg)
 	case "orders.rejected":
 		err = h.handleOrderRejected(ctx, msg)
+	case "orders.expired":
+		err = h.handleOrderExpired(ctx, msg)
+	case "orders.armed":
+		err = h.handleOrderArmed(ctx, msg)
+	case "orders.cancelled":
+		err = h.handleOrderCancelled(ctx, msg)
+	case "orders.cancel_rejected":
+		err = h.handleCancelRejected(ctx, msg)
+	case "orders.amendment_applied", "orders.amendment_rejected":
+		err = h.handleAmendmentResolved(ctx, msg)
 	default:
 		// ignore events we don't care about
 		// we must ack them, otherwise they come back forever
@@ -110,10 +136,31 @@ func (h *OrderHandler) GetOrderById(ctx context.Context, req *orderpb.GetOrderBy
 		}, err
 	}
 
-	return &orderpb.GetOrderByIdResponse{
+	fills, err := h.db.GetQueries().ListOrderFills(ctx, orderId)
+	if err != nil {
+		return &orderpb.GetOrderByIdResponse{
+			Code: basepb.ErrorCode_INTERNAL,
+		}, err
+	}
+
+	response := &orderpb.GetOrderByIdResponse{
 		Code:  basepb.ErrorCode_OK,
-		Order: convertOrderToProto(order),
-	}, nil
+		Order: convertGroupedOrderToProto(order.Order, order.GroupType),
+	}
+	for _, fill := range fills {
+		response.Fills = append(response.Fills, convertFillToProto(fill))
+	}
+
+	amendments, err := h.db.GetQueries().ListOrderAmendments(ctx, orderId)
+	if err != nil {
+		return &orderpb.GetOrderByIdResponse{
+			Code: basepb.ErrorCode_INTERNAL,
+		}, err
+	}
+	for _, amendment := range amendments {
+		response.Amendments = append(response.Amendments, convertAmendmentToProto(amendment))
+	}
+	return response, nil
 }
 
 func (h *OrderHandler) GetOrdersByUserId(ctx context.Context, req *orderpb.GetOrdersByUserIdRequest) (*orderpb.GetOrdersByUserIdResponse, error) {
@@ -133,7 +180,7 @@ func (h *OrderHandler) GetOrdersByUserId(ctx context.Context, req *orderpb.GetOr
 
 	var responseOrders []*orderpb.Order
 	for _, order := range orders {
-		responseOrders = append(responseOrders, convertOrderToProto(order))
+		responseOrders = append(responseOrders, convertGroupedOrderToProto(order.Order, order.GroupType))
 	}
 
 	return &orderpb.GetOrdersByUserIdResponse{
@@ -143,26 +190,14 @@ func (h *OrderHandler) GetOrdersByUserId(ctx context.Context, req *orderpb.GetOr
 }
 
 func (h *OrderHandler) InsertOrder(ctx context.Context, req *orderpb.InsertOrderRequest) (*orderpb.InsertOrderResponse, error) {
-	if req.Side != orderpb.OrderSide_ORDER_SIDE_BUY && req.Side != orderpb.OrderSide_ORDER_SIDE_SELL {
-		return &orderpb.InsertOrderResponse{
-			Code: basepb.ErrorCode_INVALID_ARGUMENT,
-		}, errors.New("side must be BUY or SELL")
-	}
-
-	if req.Type != orderpb.OrderType_ORDER_TYPE_MARKET && req.Type != orderpb.OrderType_ORDER_TYPE_LIMIT {
-		return &orderpb.InsertOrderResponse{
-			Code: basepb.ErrorCode_INVALID_ARGUMENT,
-		}, errors.New("only MARKET and LIMIT orders are supported")
+	timeInForce, err := validateOrderRequest(req)
+	if err != nil {
+		return &orderpb.InsertOrderResponse{Code: basepb.ErrorCode_INVALID_ARGUMENT}, err
 	}
 
-	if !isPositiveFinite(req.Quantity) {
-		return &orderpb.InsertOrderResponse{Code: basepb.ErrorCode_INVALID_ARGUMENT}, errors.New("quantity must be greater than zero")
-	}
-	if req.Type == orderpb.OrderType_ORDER_TYPE_LIMIT && !isPositiveFinite(req.Price) {
-		return &orderpb.InsertOrderResponse{Code: basepb.ErrorCode_INVALID_ARGUMENT}, errors.New("limit price must be greater than zero")
-	}
-	if req.StopPrice != 0 {
-		return &orderpb.InsertOrderResponse{Code: basepb.ErrorCode_INVALID_ARGUMENT}, errors.New("stop prices are not supported")
+	legs, err := groupLegs(req)
+	if err != nil {
+		return &orderpb.InsertOrderResponse{Code: basepb.ErrorCode_INVALID_ARGUMENT}, err
 	}
 
 	userID, err := uuid.Parse(req.UserId)
@@ -193,36 +228,84 @@ func (h *OrderHandler) InsertOrder(ctx context.Context, req *orderpb.InsertOrder
 		}, fmt.Errorf("%s instruments are not supported for trading", metadata.Data.InstrumentType)
 	}
 
-	params := generated.InsertOrderParams{
-		UserID:    userID,
-		Symbol:    symbol,
-		Side:      convertOrderSideToDB(req.Side),
-		Type:      convertOrderTypeToDB(req.Type),
-		Status:    generated.OrderStatusPending,
-		Quantity:  floatToNumeric(req.Quantity),
-		Price:     floatToNumericNullIfZero(req.Price),
-		StopPrice: floatToNumericNullIfZero(req.StopPrice),
+	var dayExpiry time.Time
+	if timeInForce == orderpb.TimeInForce_TIME_IN_FORCE_DAY || slices.ContainsFunc(legs, func(leg *orderpb.InsertOrderRequest) bool {
+		return leg.TimeInForce == orderpb.TimeInForce_TIME_IN_FORCE_DAY
+	}) {
+		dayExpiry, err = h.dayOrderExpiry(ctx, symbol)
+		if err != nil {
+			return &orderpb.InsertOrderResponse{Code: basepb.ErrorCode_INTERNAL}, err
+		}
+	}
+
+	account, err := h.riskExposure(ctx, userID, symbol)
+	if err != nil {
+		return &orderpb.InsertOrderResponse{Code: basepb.ErrorCode_INTERNAL}, err
 	}
 
-	order, err := h.db.GetQueries().InsertOrder(ctx, params)
+	violation, err := h.checkRisk(ctx, userID, symbol, metadata.Data.Currency, account, req, legs)
+	if err != nil {
+		return &orderpb.InsertOrderResponse{Code: basepb.ErrorCode_INTERNAL}, err
+	}
+	if violation != nil {
+		return h.rejectAtEntry(ctx, userID, symbol, req, timeInForce, dayExpiry, violation)
+	}
+
+	var order generated.Order
+	attached := make([]generated.Order, 0, len(legs))
+	err = h.db.ExecMultiTx(ctx, func(queries *generated.Queries) error {
+		var groupID *uuid.UUID
+		if req.GroupType != orderpb.OrderGroupType_ORDER_GROUP_TYPE_UNSPECIFIED {
+			group, err := queries.InsertOrderGroup(ctx, generated.InsertOrderGroupParams{
+				UserID: userID,
+				Type:   convertOrderGroupTypeToDB(req.GroupType),
+			})
+			if err != nil {
+				return fmt.Errorf("insert order group: %w", err)
+			}
+			groupID = &group.ID
+		}
+
+		order, err = queries.InsertOrder(ctx, insertOrderParams(userID, symbol, req, timeInForce, dayExpiry, generated.OrderStatusPending, groupID, nil))
+		if err != nil {
+			return err
+		}
+
+		// bracket exits wait until the entry fills, one-cancels-other legs work right away
+		status, parentID := generated.OrderStatusPending, (*uuid.UUID)(nil)
+		if req.GroupType == orderpb.OrderGroupType_ORDER_GROUP_TYPE_BRACKET {
+			status, parentID = generated.OrderStatusHeld, &order.ID
+		}
+		for _, leg := range legs {
+			inserted, err := queries.InsertOrder(ctx, insertOrderParams(userID, symbol, leg, leg.TimeInForce, dayExpiry, status, groupID, parentID))
+			if err != nil {
+				return fmt.Errorf("insert attached order: %w", err)
+			}
+			attached = append(attached, inserted)
+		}
+
+		return nil
+	})
 	if err != nil {
 		return &orderpb.InsertOrderResponse{
 			Code: basepb.ErrorCode_INTERNAL,
 		}, err
 	}
 
-	// publish order created event
-	event := &orderpb.OrderCreatedEvent{
-		OrderId:   order.ID.String(),
-		UserId:    order.UserID.String(),
-		Symbol:    order.Symbol,
-		Side:      req.Side,
-		Type:      req.Type,
-		Status:    orderpb.OrderStatus_ORDER_STATUS_PENDING,
-		Quantity:  req.Quantity,
-		Price:     req.Price,
-		StopPrice: req.StopPrice,
-		CreatedAt: convertTime(order.CreatedAt),
+	// the whole group travels in one created event, so the engine accepts its legs together
+	event := orderCreatedEvent(order, req, timeInForce)
+	for i, leg := range legs {
+		event.AttachedOrders = append(event.AttachedOrders, orderCreatedEvent(attached[i], leg, leg.TimeInForce))
+	}
+	linkOneCancelsOther(event)
+
+	// an order that cannot be reserved for never reaches the engine
+	reason, err := h.reserveHolds(ctx, event, metadata.Data.Currency, account)
+	if err != nil {
+		return &orderpb.InsertOrderResponse{Code: basepb.ErrorCode_INTERNAL}, h.discardOrders(ctx, append([]generated.Order{order}, attached...), err)
+	}
+	if reason != "" {
+		return h.rejectUnreserved(ctx, order, attached, reason)
 	}
 
 	eventBytes, err := proto.Marshal(event)
@@ -230,17 +313,348 @@ func (h *OrderHandler) InsertOrder(ctx context.Context, req *orderpb.InsertOrder
 		return &orderpb.InsertOrderResponse{Code: basepb.ErrorCode_INTERNAL}, fmt.Errorf("marshal orders.created event: %w", err)
 	}
 	if err := h.publishEvent(ctx, "orders.created", order.ID.String()+":created", eventBytes); err != nil {
-		_, rejectErr := h.db.GetQueries().RejectOrder(ctx, order.ID)
-		return &orderpb.InsertOrderResponse{Code: basepb.ErrorCode_INTERNAL}, errors.Join(
-			fmt.Errorf("publish orders.created event: %w", err),
-			rejectErr,
-		)
+		orders := append([]generated.Order{order}, attached...)
+		orderIDs := make([]string, 0, len(orders))
+		for _, discarded := range orders {
+			orderIDs = append(orderIDs, discarded.ID.String())
+		}
+		h.releaseHolds(ctx, orderIDs)
+		return &orderpb.InsertOrderResponse{Code: basepb.ErrorCode_INTERNAL}, h.discardOrders(ctx, orders, fmt.Errorf("publish orders.created event: %w", err))
 	}
 
-	return &orderpb.InsertOrderResponse{
+	response := &orderpb.InsertOrderResponse{
 		Code:  basepb.ErrorCode_OK,
+		Order: convertGroupedOrderToProto(order, nullGroupType(req.GroupType)),
+	}
+	for _, leg := range attached {
+		response.AttachedOrders = append(response.AttachedOrders, convertGroupedOrderToProto(leg, nullGroupType(req.GroupType)))
+	}
+	return response, nil
+}
+
+// discardOrders rejects orders that failed before reaching the engine, returning cause together with any
+// failure to reject them
+func (h *OrderHandler) discardOrders(ctx context.Context, orders []generated.Order, cause error) error {
+	errs := []error{cause}
+	for _, order := range orders {
+		if _, err := h.db.GetQueries().RejectOrder(ctx, generated.RejectOrderParams{ID: order.ID}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// riskExposure is what portfolio knows of the user's investment account for symbol, or nil without one
+func (h *OrderHandler) riskExposure(ctx context.Context, userID uuid.UUID, symbol string) (*portfoliopb.RiskExposure, error) {
+	resp, err := h.portfolioClient.GetRiskExposure(ctx, &portfoliopb.GetRiskExposureRequest{
+		UserId: userID.String(),
+		Symbol: symbol,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get risk exposure: %w", err)
+	}
+	if resp.Code != basepb.ErrorCode_OK {
+		return nil, nil
+	}
+
+	return resp.Exposure, nil
+}
+
+// checkRisk runs the pre-trade risk checks on an order and the one-cancels-other legs that work alongside it.
+// Bracket exits are left to the engine, which checks them once the entry has filled and they are armed.
+func (h *OrderHandler) checkRisk(ctx context.Context, userID uuid.UUID, symbol string, instrumentCurrency string, account *portfoliopb.RiskExposure, req *orderpb.InsertOrderRequest, legs []*orderpb.InsertOrderRequest) (*risk.Violation, error) {
+	quote, err := h.stockClient.GetStockQuote(ctx, &stockpb.GetStockQuoteRequest{Symbol: symbol})
+	if err != nil {
+		return nil, fmt.Errorf("get quote for risk checks: %w", err)
+	}
+	if quote.Code != basepb.ErrorCode_OK || quote.Data == nil {
+		return nil, fmt.Errorf("get quote for risk checks: stock service returned %s", quote.Code.String())
+	}
+
+	exposure := risk.Exposure{LastPrice: quote.Data.LastPrice}
+	// without an investment account only the price checks apply, the order is rejected when its hold is reserved
+	if account != nil {
+		exposure.AccountValue = account.AccountValue
+		exposure.Position = account.PositionQuantity
+		exposure.RealizedPnlToday = account.RealizedPnlToday
+		// the order's own hold is reserved only after these checks, so it is not counted yet
+		exposure.OpenOrders = int(account.OpenOrders)
+		// amounts can only be compared here when no conversion is needed, the engine checks again with its exchange rates
+		if accountCurrency(account) == strings.ToUpper(instrumentCurrency) {
+			exposure.Rate = 1
+		}
+	}
+
+	orders := []*orderpb.InsertOrderRequest{req}
+	if req.GroupType == orderpb.OrderGroupType_ORDER_GROUP_TYPE_OCO {
+		orders = append(orders, legs...)
+	}
+	for _, order := range orders {
+		violation, err := h.risk.Check(ctx, risk.Order{
+			UserID:    userID.String(),
+			Symbol:    symbol,
+			Side:      order.Side,
+			Type:      order.Type,
+			Quantity:  order.Quantity,
+			Price:     order.Price,
+			StopPrice: order.StopPrice,
+		}, exposure)
+		if err != nil || violation != nil {
+			return violation, err
+		}
+	}
+
+	return nil, nil
+}
+
+// rejectAtEntry records an order that failed the risk checks as rejected, so the user can see why, and tells the
+// rest of the system through the same orders.rejected event the engine sends. Attached orders are not created.
+func (h *OrderHandler) rejectAtEntry(ctx context.Context, userID uuid.UUID, symbol string, req *orderpb.InsertOrderRequest, timeInForce orderpb.TimeInForce, dayExpiry time.Time, violation *risk.Violation) (*orderpb.InsertOrderResponse, error) {
+	var order generated.Order
+	err := h.db.ExecMultiTx(ctx, func(queries *generated.Queries) error {
+		inserted, err := queries.InsertOrder(ctx, insertOrderParams(userID, symbol, req, timeInForce, dayExpiry, generated.OrderStatusPending, nil, nil))
+		if err != nil {
+			return err
+		}
+
+		order, err = queries.RejectOrder(ctx, generated.RejectOrderParams{
+			ID:           inserted.ID,
+			RejectCode:   convertRejectCodeToDB(violation.Code),
+			RejectReason: pgtype.Text{String: violation.Reason, Valid: true},
+		})
+		return err
+	})
+	if err != nil {
+		return &orderpb.InsertOrderResponse{Code: basepb.ErrorCode_INTERNAL}, err
+	}
+
+	eventBytes, err := proto.Marshal(&orderpb.OrderRejectedEvent{
+		OrderId:    order.ID.String(),
+		UserId:     order.UserID.String(),
+		Symbol:     order.Symbol,
+		Reason:     violation.Reason,
+		RejectedAt: convertTime(order.UpdatedAt),
+		ReasonCode: violation.Code,
+	})
+	if err != nil {
+		return &orderpb.InsertOrderResponse{Code: basepb.ErrorCode_INTERNAL}, fmt.Errorf("marshal orders.rejected event: %w", err)
+	}
+	if err := h.publishEvent(ctx, "orders.rejected", order.ID.String()+":rejected", eventBytes); err != nil {
+		// the order is already stored as rejected, only listeners miss out
+		h.logger.Error(ctx, "Failed to publish orders.rejected event", "order_id", order.ID.String(), "error", err)
+	}
+
+	h.logger.Info(ctx, "Order rejected by risk checks", "order_id", order.ID.String(), "reason_code", violation.Code.String(), "reason", violation.Reason)
+	return &orderpb.InsertOrderResponse{
+		Code:  basepb.ErrorCode_FAILED_PRECONDITION,
 		Order: convertOrderToProto(order),
-	}, nil
+	}, violation
+}
+
+// validateOrderRequest checks the terms of a single order and returns the time in force it rests with
+func validateOrderRequest(req *orderpb.InsertOrderRequest) (orderpb.TimeInForce, error) {
+	unspecified := orderpb.TimeInForce_TIME_IN_FORCE_UNSPECIFIED
+	if req.Side != orderpb.OrderSide_ORDER_SIDE_BUY && req.Side != orderpb.OrderSide_ORDER_SIDE_SELL {
+		return unspecified, errors.New("side must be BUY or SELL")
+	}
+
+	switch req.Type {
+	case orderpb.OrderType_ORDER_TYPE_MARKET, orderpb.OrderType_ORDER_TYPE_LIMIT, orderpb.OrderType_ORDER_TYPE_STOP, orderpb.OrderType_ORDER_TYPE_STOP_LIMIT, orderpb.OrderType_ORDER_TYPE_TRAILING_STOP:
+	default:
+		return unspecified, errors.New("only MARKET, LIMIT, STOP, STOP_LIMIT and TRAILING_STOP orders are supported")
+	}
+
+	if !isPositiveFinite(req.Quantity) {
+		return unspecified, errors.New("quantity must be greater than zero")
+	}
+	if requiresLimitPrice(req.Type) && !isPositiveFinite(req.Price) {
+		return unspecified, errors.New("limit price must be greater than zero")
+	}
+	if isStopOrder(req.Type) && !isPositiveFinite(req.StopPrice) {
+		return unspecified, errors.New("stop price must be greater than zero")
+	}
+	if !isStopOrder(req.Type) && req.StopPrice != 0 {
+		return unspecified, errors.New("stop prices are only supported for STOP and STOP_LIMIT orders")
+	}
+	if err := validateTrail(req); err != nil {
+		return unspecified, err
+	}
+
+	// orders placed before time in force existed rested until filled or cancelled
+	timeInForce := req.TimeInForce
+	if timeInForce == orderpb.TimeInForce_TIME_IN_FORCE_UNSPECIFIED {
+		timeInForce = orderpb.TimeInForce_TIME_IN_FORCE_GTC
+	}
+	switch timeInForce {
+	case orderpb.TimeInForce_TIME_IN_FORCE_DAY, orderpb.TimeInForce_TIME_IN_FORCE_GTC:
+	case orderpb.TimeInForce_TIME_IN_FORCE_IOC, orderpb.TimeInForce_TIME_IN_FORCE_FOK:
+		if isStopOrder(req.Type) || req.Type == orderpb.OrderType_ORDER_TYPE_TRAILING_STOP {
+			return unspecified, errors.New("IOC and FOK are not supported for stop orders")
+		}
+	default:
+		return unspecified, errors.New("time in force must be DAY, GTC, IOC or FOK")
+	}
+
+	return timeInForce, nil
+}
+
+// groupLegs validates the orders attached to req and expands them into full requests:
+// bracket exits trade the entry's quantity on the other side and stay working until filled or cancelled,
+// one-cancels-other legs share the side and time in force of req
+func groupLegs(req *orderpb.InsertOrderRequest) ([]*orderpb.InsertOrderRequest, error) {
+	switch req.GroupType {
+	case orderpb.OrderGroupType_ORDER_GROUP_TYPE_UNSPECIFIED:
+		if len(req.AttachedOrders) > 0 {
+			return nil, errors.New("attached orders need a BRACKET or OCO group type")
+		}
+		return nil, nil
+	case orderpb.OrderGroupType_ORDER_GROUP_TYPE_BRACKET:
+		if len(req.AttachedOrders) == 0 || len(req.AttachedOrders) > maxBracketExits {
+			return nil, errors.New("a bracket needs a take-profit, a stop-loss or both")
+		}
+	case orderpb.OrderGroupType_ORDER_GROUP_TYPE_OCO:
+		if len(req.AttachedOrders) != 1 {
+			return nil, errors.New("a one-cancels-other group needs exactly one attached order")
+		}
+		if req.Type == orderpb.OrderType_ORDER_TYPE_MARKET || isImmediateTimeInForce(req.TimeInForce) {
+			return nil, errors.New("one-cancels-other legs must be able to rest, so MARKET, IOC and FOK are not supported")
+		}
+	default:
+		return nil, errors.New("group type must be BRACKET or OCO")
+	}
+
+	legs := make([]*orderpb.InsertOrderRequest, 0, len(req.AttachedOrders))
+	for _, attached := range req.AttachedOrders {
+		if attached.Type == orderpb.OrderType_ORDER_TYPE_MARKET {
+			return nil, errors.New("attached orders cannot be MARKET orders")
+		}
+
+		leg := &orderpb.InsertOrderRequest{
+			UserId:       req.UserId,
+			Symbol:       req.Symbol,
+			Side:         req.Side,
+			Type:         attached.Type,
+			Quantity:     req.Quantity,
+			Price:        attached.Price,
+			StopPrice:    attached.StopPrice,
+			TimeInForce:  req.TimeInForce,
+			TrailAmount:  attached.TrailAmount,
+			TrailPercent: attached.TrailPercent,
+		}
+		if req.GroupType == orderpb.OrderGroupType_ORDER_GROUP_TYPE_BRACKET {
+			leg.Side = oppositeSide(req.Side)
+			leg.TimeInForce = orderpb.TimeInForce_TIME_IN_FORCE_GTC
+		}
+
+		timeInForce, err := validateOrderRequest(leg)
+		if err != nil {
+			return nil, fmt.Errorf("attached order: %w", err)
+		}
+		leg.TimeInForce = timeInForce
+		legs = append(legs, leg)
+	}
+
+	return legs, nil
+}
+
+// dayOrderExpiry is the close of the current or next regular session of the exchange symbol trades on,
+// which is when a day order that has not filled expires
+func (h *OrderHandler) dayOrderExpiry(ctx context.Context, symbol string) (time.Time, error) {
+	resp, err := h.stockClient.GetMarketSession(ctx, &stockpb.GetMarketSessionRequest{
+		Symbol: symbol,
+		At:     timestamppb.Now(),
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("get market session: %w", err)
+	}
+	if resp.GetCode() != basepb.ErrorCode_OK || resp.GetData().GetNextClose() == nil {
+		return time.Time{}, fmt.Errorf("get market session: stock service returned %s", resp.GetCode().String())
+	}
+
+	return resp.GetData().GetNextClose().AsTime(), nil
+}
+
+// insertOrderParams builds the row of an order; dayExpiry is only used by day orders
+func insertOrderParams(userID uuid.UUID, symbol string, req *orderpb.InsertOrderRequest, timeInForce orderpb.TimeInForce, dayExpiry time.Time, status generated.OrderStatus, groupID *uuid.UUID, parentID *uuid.UUID) generated.InsertOrderParams {
+	expiresAt := pgtype.Timestamptz{}
+	if timeInForce == orderpb.TimeInForce_TIME_IN_FORCE_DAY {
+		expiresAt = pgtype.Timestamptz{Time: dayExpiry, Valid: true}
+	}
+
+	return generated.InsertOrderParams{
+		UserID:        userID,
+		Symbol:        symbol,
+		Side:          convertOrderSideToDB(req.Side),
+		Type:          convertOrderTypeToDB(req.Type),
+		Status:        status,
+		Quantity:      floatToNumeric(req.Quantity),
+		Price:         floatToNumericNullIfZero(req.Price),
+		StopPrice:     floatToNumericNullIfZero(req.StopPrice),
+		TimeInForce:   convertTimeInForceToDB(timeInForce),
+		ExpiresAt:     expiresAt,
+		TrailAmount:   floatToNumericNullIfZero(req.TrailAmount),
+		TrailPercent:  floatToNumericNullIfZero(req.TrailPercent),
+		GroupID:       groupID,
+		ParentOrderID: parentID,
+	}
+}
+
+func orderCreatedEvent(order generated.Order, req *orderpb.InsertOrderRequest, timeInForce orderpb.TimeInForce) *orderpb.OrderCreatedEvent {
+	return &orderpb.OrderCreatedEvent{
+		OrderId:       order.ID.String(),
+		UserId:        order.UserID.String(),
+		Symbol:        order.Symbol,
+		Side:          req.Side,
+		Type:          req.Type,
+		Status:        convertOrderStatus(order.Status),
+		Quantity:      req.Quantity,
+		Price:         req.Price,
+		StopPrice:     req.StopPrice,
+		CreatedAt:     convertTime(order.CreatedAt),
+		TimeInForce:   timeInForce,
+		ExpiresAt:     convertTime(order.ExpiresAt),
+		TrailAmount:   req.TrailAmount,
+		TrailPercent:  req.TrailPercent,
+		GroupId:       convertUUID(order.GroupID),
+		GroupType:     req.GroupType,
+		ParentOrderId: convertUUID(order.ParentOrderID),
+	}
+}
+
+// linkOneCancelsOther points every leg that must not fill alongside another at that other leg:
+// all legs of an OCO group, and the exits of a bracket among themselves
+func linkOneCancelsOther(event *orderpb.OrderCreatedEvent) {
+	legs := event.AttachedOrders
+	if event.GroupType == orderpb.OrderGroupType_ORDER_GROUP_TYPE_OCO {
+		legs = append([]*orderpb.OrderCreatedEvent{event}, event.AttachedOrders...)
+	}
+
+	for _, leg := range legs {
+		leg.GroupType = event.GroupType
+		for _, other := range legs {
+			if other != leg {
+				leg.OcoOrderIds = append(leg.OcoOrderIds, other.OrderId)
+			}
+		}
+	}
+}
+
+func nullGroupType(groupType orderpb.OrderGroupType) generated.NullOrderGroupType {
+	if groupType == orderpb.OrderGroupType_ORDER_GROUP_TYPE_UNSPECIFIED {
+		return generated.NullOrderGroupType{}
+	}
+	return generated.NullOrderGroupType{OrderGroupType: convertOrderGroupTypeToDB(groupType), Valid: true}
+}
+
+func isImmediateTimeInForce(timeInForce orderpb.TimeInForce) bool {
+	return timeInForce == orderpb.TimeInForce_TIME_IN_FORCE_IOC || timeInForce == orderpb.TimeInForce_TIME_IN_FORCE_FOK
+}
+
+func oppositeSide(side orderpb.OrderSide) orderpb.OrderSide {
+	if side == orderpb.OrderSide_ORDER_SIDE_BUY {
+		return orderpb.OrderSide_ORDER_SIDE_SELL
+	}
+	return orderpb.OrderSide_ORDER_SIDE_BUY
 }
 
 func isTradableInstrument(instrumentType string) bool {
@@ -252,10 +666,43 @@ func isTradableInstrument(instrumentType string) bool {
 	}
 }
 
+func requiresLimitPrice(orderType orderpb.OrderType) bool {
+	return orderType == orderpb.OrderType_ORDER_TYPE_LIMIT || orderType == orderpb.OrderType_ORDER_TYPE_STOP_LIMIT
+}
+
+func isStopOrder(orderType orderpb.OrderType) bool {
+	return orderType == orderpb.OrderType_ORDER_TYPE_STOP || orderType == orderpb.OrderType_ORDER_TYPE_STOP_LIMIT
+}
+
+// validateTrail checks that trailing stops trail by exactly one of an amount or a percentage below 100,
+// and that no other order type carries a trail
+func validateTrail(req *orderpb.InsertOrderRequest) error {
+	if req.Type != orderpb.OrderType_ORDER_TYPE_TRAILING_STOP {
+		if req.TrailAmount != 0 || req.TrailPercent != 0 {
+			return errors.New("trails are only supported for TRAILING_STOP orders")
+		}
+		return nil
+	}
+
+	if (req.TrailAmount != 0) == (req.TrailPercent != 0) {
+		return errors.New("trailing stops need exactly one of a trail amount or a trail percent")
+	}
+	if req.TrailAmount != 0 && !isPositiveFinite(req.TrailAmount) {
+		return errors.New("trail amount must be greater than zero")
+	}
+	if req.TrailPercent != 0 && (!isPositiveFinite(req.TrailPercent) || req.TrailPercent >= 100) {
+		return errors.New("trail percent must be greater than zero and below 100")
+	}
+
+	return nil
+}
+
 func isPositiveFinite(value float64) bool {
 	return value > 0 && !math.IsNaN(value) && !math.IsInf(value, 0)
 }
 
+// CancelOrder cancels a held bracket exit right away. A working order may be executing in the engine, so it
+// moves to pending_cancel instead, together with its linked legs, until the engine confirms or denies the cancel.
 func (h *OrderHandler) CancelOrder(ctx context.Context, req *orderpb.CancelOrderRequest) (*orderpb.CancelOrderResponse, error) {
 	orderId, err := uuid.Parse(req.OrderId)
 	if err != nil {
@@ -268,42 +715,287 @@ func (h *OrderHandler) CancelOrder(ctx context.Context, req *orderpb.CancelOrder
 		return &orderpb.CancelOrderResponse{Code: basepb.ErrorCode_INVALID_ARGUMENT}, errors.New("invalid user ID")
 	}
 
-	order, err := h.db.GetQueries().CancelOrder(ctx, generated.CancelOrderParams{
-		ID:     orderId,
-		UserID: userID,
+	var order generated.Order
+	var linked []generated.Order
+	err = h.db.ExecMultiTx(ctx, func(queries *generated.Queries) error {
+		order, err = queries.GetOrderByIdForUpdate(ctx, orderId)
+		if err != nil {
+			return err
+		}
+		if order.UserID != userID {
+			return pgx.ErrNoRows
+		}
+
+		switch order.Status {
+		case generated.OrderStatusHeld:
+			order, err = queries.CancelOrder(ctx, generated.CancelOrderParams{
+				ID:     orderId,
+				UserID: userID,
+			})
+			if err != nil || order.GroupID == nil {
+				return err
+			}
+
+			linked, err = queries.CancelLinkedOrders(ctx, generated.CancelLinkedOrdersParams{
+				ID:            order.ID,
+				GroupID:       order.GroupID,
+				ParentOrderID: order.ParentOrderID,
+			})
+			return err
+		case generated.OrderStatusPending, generated.OrderStatusPartiallyFilled:
+			order, err = queries.RequestCancel(ctx, orderId)
+			if err != nil {
+				return err
+			}
+
+			linked, err = queries.RequestLinkedCancels(ctx, generated.RequestLinkedCancelsParams{
+				ID:            order.ID,
+				GroupID:       order.GroupID,
+				ParentOrderID: order.ParentOrderID,
+			})
+			return err
+		case generated.OrderStatusPendingCancel:
+			// asked again, e.g. after a request could not be published, so the requests of the legs that were
+			// asked to cancel with it go out again too; the engine handles repeats
+			linked, err = queries.ListPendingLinkedCancels(ctx, generated.ListPendingLinkedCancelsParams{
+				ID:            order.ID,
+				GroupID:       order.GroupID,
+				ParentOrderID: order.ParentOrderID,
+			})
+			return err
+		default:
+			return pgx.ErrNoRows
+		}
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return &orderpb.CancelOrderResponse{
 				Code: basepb.ErrorCode_NOT_FOUND,
-			}, errors.New("order not found or not in pending status")
+			}, errors.New("order not found or no longer open")
 		}
 		return &orderpb.CancelOrderResponse{
 			Code: basepb.ErrorCode_INTERNAL,
 		}, err
 	}
 
-	// publish order cancelled event
-	event := &orderpb.OrderCancelledEvent{
+	for _, canceled := range append([]generated.Order{order}, linked...) {
+		reason := "Canceled by user"
+		if canceled.ID != order.ID {
+			reason = fmt.Sprintf("Canceled together with order %s", order.ID)
+		}
+
+		publish := h.publishCancelledEvent
+		if canceled.Status == generated.OrderStatusPendingCancel {
+			publish = h.publishCancelRequestedEvent
+		}
+		if err := publish(ctx, canceled, reason); err != nil {
+			return &orderpb.CancelOrderResponse{Code: basepb.ErrorCode_INTERNAL}, err
+		}
+	}
+
+	return &orderpb.CancelOrderResponse{
+		Code:  basepb.ErrorCode_OK,
+		Order: convertOrderToProto(order),
+	}, nil
+}
+
+func (h *OrderHandler) publishCancelRequestedEvent(ctx context.Context, order generated.Order, reason string) error {
+	event := &orderpb.OrderCancelRequestedEvent{
 		OrderId:     order.ID.String(),
 		UserId:      order.UserID.String(),
 		Symbol:      order.Symbol,
 		Side:        convertOrderSide(order.Side),
-		Status:      convertOrderStatus(order.Status),
-		CancelledAt: convertTime(order.UpdatedAt),
+		Reason:      reason,
+		RequestedAt: convertTime(order.UpdatedAt),
 	}
 
 	eventBytes, err := proto.Marshal(event)
 	if err != nil {
-		return &orderpb.CancelOrderResponse{Code: basepb.ErrorCode_INTERNAL}, fmt.Errorf("marshal orders.cancelled event: %w", err)
+		return fmt.Errorf("marshal orders.cancel_requested event: %w", err)
+	}
+	// a denied cancel can be asked for again, so every request gets its own message ID; asking again while
+	// one is pending repeats the same request
+	messageID := fmt.Sprintf("%s:cancel_requested:%d", order.ID, order.UpdatedAt.Time.UnixNano())
+	if err := h.publishEvent(ctx, "orders.cancel_requested", messageID, eventBytes); err != nil {
+		return fmt.Errorf("publish orders.cancel_requested event: %w", err)
+	}
+
+	return nil
+}
+
+func (h *OrderHandler) publishCancelledEvent(ctx context.Context, order generated.Order, reason string) error {
+	event := &orderpb.OrderCancelledEvent{
+		OrderId:        order.ID.String(),
+		UserId:         order.UserID.String(),
+		Symbol:         order.Symbol,
+		Side:           convertOrderSide(order.Side),
+		Status:         convertOrderStatus(order.Status),
+		CancelledAt:    convertTime(order.UpdatedAt),
+		FilledQuantity: convertNumeric(order.FilledQuantity),
+		Reason:         reason,
+	}
+
+	eventBytes, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal orders.cancelled event: %w", err)
 	}
 	if err := h.publishEvent(ctx, "orders.cancelled", order.ID.String()+":cancelled", eventBytes); err != nil {
-		return &orderpb.CancelOrderResponse{Code: basepb.ErrorCode_INTERNAL}, fmt.Errorf("publish orders.cancelled event: %w", err)
+		return fmt.Errorf("publish orders.cancelled event: %w", err)
 	}
 
-	return &orderpb.CancelOrderResponse{
-		Code:  basepb.ErrorCode_OK,
-		Order: convertOrderToProto(order),
+	return nil
+}
+
+// ModifyOrder asks the engine to change the quantity or limit price of a resting order. The order keeps its
+// place in the book and is only changed once the engine applies the amendment, which it refuses when the order
+// is being executed or no longer rests.
+func (h *OrderHandler) ModifyOrder(ctx context.Context, req *orderpb.ModifyOrderRequest) (*orderpb.ModifyOrderResponse, error) {
+	orderId, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return &orderpb.ModifyOrderResponse{Code: basepb.ErrorCode_INVALID_ARGUMENT}, err
+	}
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &orderpb.ModifyOrderResponse{Code: basepb.ErrorCode_INVALID_ARGUMENT}, errors.New("invalid user ID")
+	}
+	if (req.Quantity != 0 && !isPositiveFinite(req.Quantity)) || (req.Price != 0 && !isPositiveFinite(req.Price)) {
+		return &orderpb.ModifyOrderResponse{Code: basepb.ErrorCode_INVALID_ARGUMENT}, errors.New("quantity and price must be greater than zero")
+	}
+	if req.Quantity == 0 && req.Price == 0 {
+		return &orderpb.ModifyOrderResponse{Code: basepb.ErrorCode_INVALID_ARGUMENT}, errors.New("a new quantity or limit price is required")
+	}
+
+	var order generated.Order
+	var amendment generated.OrderAmendment
+	var code basepb.ErrorCode
+	err = h.db.ExecMultiTx(ctx, func(queries *generated.Queries) error {
+		order, err = queries.GetOrderByIdForUpdate(ctx, orderId)
+		if err != nil {
+			return err
+		}
+		if order.UserID != userID {
+			return pgx.ErrNoRows
+		}
+
+		var quantity, price float64
+		code, quantity, price, err = amendedValues(order, req)
+		if err != nil {
+			return err
+		}
+
+		pending, err := queries.ListOrderAmendments(ctx, order.ID)
+		if err != nil {
+			return err
+		}
+		for _, existing := range pending {
+			if existing.Status == generated.AmendmentStatusPending {
+				code = basepb.ErrorCode_ALREADY_EXISTS
+				return errors.New("an amendment of this order is already pending")
+			}
+		}
+
+		amendment, err = queries.InsertOrderAmendment(ctx, generated.InsertOrderAmendmentParams{
+			OrderID:  order.ID,
+			Quantity: floatToNumeric(quantity),
+			Price:    floatToNumericNullIfZero(price),
+		})
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &orderpb.ModifyOrderResponse{Code: basepb.ErrorCode_NOT_FOUND}, errors.New("order not found")
+		}
+		if code != basepb.ErrorCode_OK {
+			return &orderpb.ModifyOrderResponse{Code: code}, err
+		}
+		return &orderpb.ModifyOrderResponse{Code: basepb.ErrorCode_INTERNAL}, err
+	}
+
+	event := &orderpb.OrderAmendedEvent{
+		AmendmentId: amendment.ID.String(),
+		OrderId:     order.ID.String(),
+		UserId:      order.UserID.String(),
+		Symbol:      order.Symbol,
+		Quantity:    convertNumeric(amendment.Quantity),
+		Price:       convertNumeric(amendment.Price),
+		AmendedAt:   convertTime(amendment.CreatedAt),
+	}
+	eventBytes, err := proto.Marshal(event)
+	if err == nil {
+		err = h.publishEvent(ctx, "orders.amended", amendment.ID.String()+":amended", eventBytes)
+	}
+	if err != nil {
+		// nothing will resolve an amendment the engine never heard of, so it must not block the next one
+		if _, resolveErr := h.db.GetQueries().ResolveOrderAmendment(ctx, generated.ResolveOrderAmendmentParams{
+			ID:     amendment.ID,
+			Status: generated.AmendmentStatusRejected,
+			Reason: pgtype.Text{String: "Could not reach the trade engine", Valid: true},
+		}); resolveErr != nil {
+			h.logger.Error(ctx, "Failed to reject unpublished amendment", "amendment_id", amendment.ID, "error", resolveErr)
+		}
+		return &orderpb.ModifyOrderResponse{Code: basepb.ErrorCode_INTERNAL}, fmt.Errorf("publish orders.amended event: %w", err)
+	}
+
+	return &orderpb.ModifyOrderResponse{
+		Code:      basepb.ErrorCode_OK,
+		Order:     convertOrderToProto(order),
+		Amendment: convertAmendmentToProto(amendment),
+	}, nil
+}
+
+// amendedValues returns the quantity and limit price the order has after the requested amendment,
+// or the code to refuse it with
+func amendedValues(order generated.Order, req *orderpb.ModifyOrderRequest) (basepb.ErrorCode, float64, float64, error) {
+	if order.Status != generated.OrderStatusPending && order.Status != generated.OrderStatusPartiallyFilled {
+		return basepb.ErrorCode_FAILED_PRECONDITION, 0, 0, errors.New("order is no longer open")
+	}
+	if order.GroupID != nil {
+		return basepb.ErrorCode_FAILED_PRECONDITION, 0, 0, errors.New("orders in a bracket or one-cancels-other group cannot be amended")
+	}
+
+	orderType := convertOrderType(order.Type)
+	quantity, price := convertNumeric(order.Quantity), convertNumeric(order.Price)
+	if req.Price != 0 {
+		if !requiresLimitPrice(orderType) {
+			return basepb.ErrorCode_INVALID_ARGUMENT, 0, 0, errors.New("only LIMIT and STOP_LIMIT orders have a limit price to amend")
+		}
+		price = req.Price
+	}
+	if req.Quantity != 0 {
+		if req.Quantity <= convertNumeric(order.FilledQuantity)+fillQuantityTolerance {
+			return basepb.ErrorCode_INVALID_ARGUMENT, 0, 0, errors.New("quantity must stay above the filled quantity")
+		}
+		quantity = req.Quantity
+	}
+	if math.Abs(quantity-convertNumeric(order.Quantity)) < fillQuantityTolerance && price == convertNumeric(order.Price) {
+		return basepb.ErrorCode_INVALID_ARGUMENT, 0, 0, errors.New("amendment does not change the order")
+	}
+
+	return basepb.ErrorCode_OK, quantity, price, nil
+}
+
+// ListOpenOrders lets the trade engine rebuild its order book from the orders that are still working.
+func (h *OrderHandler) ListOpenOrders(ctx context.Context, req *orderpb.ListOpenOrdersRequest) (*orderpb.ListOpenOrdersResponse, error) {
+	symbol := pgtype.Text{}
+	if s := strings.ToUpper(strings.TrimSpace(req.Symbol)); s != "" {
+		symbol = pgtype.Text{String: s, Valid: true}
+	}
+
+	rows, err := h.db.GetQueries().ListOpenOrders(ctx, symbol)
+	if err != nil {
+		return &orderpb.ListOpenOrdersResponse{
+			Code: basepb.ErrorCode_INTERNAL,
+		}, err
+	}
+
+	orders := make([]*orderpb.Order, 0, len(rows))
+	for _, row := range rows {
+		orders = append(orders, convertOpenOrderToProto(row))
+	}
+
+	return &orderpb.ListOpenOrdersResponse{
+		Code:   basepb.ErrorCode_OK,
+		Orders: orders,
 	}, nil
 }
 
@@ -352,33 +1044,67 @@ func (h *OrderHandler) handleOrderFilled(ctx context.Context, msg *nats.Msg) err
 		filledAt = event.FilledAt.AsTime()
 	}
 
+	// events published before partial fills existed carry no sequence; they were always the order's only fill
+	sequence := event.FillSequence
+	if sequence <= 0 {
+		sequence = 1
+	}
+
+	var status generated.OrderStatus
 	err = h.db.ExecMultiTx(ctx, func(queries *generated.Queries) error {
 		order, err := queries.GetOrderByIdForUpdate(ctx, orderId)
 		if err != nil {
 			return err
 		}
-		if order.Status == generated.OrderStatusFilled {
-			return nil
-		}
-		if order.Status != generated.OrderStatusPending {
+		// the engine can expire the remainder of an order before its last fill is processed here,
+		// so fills still count against expired orders
+		switch order.Status {
+		case generated.OrderStatusPending, generated.OrderStatusPartiallyFilled, generated.OrderStatusExpired, generated.OrderStatusPendingCancel:
+		default:
 			h.logger.Info(ctx, "Ignoring fill for terminal order", "order_id", event.OrderId, "status", order.Status)
 			return nil
 		}
 
-		if err := queries.InsertOrderFilled(ctx, generated.InsertOrderFilledParams{
+		pricing, err := marshalPricing(event.Pricing)
+		if err != nil {
+			return fmt.Errorf("%w: encode fill pricing: %v", errInvalidOrderEvent, err)
+		}
+		inserted, err := queries.InsertOrderFilled(ctx, generated.InsertOrderFilledParams{
 			OrderID:      orderId,
 			FillQuantity: floatToNumeric(event.FillQuantity),
 			FillPrice:    floatToNumeric(event.FillPrice),
 			FilledAt:     pgtype.Timestamptz{Time: filledAt, Valid: true},
-		}); err != nil {
+			Sequence:     sequence,
+			Pricing:      pricing,
+			QuoteSource:  pgtype.Text{String: event.QuoteSource, Valid: event.QuoteSource != ""},
+			QuoteAsOf:    pgtype.Timestamptz{Time: event.QuoteAsOf.AsTime(), Valid: event.QuoteAsOf != nil},
+		})
+		if err != nil {
 			return fmt.Errorf("insert order fill: %w", err)
 		}
+		if inserted == 0 {
+			// redelivered fill, already applied
+			status = order.Status
+			return nil
+		}
+
+		previousQuantity := convertNumeric(order.FilledQuantity)
+		filledQuantity := previousQuantity + event.FillQuantity
+		avgFillPrice := (previousQuantity*convertNumeric(order.AvgFillPrice) + event.FillQuantity*event.FillPrice) / filledQuantity
+
+		status = generated.OrderStatusPartiallyFilled
+		if orderQuantity := convertNumeric(order.Quantity); filledQuantity >= orderQuantity-fillQuantityTolerance {
+			filledQuantity = math.Min(filledQuantity, orderQuantity)
+			status = generated.OrderStatusFilled
+		} else if order.Status == generated.OrderStatusExpired || order.Status == generated.OrderStatusPendingCancel {
+			status = order.Status
+		}
 
 		_, err = queries.UpdateOrderStatus(ctx, generated.UpdateOrderStatusParams{
 			ID:             orderId,
-			FilledQuantity: floatToNumeric(event.FillQuantity),
-			AvgFillPrice:   floatToNumeric(event.FillPrice),
-			Status:         generated.OrderStatusFilled,
+			FilledQuantity: floatToNumeric(filledQuantity),
+			AvgFillPrice:   floatToNumeric(avgFillPrice),
+			Status:         status,
 		})
 
 		return err
@@ -392,7 +1118,16 @@ func (h *OrderHandler) handleOrderFilled(ctx context.Context, msg *nats.Msg) err
 		return err
 	}
 
-	h.logger.Info(ctx, "Order updated to FILLED", "order_id", event.OrderId)
+	switch status {
+	case generated.OrderStatusFilled:
+		h.logger.Info(ctx, "Order updated to FILLED", "order_id", event.OrderId, "fill_sequence", sequence)
+	case generated.OrderStatusPartiallyFilled:
+		h.logger.Info(ctx, "Order updated to PARTIALLY_FILLED", "order_id", event.OrderId, "fill_sequence", sequence)
+	case generated.OrderStatusExpired:
+		h.logger.Info(ctx, "Late fill applied to EXPIRED order", "order_id", event.OrderId, "fill_sequence", sequence)
+	case generated.OrderStatusPendingCancel:
+		h.logger.Info(ctx, "Fill applied to order waiting for its cancel", "order_id", event.OrderId, "fill_sequence", sequence)
+	}
 	return nil
 }
 
@@ -409,7 +1144,11 @@ func (h *OrderHandler) handleOrderRejected(ctx context.Context, msg *nats.Msg) e
 		return fmt.Errorf("%w: invalid rejected order ID", errInvalidOrderEvent)
 	}
 
-	_, err = h.db.GetQueries().RejectOrder(ctx, orderId)
+	_, err = h.db.GetQueries().RejectOrder(ctx, generated.RejectOrderParams{
+		ID:           orderId,
+		RejectCode:   convertRejectCodeToDB(event.ReasonCode),
+		RejectReason: pgtype.Text{String: event.Reason, Valid: event.Reason != ""},
+	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			h.logger.Info(ctx, "Ignoring rejection for terminal order", "order_id", event.OrderId)
@@ -419,6 +1158,207 @@ func (h *OrderHandler) handleOrderRejected(ctx context.Context, msg *nats.Msg) e
 		return err
 	}
 
-	h.logger.Info(ctx, "Order updated to REJECTED", "order_id", event.OrderId, "reason", event.Reason)
+	h.logger.Info(ctx, "Order updated to REJECTED", "order_id", event.OrderId, "reason", event.Reason, "reason_code", event.ReasonCode.String())
+	return nil
+}
+
+func (h *OrderHandler) handleOrderExpired(ctx context.Context, msg *nats.Msg) error {
+	var event orderpb.OrderExpiredEvent
+	if err := proto.Unmarshal(msg.Data, &event); err != nil {
+		h.logger.Debug(ctx, "Error unmarshalling order expired event", "error", err)
+		return fmt.Errorf("%w: decode expired event: %v", errInvalidOrderEvent, err)
+	}
+
+	orderId, err := uuid.Parse(event.OrderId)
+	if err != nil {
+		h.logger.Debug(ctx, "Invalid order ID in expired event", "error", err)
+		return fmt.Errorf("%w: invalid expired order ID", errInvalidOrderEvent)
+	}
+
+	_, err = h.db.GetQueries().ExpireOrder(ctx, orderId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			h.logger.Info(ctx, "Ignoring expiry for terminal order", "order_id", event.OrderId)
+			return nil
+		}
+		h.logger.Debug(ctx, "Failed to update order status to expired", "order_id", event.OrderId, "error", err)
+		return err
+	}
+
+	h.logger.Info(ctx, "Order updated to EXPIRED", "order_id", event.OrderId, "reason", event.Reason)
+	return nil
+}
+
+// handleOrderArmed turns a bracket exit into a working order once the engine has armed it
+func (h *OrderHandler) handleOrderArmed(ctx context.Context, msg *nats.Msg) error {
+	var event orderpb.OrderArmedEvent
+	if err := proto.Unmarshal(msg.Data, &event); err != nil {
+		h.logger.Debug(ctx, "Error unmarshalling order armed event", "error", err)
+		return fmt.Errorf("%w: decode armed event: %v", errInvalidOrderEvent, err)
+	}
+
+	orderId, err := uuid.Parse(event.OrderId)
+	if err != nil {
+		h.logger.Debug(ctx, "Invalid order ID in armed event", "error", err)
+		return fmt.Errorf("%w: invalid armed order ID", errInvalidOrderEvent)
+	}
+	if !isPositiveFinite(event.Quantity) {
+		return fmt.Errorf("%w: armed quantity must be greater than zero", errInvalidOrderEvent)
+	}
+
+	_, err = h.db.GetQueries().ArmOrder(ctx, generated.ArmOrderParams{
+		ID:       orderId,
+		Quantity: floatToNumeric(event.Quantity),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			h.logger.Info(ctx, "Ignoring arming of order that is no longer held", "order_id", event.OrderId)
+			return nil
+		}
+		h.logger.Debug(ctx, "Failed to arm order", "order_id", event.OrderId, "error", err)
+		return err
+	}
+
+	h.logger.Info(ctx, "Order armed", "order_id", event.OrderId, "parent_order_id", event.ParentOrderId, "quantity", event.Quantity)
+	return nil
+}
+
+// handleOrderCancelled applies cancellations decided by the engine, such as the other leg of a
+// one-cancels-other group filling, and the engine's confirmations of cancels users asked for;
+// cancellations made here are already applied and are skipped
+func (h *OrderHandler) handleOrderCancelled(ctx context.Context, msg *nats.Msg) error {
+	var event orderpb.OrderCancelledEvent
+	if err := proto.Unmarshal(msg.Data, &event); err != nil {
+		h.logger.Debug(ctx, "Error unmarshalling order cancelled event", "error", err)
+		return fmt.Errorf("%w: decode cancelled event: %v", errInvalidOrderEvent, err)
+	}
+
+	orderId, err := uuid.Parse(event.OrderId)
+	if err != nil {
+		h.logger.Debug(ctx, "Invalid order ID in cancelled event", "error", err)
+		return fmt.Errorf("%w: invalid cancelled order ID", errInvalidOrderEvent)
+	}
+
+	var exits []generated.Order
+	err = h.db.ExecMultiTx(ctx, func(queries *generated.Queries) error {
+		if _, err := queries.CancelOrderById(ctx, orderId); err != nil {
+			return err
+		}
+		if event.FilledQuantity > 0 {
+			// the engine arms the exits of a bracket entry for the part that filled
+			return nil
+		}
+		// the exits of a bracket entry that never filled never reach the book
+		exits, err = queries.CancelHeldExits(ctx, &orderId)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		h.logger.Debug(ctx, "Failed to update order status to canceled", "order_id", event.OrderId, "error", err)
+		return err
+	}
+
+	for _, exit := range exits {
+		if err := h.publishCancelledEvent(ctx, exit, fmt.Sprintf("Canceled together with order %s", event.OrderId)); err != nil {
+			return err
+		}
+	}
+
+	h.logger.Info(ctx, "Order updated to CANCELED", "order_id", event.OrderId, "reason", event.Reason, "exits", len(exits))
+	return nil
+}
+
+// handleCancelRejected reopens an order whose cancel the engine denied because it had nothing left to cancel
+func (h *OrderHandler) handleCancelRejected(ctx context.Context, msg *nats.Msg) error {
+	var event orderpb.OrderCancelRejectedEvent
+	if err := proto.Unmarshal(msg.Data, &event); err != nil {
+		h.logger.Debug(ctx, "Error unmarshalling cancel rejected event", "error", err)
+		return fmt.Errorf("%w: decode cancel rejected event: %v", errInvalidOrderEvent, err)
+	}
+
+	orderId, err := uuid.Parse(event.OrderId)
+	if err != nil {
+		h.logger.Debug(ctx, "Invalid order ID in cancel rejected event", "error", err)
+		return fmt.Errorf("%w: invalid cancel rejected order ID", errInvalidOrderEvent)
+	}
+
+	order, err := h.db.GetQueries().DenyCancel(ctx, orderId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// the order filled, expired or was rejected before the engine got to the cancel
+			h.logger.Info(ctx, "Ignoring denied cancel for closed order", "order_id", event.OrderId)
+			return nil
+		}
+		h.logger.Debug(ctx, "Failed to reopen order after denied cancel", "order_id", event.OrderId, "error", err)
+		return err
+	}
+
+	h.logger.Info(ctx, "Cancel denied; order reopened", "order_id", event.OrderId, "status", string(order.Status), "reason", event.Reason)
+	return nil
+}
+
+// handleAmendmentResolved records the engine's answer to an amendment and, when it was applied, changes the order to match
+func (h *OrderHandler) handleAmendmentResolved(ctx context.Context, msg *nats.Msg) error {
+	var event orderpb.OrderAmendmentResolvedEvent
+	if err := proto.Unmarshal(msg.Data, &event); err != nil {
+		h.logger.Debug(ctx, "Error unmarshalling amendment resolved event", "error", err)
+		return fmt.Errorf("%w: decode amendment resolved event: %v", errInvalidOrderEvent, err)
+	}
+
+	amendmentId, err := uuid.Parse(event.AmendmentId)
+	if err != nil {
+		return fmt.Errorf("%w: invalid amendment ID", errInvalidOrderEvent)
+	}
+	orderId, err := uuid.Parse(event.OrderId)
+	if err != nil {
+		return fmt.Errorf("%w: invalid amended order ID", errInvalidOrderEvent)
+	}
+	if event.Applied && !isPositiveFinite(event.Quantity) {
+		return fmt.Errorf("%w: amended quantity must be greater than zero", errInvalidOrderEvent)
+	}
+
+	status := generated.AmendmentStatusRejected
+	if event.Applied {
+		status = generated.AmendmentStatusApplied
+	}
+	resolved := true
+	err = h.db.ExecMultiTx(ctx, func(queries *generated.Queries) error {
+		_, err := queries.ResolveOrderAmendment(ctx, generated.ResolveOrderAmendmentParams{
+			ID:     amendmentId,
+			Status: status,
+			Reason: pgtype.Text{String: event.Reason, Valid: event.Reason != ""},
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			// redelivered, or rejected here before the engine answered
+			resolved = false
+			return nil
+		}
+		if err != nil || !event.Applied {
+			return err
+		}
+
+		_, err = queries.AmendOrder(ctx, generated.AmendOrderParams{
+			ID:       orderId,
+			Quantity: floatToNumeric(event.Quantity),
+			Price:    floatToNumericNullIfZero(event.Price),
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			// a fill that closed the order was applied first; it already reflects the amended book
+			h.logger.Info(ctx, "Amended order is no longer open", "order_id", event.OrderId, "amendment_id", event.AmendmentId)
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		h.logger.Debug(ctx, "Failed to resolve amendment", "amendment_id", event.AmendmentId, "error", err)
+		return err
+	}
+	if !resolved {
+		return nil
+	}
+
+	h.logger.Info(ctx, "Order amendment resolved", "order_id", event.OrderId, "amendment_id", event.AmendmentId, "status", string(status), "reason", event.Reason)
 	return nil
 }
@@ -0,0 +1,193 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fafnir/order-service/internal/db/generated"
+	basepb "fafnir/shared/pb/base"
+	orderpb "fafnir/shared/pb/order"
+	portfoliopb "fafnir/shared/pb/portfolio"
+	stockpb "fafnir/shared/pb/stock"
+	"fafnir/shared/pkg/fees"
+	"fafnir/shared/pkg/risk"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/proto"
+)
+
+// reserveHolds sets aside the cash or shares of an accepted order, and of the one-cancels-other legs working
+// alongside it, before the engine sees the order, so no other order of the user can spend them in between.
+// Bracket exits are reserved by the engine once it arms them.
+// Cash holds are sized in the account's currency with the fee schedule, the same way the engine sizes the holds of
+// bracket exits. A non-empty reason means the order has to be rejected; the holds reserved so far are released then.
+func (h *OrderHandler) reserveHolds(ctx context.Context, event *orderpb.OrderCreatedEvent, instrumentCurrency string, account *portfoliopb.RiskExposure) (string, error) {
+	orders := []*orderpb.OrderCreatedEvent{event}
+	if event.GroupType == orderpb.OrderGroupType_ORDER_GROUP_TYPE_OCO {
+		orders = append(orders, event.AttachedOrders...)
+	}
+
+	var lastPrice, rate float64
+	reserved := make([]string, 0, len(orders))
+	for _, order := range orders {
+		req := &portfoliopb.ReserveHoldRequest{
+			OrderId:  order.OrderId,
+			UserId:   order.UserId,
+			Kind:     portfoliopb.HoldKind_HOLD_KIND_SHARES,
+			Symbol:   order.Symbol,
+			Quantity: order.Quantity,
+		}
+		if len(order.OcoOrderIds) > 0 {
+			// only one leg of the group can execute, so the legs share a single reservation
+			req.GroupId = order.GroupId
+		}
+
+		if order.Side == orderpb.OrderSide_ORDER_SIDE_BUY {
+			if account == nil {
+				h.releaseHolds(ctx, reserved)
+				return "No investment account found", nil
+			}
+			if lastPrice == 0 && !requiresLimitPrice(order.Type) {
+				price, err := h.lastPrice(ctx, order.Symbol)
+				if err != nil {
+					h.releaseHolds(ctx, reserved)
+					return "", err
+				}
+				lastPrice = price
+			}
+			if rate == 0 {
+				var err error
+				rate, err = h.exchangeRate(ctx, instrumentCurrency, accountCurrency(account))
+				if err != nil {
+					h.releaseHolds(ctx, reserved)
+					return "", err
+				}
+			}
+
+			req.Kind = portfoliopb.HoldKind_HOLD_KIND_CASH
+			req.Amount = h.fees.Hold(fees.Fill{
+				Quantity:           order.Quantity,
+				Price:              risk.HoldPrice(risk.Order{Type: order.Type, Price: order.Price, StopPrice: order.StopPrice}, lastPrice),
+				ExchangeRate:       rate,
+				InstrumentCurrency: instrumentCurrency,
+				AccountCurrency:    accountCurrency(account),
+			})
+			req.Currency = accountCurrency(account)
+			if !isPositiveFinite(req.Amount) {
+				h.releaseHolds(ctx, reserved)
+				return "", errors.New("calculate hold amount: result is invalid")
+			}
+		}
+
+		resp, err := h.portfolioClient.ReserveHold(ctx, req)
+		if err != nil {
+			h.releaseHolds(ctx, reserved)
+			return "", fmt.Errorf("reserve hold: %w", err)
+		}
+
+		reason := ""
+		switch resp.GetCode() {
+		case basepb.ErrorCode_OK:
+			reserved = append(reserved, order.OrderId)
+			continue
+		case basepb.ErrorCode_NOT_FOUND:
+			reason = "No investment account found"
+		case basepb.ErrorCode_FAILED_PRECONDITION:
+			reason = "Insufficient holdings: shares are already reserved by other open orders"
+			if req.Kind == portfoliopb.HoldKind_HOLD_KIND_CASH {
+				reason = fmt.Sprintf("Insufficient buying power: need %.2f %s", req.Amount, req.Currency)
+			}
+		default:
+			h.releaseHolds(ctx, reserved)
+			return "", fmt.Errorf("reserve hold: portfolio service returned %s", resp.GetCode().String())
+		}
+
+		h.releaseHolds(ctx, reserved)
+		return reason, nil
+	}
+
+	return "", nil
+}
+
+// releaseHolds gives back the holds of orders that never reached the engine
+func (h *OrderHandler) releaseHolds(ctx context.Context, orderIDs []string) {
+	for _, orderID := range orderIDs {
+		resp, err := h.portfolioClient.ReleaseHold(ctx, &portfoliopb.ReleaseHoldRequest{OrderId: orderID})
+		if err == nil && resp.GetCode() != basepb.ErrorCode_OK && resp.GetCode() != basepb.ErrorCode_NOT_FOUND {
+			err = fmt.Errorf("portfolio service returned %s", resp.GetCode().String())
+		}
+		if err != nil {
+			h.logger.Error(ctx, "Failed to release hold of unaccepted order", "order_id", orderID, "error", err)
+		}
+	}
+}
+
+func (h *OrderHandler) exchangeRate(ctx context.Context, from string, to string) (float64, error) {
+	rate, err := h.fx.Rate(ctx, from, to)
+	if err != nil {
+		return 0, fmt.Errorf("get %s/%s exchange rate for hold: %w", from, to, err)
+	}
+	if !isPositiveFinite(rate) {
+		return 0, fmt.Errorf("get %s/%s exchange rate for hold: provider returned an invalid rate", from, to)
+	}
+
+	return rate, nil
+}
+
+// accountCurrency is the currency code of the account exposure describes
+func accountCurrency(exposure *portfoliopb.RiskExposure) string {
+	return strings.TrimPrefix(exposure.Currency.String(), "CURRENCY_TYPE_")
+}
+
+func (h *OrderHandler) lastPrice(ctx context.Context, symbol string) (float64, error) {
+	resp, err := h.stockClient.GetStockQuote(ctx, &stockpb.GetStockQuoteRequest{Symbol: symbol})
+	if err != nil {
+		return 0, fmt.Errorf("get quote for hold: %w", err)
+	}
+	if resp.Code != basepb.ErrorCode_OK || resp.Data == nil || !isPositiveFinite(resp.Data.LastPrice) {
+		return 0, fmt.Errorf("get quote for hold: stock service returned %s", resp.Code.String())
+	}
+
+	return resp.Data.LastPrice, nil
+}
+
+// rejectUnreserved records an order whose hold could not be reserved as rejected, together with its attached
+// orders, and tells the rest of the system through the same orders.rejected event the engine sends
+func (h *OrderHandler) rejectUnreserved(ctx context.Context, order generated.Order, attached []generated.Order, reason string) (*orderpb.InsertOrderResponse, error) {
+	rejected := order
+	for i, row := range append([]generated.Order{order}, attached...) {
+		updated, err := h.db.GetQueries().RejectOrder(ctx, generated.RejectOrderParams{
+			ID:           row.ID,
+			RejectReason: pgtype.Text{String: reason, Valid: true},
+		})
+		if err != nil {
+			return &orderpb.InsertOrderResponse{Code: basepb.ErrorCode_INTERNAL}, fmt.Errorf("reject order %s: %w", row.ID, err)
+		}
+		if i == 0 {
+			rejected = updated
+		}
+	}
+
+	eventBytes, err := proto.Marshal(&orderpb.OrderRejectedEvent{
+		OrderId:    rejected.ID.String(),
+		UserId:     rejected.UserID.String(),
+		Symbol:     rejected.Symbol,
+		Reason:     reason,
+		RejectedAt: convertTime(rejected.UpdatedAt),
+	})
+	if err != nil {
+		return &orderpb.InsertOrderResponse{Code: basepb.ErrorCode_INTERNAL}, fmt.Errorf("marshal orders.rejected event: %w", err)
+	}
+	if err := h.publishEvent(ctx, "orders.rejected", rejected.ID.String()+":rejected", eventBytes); err != nil {
+		// the order is already stored as rejected, only listeners miss out
+		h.logger.Error(ctx, "Failed to publish orders.rejected event", "order_id", rejected.ID.String(), "error", err)
+	}
+
+	h.logger.Info(ctx, "Order rejected at acceptance", "order_id", rejected.ID.String(), "reason", reason)
+	return &orderpb.InsertOrderResponse{
+		Code:  basepb.ErrorCode_FAILED_PRECONDITION,
+		Order: convertOrderToProto(rejected),
+	}, errors.New(reason)
+}
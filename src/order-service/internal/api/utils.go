@@ -5,7 +5,9 @@ import (
 	pb "fafnir/shared/pb/order"
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -29,9 +31,94 @@ func convertOrderToProto(order generated.Order) *pb.Order {
 		AvgFillPrice:   convertNumeric(order.AvgFillPrice),
 		CreatedAt:      convertTime(order.CreatedAt),
 		UpdatedAt:      convertTime(order.UpdatedAt),
+		TimeInForce:    convertTimeInForce(order.TimeInForce),
+		ExpiresAt:      convertTime(order.ExpiresAt),
+		TrailAmount:    convertNumeric(order.TrailAmount),
+		TrailPercent:   convertNumeric(order.TrailPercent),
+		GroupId:        convertUUID(order.GroupID),
+		ParentOrderId:  convertUUID(order.ParentOrderID),
+		RejectCode:     convertRejectCode(order.RejectCode),
+		RejectReason:   order.RejectReason.String,
 	}
 }
 
+func convertFillToProto(fill generated.OrdersFill) *pb.OrderFill {
+	converted := &pb.OrderFill{
+		Id:           fill.ID.String(),
+		OrderId:      fill.OrderID.String(),
+		FillQuantity: convertNumeric(fill.FillQuantity),
+		FillPrice:    convertNumeric(fill.FillPrice),
+		FilledAt:     convertTime(fill.FilledAt),
+		Sequence:     fill.Sequence,
+		QuoteSource:  fill.QuoteSource.String,
+		QuoteAsOf:    convertTime(fill.QuoteAsOf),
+	}
+	if len(fill.Pricing) > 0 {
+		pricing := &pb.ExecutionPricing{}
+		if err := protojson.Unmarshal(fill.Pricing, pricing); err == nil {
+			converted.Pricing = pricing
+		}
+	}
+
+	return converted
+}
+
+func convertAmendmentToProto(amendment generated.OrderAmendment) *pb.OrderAmendment {
+	return &pb.OrderAmendment{
+		Id:         amendment.ID.String(),
+		OrderId:    amendment.OrderID.String(),
+		Quantity:   convertNumeric(amendment.Quantity),
+		Price:      convertNumeric(amendment.Price),
+		Status:     convertAmendmentStatus(amendment.Status),
+		Reason:     amendment.Reason.String,
+		CreatedAt:  convertTime(amendment.CreatedAt),
+		ResolvedAt: convertTime(amendment.ResolvedAt),
+	}
+}
+
+func convertAmendmentStatus(s generated.AmendmentStatus) pb.AmendmentStatus {
+	switch s {
+	case generated.AmendmentStatusPending:
+		return pb.AmendmentStatus_AMENDMENT_STATUS_PENDING
+	case generated.AmendmentStatusApplied:
+		return pb.AmendmentStatus_AMENDMENT_STATUS_APPLIED
+	case generated.AmendmentStatusRejected:
+		return pb.AmendmentStatus_AMENDMENT_STATUS_REJECTED
+	default:
+		return pb.AmendmentStatus_AMENDMENT_STATUS_UNSPECIFIED
+	}
+}
+
+// marshalPricing encodes the pricing of a fill for the pricing column; fills without one store NULL
+func marshalPricing(pricing *pb.ExecutionPricing) ([]byte, error) {
+	if pricing == nil {
+		return nil, nil
+	}
+	return protojson.Marshal(pricing)
+}
+
+// convertGroupedOrderToProto is convertOrderToProto for queries that join the order's group
+func convertGroupedOrderToProto(order generated.Order, groupType generated.NullOrderGroupType) *pb.Order {
+	converted := convertOrderToProto(order)
+	if groupType.Valid {
+		converted.GroupType = convertOrderGroupType(groupType.OrderGroupType)
+	}
+	return converted
+}
+
+func convertOpenOrderToProto(row generated.ListOpenOrdersRow) *pb.Order {
+	order := convertGroupedOrderToProto(row.Order, row.GroupType)
+	order.FillCount = row.FillCount
+	return order
+}
+
+func convertUUID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
 func convertTime(t pgtype.Timestamptz) *timestamppb.Timestamp {
 	if !t.Valid {
 		return nil
@@ -71,6 +158,8 @@ func convertOrderTypeToDB(t pb.OrderType) generated.OrderType {
 		return generated.OrderTypeStop
 	case pb.OrderType_ORDER_TYPE_STOP_LIMIT:
 		return generated.OrderTypeStopLimit
+	case pb.OrderType_ORDER_TYPE_TRAILING_STOP:
+		return generated.OrderTypeTrailingStop
 	default:
 		return generated.OrderTypeMarket
 	}
@@ -86,6 +175,8 @@ func convertOrderType(t generated.OrderType) pb.OrderType {
 		return pb.OrderType_ORDER_TYPE_STOP
 	case generated.OrderTypeStopLimit:
 		return pb.OrderType_ORDER_TYPE_STOP_LIMIT
+	case generated.OrderTypeTrailingStop:
+		return pb.OrderType_ORDER_TYPE_TRAILING_STOP
 	default:
 		return pb.OrderType_ORDER_TYPE_UNSPECIFIED
 	}
@@ -103,11 +194,100 @@ func convertOrderStatus(s generated.OrderStatus) pb.OrderStatus {
 		return pb.OrderStatus_ORDER_STATUS_CANCELED
 	case generated.OrderStatusRejected:
 		return pb.OrderStatus_ORDER_STATUS_REJECTED
+	case generated.OrderStatusExpired:
+		return pb.OrderStatus_ORDER_STATUS_EXPIRED
+	case generated.OrderStatusHeld:
+		return pb.OrderStatus_ORDER_STATUS_HELD
+	case generated.OrderStatusPendingCancel:
+		return pb.OrderStatus_ORDER_STATUS_PENDING_CANCEL
 	default:
 		return pb.OrderStatus_ORDER_STATUS_UNSPECIFIED
 	}
 }
 
+func convertTimeInForceToDB(t pb.TimeInForce) generated.TimeInForce {
+	switch t {
+	case pb.TimeInForce_TIME_IN_FORCE_DAY:
+		return generated.TimeInForceDay
+	case pb.TimeInForce_TIME_IN_FORCE_IOC:
+		return generated.TimeInForceIoc
+	case pb.TimeInForce_TIME_IN_FORCE_FOK:
+		return generated.TimeInForceFok
+	default:
+		return generated.TimeInForceGtc
+	}
+}
+
+func convertTimeInForce(t generated.TimeInForce) pb.TimeInForce {
+	switch t {
+	case generated.TimeInForceDay:
+		return pb.TimeInForce_TIME_IN_FORCE_DAY
+	case generated.TimeInForceGtc:
+		return pb.TimeInForce_TIME_IN_FORCE_GTC
+	case generated.TimeInForceIoc:
+		return pb.TimeInForce_TIME_IN_FORCE_IOC
+	case generated.TimeInForceFok:
+		return pb.TimeInForce_TIME_IN_FORCE_FOK
+	default:
+		return pb.TimeInForce_TIME_IN_FORCE_UNSPECIFIED
+	}
+}
+
+func convertOrderGroupTypeToDB(t pb.OrderGroupType) generated.OrderGroupType {
+	if t == pb.OrderGroupType_ORDER_GROUP_TYPE_OCO {
+		return generated.OrderGroupTypeOco
+	}
+	return generated.OrderGroupTypeBracket
+}
+
+func convertOrderGroupType(t generated.OrderGroupType) pb.OrderGroupType {
+	switch t {
+	case generated.OrderGroupTypeBracket:
+		return pb.OrderGroupType_ORDER_GROUP_TYPE_BRACKET
+	case generated.OrderGroupTypeOco:
+		return pb.OrderGroupType_ORDER_GROUP_TYPE_OCO
+	default:
+		return pb.OrderGroupType_ORDER_GROUP_TYPE_UNSPECIFIED
+	}
+}
+
+func convertRejectCodeToDB(c pb.RejectCode) generated.NullRejectCode {
+	switch c {
+	case pb.RejectCode_REJECT_CODE_MAX_ORDER_NOTIONAL:
+		return generated.NullRejectCode{RejectCode: generated.RejectCodeMaxOrderNotional, Valid: true}
+	case pb.RejectCode_REJECT_CODE_MAX_POSITION_SIZE:
+		return generated.NullRejectCode{RejectCode: generated.RejectCodeMaxPositionSize, Valid: true}
+	case pb.RejectCode_REJECT_CODE_MAX_OPEN_ORDERS:
+		return generated.NullRejectCode{RejectCode: generated.RejectCodeMaxOpenOrders, Valid: true}
+	case pb.RejectCode_REJECT_CODE_DAILY_LOSS_LIMIT:
+		return generated.NullRejectCode{RejectCode: generated.RejectCodeDailyLossLimit, Valid: true}
+	case pb.RejectCode_REJECT_CODE_PRICE_COLLAR:
+		return generated.NullRejectCode{RejectCode: generated.RejectCodePriceCollar, Valid: true}
+	default:
+		return generated.NullRejectCode{}
+	}
+}
+
+func convertRejectCode(c generated.NullRejectCode) pb.RejectCode {
+	if !c.Valid {
+		return pb.RejectCode_REJECT_CODE_UNSPECIFIED
+	}
+	switch c.RejectCode {
+	case generated.RejectCodeMaxOrderNotional:
+		return pb.RejectCode_REJECT_CODE_MAX_ORDER_NOTIONAL
+	case generated.RejectCodeMaxPositionSize:
+		return pb.RejectCode_REJECT_CODE_MAX_POSITION_SIZE
+	case generated.RejectCodeMaxOpenOrders:
+		return pb.RejectCode_REJECT_CODE_MAX_OPEN_ORDERS
+	case generated.RejectCodeDailyLossLimit:
+		return pb.RejectCode_REJECT_CODE_DAILY_LOSS_LIMIT
+	case generated.RejectCodePriceCollar:
+		return pb.RejectCode_REJECT_CODE_PRICE_COLLAR
+	default:
+		return pb.RejectCode_REJECT_CODE_UNSPECIFIED
+	}
+}
+
 func floatToNumeric(f float64) pgtype.Numeric {
 	var n pgtype.Numeric
 	s := fmt.Sprintf("%f", f)
@@ -3,13 +3,27 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"time"
+
+	"fafnir/shared/pkg/fees"
+	"fafnir/shared/pkg/fx"
+	"fafnir/shared/pkg/redis"
 )
 
 type Config struct {
-	PORT         string
-	DB           PostgresConfig
-	NATS         NatsConfig
-	StockService StockServiceConfig
+	PORT             string
+	DB               PostgresConfig
+	NATS             NatsConfig
+	StockService     StockServiceConfig
+	PortfolioService PortfolioServiceConfig
+	SecurityService  SecurityServiceConfig
+	// JSON file with the pre-trade risk limits, the defaults apply when unset
+	RiskPolicyPath string
+	Cache          redis.CacheConfig
+	// cash holds are sized in the account's currency, with the engine's fee schedule
+	FX   fx.Config
+	Fees fees.Schedule
 }
 
 type PostgresConfig struct {
@@ -23,10 +37,16 @@ type PostgresConfig struct {
 
 func NewConfig() *Config {
 	return &Config{
-		PORT:         fmt.Sprintf(":%s", os.Getenv("SERVICE_PORT")),
-		DB:           newPostgresConfig(),
-		NATS:         newNatsConfig(),
-		StockService: newStockServiceConfig(),
+		PORT:             fmt.Sprintf(":%s", os.Getenv("SERVICE_PORT")),
+		DB:               newPostgresConfig(),
+		NATS:             newNatsConfig(),
+		StockService:     newStockServiceConfig(),
+		PortfolioService: newPortfolioServiceConfig(),
+		SecurityService:  newSecurityServiceConfig(),
+		RiskPolicyPath:   os.Getenv("RISK_POLICY_PATH"),
+		Cache:            newRedisConfig(),
+		FX:               newFXConfig(),
+		Fees:             newFeeConfig(),
 	}
 }
 
@@ -64,6 +84,40 @@ func newStockServiceConfig() StockServiceConfig {
 	}
 }
 
+type PortfolioServiceConfig struct {
+	Host string
+	Port string
+	URL  string
+}
+
+func newPortfolioServiceConfig() PortfolioServiceConfig {
+	host := os.Getenv("PORTFOLIO_SERVICE_HOST")
+	port := os.Getenv("PORTFOLIO_SERVICE_PORT")
+
+	return PortfolioServiceConfig{
+		Host: host,
+		Port: port,
+		URL:  fmt.Sprintf("%s:%s", host, port),
+	}
+}
+
+type SecurityServiceConfig struct {
+	Host string
+	Port string
+	URL  string
+}
+
+func newSecurityServiceConfig() SecurityServiceConfig {
+	host := os.Getenv("SECURITY_SERVICE_HOST")
+	port := os.Getenv("SECURITY_SERVICE_PORT")
+
+	return SecurityServiceConfig{
+		Host: host,
+		Port: port,
+		URL:  fmt.Sprintf("%s:%s", host, port),
+	}
+}
+
 func newPostgresConfig() PostgresConfig {
 	host := os.Getenv("DB_HOST")
 	port := os.Getenv("DB_PORT")
@@ -86,3 +140,75 @@ func newPostgresConfig() PostgresConfig {
 		),
 	}
 }
+
+func newRedisConfig() redis.CacheConfig {
+	host := os.Getenv("REDIS_HOST")
+	port := os.Getenv("REDIS_PORT")
+	password := os.Getenv("REDIS_PASSWORD")
+	db := 3
+
+	return redis.CacheConfig{
+		Host:     host,
+		Port:     port,
+		Password: password,
+		DB:       db,
+	}
+}
+
+func newFXConfig() fx.Config {
+	baseURL := os.Getenv("FX_API_URL")
+	if baseURL == "" {
+		baseURL = "https://api.frankfurter.dev"
+	}
+
+	offline, _ := strconv.ParseBool(os.Getenv("FX_OFFLINE"))
+
+	return fx.Config{
+		BaseURL:    baseURL,
+		Timeout:    durationFromEnv("FX_TIMEOUT", 5*time.Second),
+		TTL:        durationFromEnv("FX_CACHE_TTL", 12*time.Hour),
+		RatesFile:  os.Getenv("FX_RATES_FILE"),
+		Offline:    offline,
+		MaxRateAge: durationFromEnv("FX_MAX_RATE_AGE", 72*time.Hour),
+	}
+}
+
+// newFeeConfig reads the same fee schedule as the engine, which charges it; nothing is charged unless it is configured
+func newFeeConfig() fees.Schedule {
+	return fees.Schedule{
+		PerOrder:    floatFromEnv("FEE_PER_ORDER", 0),
+		PerShare:    floatFromEnv("FEE_PER_SHARE", 0),
+		PerShareMin: floatFromEnv("FEE_PER_SHARE_MIN", 0),
+		PerShareMax: floatFromEnv("FEE_PER_SHARE_MAX", 0),
+		Percent:     floatFromEnv("FEE_PERCENT", 0),
+		FXSpread:    floatFromEnv("FEE_FX_SPREAD", 0),
+	}
+}
+
+func durationFromEnv(name string, fallback time.Duration) time.Duration {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return fallback
+	}
+
+	return duration
+}
+
+func floatFromEnv(name string, fallback float64) float64 {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil || parsed <= 0 {
+		return fallback
+	}
+
+	return parsed
+}
@@ -12,6 +12,91 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AmendmentStatus string
+
+const (
+	AmendmentStatusPending  AmendmentStatus = "pending"
+	AmendmentStatusApplied  AmendmentStatus = "applied"
+	AmendmentStatusRejected AmendmentStatus = "rejected"
+)
+
+func (e *AmendmentStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AmendmentStatus(s)
+	case string:
+		*e = AmendmentStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AmendmentStatus: %T", src)
+	}
+	return nil
+}
+
+type NullAmendmentStatus struct {
+	AmendmentStatus AmendmentStatus `json:"amendment_status"`
+	Valid           bool            `json:"valid"` // Valid is true if AmendmentStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAmendmentStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.AmendmentStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AmendmentStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAmendmentStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AmendmentStatus), nil
+}
+
+type OrderGroupType string
+
+const (
+	OrderGroupTypeBracket OrderGroupType = "bracket"
+	OrderGroupTypeOco     OrderGroupType = "oco"
+)
+
+func (e *OrderGroupType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrderGroupType(s)
+	case string:
+		*e = OrderGroupType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrderGroupType: %T", src)
+	}
+	return nil
+}
+
+type NullOrderGroupType struct {
+	OrderGroupType OrderGroupType `json:"order_group_type"`
+	Valid          bool           `json:"valid"` // Valid is true if OrderGroupType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrderGroupType) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrderGroupType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrderGroupType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrderGroupType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrderGroupType), nil
+}
+
 type OrderSide string
 
 const (
@@ -62,6 +147,9 @@ const (
 	OrderStatusFilled          OrderStatus = "filled"
 	OrderStatusCanceled        OrderStatus = "canceled"
 	OrderStatusRejected        OrderStatus = "rejected"
+	OrderStatusExpired         OrderStatus = "expired"
+	OrderStatusHeld            OrderStatus = "held"
+	OrderStatusPendingCancel   OrderStatus = "pending_cancel"
 )
 
 func (e *OrderStatus) Scan(src interface{}) error {
@@ -102,10 +190,11 @@ func (ns NullOrderStatus) Value() (driver.Value, error) {
 type OrderType string
 
 const (
-	OrderTypeMarket    OrderType = "market"
-	OrderTypeLimit     OrderType = "limit"
-	OrderTypeStop      OrderType = "stop"
-	OrderTypeStopLimit OrderType = "stop_limit"
+	OrderTypeMarket       OrderType = "market"
+	OrderTypeLimit        OrderType = "limit"
+	OrderTypeStop         OrderType = "stop"
+	OrderTypeStopLimit    OrderType = "stop_limit"
+	OrderTypeTrailingStop OrderType = "trailing_stop"
 )
 
 func (e *OrderType) Scan(src interface{}) error {
@@ -143,6 +232,95 @@ func (ns NullOrderType) Value() (driver.Value, error) {
 	return string(ns.OrderType), nil
 }
 
+type RejectCode string
+
+const (
+	RejectCodeMaxOrderNotional RejectCode = "max_order_notional"
+	RejectCodeMaxPositionSize  RejectCode = "max_position_size"
+	RejectCodeMaxOpenOrders    RejectCode = "max_open_orders"
+	RejectCodeDailyLossLimit   RejectCode = "daily_loss_limit"
+	RejectCodePriceCollar      RejectCode = "price_collar"
+)
+
+func (e *RejectCode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RejectCode(s)
+	case string:
+		*e = RejectCode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RejectCode: %T", src)
+	}
+	return nil
+}
+
+type NullRejectCode struct {
+	RejectCode RejectCode `json:"reject_code"`
+	Valid      bool       `json:"valid"` // Valid is true if RejectCode is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRejectCode) Scan(value interface{}) error {
+	if value == nil {
+		ns.RejectCode, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RejectCode.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRejectCode) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RejectCode), nil
+}
+
+type TimeInForce string
+
+const (
+	TimeInForceDay TimeInForce = "day"
+	TimeInForceGtc TimeInForce = "gtc"
+	TimeInForceIoc TimeInForce = "ioc"
+	TimeInForceFok TimeInForce = "fok"
+)
+
+func (e *TimeInForce) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TimeInForce(s)
+	case string:
+		*e = TimeInForce(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TimeInForce: %T", src)
+	}
+	return nil
+}
+
+type NullTimeInForce struct {
+	TimeInForce TimeInForce `json:"time_in_force"`
+	Valid       bool        `json:"valid"` // Valid is true if TimeInForce is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTimeInForce) Scan(value interface{}) error {
+	if value == nil {
+		ns.TimeInForce, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TimeInForce.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTimeInForce) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TimeInForce), nil
+}
+
 type Order struct {
 	ID             uuid.UUID          `json:"id"`
 	UserID         uuid.UUID          `json:"user_id"`
@@ -157,6 +335,32 @@ type Order struct {
 	AvgFillPrice   pgtype.Numeric     `json:"avg_fill_price"`
 	CreatedAt      pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	TimeInForce    TimeInForce        `json:"time_in_force"`
+	ExpiresAt      pgtype.Timestamptz `json:"expires_at"`
+	TrailAmount    pgtype.Numeric     `json:"trail_amount"`
+	TrailPercent   pgtype.Numeric     `json:"trail_percent"`
+	GroupID        *uuid.UUID         `json:"group_id"`
+	ParentOrderID  *uuid.UUID         `json:"parent_order_id"`
+	RejectCode     NullRejectCode     `json:"reject_code"`
+	RejectReason   pgtype.Text        `json:"reject_reason"`
+}
+
+type OrderAmendment struct {
+	ID         uuid.UUID          `json:"id"`
+	OrderID    uuid.UUID          `json:"order_id"`
+	Quantity   pgtype.Numeric     `json:"quantity"`
+	Price      pgtype.Numeric     `json:"price"`
+	Status     AmendmentStatus    `json:"status"`
+	Reason     pgtype.Text        `json:"reason"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	ResolvedAt pgtype.Timestamptz `json:"resolved_at"`
+}
+
+type OrderGroup struct {
+	ID        uuid.UUID          `json:"id"`
+	UserID    uuid.UUID          `json:"user_id"`
+	Type      OrderGroupType     `json:"type"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
 }
 
 type OrdersFill struct {
@@ -165,4 +369,8 @@ type OrdersFill struct {
 	FillQuantity pgtype.Numeric     `json:"fill_quantity"`
 	FillPrice    pgtype.Numeric     `json:"fill_price"`
 	FilledAt     pgtype.Timestamptz `json:"filled_at"`
+	Sequence     int32              `json:"sequence"`
+	Pricing      []byte             `json:"pricing"`
+	QuoteSource  pgtype.Text        `json:"quote_source"`
+	QuoteAsOf    pgtype.Timestamptz `json:"quote_as_of"`
 }
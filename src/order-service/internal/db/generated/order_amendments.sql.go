@@ -0,0 +1,105 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: order_amendments.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const insertOrderAmendment = `-- name: InsertOrderAmendment :one
+INSERT INTO order_amendments (order_id, quantity, price)
+VALUES ($1, $2, $3)
+RETURNING id, order_id, quantity, price, status, reason, created_at, resolved_at
+`
+
+type InsertOrderAmendmentParams struct {
+	OrderID  uuid.UUID      `json:"order_id"`
+	Quantity pgtype.Numeric `json:"quantity"`
+	Price    pgtype.Numeric `json:"price"`
+}
+
+func (q *Queries) InsertOrderAmendment(ctx context.Context, arg InsertOrderAmendmentParams) (OrderAmendment, error) {
+	row := q.db.QueryRow(ctx, insertOrderAmendment, arg.OrderID, arg.Quantity, arg.Price)
+	var i OrderAmendment
+	err := row.Scan(
+		&i.ID,
+		&i.OrderID,
+		&i.Quantity,
+		&i.Price,
+		&i.Status,
+		&i.Reason,
+		&i.CreatedAt,
+		&i.ResolvedAt,
+	)
+	return i, err
+}
+
+const listOrderAmendments = `-- name: ListOrderAmendments :many
+SELECT id, order_id, quantity, price, status, reason, created_at, resolved_at FROM order_amendments
+WHERE order_id = $1
+ORDER BY created_at
+`
+
+func (q *Queries) ListOrderAmendments(ctx context.Context, orderID uuid.UUID) ([]OrderAmendment, error) {
+	rows, err := q.db.Query(ctx, listOrderAmendments, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OrderAmendment{}
+	for rows.Next() {
+		var i OrderAmendment
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderID,
+			&i.Quantity,
+			&i.Price,
+			&i.Status,
+			&i.Reason,
+			&i.CreatedAt,
+			&i.ResolvedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resolveOrderAmendment = `-- name: ResolveOrderAmendment :one
+UPDATE order_amendments
+SET status = $2, reason = $3, resolved_at = NOW()
+WHERE id = $1 AND status = 'pending'
+RETURNING id, order_id, quantity, price, status, reason, created_at, resolved_at
+`
+
+type ResolveOrderAmendmentParams struct {
+	ID     uuid.UUID       `json:"id"`
+	Status AmendmentStatus `json:"status"`
+	Reason pgtype.Text     `json:"reason"`
+}
+
+func (q *Queries) ResolveOrderAmendment(ctx context.Context, arg ResolveOrderAmendmentParams) (OrderAmendment, error) {
+	row := q.db.QueryRow(ctx, resolveOrderAmendment, arg.ID, arg.Status, arg.Reason)
+	var i OrderAmendment
+	err := row.Scan(
+		&i.ID,
+		&i.OrderID,
+		&i.Quantity,
+		&i.Price,
+		&i.Status,
+		&i.Reason,
+		&i.CreatedAt,
+		&i.ResolvedAt,
+	)
+	return i, err
+}
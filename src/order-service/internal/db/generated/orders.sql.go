@@ -12,11 +12,204 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const amendOrder = `-- name: AmendOrder :one
+UPDATE orders
+SET quantity = $2, price = $3, updated_at = NOW()
+WHERE id = $1 AND status IN ('pending', 'partially_filled')
+RETURNING id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at, time_in_force, expires_at, trail_amount, trail_percent, group_id, parent_order_id, reject_code, reject_reason
+`
+
+type AmendOrderParams struct {
+	ID       uuid.UUID      `json:"id"`
+	Quantity pgtype.Numeric `json:"quantity"`
+	Price    pgtype.Numeric `json:"price"`
+}
+
+// Applies an amendment the engine made to the resting order
+func (q *Queries) AmendOrder(ctx context.Context, arg AmendOrderParams) (Order, error) {
+	row := q.db.QueryRow(ctx, amendOrder, arg.ID, arg.Quantity, arg.Price)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Symbol,
+		&i.Side,
+		&i.Type,
+		&i.Status,
+		&i.Quantity,
+		&i.FilledQuantity,
+		&i.Price,
+		&i.StopPrice,
+		&i.AvgFillPrice,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.TrailAmount,
+		&i.TrailPercent,
+		&i.GroupID,
+		&i.ParentOrderID,
+		&i.RejectCode,
+		&i.RejectReason,
+	)
+	return i, err
+}
+
+const armOrder = `-- name: ArmOrder :one
+UPDATE orders
+SET status = 'pending', quantity = $2, updated_at = NOW()
+WHERE id = $1 AND status = 'held'
+RETURNING id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at, time_in_force, expires_at, trail_amount, trail_percent, group_id, parent_order_id, reject_code, reject_reason
+`
+
+type ArmOrderParams struct {
+	ID       uuid.UUID      `json:"id"`
+	Quantity pgtype.Numeric `json:"quantity"`
+}
+
+func (q *Queries) ArmOrder(ctx context.Context, arg ArmOrderParams) (Order, error) {
+	row := q.db.QueryRow(ctx, armOrder, arg.ID, arg.Quantity)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Symbol,
+		&i.Side,
+		&i.Type,
+		&i.Status,
+		&i.Quantity,
+		&i.FilledQuantity,
+		&i.Price,
+		&i.StopPrice,
+		&i.AvgFillPrice,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.TrailAmount,
+		&i.TrailPercent,
+		&i.GroupID,
+		&i.ParentOrderID,
+		&i.RejectCode,
+		&i.RejectReason,
+	)
+	return i, err
+}
+
+const cancelHeldExits = `-- name: CancelHeldExits :many
+UPDATE orders
+SET status = 'canceled', updated_at = NOW()
+WHERE parent_order_id = $1 AND status = 'held'
+RETURNING id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at, time_in_force, expires_at, trail_amount, trail_percent, group_id, parent_order_id, reject_code, reject_reason
+`
+
+// Held bracket exits are canceled together with an entry that never filled once the engine confirms its cancel
+func (q *Queries) CancelHeldExits(ctx context.Context, parentOrderID *uuid.UUID) ([]Order, error) {
+	rows, err := q.db.Query(ctx, cancelHeldExits, parentOrderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Order{}
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Symbol,
+			&i.Side,
+			&i.Type,
+			&i.Status,
+			&i.Quantity,
+			&i.FilledQuantity,
+			&i.Price,
+			&i.StopPrice,
+			&i.AvgFillPrice,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TimeInForce,
+			&i.ExpiresAt,
+			&i.TrailAmount,
+			&i.TrailPercent,
+			&i.GroupID,
+			&i.ParentOrderID,
+			&i.RejectCode,
+			&i.RejectReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const cancelLinkedOrders = `-- name: CancelLinkedOrders :many
+UPDATE orders
+SET status = 'canceled', updated_at = NOW()
+WHERE status IN ('pending', 'partially_filled', 'held')
+  AND id <> $1
+  AND (parent_order_id = $1
+       OR (group_id = $2 AND parent_order_id IS NOT DISTINCT FROM $3))
+RETURNING id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at, time_in_force, expires_at, trail_amount, trail_percent, group_id, parent_order_id, reject_code, reject_reason
+`
+
+type CancelLinkedOrdersParams struct {
+	ID            uuid.UUID  `json:"id"`
+	GroupID       *uuid.UUID `json:"group_id"`
+	ParentOrderID *uuid.UUID `json:"parent_order_id"`
+}
+
+// Cancels the orders that cannot outlive a canceled order: its bracket exits and its one-cancels-other legs
+func (q *Queries) CancelLinkedOrders(ctx context.Context, arg CancelLinkedOrdersParams) ([]Order, error) {
+	rows, err := q.db.Query(ctx, cancelLinkedOrders, arg.ID, arg.GroupID, arg.ParentOrderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Order{}
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Symbol,
+			&i.Side,
+			&i.Type,
+			&i.Status,
+			&i.Quantity,
+			&i.FilledQuantity,
+			&i.Price,
+			&i.StopPrice,
+			&i.AvgFillPrice,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TimeInForce,
+			&i.ExpiresAt,
+			&i.TrailAmount,
+			&i.TrailPercent,
+			&i.GroupID,
+			&i.ParentOrderID,
+			&i.RejectCode,
+			&i.RejectReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const cancelOrder = `-- name: CancelOrder :one
 UPDATE orders
 SET status = 'canceled', updated_at = NOW()
-WHERE id = $1 AND user_id = $2 AND status = 'pending'
-RETURNING id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at
+WHERE id = $1 AND user_id = $2 AND status = 'held'
+RETURNING id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at, time_in_force, expires_at, trail_amount, trail_percent, group_id, parent_order_id, reject_code, reject_reason
 `
 
 type CancelOrderParams struct {
@@ -24,6 +217,7 @@ type CancelOrderParams struct {
 	UserID uuid.UUID `json:"user_id"`
 }
 
+// Held bracket exits are not in the book yet, so nothing can execute them and they cancel right away
 func (q *Queries) CancelOrder(ctx context.Context, arg CancelOrderParams) (Order, error) {
 	row := q.db.QueryRow(ctx, cancelOrder, arg.ID, arg.UserID)
 	var i Order
@@ -41,23 +235,67 @@ func (q *Queries) CancelOrder(ctx context.Context, arg CancelOrderParams) (Order
 		&i.AvgFillPrice,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.TrailAmount,
+		&i.TrailPercent,
+		&i.GroupID,
+		&i.ParentOrderID,
+		&i.RejectCode,
+		&i.RejectReason,
 	)
 	return i, err
 }
 
-const getOrderByIdAndUserId = `-- name: GetOrderByIdAndUserId :one
-SELECT id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at FROM orders
-WHERE id = $1 AND user_id = $2
-LIMIT 1
+const cancelOrderById = `-- name: CancelOrderById :one
+UPDATE orders
+SET status = 'canceled', updated_at = NOW()
+WHERE id = $1 AND status IN ('pending', 'partially_filled', 'held', 'pending_cancel')
+RETURNING id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at, time_in_force, expires_at, trail_amount, trail_percent, group_id, parent_order_id, reject_code, reject_reason
 `
 
-type GetOrderByIdAndUserIdParams struct {
-	ID     uuid.UUID `json:"id"`
-	UserID uuid.UUID `json:"user_id"`
+// Used for cancellations the engine decides or confirms, e.g. the other leg of a one-cancels-other group filled
+func (q *Queries) CancelOrderById(ctx context.Context, id uuid.UUID) (Order, error) {
+	row := q.db.QueryRow(ctx, cancelOrderById, id)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Symbol,
+		&i.Side,
+		&i.Type,
+		&i.Status,
+		&i.Quantity,
+		&i.FilledQuantity,
+		&i.Price,
+		&i.StopPrice,
+		&i.AvgFillPrice,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.TrailAmount,
+		&i.TrailPercent,
+		&i.GroupID,
+		&i.ParentOrderID,
+		&i.RejectCode,
+		&i.RejectReason,
+	)
+	return i, err
 }
 
-func (q *Queries) GetOrderByIdAndUserId(ctx context.Context, arg GetOrderByIdAndUserIdParams) (Order, error) {
-	row := q.db.QueryRow(ctx, getOrderByIdAndUserId, arg.ID, arg.UserID)
+const denyCancel = `-- name: DenyCancel :one
+UPDATE orders
+SET status = CASE WHEN filled_quantity > 0 THEN 'partially_filled'::order_status ELSE 'pending'::order_status END,
+    updated_at = NOW()
+WHERE id = $1 AND status = 'pending_cancel'
+RETURNING id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at, time_in_force, expires_at, trail_amount, trail_percent, group_id, parent_order_id, reject_code, reject_reason
+`
+
+// The engine had nothing left to cancel because the order closed meanwhile; an order that is still open
+// is reopened, a closed one keeps its final status
+func (q *Queries) DenyCancel(ctx context.Context, id uuid.UUID) (Order, error) {
+	row := q.db.QueryRow(ctx, denyCancel, id)
 	var i Order
 	err := row.Scan(
 		&i.ID,
@@ -73,12 +311,104 @@ func (q *Queries) GetOrderByIdAndUserId(ctx context.Context, arg GetOrderByIdAnd
 		&i.AvgFillPrice,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.TrailAmount,
+		&i.TrailPercent,
+		&i.GroupID,
+		&i.ParentOrderID,
+		&i.RejectCode,
+		&i.RejectReason,
+	)
+	return i, err
+}
+
+const expireOrder = `-- name: ExpireOrder :one
+UPDATE orders
+SET status = 'expired', updated_at = NOW()
+WHERE id = $1 AND status IN ('pending', 'partially_filled', 'pending_cancel')
+RETURNING id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at, time_in_force, expires_at, trail_amount, trail_percent, group_id, parent_order_id, reject_code, reject_reason
+`
+
+func (q *Queries) ExpireOrder(ctx context.Context, id uuid.UUID) (Order, error) {
+	row := q.db.QueryRow(ctx, expireOrder, id)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Symbol,
+		&i.Side,
+		&i.Type,
+		&i.Status,
+		&i.Quantity,
+		&i.FilledQuantity,
+		&i.Price,
+		&i.StopPrice,
+		&i.AvgFillPrice,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.TrailAmount,
+		&i.TrailPercent,
+		&i.GroupID,
+		&i.ParentOrderID,
+		&i.RejectCode,
+		&i.RejectReason,
+	)
+	return i, err
+}
+
+const getOrderByIdAndUserId = `-- name: GetOrderByIdAndUserId :one
+SELECT o.id, o.user_id, o.symbol, o.side, o.type, o.status, o.quantity, o.filled_quantity, o.price, o.stop_price, o.avg_fill_price, o.created_at, o.updated_at, o.time_in_force, o.expires_at, o.trail_amount, o.trail_percent, o.group_id, o.parent_order_id, o.reject_code, o.reject_reason, g.type AS group_type
+FROM orders o
+LEFT JOIN order_groups g ON g.id = o.group_id
+WHERE o.id = $1 AND o.user_id = $2
+LIMIT 1
+`
+
+type GetOrderByIdAndUserIdParams struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type GetOrderByIdAndUserIdRow struct {
+	Order     Order              `json:"order"`
+	GroupType NullOrderGroupType `json:"group_type"`
+}
+
+func (q *Queries) GetOrderByIdAndUserId(ctx context.Context, arg GetOrderByIdAndUserIdParams) (GetOrderByIdAndUserIdRow, error) {
+	row := q.db.QueryRow(ctx, getOrderByIdAndUserId, arg.ID, arg.UserID)
+	var i GetOrderByIdAndUserIdRow
+	err := row.Scan(
+		&i.Order.ID,
+		&i.Order.UserID,
+		&i.Order.Symbol,
+		&i.Order.Side,
+		&i.Order.Type,
+		&i.Order.Status,
+		&i.Order.Quantity,
+		&i.Order.FilledQuantity,
+		&i.Order.Price,
+		&i.Order.StopPrice,
+		&i.Order.AvgFillPrice,
+		&i.Order.CreatedAt,
+		&i.Order.UpdatedAt,
+		&i.Order.TimeInForce,
+		&i.Order.ExpiresAt,
+		&i.Order.TrailAmount,
+		&i.Order.TrailPercent,
+		&i.Order.GroupID,
+		&i.Order.ParentOrderID,
+		&i.Order.RejectCode,
+		&i.Order.RejectReason,
+		&i.GroupType,
 	)
 	return i, err
 }
 
 const getOrderByIdForUpdate = `-- name: GetOrderByIdForUpdate :one
-SELECT id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at FROM orders
+SELECT id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at, time_in_force, expires_at, trail_amount, trail_percent, group_id, parent_order_id, reject_code, reject_reason FROM orders
 WHERE id = $1
 FOR UPDATE
 `
@@ -100,22 +430,245 @@ func (q *Queries) GetOrderByIdForUpdate(ctx context.Context, id uuid.UUID) (Orde
 		&i.AvgFillPrice,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.TrailAmount,
+		&i.TrailPercent,
+		&i.GroupID,
+		&i.ParentOrderID,
+		&i.RejectCode,
+		&i.RejectReason,
 	)
 	return i, err
 }
 
 const getOrdersByUserId = `-- name: GetOrdersByUserId :many
-SELECT id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at FROM orders
-WHERE user_id = $1
-ORDER BY created_at DESC
+SELECT o.id, o.user_id, o.symbol, o.side, o.type, o.status, o.quantity, o.filled_quantity, o.price, o.stop_price, o.avg_fill_price, o.created_at, o.updated_at, o.time_in_force, o.expires_at, o.trail_amount, o.trail_percent, o.group_id, o.parent_order_id, o.reject_code, o.reject_reason, g.type AS group_type
+FROM orders o
+LEFT JOIN order_groups g ON g.id = o.group_id
+WHERE o.user_id = $1
+ORDER BY o.created_at DESC
 `
 
-func (q *Queries) GetOrdersByUserId(ctx context.Context, userID uuid.UUID) ([]Order, error) {
+type GetOrdersByUserIdRow struct {
+	Order     Order              `json:"order"`
+	GroupType NullOrderGroupType `json:"group_type"`
+}
+
+func (q *Queries) GetOrdersByUserId(ctx context.Context, userID uuid.UUID) ([]GetOrdersByUserIdRow, error) {
 	rows, err := q.db.Query(ctx, getOrdersByUserId, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
+	items := []GetOrdersByUserIdRow{}
+	for rows.Next() {
+		var i GetOrdersByUserIdRow
+		if err := rows.Scan(
+			&i.Order.ID,
+			&i.Order.UserID,
+			&i.Order.Symbol,
+			&i.Order.Side,
+			&i.Order.Type,
+			&i.Order.Status,
+			&i.Order.Quantity,
+			&i.Order.FilledQuantity,
+			&i.Order.Price,
+			&i.Order.StopPrice,
+			&i.Order.AvgFillPrice,
+			&i.Order.CreatedAt,
+			&i.Order.UpdatedAt,
+			&i.Order.TimeInForce,
+			&i.Order.ExpiresAt,
+			&i.Order.TrailAmount,
+			&i.Order.TrailPercent,
+			&i.Order.GroupID,
+			&i.Order.ParentOrderID,
+			&i.Order.RejectCode,
+			&i.Order.RejectReason,
+			&i.GroupType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertOrder = `-- name: InsertOrder :one
+INSERT INTO orders (user_id, symbol, side, type, status, quantity, price, stop_price, time_in_force, expires_at, trail_amount, trail_percent, group_id, parent_order_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+RETURNING id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at, time_in_force, expires_at, trail_amount, trail_percent, group_id, parent_order_id, reject_code, reject_reason
+`
+
+type InsertOrderParams struct {
+	UserID        uuid.UUID          `json:"user_id"`
+	Symbol        string             `json:"symbol"`
+	Side          OrderSide          `json:"side"`
+	Type          OrderType          `json:"type"`
+	Status        OrderStatus        `json:"status"`
+	Quantity      pgtype.Numeric     `json:"quantity"`
+	Price         pgtype.Numeric     `json:"price"`
+	StopPrice     pgtype.Numeric     `json:"stop_price"`
+	TimeInForce   TimeInForce        `json:"time_in_force"`
+	ExpiresAt     pgtype.Timestamptz `json:"expires_at"`
+	TrailAmount   pgtype.Numeric     `json:"trail_amount"`
+	TrailPercent  pgtype.Numeric     `json:"trail_percent"`
+	GroupID       *uuid.UUID         `json:"group_id"`
+	ParentOrderID *uuid.UUID         `json:"parent_order_id"`
+}
+
+func (q *Queries) InsertOrder(ctx context.Context, arg InsertOrderParams) (Order, error) {
+	row := q.db.QueryRow(ctx, insertOrder,
+		arg.UserID,
+		arg.Symbol,
+		arg.Side,
+		arg.Type,
+		arg.Status,
+		arg.Quantity,
+		arg.Price,
+		arg.StopPrice,
+		arg.TimeInForce,
+		arg.ExpiresAt,
+		arg.TrailAmount,
+		arg.TrailPercent,
+		arg.GroupID,
+		arg.ParentOrderID,
+	)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Symbol,
+		&i.Side,
+		&i.Type,
+		&i.Status,
+		&i.Quantity,
+		&i.FilledQuantity,
+		&i.Price,
+		&i.StopPrice,
+		&i.AvgFillPrice,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.TrailAmount,
+		&i.TrailPercent,
+		&i.GroupID,
+		&i.ParentOrderID,
+		&i.RejectCode,
+		&i.RejectReason,
+	)
+	return i, err
+}
+
+const insertOrderGroup = `-- name: InsertOrderGroup :one
+INSERT INTO order_groups (user_id, type)
+VALUES ($1, $2)
+RETURNING id, user_id, type, created_at
+`
+
+type InsertOrderGroupParams struct {
+	UserID uuid.UUID      `json:"user_id"`
+	Type   OrderGroupType `json:"type"`
+}
+
+func (q *Queries) InsertOrderGroup(ctx context.Context, arg InsertOrderGroupParams) (OrderGroup, error) {
+	row := q.db.QueryRow(ctx, insertOrderGroup, arg.UserID, arg.Type)
+	var i OrderGroup
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Type,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listOpenOrders = `-- name: ListOpenOrders :many
+SELECT o.id, o.user_id, o.symbol, o.side, o.type, o.status, o.quantity, o.filled_quantity, o.price, o.stop_price, o.avg_fill_price, o.created_at, o.updated_at, o.time_in_force, o.expires_at, o.trail_amount, o.trail_percent, o.group_id, o.parent_order_id, o.reject_code, o.reject_reason, (SELECT COUNT(*) FROM orders_fill f WHERE f.order_id = o.id)::INTEGER AS fill_count, g.type AS group_type
+FROM orders o
+LEFT JOIN order_groups g ON g.id = o.group_id
+WHERE o.status IN ('pending', 'partially_filled', 'held', 'pending_cancel')
+  AND ($1::VARCHAR IS NULL OR o.symbol = $1)
+ORDER BY o.created_at
+`
+
+type ListOpenOrdersRow struct {
+	Order     Order              `json:"order"`
+	FillCount int32              `json:"fill_count"`
+	GroupType NullOrderGroupType `json:"group_type"`
+}
+
+func (q *Queries) ListOpenOrders(ctx context.Context, symbol pgtype.Text) ([]ListOpenOrdersRow, error) {
+	rows, err := q.db.Query(ctx, listOpenOrders, symbol)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListOpenOrdersRow{}
+	for rows.Next() {
+		var i ListOpenOrdersRow
+		if err := rows.Scan(
+			&i.Order.ID,
+			&i.Order.UserID,
+			&i.Order.Symbol,
+			&i.Order.Side,
+			&i.Order.Type,
+			&i.Order.Status,
+			&i.Order.Quantity,
+			&i.Order.FilledQuantity,
+			&i.Order.Price,
+			&i.Order.StopPrice,
+			&i.Order.AvgFillPrice,
+			&i.Order.CreatedAt,
+			&i.Order.UpdatedAt,
+			&i.Order.TimeInForce,
+			&i.Order.ExpiresAt,
+			&i.Order.TrailAmount,
+			&i.Order.TrailPercent,
+			&i.Order.GroupID,
+			&i.Order.ParentOrderID,
+			&i.Order.RejectCode,
+			&i.Order.RejectReason,
+			&i.FillCount,
+			&i.GroupType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPendingLinkedCancels = `-- name: ListPendingLinkedCancels :many
+SELECT id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at, time_in_force, expires_at, trail_amount, trail_percent, group_id, parent_order_id, reject_code, reject_reason FROM orders
+WHERE status = 'pending_cancel'
+  AND id <> $1
+  AND (parent_order_id = $1
+       OR (group_id = $2 AND parent_order_id IS NOT DISTINCT FROM $3))
+ORDER BY created_at
+`
+
+type ListPendingLinkedCancelsParams struct {
+	ID            uuid.UUID  `json:"id"`
+	GroupID       *uuid.UUID `json:"group_id"`
+	ParentOrderID *uuid.UUID `json:"parent_order_id"`
+}
+
+// The orders RequestLinkedCancels moved to pending_cancel together with id, whose requests are repeated with its own
+func (q *Queries) ListPendingLinkedCancels(ctx context.Context, arg ListPendingLinkedCancelsParams) ([]Order, error) {
+	rows, err := q.db.Query(ctx, listPendingLinkedCancels, arg.ID, arg.GroupID, arg.ParentOrderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 	items := []Order{}
 	for rows.Next() {
 		var i Order
@@ -133,6 +686,14 @@ func (q *Queries) GetOrdersByUserId(ctx context.Context, userID uuid.UUID) ([]Or
 			&i.AvgFillPrice,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TimeInForce,
+			&i.ExpiresAt,
+			&i.TrailAmount,
+			&i.TrailPercent,
+			&i.GroupID,
+			&i.ParentOrderID,
+			&i.RejectCode,
+			&i.RejectReason,
 		); err != nil {
 			return nil, err
 		}
@@ -144,34 +705,21 @@ func (q *Queries) GetOrdersByUserId(ctx context.Context, userID uuid.UUID) ([]Or
 	return items, nil
 }
 
-const insertOrder = `-- name: InsertOrder :one
-INSERT INTO orders (user_id, symbol, side, type, status, quantity, price, stop_price)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-RETURNING id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at
+const rejectOrder = `-- name: RejectOrder :one
+UPDATE orders
+SET status = 'rejected', reject_code = $2, reject_reason = $3, updated_at = NOW()
+WHERE id = $1 AND status IN ('pending', 'partially_filled', 'held', 'pending_cancel')
+RETURNING id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at, time_in_force, expires_at, trail_amount, trail_percent, group_id, parent_order_id, reject_code, reject_reason
 `
 
-type InsertOrderParams struct {
-	UserID    uuid.UUID      `json:"user_id"`
-	Symbol    string         `json:"symbol"`
-	Side      OrderSide      `json:"side"`
-	Type      OrderType      `json:"type"`
-	Status    OrderStatus    `json:"status"`
-	Quantity  pgtype.Numeric `json:"quantity"`
-	Price     pgtype.Numeric `json:"price"`
-	StopPrice pgtype.Numeric `json:"stop_price"`
+type RejectOrderParams struct {
+	ID           uuid.UUID      `json:"id"`
+	RejectCode   NullRejectCode `json:"reject_code"`
+	RejectReason pgtype.Text    `json:"reject_reason"`
 }
 
-func (q *Queries) InsertOrder(ctx context.Context, arg InsertOrderParams) (Order, error) {
-	row := q.db.QueryRow(ctx, insertOrder,
-		arg.UserID,
-		arg.Symbol,
-		arg.Side,
-		arg.Type,
-		arg.Status,
-		arg.Quantity,
-		arg.Price,
-		arg.StopPrice,
-	)
+func (q *Queries) RejectOrder(ctx context.Context, arg RejectOrderParams) (Order, error) {
+	row := q.db.QueryRow(ctx, rejectOrder, arg.ID, arg.RejectCode, arg.RejectReason)
 	var i Order
 	err := row.Scan(
 		&i.ID,
@@ -187,19 +735,28 @@ func (q *Queries) InsertOrder(ctx context.Context, arg InsertOrderParams) (Order
 		&i.AvgFillPrice,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.TrailAmount,
+		&i.TrailPercent,
+		&i.GroupID,
+		&i.ParentOrderID,
+		&i.RejectCode,
+		&i.RejectReason,
 	)
 	return i, err
 }
 
-const rejectOrder = `-- name: RejectOrder :one
+const requestCancel = `-- name: RequestCancel :one
 UPDATE orders
-SET status = 'rejected', updated_at = NOW()
-WHERE id = $1 AND status = 'pending'
-RETURNING id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at
+SET status = 'pending_cancel', updated_at = NOW()
+WHERE id = $1 AND status IN ('pending', 'partially_filled')
+RETURNING id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at, time_in_force, expires_at, trail_amount, trail_percent, group_id, parent_order_id, reject_code, reject_reason
 `
 
-func (q *Queries) RejectOrder(ctx context.Context, id uuid.UUID) (Order, error) {
-	row := q.db.QueryRow(ctx, rejectOrder, id)
+// A working order may be executing right now, so it is only canceled once the engine confirms
+func (q *Queries) RequestCancel(ctx context.Context, id uuid.UUID) (Order, error) {
+	row := q.db.QueryRow(ctx, requestCancel, id)
 	var i Order
 	err := row.Scan(
 		&i.ID,
@@ -215,16 +772,83 @@ func (q *Queries) RejectOrder(ctx context.Context, id uuid.UUID) (Order, error)
 		&i.AvgFillPrice,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.TrailAmount,
+		&i.TrailPercent,
+		&i.GroupID,
+		&i.ParentOrderID,
+		&i.RejectCode,
+		&i.RejectReason,
 	)
 	return i, err
 }
 
+const requestLinkedCancels = `-- name: RequestLinkedCancels :many
+UPDATE orders
+SET status = 'pending_cancel', updated_at = NOW()
+WHERE status IN ('pending', 'partially_filled')
+  AND id <> $1
+  AND (parent_order_id = $1
+       OR (group_id = $2 AND parent_order_id IS NOT DISTINCT FROM $3))
+RETURNING id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at, time_in_force, expires_at, trail_amount, trail_percent, group_id, parent_order_id, reject_code, reject_reason
+`
+
+type RequestLinkedCancelsParams struct {
+	ID            uuid.UUID  `json:"id"`
+	GroupID       *uuid.UUID `json:"group_id"`
+	ParentOrderID *uuid.UUID `json:"parent_order_id"`
+}
+
+// Same orders as CancelLinkedOrders, for a working order; held exits wait for the engine to confirm it
+func (q *Queries) RequestLinkedCancels(ctx context.Context, arg RequestLinkedCancelsParams) ([]Order, error) {
+	rows, err := q.db.Query(ctx, requestLinkedCancels, arg.ID, arg.GroupID, arg.ParentOrderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Order{}
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Symbol,
+			&i.Side,
+			&i.Type,
+			&i.Status,
+			&i.Quantity,
+			&i.FilledQuantity,
+			&i.Price,
+			&i.StopPrice,
+			&i.AvgFillPrice,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TimeInForce,
+			&i.ExpiresAt,
+			&i.TrailAmount,
+			&i.TrailPercent,
+			&i.GroupID,
+			&i.ParentOrderID,
+			&i.RejectCode,
+			&i.RejectReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateOrderStatus = `-- name: UpdateOrderStatus :one
 UPDATE orders
 SET filled_quantity = $2, avg_fill_price = $3,
     status = $4, updated_at = NOW()
-WHERE id = $1 AND status = 'pending'
-RETURNING id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at
+WHERE id = $1 AND status IN ('pending', 'partially_filled', 'expired', 'pending_cancel')
+RETURNING id, user_id, symbol, side, type, status, quantity, filled_quantity, price, stop_price, avg_fill_price, created_at, updated_at, time_in_force, expires_at, trail_amount, trail_percent, group_id, parent_order_id, reject_code, reject_reason
 `
 
 type UpdateOrderStatusParams struct {
@@ -256,6 +880,14 @@ func (q *Queries) UpdateOrderStatus(ctx context.Context, arg UpdateOrderStatusPa
 		&i.AvgFillPrice,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.TrailAmount,
+		&i.TrailPercent,
+		&i.GroupID,
+		&i.ParentOrderID,
+		&i.RejectCode,
+		&i.RejectReason,
 	)
 	return i, err
 }
@@ -12,10 +12,10 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const insertOrderFilled = `-- name: InsertOrderFilled :exec
-INSERT INTO orders_fill (order_id, fill_quantity, fill_price, filled_at)
-VALUES ($1, $2, $3, $4)
-ON CONFLICT (order_id) DO NOTHING
+const insertOrderFilled = `-- name: InsertOrderFilled :execrows
+INSERT INTO orders_fill (order_id, fill_quantity, fill_price, filled_at, sequence, pricing, quote_source, quote_as_of)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (order_id, sequence) DO NOTHING
 `
 
 type InsertOrderFilledParams struct {
@@ -23,14 +23,61 @@ type InsertOrderFilledParams struct {
 	FillQuantity pgtype.Numeric     `json:"fill_quantity"`
 	FillPrice    pgtype.Numeric     `json:"fill_price"`
 	FilledAt     pgtype.Timestamptz `json:"filled_at"`
+	Sequence     int32              `json:"sequence"`
+	Pricing      []byte             `json:"pricing"`
+	QuoteSource  pgtype.Text        `json:"quote_source"`
+	QuoteAsOf    pgtype.Timestamptz `json:"quote_as_of"`
 }
 
-func (q *Queries) InsertOrderFilled(ctx context.Context, arg InsertOrderFilledParams) error {
-	_, err := q.db.Exec(ctx, insertOrderFilled,
+func (q *Queries) InsertOrderFilled(ctx context.Context, arg InsertOrderFilledParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertOrderFilled,
 		arg.OrderID,
 		arg.FillQuantity,
 		arg.FillPrice,
 		arg.FilledAt,
+		arg.Sequence,
+		arg.Pricing,
+		arg.QuoteSource,
+		arg.QuoteAsOf,
 	)
-	return err
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listOrderFills = `-- name: ListOrderFills :many
+SELECT id, order_id, fill_quantity, fill_price, filled_at, sequence, pricing, quote_source, quote_as_of FROM orders_fill
+WHERE order_id = $1
+ORDER BY sequence
+`
+
+func (q *Queries) ListOrderFills(ctx context.Context, orderID uuid.UUID) ([]OrdersFill, error) {
+	rows, err := q.db.Query(ctx, listOrderFills, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OrdersFill{}
+	for rows.Next() {
+		var i OrdersFill
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderID,
+			&i.FillQuantity,
+			&i.FillPrice,
+			&i.FilledAt,
+			&i.Sequence,
+			&i.Pricing,
+			&i.QuoteSource,
+			&i.QuoteAsOf,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
@@ -8,16 +8,43 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	// Applies an amendment the engine made to the resting order
+	AmendOrder(ctx context.Context, arg AmendOrderParams) (Order, error)
+	ArmOrder(ctx context.Context, arg ArmOrderParams) (Order, error)
+	// Held bracket exits are canceled together with an entry that never filled once the engine confirms its cancel
+	CancelHeldExits(ctx context.Context, parentOrderID *uuid.UUID) ([]Order, error)
+	// Cancels the orders that cannot outlive a canceled order: its bracket exits and its one-cancels-other legs
+	CancelLinkedOrders(ctx context.Context, arg CancelLinkedOrdersParams) ([]Order, error)
+	// Held bracket exits are not in the book yet, so nothing can execute them and they cancel right away
 	CancelOrder(ctx context.Context, arg CancelOrderParams) (Order, error)
-	GetOrderByIdAndUserId(ctx context.Context, arg GetOrderByIdAndUserIdParams) (Order, error)
+	// Used for cancellations the engine decides or confirms, e.g. the other leg of a one-cancels-other group filled
+	CancelOrderById(ctx context.Context, id uuid.UUID) (Order, error)
+	// The engine had nothing left to cancel because the order closed meanwhile; an order that is still open
+	// is reopened, a closed one keeps its final status
+	DenyCancel(ctx context.Context, id uuid.UUID) (Order, error)
+	ExpireOrder(ctx context.Context, id uuid.UUID) (Order, error)
+	GetOrderByIdAndUserId(ctx context.Context, arg GetOrderByIdAndUserIdParams) (GetOrderByIdAndUserIdRow, error)
 	GetOrderByIdForUpdate(ctx context.Context, id uuid.UUID) (Order, error)
-	GetOrdersByUserId(ctx context.Context, userID uuid.UUID) ([]Order, error)
+	GetOrdersByUserId(ctx context.Context, userID uuid.UUID) ([]GetOrdersByUserIdRow, error)
 	InsertOrder(ctx context.Context, arg InsertOrderParams) (Order, error)
-	InsertOrderFilled(ctx context.Context, arg InsertOrderFilledParams) error
-	RejectOrder(ctx context.Context, id uuid.UUID) (Order, error)
+	InsertOrderAmendment(ctx context.Context, arg InsertOrderAmendmentParams) (OrderAmendment, error)
+	InsertOrderFilled(ctx context.Context, arg InsertOrderFilledParams) (int64, error)
+	InsertOrderGroup(ctx context.Context, arg InsertOrderGroupParams) (OrderGroup, error)
+	ListOpenOrders(ctx context.Context, symbol pgtype.Text) ([]ListOpenOrdersRow, error)
+	ListOrderAmendments(ctx context.Context, orderID uuid.UUID) ([]OrderAmendment, error)
+	ListOrderFills(ctx context.Context, orderID uuid.UUID) ([]OrdersFill, error)
+	// The orders RequestLinkedCancels moved to pending_cancel together with id, whose requests are repeated with its own
+	ListPendingLinkedCancels(ctx context.Context, arg ListPendingLinkedCancelsParams) ([]Order, error)
+	RejectOrder(ctx context.Context, arg RejectOrderParams) (Order, error)
+	// A working order may be executing right now, so it is only canceled once the engine confirms
+	RequestCancel(ctx context.Context, id uuid.UUID) (Order, error)
+	// Same orders as CancelLinkedOrders, for a working order; held exits wait for the engine to confirm it
+	RequestLinkedCancels(ctx context.Context, arg RequestLinkedCancelsParams) ([]Order, error)
+	ResolveOrderAmendment(ctx context.Context, arg ResolveOrderAmendmentParams) (OrderAmendment, error)
 	UpdateOrderStatus(ctx context.Context, arg UpdateOrderStatusParams) (Order, error)
 }
 
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- an order can now be filled in several pieces; the engine numbers each fill per order
+ALTER TABLE orders_fill ADD COLUMN sequence INTEGER NOT NULL DEFAULT 1 CHECK (sequence > 0);
+
+DROP INDEX IF EXISTS orders_fill_order_id_unique;
+CREATE UNIQUE INDEX orders_fill_order_sequence_unique ON orders_fill(order_id, sequence);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS orders_fill_order_sequence_unique;
+DELETE FROM orders_fill WHERE sequence > 1;
+CREATE UNIQUE INDEX orders_fill_order_id_unique ON orders_fill(order_id);
+
+ALTER TABLE orders_fill DROP COLUMN IF EXISTS sequence;
+-- +goose StatementEnd
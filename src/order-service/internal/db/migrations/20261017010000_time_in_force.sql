@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE time_in_force AS ENUM ('day', 'gtc', 'ioc', 'fok');
+
+ALTER TYPE order_status ADD VALUE IF NOT EXISTS 'expired';
+
+ALTER TABLE orders ADD COLUMN time_in_force time_in_force NOT NULL DEFAULT 'gtc';
+ALTER TABLE orders ADD COLUMN expires_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- postgres cannot drop an enum value, so expired orders fall back to canceled
+UPDATE orders SET status = 'canceled' WHERE status = 'expired';
+
+ALTER TABLE orders DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE orders DROP COLUMN IF EXISTS time_in_force;
+
+DROP TYPE IF EXISTS time_in_force;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE order_type ADD VALUE IF NOT EXISTS 'trailing_stop';
+
+-- a trailing stop trails by a fixed amount or by a percentage of the best price, never both
+ALTER TABLE orders ADD COLUMN trail_amount NUMERIC(20, 6) CHECK (trail_amount > 0);
+ALTER TABLE orders ADD COLUMN trail_percent NUMERIC(9, 4) CHECK (trail_percent > 0 AND trail_percent < 100);
+ALTER TABLE orders ADD CONSTRAINT orders_single_trail CHECK (trail_amount IS NULL OR trail_percent IS NULL);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- postgres cannot drop an enum value, so trailing stops become plain stops and open ones are canceled
+UPDATE orders
+SET type = 'stop',
+    status = CASE WHEN status IN ('pending', 'partially_filled') THEN 'canceled'::order_status ELSE status END
+WHERE type = 'trailing_stop';
+
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_single_trail;
+ALTER TABLE orders DROP COLUMN IF EXISTS trail_percent;
+ALTER TABLE orders DROP COLUMN IF EXISTS trail_amount;
+-- +goose StatementEnd
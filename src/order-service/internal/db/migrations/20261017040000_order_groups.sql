@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE order_group_type AS ENUM ('bracket', 'oco');
+
+-- bracket exits wait in 'held' until their entry fills
+ALTER TYPE order_status ADD VALUE IF NOT EXISTS 'held';
+
+-- this table ties together orders that were placed as one bracket or one-cancels-other group
+CREATE TABLE order_groups (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    type order_group_type NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE orders ADD COLUMN group_id UUID REFERENCES order_groups(id) ON DELETE CASCADE;
+ALTER TABLE orders ADD COLUMN parent_order_id UUID REFERENCES orders(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_orders_group ON orders(group_id) WHERE group_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- postgres cannot drop an enum value, so held exits are canceled
+UPDATE orders SET status = 'canceled' WHERE status = 'held';
+
+DROP INDEX IF EXISTS idx_orders_group;
+ALTER TABLE orders DROP COLUMN IF EXISTS parent_order_id;
+ALTER TABLE orders DROP COLUMN IF EXISTS group_id;
+
+DROP TABLE IF EXISTS order_groups;
+DROP TYPE IF EXISTS order_group_type;
+-- +goose StatementEnd
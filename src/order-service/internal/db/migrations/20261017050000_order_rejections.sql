@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- rejections keep why they happened; the code is set when a pre-trade risk check refused the order
+CREATE TYPE reject_code AS ENUM ('max_order_notional', 'max_position_size', 'max_open_orders', 'daily_loss_limit', 'price_collar');
+
+ALTER TABLE orders
+    ADD COLUMN reject_code reject_code,
+    ADD COLUMN reject_reason TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS reject_reason,
+    DROP COLUMN IF EXISTS reject_code;
+DROP TYPE IF EXISTS reject_code;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- how the engine priced a fill against its quote: the model, the last price and the slippage terms
+ALTER TABLE orders_fill ADD COLUMN pricing JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders_fill DROP COLUMN IF EXISTS pricing;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the quote a fill was made against, so disputed fills can be traced back to the provider's data
+ALTER TABLE orders_fill ADD COLUMN quote_source TEXT;
+ALTER TABLE orders_fill ADD COLUMN quote_as_of TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders_fill DROP COLUMN IF EXISTS quote_as_of;
+ALTER TABLE orders_fill DROP COLUMN IF EXISTS quote_source;
+-- +goose StatementEnd
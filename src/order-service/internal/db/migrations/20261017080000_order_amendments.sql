@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE amendment_status AS ENUM ('pending', 'applied', 'rejected');
+
+-- requested changes to resting orders; the order itself only changes once the engine applies one
+CREATE TABLE order_amendments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    quantity NUMERIC NOT NULL,
+    price NUMERIC,
+    status amendment_status NOT NULL DEFAULT 'pending',
+    reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_order_amendments_order ON order_amendments(order_id);
+-- an order has at most one amendment waiting for the engine
+CREATE UNIQUE INDEX idx_order_amendments_pending ON order_amendments(order_id) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS order_amendments;
+DROP TYPE IF EXISTS amendment_status;
+-- +goose StatementEnd
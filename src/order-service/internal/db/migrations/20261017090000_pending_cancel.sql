@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- cancels requested by users wait here until the engine has taken the order out of the book
+ALTER TYPE order_status ADD VALUE IF NOT EXISTS 'pending_cancel';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- postgres cannot drop an enum value, so cancels still waiting for the engine fall back to the open order
+UPDATE orders
+SET status = CASE WHEN filled_quantity > 0 THEN 'partially_filled'::order_status ELSE 'pending'::order_status END
+WHERE status = 'pending_cancel';
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- name: InsertOrderAmendment :one
+INSERT INTO order_amendments (order_id, quantity, price)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: ResolveOrderAmendment :one
+UPDATE order_amendments
+SET status = $2, reason = $3, resolved_at = NOW()
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: ListOrderAmendments :many
+SELECT * FROM order_amendments
+WHERE order_id = $1
+ORDER BY created_at;
@@ -1,6 +1,8 @@
 -- name: GetOrderByIdAndUserId :one
-SELECT * FROM orders
-WHERE id = $1 AND user_id = $2
+SELECT sqlc.embed(o), g.type AS group_type
+FROM orders o
+LEFT JOIN order_groups g ON g.id = o.group_id
+WHERE o.id = $1 AND o.user_id = $2
 LIMIT 1;
 
 -- name: GetOrderByIdForUpdate :one
@@ -9,30 +11,124 @@ WHERE id = $1
 FOR UPDATE;
 
 -- name: GetOrdersByUserId :many
-SELECT * FROM orders
-WHERE user_id = $1
-ORDER BY created_at DESC;
+SELECT sqlc.embed(o), g.type AS group_type
+FROM orders o
+LEFT JOIN order_groups g ON g.id = o.group_id
+WHERE o.user_id = $1
+ORDER BY o.created_at DESC;
 
 -- name: InsertOrder :one
-INSERT INTO orders (user_id, symbol, side, type, status, quantity, price, stop_price)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+INSERT INTO orders (user_id, symbol, side, type, status, quantity, price, stop_price, time_in_force, expires_at, trail_amount, trail_percent, group_id, parent_order_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+RETURNING *;
+
+-- name: InsertOrderGroup :one
+INSERT INTO order_groups (user_id, type)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: CancelLinkedOrders :many
+-- Cancels the orders that cannot outlive a canceled order: its bracket exits and its one-cancels-other legs
+UPDATE orders
+SET status = 'canceled', updated_at = NOW()
+WHERE status IN ('pending', 'partially_filled', 'held')
+  AND id <> sqlc.arg('id')
+  AND (parent_order_id = sqlc.arg('id')
+       OR (group_id = sqlc.narg('group_id') AND parent_order_id IS NOT DISTINCT FROM sqlc.narg('parent_order_id')))
+RETURNING *;
+
+-- name: CancelOrderById :one
+-- Used for cancellations the engine decides or confirms, e.g. the other leg of a one-cancels-other group filled
+UPDATE orders
+SET status = 'canceled', updated_at = NOW()
+WHERE id = $1 AND status IN ('pending', 'partially_filled', 'held', 'pending_cancel')
+RETURNING *;
+
+-- name: RequestCancel :one
+-- A working order may be executing right now, so it is only canceled once the engine confirms
+UPDATE orders
+SET status = 'pending_cancel', updated_at = NOW()
+WHERE id = $1 AND status IN ('pending', 'partially_filled')
+RETURNING *;
+
+-- name: RequestLinkedCancels :many
+-- Same orders as CancelLinkedOrders, for a working order; held exits wait for the engine to confirm it
+UPDATE orders
+SET status = 'pending_cancel', updated_at = NOW()
+WHERE status IN ('pending', 'partially_filled')
+  AND id <> sqlc.arg('id')
+  AND (parent_order_id = sqlc.arg('id')
+       OR (group_id = sqlc.narg('group_id') AND parent_order_id IS NOT DISTINCT FROM sqlc.narg('parent_order_id')))
+RETURNING *;
+
+-- name: ListPendingLinkedCancels :many
+-- The orders RequestLinkedCancels moved to pending_cancel together with id, whose requests are repeated with its own
+SELECT * FROM orders
+WHERE status = 'pending_cancel'
+  AND id <> sqlc.arg('id')
+  AND (parent_order_id = sqlc.arg('id')
+       OR (group_id = sqlc.narg('group_id') AND parent_order_id IS NOT DISTINCT FROM sqlc.narg('parent_order_id')))
+ORDER BY created_at;
+
+-- name: DenyCancel :one
+-- The engine had nothing left to cancel because the order closed meanwhile; an order that is still open
+-- is reopened, a closed one keeps its final status
+UPDATE orders
+SET status = CASE WHEN filled_quantity > 0 THEN 'partially_filled'::order_status ELSE 'pending'::order_status END,
+    updated_at = NOW()
+WHERE id = $1 AND status = 'pending_cancel'
+RETURNING *;
+
+-- name: CancelHeldExits :many
+-- Held bracket exits are canceled together with an entry that never filled once the engine confirms its cancel
+UPDATE orders
+SET status = 'canceled', updated_at = NOW()
+WHERE parent_order_id = $1 AND status = 'held'
+RETURNING *;
+
+-- name: ArmOrder :one
+UPDATE orders
+SET status = 'pending', quantity = $2, updated_at = NOW()
+WHERE id = $1 AND status = 'held'
 RETURNING *;
 
 -- name: UpdateOrderStatus :one
 UPDATE orders
 SET filled_quantity = $2, avg_fill_price = $3,
     status = $4, updated_at = NOW()
-WHERE id = $1 AND status = 'pending'
+WHERE id = $1 AND status IN ('pending', 'partially_filled', 'expired', 'pending_cancel')
 RETURNING *;
 
 -- name: CancelOrder :one
+-- Held bracket exits are not in the book yet, so nothing can execute them and they cancel right away
 UPDATE orders
 SET status = 'canceled', updated_at = NOW()
-WHERE id = $1 AND user_id = $2 AND status = 'pending'
+WHERE id = $1 AND user_id = $2 AND status = 'held'
 RETURNING *;
 
 -- name: RejectOrder :one
 UPDATE orders
-SET status = 'rejected', updated_at = NOW()
-WHERE id = $1 AND status = 'pending'
+SET status = 'rejected', reject_code = sqlc.narg('reject_code'), reject_reason = sqlc.narg('reject_reason'), updated_at = NOW()
+WHERE id = $1 AND status IN ('pending', 'partially_filled', 'held', 'pending_cancel')
+RETURNING *;
+
+-- name: ExpireOrder :one
+UPDATE orders
+SET status = 'expired', updated_at = NOW()
+WHERE id = $1 AND status IN ('pending', 'partially_filled', 'pending_cancel')
+RETURNING *;
+
+-- name: ListOpenOrders :many
+SELECT sqlc.embed(o), (SELECT COUNT(*) FROM orders_fill f WHERE f.order_id = o.id)::INTEGER AS fill_count, g.type AS group_type
+FROM orders o
+LEFT JOIN order_groups g ON g.id = o.group_id
+WHERE o.status IN ('pending', 'partially_filled', 'held', 'pending_cancel')
+  AND (sqlc.narg('symbol')::VARCHAR IS NULL OR o.symbol = sqlc.narg('symbol'))
+ORDER BY o.created_at;
+
+-- name: AmendOrder :one
+-- Applies an amendment the engine made to the resting order
+UPDATE orders
+SET quantity = $2, price = $3, updated_at = NOW()
+WHERE id = $1 AND status IN ('pending', 'partially_filled')
 RETURNING *;
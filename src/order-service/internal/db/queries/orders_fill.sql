@@ -1,4 +1,9 @@
--- name: InsertOrderFilled :exec
-INSERT INTO orders_fill (order_id, fill_quantity, fill_price, filled_at)
-VALUES ($1, $2, $3, $4)
-ON CONFLICT (order_id) DO NOTHING;
+-- name: InsertOrderFilled :execrows
+INSERT INTO orders_fill (order_id, fill_quantity, fill_price, filled_at, sequence, pricing, quote_source, quote_as_of)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (order_id, sequence) DO NOTHING;
+
+-- name: ListOrderFills :many
+SELECT * FROM orders_fill
+WHERE order_id = $1
+ORDER BY sequence;
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"fafnir/portfolio-service/internal/config"
+	"fafnir/shared/pkg/logger"
+	"fafnir/shared/pkg/nats"
+)
+
+const usage = `Inspect and replay messages in the dead letter stream.
+
+Usage:
+  dlq [--url nats://host:port] list
+  dlq [--url nats://host:port] replay <sequence>|all
+`
+
+func main() {
+	// defaults to the same NATS server as the service (NATS_HOST and NATS_PORT)
+	url := flag.String("url", config.NewConfig().NATS.URL, "NATS server URL")
+	flag.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	client, err := nats.New(*url, logger.New(nil))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer client.Close()
+
+	switch {
+	case args[0] == "list" && len(args) == 1:
+		err = list(client)
+	case args[0] == "replay" && len(args) == 2:
+		err = replay(client, args[1])
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func list(client *nats.NatsClient) error {
+	letters, err := client.DeadLetters()
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SEQ\tSERVICE\tSUBJECT\tDELIVERIES\tFAILED AT\tERROR")
+	for _, letter := range letters {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%s\t%s\n", letter.Sequence, letter.Service, letter.Subject, letter.Deliveries, letter.FailedAt.Format(time.RFC3339), letter.Error)
+	}
+	return w.Flush()
+}
+
+func replay(client *nats.NatsClient, target string) error {
+	var sequences []uint64
+	if target == "all" {
+		letters, err := client.DeadLetters()
+		if err != nil {
+			return err
+		}
+		for _, letter := range letters {
+			sequences = append(sequences, letter.Sequence)
+		}
+	} else {
+		seq, err := strconv.ParseUint(target, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid sequence %q", target)
+		}
+		sequences = append(sequences, seq)
+	}
+
+	for _, seq := range sequences {
+		if err := client.ReplayDeadLetter(seq); err != nil {
+			return err
+		}
+		fmt.Printf("replayed %d\n", seq)
+	}
+	return nil
+}
@@ -5,16 +5,24 @@ import (
 	"fafnir/portfolio-service/internal/api"
 	"fafnir/portfolio-service/internal/config"
 	"fafnir/portfolio-service/internal/db"
+	stockpb "fafnir/shared/pb/stock"
+	"fafnir/shared/pkg/fx"
 	"fafnir/shared/pkg/logger"
 	"fafnir/shared/pkg/nats"
+	"fafnir/shared/pkg/redis"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
+// the Redis hash holding the last rate served per currency pair
+const fxRatesKey = "portfolio:v1:fx-rates"
+
 func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
@@ -39,7 +47,28 @@ func main() {
 		os.Exit(1)
 	}
 
-	handler := api.NewPortfolioHandler(db, natsClient, logger)
+	// create stock service client, for the quotes holdings are valued at
+	stockConn, err := grpc.NewClient(cfg.StockService.URL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Error(ctx, "Failed to connect to stock service", "error", err)
+		os.Exit(1)
+	}
+
+	// create redis cache, for the last FX rates valuations fall back to while the FX API is failing
+	redisCache, err := redis.New(cfg.Cache, logger)
+	if err != nil {
+		logger.Error(ctx, "Failed to initialize redis", "error", err)
+		os.Exit(1)
+	}
+	defer redisCache.Close()
+
+	fxProvider, err := fx.New(cfg.FX, fx.NewRedisStore(redisCache, fxRatesKey))
+	if err != nil {
+		logger.Error(ctx, "Failed to initialize FX provider", "error", err)
+		os.Exit(1)
+	}
+
+	handler := api.NewPortfolioHandler(db, natsClient, stockpb.NewStockServiceClient(stockConn), fxProvider, logger)
 
 	server := api.NewServer(cfg, logger, handler)
 
@@ -56,6 +85,11 @@ func main() {
 		return server.RunMetricsServer()
 	})
 
+	// snapshot the accounts after each market close, for performance over time
+	g.Go(func() error {
+		return handler.RunSnapshots(ctx)
+	})
+
 	// wait for shutdown signal
 	g.Go(func() error {
 		<-ctx.Done()
@@ -4,32 +4,63 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
 
 	"fafnir/portfolio-service/internal/db"
 	"fafnir/portfolio-service/internal/db/generated"
 	basepb "fafnir/shared/pb/base"
 	orderpb "fafnir/shared/pb/order"
 	portfoliopb "fafnir/shared/pb/portfolio"
+	stockpb "fafnir/shared/pb/stock"
+	"fafnir/shared/pkg/fx"
 	"fafnir/shared/pkg/logger"
 	natsC "fafnir/shared/pkg/nats"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/nats-io/nats.go"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errInsufficientBuyingPower = errors.New("insufficient buying power")
+	errInsufficientShares      = errors.New("insufficient available shares")
+	errInsufficientFunds       = errors.New("insufficient funds")
+	errHoldExhausted           = errors.New("hold reserves less than the change takes off")
+	errNoInvestmentAccount     = errors.New("no investment account found for user")
+	errInvalidFillEvent        = errors.New("invalid fill event")
+)
+
+const (
+	// deliveries of a fill before it is dead-lettered
+	settlementMaxDeliveries  = 5
+	settlementRetryBaseDelay = 2 * time.Second
+	settlementMaxRetryDelay  = time.Minute
+
+	// dead letters of this service go to dlq.portfolio.<subject> and come back on replay.portfolio.<subject>
+	deadLetterService = "portfolio"
 )
 
 type PortfolioHandler struct {
 	db     *db.Database
 	nats   *natsC.NatsClient
+	stock  stockpb.StockServiceClient
+	fx     fx.Provider
 	logger *logger.Logger
 	portfoliopb.UnimplementedPortfolioServiceServer
 }
 
-func NewPortfolioHandler(db *db.Database, nats *natsC.NatsClient, logger *logger.Logger) *PortfolioHandler {
+func NewPortfolioHandler(db *db.Database, nats *natsC.NatsClient, stock stockpb.StockServiceClient, fxProvider fx.Provider, logger *logger.Logger) *PortfolioHandler {
 	return &PortfolioHandler{
 		db:     db,
 		nats:   nats,
+		stock:  stock,
+		fx:     fxProvider,
 		logger: logger,
 	}
 }
@@ -40,21 +71,131 @@ func (h *PortfolioHandler) RegisterSubscribeHandlers() {
 	if err != nil {
 		h.logger.Debug(context.Background(), "Failed to subscribe to orders.filled", "error", err)
 	}
+
+	// fills an operator replays from the dead letter stream settle like any other
+	replaySubject := natsC.ReplaySubject(deadLetterService, "orders.filled")
+	if _, err := h.nats.QueueSubscribe(replaySubject, "portfolio-service", "portfolio-service-replay", h.handleOrderFilled); err != nil {
+		h.logger.Debug(context.Background(), "Failed to subscribe to "+replaySubject, "error", err)
+	}
+
+	// an order that ends without filling completely gives back whatever its hold still reserves
+	for _, subject := range []string{"orders.cancelled", "orders.rejected", "orders.expired"} {
+		durable := "portfolio-service-" + strings.TrimPrefix(subject, "orders.")
+		if _, err := h.nats.QueueSubscribe(subject, "portfolio-service", durable, h.handleOrderClosed); err != nil {
+			h.logger.Debug(context.Background(), "Failed to subscribe to "+subject, "error", err)
+		}
+	}
+}
+
+func (h *PortfolioHandler) handleOrderClosed(msg *nats.Msg) {
+	var orderID string
+	var err error
+	switch msg.Subject {
+	case "orders.cancelled":
+		var event orderpb.OrderCancelledEvent
+		err = proto.Unmarshal(msg.Data, &event)
+		orderID = event.OrderId
+	case "orders.rejected":
+		var event orderpb.OrderRejectedEvent
+		err = proto.Unmarshal(msg.Data, &event)
+		orderID = event.OrderId
+	case "orders.expired":
+		var event orderpb.OrderExpiredEvent
+		err = proto.Unmarshal(msg.Data, &event)
+		orderID = event.OrderId
+	default:
+		_ = msg.Ack()
+		return
+	}
+
+	if err != nil {
+		h.logger.Debug(context.Background(), "Failed to unmarshal order event", "subject", msg.Subject, "error", err)
+		_ = msg.Term()
+		return
+	}
+	orderUUID, err := uuid.Parse(orderID)
+	if err != nil {
+		h.logger.Debug(context.Background(), "Invalid order ID in event", "subject", msg.Subject, "error", err)
+		_ = msg.Term()
+		return
+	}
+
+	hold, err := h.db.GetQueries().ReleaseHold(context.Background(), orderUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// orders without a hold, or whose hold already settled or was released
+			_ = msg.Ack()
+			return
+		}
+
+		h.logger.Error(context.Background(), "Failed to release hold", "order_id", orderID, "error", err)
+		_ = msg.NakWithDelay(2 * time.Second)
+		return
+	}
+
+	h.logger.Info(context.Background(), "Hold released", "order_id", orderID, "subject", msg.Subject, "kind", hold.Kind)
+	_ = msg.Ack()
 }
 
 func (h *PortfolioHandler) handleOrderFilled(msg *nats.Msg) {
+	ctx := context.Background()
+
 	var event orderpb.OrderFilledEvent
-	if err := proto.Unmarshal(msg.Data, &event); err != nil {
-		h.logger.Debug(context.Background(), "Failed to unmarshal OrderFilledEvent", "error", err)
+	err := proto.Unmarshal(msg.Data, &event)
+	if err != nil {
+		err = fmt.Errorf("%w: %w", errInvalidFillEvent, err)
+	} else {
+		// each fill of a partially filled order arrives as its own event and settles independently;
+		// an internal trade between two users arrives as one event per side, sharing the trade ID
+		h.logger.Info(ctx, "Processing OrderFilledEvent", "order_id", event.OrderId, "fill_sequence", event.FillSequence, "trade_id", event.TradeId, "counterparty_order_id", event.CounterpartyOrderId)
+		err = h.settleFill(ctx, &event)
+	}
+	if err == nil {
+		_ = msg.Ack()
+		return
+	}
+
+	deliveries := uint64(1)
+	if meta, metaErr := msg.Metadata(); metaErr == nil {
+		deliveries = meta.NumDelivered
+	}
+
+	// a fill that cannot settle as it is, or still fails after its retries, is parked for an operator
+	// instead of being redelivered forever
+	permanent := errors.Is(err, errInvalidFillEvent) || errors.Is(err, errNoInvestmentAccount)
+	if !permanent && deliveries < settlementMaxDeliveries {
+		delay := settlementRetryDelay(deliveries)
+		h.logger.Error(ctx, "Settlement failed; scheduling retry", "order_id", event.OrderId, "fill_sequence", event.FillSequence, "deliveries", deliveries, "delay", delay, "error", err)
+		_ = msg.NakWithDelay(delay)
 		return
 	}
 
-	h.logger.Info(context.Background(), "Processing OrderFilledEvent", "order_id", event.OrderId)
+	if dlqErr := h.nats.DeadLetter(msg, deadLetterService, err); dlqErr != nil {
+		h.logger.Error(ctx, "Failed to dead-letter fill; scheduling retry", "order_id", event.OrderId, "fill_sequence", event.FillSequence, "error", dlqErr)
+		_ = msg.NakWithDelay(settlementMaxRetryDelay)
+		return
+	}
+
+	h.logger.Error(ctx, "Settlement failed; fill dead-lettered", "order_id", event.OrderId, "fill_sequence", event.FillSequence, "deliveries", deliveries, "error", err)
+	_ = msg.Term()
+}
 
+// settleFill books a fill against the user's investment account. A fill that already settled is skipped,
+// so redelivered events are safe to process.
+func (h *PortfolioHandler) settleFill(ctx context.Context, event *orderpb.OrderFilledEvent) error {
 	userId, err := uuid.Parse(event.UserId)
 	if err != nil {
-		h.logger.Debug(context.Background(), "Invalid user ID in event", "error", err)
-		return
+		return fmt.Errorf("%w: user ID: %w", errInvalidFillEvent, err)
+	}
+	refID, err := uuid.Parse(event.OrderId)
+	if err != nil {
+		return fmt.Errorf("%w: order ID: %w", errInvalidFillEvent, err)
+	}
+	if event.FillQuantity <= 0 {
+		return fmt.Errorf("%w: fill quantity %f", errInvalidFillEvent, event.FillQuantity)
+	}
+	if event.Side != orderpb.OrderSide_ORDER_SIDE_BUY && event.Side != orderpb.OrderSide_ORDER_SIDE_SELL {
+		return fmt.Errorf("%w: order side %s", errInvalidFillEvent, event.Side.String())
 	}
 
 	// calculate total cost/proceeds
@@ -70,43 +211,46 @@ func (h *PortfolioHandler) handleOrderFilled(msg *nats.Msg) {
 		totalSettlementValue = event.FillQuantity * event.FillPrice
 		avgCostBasis = event.FillPrice
 	}
+	// fees of a buy are part of what the shares cost, so they count against the P&L of selling them
+	if event.Side == orderpb.OrderSide_ORDER_SIDE_BUY {
+		avgCostBasis += event.Fee / event.FillQuantity
+	}
+
+	var alreadySettled bool
+	err = h.db.ExecMultiTx(ctx, func(q *generated.Queries) error {
+		// claiming the fill first makes a redelivered event settle nothing
+		claimed, err := q.InsertSettledFill(ctx, generated.InsertSettledFillParams{
+			OrderID:      refID,
+			FillSequence: max(event.FillSequence, 1),
+			TradeID:      pgtype.Text{String: event.TradeId, Valid: event.TradeId != ""},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to claim fill: %w", err)
+		}
+		if claimed == 0 {
+			alreadySettled = true
+			return nil
+		}
+
+		var realizedPnl pgtype.Numeric
 
-	err = h.db.ExecMultiTx(context.Background(), func(q *generated.Queries) error {
 		// first get the investment account for the user
-		accounts, err := q.GetAccountByUserId(context.Background(), userId)
+		accounts, err := q.GetAccountByUserId(ctx, userId)
 		if err != nil {
 			return fmt.Errorf("failed to get accounts: %w", err)
 		}
 
 		// find the investment account
-		// if they have multiple, we just take the first one for now
-		var investmentAcc *generated.Account
-		for _, acc := range accounts {
-			if acc.AccountType == generated.AccountTypeInvestment {
-				investmentAcc = &acc
-				break
-			}
-		}
-
-		// if no investment account found, return error
+		investmentAcc := findInvestmentAccount(accounts)
 		if investmentAcc == nil {
-			return errors.New("no investment account found for user")
+			return errNoInvestmentAccount
 		}
 
 		// then, settle the order
 		switch event.Side {
 		case orderpb.OrderSide_ORDER_SIDE_BUY:
-			// buy order, deduct funds
-			_, err := q.UpdateAccountBalance(context.Background(), generated.UpdateAccountBalanceParams{
-				ID:      investmentAcc.ID,
-				Balance: floatToNumeric(-totalSettlementValue),
-			})
-			if err != nil {
-				return fmt.Errorf("failed to deduct funds: %w", err)
-			}
-
 			// add/update holdings (for buy, quantity increases, avg cost updates)
-			_, err = q.UpsertHolding(context.Background(), generated.UpsertHoldingParams{
+			_, err := q.UpsertHolding(ctx, generated.UpsertHoldingParams{
 				AccountID: investmentAcc.ID,
 				Symbol:    event.Symbol,
 				Quantity:  floatToNumeric(event.FillQuantity),
@@ -116,18 +260,21 @@ func (h *PortfolioHandler) handleOrderFilled(msg *nats.Msg) {
 				return fmt.Errorf("failed to upsert holding (buy): %w", err)
 			}
 
-		case orderpb.OrderSide_ORDER_SIDE_SELL:
-			// otherwise, sell order, add funds
-			_, err := q.UpdateAccountBalance(context.Background(), generated.UpdateAccountBalanceParams{
-				ID:      investmentAcc.ID,
-				Balance: floatToNumeric(totalSettlementValue),
+			// every buy opens a lot of its own, for sells to close by the account's lot method
+			_, err = q.InsertTaxLot(ctx, generated.InsertTaxLotParams{
+				AccountID: investmentAcc.ID,
+				Symbol:    event.Symbol,
+				OrderID:   &refID,
+				Quantity:  floatToNumeric(event.FillQuantity),
+				CostBasis: floatToNumeric(avgCostBasis),
 			})
 			if err != nil {
-				return fmt.Errorf("failed to add funds: %w", err)
+				return fmt.Errorf("failed to open tax lot: %w", err)
 			}
 
-			// decrease holdings (for sell, quantity decreases, avg cost remains same)
-			_, err = q.DecreaseHolding(context.Background(), generated.DecreaseHoldingParams{
+		case orderpb.OrderSide_ORDER_SIDE_SELL:
+			// otherwise, sell order, decrease holdings (quantity decreases; the avg cost follows the lots left open below)
+			holding, err := q.DecreaseHolding(ctx, generated.DecreaseHoldingParams{
 				AccountID: investmentAcc.ID,
 				Symbol:    event.Symbol,
 				Quantity:  floatToNumeric(event.FillQuantity),
@@ -135,46 +282,79 @@ func (h *PortfolioHandler) handleOrderFilled(msg *nats.Msg) {
 			if err != nil {
 				return fmt.Errorf("failed to decrease holding (sell): %w", err)
 			}
-		default:
-			h.logger.Debug(context.Background(), "Order side unspecified/unknown. Skipping settlement.", "order_id", event.OrderId)
-			return errors.New("order side unspecified/unknown")
+
+			pnl, err := closeTaxLots(ctx, q, investmentAcc, refID, event.Symbol, event.FillQuantity, totalSettlementValue-event.Fee, numericToFloat(holding.AvgCost))
+			if err != nil {
+				return fmt.Errorf("failed to close tax lots (sell): %w", err)
+			}
+			realizedPnl = floatToNumeric(pnl)
 		}
 
-		// audit log
-		var txType generated.TransactionType
-		var desc string
-		if event.Side == orderpb.OrderSide_ORDER_SIDE_BUY {
-			txType = generated.TransactionTypeBuy
-			desc = fmt.Sprintf("Bought %f shares of %s", event.FillQuantity, event.Symbol)
-		} else {
-			txType = generated.TransactionTypeSell
-			desc = fmt.Sprintf("Sold %f shares of %s", event.FillQuantity, event.Symbol)
+		// the cash of the fill is paid to or received from the clearing account, which stands in for the market
+		entry := journalEntry{
+			entryType:   generated.JournalEntryTypeBuy,
+			description: fmt.Sprintf("Bought %f shares of %s at %f", event.FillQuantity, event.Symbol, event.FillPrice),
+			referenceID: &refID,
+		}
+		cashAmount := -totalSettlementValue
+		if event.Side == orderpb.OrderSide_ORDER_SIDE_SELL {
+			entry.entryType = generated.JournalEntryTypeSell
+			entry.description = fmt.Sprintf("Sold %f shares of %s at %f", event.FillQuantity, event.Symbol, event.FillPrice)
+			entry.realizedPnl = realizedPnl
+			cashAmount = totalSettlementValue
+		}
+		if err := postWithSystem(ctx, q, *investmentAcc, generated.LedgerAccountKindClearing, cashAmount, entry); err != nil {
+			return fmt.Errorf("failed to post fill: %w", err)
+		}
+
+		// fees are charged as their own entry, next to the trade they belong to
+		if event.Fee > 0 {
+			err = postWithSystem(ctx, q, *investmentAcc, generated.LedgerAccountKindFees, -event.Fee, journalEntry{
+				entryType:   generated.JournalEntryTypeFee,
+				description: fmt.Sprintf("Fees for %f shares of %s", event.FillQuantity, event.Symbol),
+				referenceID: &refID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to charge fee: %w", err)
+			}
 		}
 
-		var refID = uuid.MustParse(event.OrderId)
-		_, err = q.InsertAuditLog(context.Background(), generated.InsertAuditLogParams{
-			AccountID:       investmentAcc.ID,
-			TransactionType: txType,
-			Amount:          floatToNumeric(totalSettlementValue),
-			Description:     desc,
-			ReferenceID:     &refID,
+		// the fill uses up part of what the order reserved at acceptance, fees included
+		var consumedAmount float64
+		if event.Side == orderpb.OrderSide_ORDER_SIDE_BUY {
+			consumedAmount = totalSettlementValue + event.Fee
+		}
+		_, err = q.ConsumeHold(ctx, generated.ConsumeHoldParams{
+			Quantity: floatToNumeric(event.FillQuantity),
+			Amount:   floatToNumeric(consumedAmount),
+			OrderID:  refID,
 		})
-		if err != nil {
-			return fmt.Errorf("failed to insert audit log: %w", err)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("failed to consume hold: %w", err)
 		}
 
 		return nil
 	})
 
 	if err != nil {
-		h.logger.Debug(context.Background(), "Settlement failed", "order_id", event.OrderId, "error", err)
-		// we ACK even on failure because we don't have a retry/DLQ mechanism yet
-		// and we want to avoid infinite redelivery loops that drain money
-		_ = msg.Ack()
-	} else {
-		h.logger.Info(context.Background(), "Settlement successful", "order_id", event.OrderId)
-		_ = msg.Ack()
+		return err
 	}
+
+	if alreadySettled {
+		h.logger.Info(ctx, "Fill already settled", "order_id", event.OrderId, "fill_sequence", event.FillSequence)
+		return nil
+	}
+	h.logger.Info(ctx, "Settlement successful", "order_id", event.OrderId, "fill_sequence", event.FillSequence)
+	return nil
+}
+
+// settlementRetryDelay doubles the wait after each failed delivery, up to settlementMaxRetryDelay
+func settlementRetryDelay(deliveries uint64) time.Duration {
+	delay := settlementRetryBaseDelay
+	for i := uint64(1); i < deliveries && delay < settlementMaxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, settlementMaxRetryDelay)
 }
 
 func (h *PortfolioHandler) CreateAccount(ctx context.Context, req *portfoliopb.CreateAccountRequest) (*portfoliopb.CreateAccountResponse, error) {
@@ -203,7 +383,8 @@ func (h *PortfolioHandler) CreateAccount(ctx context.Context, req *portfoliopb.C
 		AccountNumber: uuid.New().String()[0:12], // just random 12 digits for now
 		AccountType:   convertAccountTypeToDB(req.Type),
 		Currency:      convertCurrencyTypeToDB(req.Currency),
-		Balance:       floatToNumeric(500.00), // default 500 (just a sim)
+		Balance:       floatToNumeric(0), // the initial deposit below funds it
+		LotMethod:     convertLotMethodToDB(req.LotMethod),
 	}
 
 	var account generated.Account
@@ -215,14 +396,25 @@ func (h *PortfolioHandler) CreateAccount(ctx context.Context, req *portfoliopb.C
 			return err
 		}
 
-		// log initial deposit
-		_, err = q.InsertAuditLog(ctx, generated.InsertAuditLogParams{
-			AccountID:       account.ID,
-			TransactionType: generated.TransactionTypeDeposit,
-			Amount:          params.Balance,
-			Description:     "Initial Deposit",
-			ReferenceID:     nil,
+		_, err = q.InsertLedgerAccount(ctx, generated.InsertLedgerAccountParams{
+			Kind:      generated.LedgerAccountKindCash,
+			AccountID: &account.ID,
+			Currency:  account.Currency,
+		})
+		if err != nil {
+			return err
+		}
+
+		// initial deposit, default 500 (just a sim)
+		err = postWithSystem(ctx, q, account, generated.LedgerAccountKindFunding, 500.00, journalEntry{
+			entryType:   generated.JournalEntryTypeDeposit,
+			description: "Initial Deposit",
 		})
+		if err != nil {
+			return err
+		}
+
+		account, err = q.GetAccountById(ctx, account.ID)
 		return err
 	})
 
@@ -277,15 +469,31 @@ func (h *PortfolioHandler) GetPortfolioSummary(ctx context.Context, req *portfol
 	var totalBal float64
 
 	for _, acc := range accounts {
-		protoAccounts = append(protoAccounts, convertAccountToProto(acc))
-		bal, _ := acc.Balance.Float64Value()
-		totalBal += bal.Float64
+		reserved, err := h.db.GetQueries().GetReservedBalance(ctx, acc.ID)
+		if err != nil {
+			return &portfoliopb.GetPortfolioSummaryResponse{
+				Code: basepb.ErrorCode_INTERNAL,
+			}, err
+		}
+
+		protoAcc := convertAccountToProto(acc)
+		protoAcc.AvailableBalance -= numericToFloat(reserved)
+		protoAccounts = append(protoAccounts, protoAcc)
+		totalBal += protoAcc.Balance
+	}
+
+	totalFees, err := h.db.GetQueries().GetTotalFeesByUserId(ctx, userId)
+	if err != nil {
+		return &portfoliopb.GetPortfolioSummaryResponse{
+			Code: basepb.ErrorCode_INTERNAL,
+		}, err
 	}
 
 	return &portfoliopb.GetPortfolioSummaryResponse{
 		Code:         basepb.ErrorCode_OK,
 		Accounts:     protoAccounts,
 		TotalBalance: totalBal,
+		TotalFees:    numericToFloat(totalFees),
 	}, nil
 }
 
@@ -300,9 +508,20 @@ func (h *PortfolioHandler) GetHoldings(ctx context.Context, req *portfoliopb.Get
 		return &portfoliopb.GetHoldingsResponse{Code: basepb.ErrorCode_INTERNAL}, err
 	}
 
+	reservedRows, err := h.db.GetQueries().GetReservedQuantitiesByAccountId(ctx, accountId)
+	if err != nil {
+		return &portfoliopb.GetHoldingsResponse{Code: basepb.ErrorCode_INTERNAL}, err
+	}
+	reserved := make(map[string]float64, len(reservedRows))
+	for _, row := range reservedRows {
+		reserved[row.Symbol] = numericToFloat(row.Reserved)
+	}
+
 	var protoHoldings []*portfoliopb.Holding
 	for _, holding := range holdings {
-		protoHoldings = append(protoHoldings, convertHoldingToProto(holding))
+		protoHolding := convertHoldingToProto(holding)
+		protoHolding.AvailableQuantity -= reserved[holding.Symbol]
+		protoHoldings = append(protoHoldings, protoHolding)
 	}
 
 	return &portfoliopb.GetHoldingsResponse{
@@ -336,9 +555,22 @@ func (h *PortfolioHandler) GetHolding(ctx context.Context, req *portfoliopb.GetH
 		}, err
 	}
 
+	reserved, err := h.db.GetQueries().GetReservedQuantity(ctx, generated.GetReservedQuantityParams{
+		AccountID: accountId,
+		Symbol:    req.Symbol,
+	})
+	if err != nil {
+		return &portfoliopb.GetHoldingResponse{
+			Code: basepb.ErrorCode_INTERNAL,
+		}, err
+	}
+
+	protoHolding := convertHoldingToProto(holding)
+	protoHolding.AvailableQuantity -= numericToFloat(reserved)
+
 	return &portfoliopb.GetHoldingResponse{
 		Code:    basepb.ErrorCode_OK,
-		Holding: convertHoldingToProto(holding),
+		Holding: protoHolding,
 	}, nil
 }
 
@@ -458,9 +690,17 @@ func (h *PortfolioHandler) GetTransactions(ctx context.Context, req *portfoliopb
 		protoTxs = append(protoTxs, protoTx)
 	}
 
+	totalFees, err := h.db.GetQueries().GetTotalFeesByAccountId(ctx, accountId)
+	if err != nil {
+		return &portfoliopb.GetTransactionsResponse{
+			Code: basepb.ErrorCode_INTERNAL,
+		}, err
+	}
+
 	return &portfoliopb.GetTransactionsResponse{
 		Code:         basepb.ErrorCode_OK,
 		Transactions: protoTxs,
+		TotalFees:    numericToFloat(totalFees),
 	}, nil
 }
 
@@ -502,26 +742,21 @@ func (h *PortfolioHandler) Deposit(ctx context.Context, req *portfoliopb.Deposit
 			return fmt.Errorf("currency mismatch: account is %s, deposit is %s", dbCurrency, req.Currency)
 		}
 
-		// update balance
-		updatedAcc, err := q.UpdateAccountBalance(ctx, generated.UpdateAccountBalanceParams{
-			ID:      accountId,
-			Balance: floatToNumeric(req.Amount),
+		// the money comes in from the funding account, which stands in for the outside world
+		err = postWithSystem(ctx, q, acc, generated.LedgerAccountKindFunding, req.Amount, journalEntry{
+			entryType:   generated.JournalEntryTypeDeposit,
+			description: "Manual Deposit",
 		})
 		if err != nil {
 			return err
 		}
 
-		bal, _ := updatedAcc.Balance.Float64Value()
-		newBalance = bal.Float64
-
-		// insert audit log
-		_, err = q.InsertAuditLog(ctx, generated.InsertAuditLogParams{
-			AccountID:       accountId,
-			TransactionType: generated.TransactionTypeDeposit,
-			Amount:          floatToNumeric(req.Amount),
-			Description:     "Manual Deposit",
-		})
-		return err
+		updatedAcc, err := q.GetAccountById(ctx, accountId)
+		if err != nil {
+			return err
+		}
+		newBalance = numericToFloat(updatedAcc.Balance)
+		return nil
 	})
 
 	if err != nil {
@@ -555,8 +790,8 @@ func (h *PortfolioHandler) Transfer(ctx context.Context, req *portfoliopb.Transf
 	}
 
 	err = h.db.ExecMultiTx(ctx, func(q *generated.Queries) error {
-		// get both accounts
-		fromAcc, err := q.GetAccountById(ctx, fromId)
+		// lock the source first, as holds do, so a hold placed meanwhile cannot reserve what is transferred away
+		fromAcc, err := q.LockAccount(ctx, fromId)
 		if err != nil {
 			return fmt.Errorf("from_account not found: %w", err)
 		}
@@ -579,66 +814,777 @@ func (h *PortfolioHandler) Transfer(ctx context.Context, req *portfoliopb.Transf
 			return fmt.Errorf("cross-currency transfer not supported yet (%s -> %s)", fromCurr, toCurr)
 		}
 
-		// check balance
-		currentBal, _ := fromAcc.Balance.Float64Value()
-		if currentBal.Float64 < req.Amount {
-			return errors.New("insufficient funds")
+		// cash held for open buys is not the account's to transfer
+		reserved, err := q.GetReservedBalance(ctx, fromAcc.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get reserved balance: %w", err)
+		}
+		if numericToFloat(fromAcc.Balance)-numericToFloat(reserved) < req.Amount {
+			return errInsufficientFunds
 		}
 
-		// deduct from source
-		_, err = q.UpdateAccountBalance(ctx, generated.UpdateAccountBalanceParams{
-			ID:      fromId,
-			Balance: floatToNumeric(-req.Amount),
-		})
+		fromCash, err := q.GetCashLedgerAccount(ctx, &fromId)
 		if err != nil {
-			return err
+			return fmt.Errorf("get cash ledger account of from_account: %w", err)
+		}
+		toCash, err := q.GetCashLedgerAccount(ctx, &toId)
+		if err != nil {
+			return fmt.Errorf("get cash ledger account of to_account: %w", err)
 		}
 
-		// add to destination
-		_, err = q.UpdateAccountBalance(ctx, generated.UpdateAccountBalanceParams{
-			ID:      toId,
-			Balance: floatToNumeric(req.Amount),
+		// one entry moves the money out of the source and into the destination
+		return post(ctx, q, journalEntry{
+			entryType:   generated.JournalEntryTypeTransfer,
+			description: fmt.Sprintf("Transfer from %s to %s", fromAcc.AccountNumber, toAcc.AccountNumber),
+			postings: []posting{
+				{account: fromCash, amount: -req.Amount, memo: fmt.Sprintf("Transfer to %s", toAcc.AccountNumber)},
+				{account: toCash, amount: req.Amount, memo: fmt.Sprintf("Transfer from %s", fromAcc.AccountNumber)},
+			},
 		})
+	})
+
+	if err != nil {
+		if errors.Is(err, errInsufficientFunds) {
+			return &portfoliopb.TransferResponse{
+				Code: basepb.ErrorCode_FAILED_PRECONDITION,
+			}, nil
+		}
+		return &portfoliopb.TransferResponse{
+			Code: basepb.ErrorCode_INTERNAL,
+		}, err
+	}
+
+	return &portfoliopb.TransferResponse{
+		Code: basepb.ErrorCode_OK,
+	}, nil
+}
+
+func (h *PortfolioHandler) ReserveHold(ctx context.Context, req *portfoliopb.ReserveHoldRequest) (*portfoliopb.ReserveHoldResponse, error) {
+	orderId, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return &portfoliopb.ReserveHoldResponse{
+			Code: basepb.ErrorCode_INVALID_ARGUMENT,
+		}, err
+	}
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &portfoliopb.ReserveHoldResponse{
+			Code: basepb.ErrorCode_INVALID_ARGUMENT,
+		}, err
+	}
+
+	if req.Symbol == "" || req.Quantity <= 0 {
+		return &portfoliopb.ReserveHoldResponse{
+			Code: basepb.ErrorCode_INVALID_ARGUMENT,
+		}, errors.New("symbol and a positive quantity are required")
+	}
+	switch req.Kind {
+	case portfoliopb.HoldKind_HOLD_KIND_CASH:
+		if req.Amount <= 0 {
+			return &portfoliopb.ReserveHoldResponse{
+				Code: basepb.ErrorCode_INVALID_ARGUMENT,
+			}, errors.New("cash holds need a positive amount")
+		}
+	case portfoliopb.HoldKind_HOLD_KIND_SHARES:
+	default:
+		return &portfoliopb.ReserveHoldResponse{
+			Code: basepb.ErrorCode_INVALID_ARGUMENT,
+		}, errors.New("hold kind is unspecified")
+	}
+
+	var groupId *uuid.UUID
+	if req.GroupId != "" {
+		parsed, err := uuid.Parse(req.GroupId)
 		if err != nil {
-			return err
+			return &portfoliopb.ReserveHoldResponse{
+				Code: basepb.ErrorCode_INVALID_ARGUMENT,
+			}, errors.New("invalid group ID")
 		}
+		groupId = &parsed
+	}
 
-		// insert audit logs
-		// outgoing
-		_, err = q.InsertAuditLog(ctx, generated.InsertAuditLogParams{
-			AccountID:       fromId,
-			TransactionType: generated.TransactionTypeTransferOut,
-			Amount:          floatToNumeric(req.Amount), // positive amount
-			Description:     fmt.Sprintf("Transfer to %s", toAcc.AccountNumber),
-			ReferenceID:     &toId, // referencing other account ID
-		})
+	var hold generated.Hold
+
+	err = h.db.ExecMultiTx(ctx, func(q *generated.Queries) error {
+		accounts, err := q.GetAccountByUserId(ctx, userId)
 		if err != nil {
-			return err
+			return fmt.Errorf("failed to get accounts: %w", err)
+		}
+		investmentAcc := findInvestmentAccount(accounts)
+		if investmentAcc == nil {
+			return errNoInvestmentAccount
+		}
+
+		// convert before taking the lock, the rate may have to be fetched
+		amount := req.Amount
+		if req.Kind == portfoliopb.HoldKind_HOLD_KIND_CASH && req.Currency != "" && req.Currency != string(investmentAcc.Currency) {
+			rate, err := h.fx.Rate(ctx, req.Currency, string(investmentAcc.Currency))
+			if err != nil {
+				return fmt.Errorf("failed to get %s/%s exchange rate: %w", req.Currency, investmentAcc.Currency, err)
+			}
+			amount *= rate
+		}
+
+		// lock the account first, so concurrent holds see each other's reservations
+		account, err := q.LockAccount(ctx, investmentAcc.ID)
+		if err != nil {
+			return fmt.Errorf("failed to lock account: %w", err)
+		}
+
+		// a redelivered order keeps the hold it got the first time
+		hold, err = q.GetHoldByOrderId(ctx, orderId)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("failed to get hold: %w", err)
+		}
+
+		// another leg of the group may already reserve some of what this one needs
+		neededAmount, neededQuantity := amount, req.Quantity
+		if groupId != nil {
+			group, err := q.GetGroupReservation(ctx, generated.GetGroupReservationParams{
+				GroupID: groupId,
+				Kind:    convertHoldKindToDB(req.Kind),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get group reservation: %w", err)
+			}
+			neededAmount -= numericToFloat(group.ReservedAmount)
+			neededQuantity -= numericToFloat(group.ReservedQuantity)
+		}
+
+		if req.Kind == portfoliopb.HoldKind_HOLD_KIND_CASH {
+			reserved, err := q.GetReservedBalance(ctx, account.ID)
+			if err != nil {
+				return fmt.Errorf("failed to get reserved balance: %w", err)
+			}
+			if numericToFloat(account.Balance)-numericToFloat(reserved) < neededAmount {
+				return errInsufficientBuyingPower
+			}
+		} else {
+			var owned float64
+			holding, err := q.GetHoldingByAccountIdAndSymbol(ctx, generated.GetHoldingByAccountIdAndSymbolParams{
+				AccountID: account.ID,
+				Symbol:    req.Symbol,
+			})
+			if err == nil {
+				owned = numericToFloat(holding.Quantity)
+			} else if !errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("failed to get holding: %w", err)
+			}
+
+			reserved, err := q.GetReservedQuantity(ctx, generated.GetReservedQuantityParams{
+				AccountID: account.ID,
+				Symbol:    req.Symbol,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get reserved quantity: %w", err)
+			}
+			if owned-numericToFloat(reserved) < neededQuantity {
+				return errInsufficientShares
+			}
 		}
 
-		// incoming
-		_, err = q.InsertAuditLog(ctx, generated.InsertAuditLogParams{
-			AccountID:       toId,
-			TransactionType: generated.TransactionTypeTransferIn,
-			Amount:          floatToNumeric(req.Amount), // positive amount
-			Description:     fmt.Sprintf("Transfer from %s", fromAcc.AccountNumber),
-			ReferenceID:     &fromId, // referencing other account ID
+		hold, err = q.InsertHold(ctx, generated.InsertHoldParams{
+			OrderID:   orderId,
+			AccountID: account.ID,
+			Kind:      convertHoldKindToDB(req.Kind),
+			Symbol:    req.Symbol,
+			Quantity:  floatToNumeric(req.Quantity),
+			Amount:    floatToNumeric(amount),
+			GroupID:   groupId,
 		})
 		return err
 	})
 
 	if err != nil {
-		if err.Error() == "insufficient funds" {
-			return &portfoliopb.TransferResponse{
-				Code: basepb.ErrorCode_INTERNAL,
-			}, err
+		// refusals come back as codes, so callers can tell them apart from failures
+		switch {
+		case errors.Is(err, errInsufficientBuyingPower), errors.Is(err, errInsufficientShares):
+			return &portfoliopb.ReserveHoldResponse{
+				Code: basepb.ErrorCode_FAILED_PRECONDITION,
+			}, nil
+		case errors.Is(err, errNoInvestmentAccount):
+			return &portfoliopb.ReserveHoldResponse{
+				Code: basepb.ErrorCode_NOT_FOUND,
+			}, nil
 		}
-		return &portfoliopb.TransferResponse{
+
+		h.logger.Error(ctx, "Failed to reserve hold", "order_id", req.OrderId, "error", err)
+		return &portfoliopb.ReserveHoldResponse{
 			Code: basepb.ErrorCode_INTERNAL,
 		}, err
 	}
 
-	return &portfoliopb.TransferResponse{
+	return &portfoliopb.ReserveHoldResponse{
+		Code: basepb.ErrorCode_OK,
+		Hold: convertHoldToProto(hold),
+	}, nil
+}
+
+func (h *PortfolioHandler) ReleaseHold(ctx context.Context, req *portfoliopb.ReleaseHoldRequest) (*portfoliopb.ReleaseHoldResponse, error) {
+	orderId, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return &portfoliopb.ReleaseHoldResponse{
+			Code: basepb.ErrorCode_INVALID_ARGUMENT,
+		}, err
+	}
+
+	hold, err := h.db.GetQueries().ReleaseHold(ctx, orderId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// releasing twice is fine, the hold just reports its final state
+		hold, err = h.db.GetQueries().GetHoldByOrderId(ctx, orderId)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &portfoliopb.ReleaseHoldResponse{
+				Code: basepb.ErrorCode_NOT_FOUND,
+			}, nil
+		}
+	}
+	if err != nil {
+		return &portfoliopb.ReleaseHoldResponse{
+			Code: basepb.ErrorCode_INTERNAL,
+		}, err
+	}
+
+	return &portfoliopb.ReleaseHoldResponse{
 		Code: basepb.ErrorCode_OK,
+		Hold: convertHoldToProto(hold),
 	}, nil
 }
+
+func (h *PortfolioHandler) GetHold(ctx context.Context, req *portfoliopb.GetHoldRequest) (*portfoliopb.GetHoldResponse, error) {
+	orderId, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return &portfoliopb.GetHoldResponse{
+			Code: basepb.ErrorCode_INVALID_ARGUMENT,
+		}, err
+	}
+
+	hold, err := h.db.GetQueries().GetHoldByOrderId(ctx, orderId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &portfoliopb.GetHoldResponse{
+			Code: basepb.ErrorCode_NOT_FOUND,
+		}, nil
+	}
+	if err != nil {
+		return &portfoliopb.GetHoldResponse{
+			Code: basepb.ErrorCode_INTERNAL,
+		}, err
+	}
+
+	return &portfoliopb.GetHoldResponse{
+		Code: basepb.ErrorCode_OK,
+		Hold: convertHoldToProto(hold),
+	}, nil
+}
+
+// ResizeHold moves what the hold of an amended order still reserves by the requested changes, on top of whatever
+// fills consumed meanwhile. Growing it is checked against the account's buying power or available shares the same
+// way a new reservation is.
+func (h *PortfolioHandler) ResizeHold(ctx context.Context, req *portfoliopb.ResizeHoldRequest) (*portfoliopb.ResizeHoldResponse, error) {
+	orderId, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return &portfoliopb.ResizeHoldResponse{
+			Code: basepb.ErrorCode_INVALID_ARGUMENT,
+		}, err
+	}
+	if math.IsNaN(req.QuantityChange) || math.IsInf(req.QuantityChange, 0) || math.IsNaN(req.AmountChange) || math.IsInf(req.AmountChange, 0) {
+		return &portfoliopb.ResizeHoldResponse{
+			Code: basepb.ErrorCode_INVALID_ARGUMENT,
+		}, errors.New("quantity and amount changes must be finite")
+	}
+
+	var hold generated.Hold
+
+	err = h.db.ExecMultiTx(ctx, func(q *generated.Queries) error {
+		current, err := q.GetHoldByOrderId(ctx, orderId)
+		if err != nil {
+			return err
+		}
+
+		// lock the account before reading the hold again, so the check sees every other reservation
+		account, err := q.LockAccount(ctx, current.AccountID)
+		if err != nil {
+			return fmt.Errorf("failed to lock account: %w", err)
+		}
+		current, err = q.GetHoldByOrderId(ctx, orderId)
+		if err != nil {
+			return err
+		}
+		if current.Status != generated.HoldStatusActive {
+			return pgx.ErrNoRows
+		}
+
+		// what fills consumed since the order was read is gone, so a shrink cannot take the hold below zero
+		if numericToFloat(current.RemainingQuantity)+req.QuantityChange < 0 || numericToFloat(current.RemainingAmount)+req.AmountChange < 0 {
+			return errHoldExhausted
+		}
+
+		if current.Kind == generated.HoldKindCash {
+			if growth := req.AmountChange; growth > 0 {
+				reserved, err := q.GetReservedBalance(ctx, account.ID)
+				if err != nil {
+					return fmt.Errorf("failed to get reserved balance: %w", err)
+				}
+				if numericToFloat(account.Balance)-numericToFloat(reserved) < growth {
+					return errInsufficientBuyingPower
+				}
+			}
+		} else if growth := req.QuantityChange; growth > 0 {
+			var owned float64
+			holding, err := q.GetHoldingByAccountIdAndSymbol(ctx, generated.GetHoldingByAccountIdAndSymbolParams{
+				AccountID: account.ID,
+				Symbol:    current.Symbol,
+			})
+			if err == nil {
+				owned = numericToFloat(holding.Quantity)
+			} else if !errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("failed to get holding: %w", err)
+			}
+
+			reserved, err := q.GetReservedQuantity(ctx, generated.GetReservedQuantityParams{
+				AccountID: account.ID,
+				Symbol:    current.Symbol,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get reserved quantity: %w", err)
+			}
+			if owned-numericToFloat(reserved) < growth {
+				return errInsufficientShares
+			}
+		}
+
+		hold, err = q.ResizeHold(ctx, generated.ResizeHoldParams{
+			QuantityChange: floatToNumeric(req.QuantityChange),
+			AmountChange:   floatToNumeric(req.AmountChange),
+			OrderID:        orderId,
+		})
+		return err
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, errInsufficientBuyingPower), errors.Is(err, errInsufficientShares), errors.Is(err, errHoldExhausted):
+			return &portfoliopb.ResizeHoldResponse{
+				Code: basepb.ErrorCode_FAILED_PRECONDITION,
+			}, nil
+		case errors.Is(err, pgx.ErrNoRows):
+			return &portfoliopb.ResizeHoldResponse{
+				Code: basepb.ErrorCode_NOT_FOUND,
+			}, nil
+		}
+
+		h.logger.Error(ctx, "Failed to resize hold", "order_id", req.OrderId, "error", err)
+		return &portfoliopb.ResizeHoldResponse{
+			Code: basepb.ErrorCode_INTERNAL,
+		}, err
+	}
+
+	return &portfoliopb.ResizeHoldResponse{
+		Code: basepb.ErrorCode_OK,
+		Hold: convertHoldToProto(hold),
+	}, nil
+}
+
+// GetRiskExposure reports the figures pre-trade risk checks are evaluated against for the account orders settle in
+func (h *PortfolioHandler) GetRiskExposure(ctx context.Context, req *portfoliopb.GetRiskExposureRequest) (*portfoliopb.GetRiskExposureResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &portfoliopb.GetRiskExposureResponse{
+			Code: basepb.ErrorCode_INVALID_ARGUMENT,
+		}, err
+	}
+
+	accounts, err := h.db.GetQueries().GetAccountByUserId(ctx, userId)
+	if err != nil {
+		return &portfoliopb.GetRiskExposureResponse{
+			Code: basepb.ErrorCode_INTERNAL,
+		}, err
+	}
+	account := findInvestmentAccount(accounts)
+	if account == nil {
+		return &portfoliopb.GetRiskExposureResponse{
+			Code: basepb.ErrorCode_NOT_FOUND,
+		}, nil
+	}
+
+	holdings, err := h.db.GetQueries().GetHoldingsByAccountId(ctx, account.ID)
+	if err != nil {
+		return &portfoliopb.GetRiskExposureResponse{Code: basepb.ErrorCode_INTERNAL}, err
+	}
+
+	// positions are weighed against what the account is worth now, not what it paid
+	symbol := strings.ToUpper(strings.TrimSpace(req.Symbol))
+	var held []generated.Holding
+	var symbols []string
+	var position float64
+	for _, holding := range holdings {
+		quantity := numericToFloat(holding.Quantity)
+		if quantity <= 0 {
+			continue
+		}
+		held = append(held, holding)
+		symbols = append(symbols, holding.Symbol)
+		if holding.Symbol == symbol {
+			position = quantity
+		}
+	}
+	valuation := valueAccount(ctx, *account, held, h.latestQuotes(ctx, symbols), &exchangeRates{handler: h, rates: make(map[string]float64)})
+
+	realizedPnl, err := h.db.GetQueries().GetRealizedPnlSince(ctx, generated.GetRealizedPnlSinceParams{
+		AccountID: account.ID,
+		CreatedAt: pgtype.Timestamptz{Time: tradingDayStart(time.Now()), Valid: true},
+	})
+	if err != nil {
+		return &portfoliopb.GetRiskExposureResponse{Code: basepb.ErrorCode_INTERNAL}, err
+	}
+
+	excluded := make([]uuid.UUID, 0, len(req.ExcludedOrderIds))
+	for _, id := range req.ExcludedOrderIds {
+		orderId, err := uuid.Parse(id)
+		if err != nil {
+			return &portfoliopb.GetRiskExposureResponse{Code: basepb.ErrorCode_INVALID_ARGUMENT}, err
+		}
+		excluded = append(excluded, orderId)
+	}
+
+	openOrders, err := h.db.GetQueries().CountActiveHolds(ctx, generated.CountActiveHoldsParams{
+		AccountID:        account.ID,
+		ExcludedOrderIds: excluded,
+	})
+	if err != nil {
+		return &portfoliopb.GetRiskExposureResponse{Code: basepb.ErrorCode_INTERNAL}, err
+	}
+
+	return &portfoliopb.GetRiskExposureResponse{
+		Code: basepb.ErrorCode_OK,
+		Exposure: &portfoliopb.RiskExposure{
+			AccountId:        account.ID.String(),
+			Currency:         convertCurrencyTypeToProto(account.Currency),
+			AccountValue:     valuation.TotalEquity,
+			PositionQuantity: position,
+			RealizedPnlToday: numericToFloat(realizedPnl),
+			OpenOrders:       openOrders,
+		},
+	}, nil
+}
+
+// SetLotMethod changes how the account's future sells pick the tax lots they close
+func (h *PortfolioHandler) SetLotMethod(ctx context.Context, req *portfoliopb.SetLotMethodRequest) (*portfoliopb.SetLotMethodResponse, error) {
+	accountId, err := uuid.Parse(req.AccountId)
+	if err != nil {
+		return &portfoliopb.SetLotMethodResponse{
+			Code: basepb.ErrorCode_INVALID_ARGUMENT,
+		}, err
+	}
+	if req.Method == portfoliopb.LotMethod_LOT_METHOD_UNSPECIFIED {
+		return &portfoliopb.SetLotMethodResponse{
+			Code: basepb.ErrorCode_INVALID_ARGUMENT,
+		}, errors.New("lot method is unspecified")
+	}
+
+	account, err := h.db.GetQueries().SetAccountLotMethod(ctx, generated.SetAccountLotMethodParams{
+		ID:        accountId,
+		LotMethod: convertLotMethodToDB(req.Method),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &portfoliopb.SetLotMethodResponse{
+				Code: basepb.ErrorCode_NOT_FOUND,
+			}, nil
+		}
+		return &portfoliopb.SetLotMethodResponse{
+			Code: basepb.ErrorCode_INTERNAL,
+		}, err
+	}
+
+	return &portfoliopb.SetLotMethodResponse{
+		Code:    basepb.ErrorCode_OK,
+		Account: convertAccountToProto(account),
+	}, nil
+}
+
+// SelectTaxLots records which open lots of the account a sell order closes, replacing an earlier selection
+func (h *PortfolioHandler) SelectTaxLots(ctx context.Context, req *portfoliopb.SelectTaxLotsRequest) (*portfoliopb.SelectTaxLotsResponse, error) {
+	accountId, err := uuid.Parse(req.AccountId)
+	if err != nil {
+		return &portfoliopb.SelectTaxLotsResponse{
+			Code: basepb.ErrorCode_INVALID_ARGUMENT,
+		}, err
+	}
+	orderId, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return &portfoliopb.SelectTaxLotsResponse{
+			Code: basepb.ErrorCode_INVALID_ARGUMENT,
+		}, err
+	}
+	lotIds := make([]uuid.UUID, len(req.LotIds))
+	for i, id := range req.LotIds {
+		if lotIds[i], err = uuid.Parse(id); err != nil {
+			return &portfoliopb.SelectTaxLotsResponse{
+				Code: basepb.ErrorCode_INVALID_ARGUMENT,
+			}, err
+		}
+	}
+
+	account, err := h.db.GetQueries().GetAccountById(ctx, accountId)
+	if err != nil {
+		return &portfoliopb.SelectTaxLotsResponse{
+			Code: basepb.ErrorCode_NOT_FOUND,
+		}, nil
+	}
+	// other methods never look at a selection, so it would be silently ignored
+	if account.LotMethod != generated.LotMethodSpecific {
+		return &portfoliopb.SelectTaxLotsResponse{
+			Code: basepb.ErrorCode_FAILED_PRECONDITION,
+		}, nil
+	}
+
+	err = h.db.ExecMultiTx(ctx, func(q *generated.Queries) error {
+		if err := q.DeleteLotSelections(ctx, orderId); err != nil {
+			return err
+		}
+
+		for i, lotId := range lotIds {
+			lot, err := q.GetTaxLotById(ctx, lotId)
+			if err != nil {
+				return err
+			}
+			if lot.AccountID != accountId || numericToFloat(lot.RemainingQuantity) <= 0 {
+				return pgx.ErrNoRows
+			}
+
+			if err := q.InsertLotSelection(ctx, generated.InsertLotSelectionParams{
+				OrderID:  orderId,
+				LotID:    lotId,
+				Position: int32(i),
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// a lot that is not an open lot of this account
+			return &portfoliopb.SelectTaxLotsResponse{
+				Code: basepb.ErrorCode_NOT_FOUND,
+			}, nil
+		}
+
+		h.logger.Error(ctx, "Failed to select tax lots", "order_id", req.OrderId, "error", err)
+		return &portfoliopb.SelectTaxLotsResponse{
+			Code: basepb.ErrorCode_INTERNAL,
+		}, err
+	}
+
+	return &portfoliopb.SelectTaxLotsResponse{
+		Code: basepb.ErrorCode_OK,
+	}, nil
+}
+
+func (h *PortfolioHandler) GetTaxLots(ctx context.Context, req *portfoliopb.GetTaxLotsRequest) (*portfoliopb.GetTaxLotsResponse, error) {
+	accountId, err := uuid.Parse(req.AccountId)
+	if err != nil {
+		return &portfoliopb.GetTaxLotsResponse{
+			Code: basepb.ErrorCode_INVALID_ARGUMENT,
+		}, err
+	}
+
+	if _, err := h.db.GetQueries().GetAccountById(ctx, accountId); err != nil {
+		return &portfoliopb.GetTaxLotsResponse{
+			Code: basepb.ErrorCode_NOT_FOUND,
+		}, nil
+	}
+
+	lots, err := h.db.GetQueries().ListTaxLots(ctx, generated.ListTaxLotsParams{
+		AccountID:     accountId,
+		Symbol:        strings.ToUpper(strings.TrimSpace(req.Symbol)),
+		IncludeClosed: req.IncludeClosed,
+	})
+	if err != nil {
+		return &portfoliopb.GetTaxLotsResponse{
+			Code: basepb.ErrorCode_INTERNAL,
+		}, err
+	}
+
+	protoLots := make([]*portfoliopb.TaxLot, len(lots))
+	for i, lot := range lots {
+		protoLots[i] = convertTaxLotToProto(lot)
+	}
+
+	return &portfoliopb.GetTaxLotsResponse{
+		Code: basepb.ErrorCode_OK,
+		Lots: protoLots,
+	}, nil
+}
+
+// GetRealizedGains lists the lots closed by sells settled in a period, with their totals per symbol
+func (h *PortfolioHandler) GetRealizedGains(ctx context.Context, req *portfoliopb.GetRealizedGainsRequest) (*portfoliopb.GetRealizedGainsResponse, error) {
+	accountId, err := uuid.Parse(req.AccountId)
+	if err != nil {
+		return &portfoliopb.GetRealizedGainsResponse{
+			Code: basepb.ErrorCode_INVALID_ARGUMENT,
+		}, err
+	}
+
+	if _, err := h.db.GetQueries().GetAccountById(ctx, accountId); err != nil {
+		return &portfoliopb.GetRealizedGainsResponse{
+			Code: basepb.ErrorCode_NOT_FOUND,
+		}, nil
+	}
+
+	params := generated.ListRealizedGainsParams{
+		AccountID: accountId,
+		Symbol:    strings.ToUpper(strings.TrimSpace(req.Symbol)),
+	}
+	if req.From != nil {
+		params.RealizedFrom = pgtype.Timestamptz{Time: req.From.AsTime(), Valid: true}
+	}
+	if req.To != nil {
+		params.RealizedTo = pgtype.Timestamptz{Time: req.To.AsTime(), Valid: true}
+	}
+
+	gains, err := h.db.GetQueries().ListRealizedGains(ctx, params)
+	if err != nil {
+		return &portfoliopb.GetRealizedGainsResponse{
+			Code: basepb.ErrorCode_INTERNAL,
+		}, err
+	}
+
+	resp := &portfoliopb.GetRealizedGainsResponse{
+		Code:  basepb.ErrorCode_OK,
+		Gains: make([]*portfoliopb.RealizedGain, len(gains)),
+	}
+	bySymbol := make(map[string]*portfoliopb.SymbolRealizedGain)
+	for i, gain := range gains {
+		protoGain := convertRealizedGainToProto(gain)
+		resp.Gains[i] = protoGain
+
+		total, ok := bySymbol[gain.Symbol]
+		if !ok {
+			total = &portfoliopb.SymbolRealizedGain{Symbol: gain.Symbol}
+			bySymbol[gain.Symbol] = total
+			resp.BySymbol = append(resp.BySymbol, total)
+		}
+		total.Quantity += protoGain.Quantity
+		total.Proceeds += protoGain.Proceeds
+		total.CostBasis += protoGain.CostBasis
+		total.RealizedPnl += protoGain.RealizedPnl
+		resp.TotalRealizedPnl += protoGain.RealizedPnl
+	}
+
+	return resp, nil
+}
+
+// GetPortfolioValuation marks the holdings of every account of the user to their latest quotes, converted into
+// the account's currency. Holdings without a quote or exchange rate are valued at cost and reported unpriced.
+func (h *PortfolioHandler) GetPortfolioValuation(ctx context.Context, req *portfoliopb.GetPortfolioValuationRequest) (*portfoliopb.GetPortfolioValuationResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &portfoliopb.GetPortfolioValuationResponse{
+			Code: basepb.ErrorCode_INVALID_ARGUMENT,
+		}, err
+	}
+
+	accounts, err := h.db.GetQueries().GetAccountByUserId(ctx, userId)
+	if err != nil {
+		return &portfoliopb.GetPortfolioValuationResponse{
+			Code: basepb.ErrorCode_INTERNAL,
+		}, err
+	}
+
+	// quote every symbol once, however many accounts hold it
+	held := make([][]generated.Holding, len(accounts))
+	var symbols []string
+	for i, account := range accounts {
+		holdings, err := h.db.GetQueries().GetHoldingsByAccountId(ctx, account.ID)
+		if err != nil {
+			return &portfoliopb.GetPortfolioValuationResponse{
+				Code: basepb.ErrorCode_INTERNAL,
+			}, err
+		}
+
+		for _, holding := range holdings {
+			if numericToFloat(holding.Quantity) <= 0 {
+				continue
+			}
+			held[i] = append(held[i], holding)
+			if !slices.Contains(symbols, holding.Symbol) {
+				symbols = append(symbols, holding.Symbol)
+			}
+		}
+	}
+
+	quotes := h.latestQuotes(ctx, symbols)
+	rates := &exchangeRates{handler: h, rates: make(map[string]float64)}
+
+	resp := &portfoliopb.GetPortfolioValuationResponse{
+		Code:     basepb.ErrorCode_OK,
+		ValuedAt: timestamppb.Now(),
+	}
+	for i, account := range accounts {
+		resp.Accounts = append(resp.Accounts, valueAccount(ctx, account, held[i], quotes, rates))
+	}
+
+	return resp, nil
+}
+
+// GetPortfolioPerformance measures every account of the user over period from its daily snapshots, as the
+// time-weighted and money-weighted return of the period
+func (h *PortfolioHandler) GetPortfolioPerformance(ctx context.Context, req *portfoliopb.GetPortfolioPerformanceRequest) (*portfoliopb.GetPortfolioPerformanceResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &portfoliopb.GetPortfolioPerformanceResponse{
+			Code: basepb.ErrorCode_INVALID_ARGUMENT,
+		}, err
+	}
+
+	since, ok := periodStart(req.Period, time.Now().In(marketLocation()))
+	if !ok {
+		return &portfoliopb.GetPortfolioPerformanceResponse{
+			Code: basepb.ErrorCode_INVALID_ARGUMENT,
+		}, fmt.Errorf("invalid period %q", req.Period)
+	}
+	sinceDate := pgtype.Date{Time: time.Date(since.Year(), since.Month(), since.Day(), 0, 0, 0, 0, time.UTC), Valid: true}
+
+	accounts, err := h.db.GetQueries().GetAccountByUserId(ctx, userId)
+	if err != nil {
+		return &portfoliopb.GetPortfolioPerformanceResponse{
+			Code: basepb.ErrorCode_INTERNAL,
+		}, err
+	}
+
+	resp := &portfoliopb.GetPortfolioPerformanceResponse{
+		Code: basepb.ErrorCode_OK,
+	}
+	for _, account := range accounts {
+		snapshots, err := h.db.GetQueries().ListSnapshotsSince(ctx, generated.ListSnapshotsSinceParams{
+			AccountID: account.ID,
+			Since:     sinceDate,
+		})
+		if err != nil {
+			return &portfoliopb.GetPortfolioPerformanceResponse{
+				Code: basepb.ErrorCode_INTERNAL,
+			}, err
+		}
+		if len(snapshots) == 0 {
+			continue
+		}
+		resp.Accounts = append(resp.Accounts, accountPerformance(account, snapshots))
+	}
+
+	return resp, nil
+}
+
+// findInvestmentAccount picks the account orders settle against;
+// if a user has multiple, we just take the first one for now
+func findInvestmentAccount(accounts []generated.Account) *generated.Account {
+	for i := range accounts {
+		if accounts[i].AccountType == generated.AccountTypeInvestment {
+			return &accounts[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"fafnir/portfolio-service/internal/db/generated"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	errUnbalancedEntry = errors.New("journal entry does not balance")
+	errBalanceDrift    = errors.New("account balance does not match its ledger")
+)
+
+// journalEntry moves money between ledger accounts; its postings are debits (positive) and credits (negative)
+// that sum to zero
+type journalEntry struct {
+	entryType   generated.JournalEntryType
+	description string
+	referenceID *uuid.UUID
+	realizedPnl pgtype.Numeric
+	postings    []posting
+}
+
+type posting struct {
+	account generated.LedgerAccount
+	amount  float64
+	// what the account sees in place of the entry's description, if set
+	memo string
+}
+
+// post records entry and moves the balance of each portfolio account whose cash it posts to, so a balance only
+// ever changes along with its ledger. A balance that moves by anything but its posting fails the entry, and the
+// database rejects an unbalanced entry at commit as well.
+func post(ctx context.Context, q *generated.Queries, entry journalEntry) error {
+	// amounts are stored to six decimals, so that is what has to balance
+	var total int64
+	for _, p := range entry.postings {
+		total += int64(math.Round(p.amount * 1e6))
+	}
+	if total != 0 || len(entry.postings) < 2 {
+		return fmt.Errorf("%w: %s", errUnbalancedEntry, entry.description)
+	}
+
+	recorded, err := q.InsertJournalEntry(ctx, generated.InsertJournalEntryParams{
+		EntryType:   entry.entryType,
+		Description: entry.description,
+		ReferenceID: entry.referenceID,
+		RealizedPnl: entry.realizedPnl,
+	})
+	if err != nil {
+		return fmt.Errorf("insert journal entry: %w", err)
+	}
+
+	for _, p := range entry.postings {
+		if err := q.InsertLedgerPosting(ctx, generated.InsertLedgerPostingParams{
+			EntryID:         recorded.ID,
+			LedgerAccountID: p.account.ID,
+			Amount:          floatToNumeric(p.amount),
+			Memo:            pgtype.Text{String: p.memo, Valid: p.memo != ""},
+		}); err != nil {
+			return fmt.Errorf("insert posting: %w", err)
+		}
+
+		if p.account.Kind == generated.LedgerAccountKindCash && p.account.AccountID != nil {
+			account, err := q.UpdateAccountBalance(ctx, generated.UpdateAccountBalanceParams{
+				ID:      *p.account.AccountID,
+				Balance: floatToNumeric(p.amount),
+			})
+			if err != nil {
+				return fmt.Errorf("update balance: %w", err)
+			}
+
+			// the balance was the sum of the ledger before, so it still is if the posting moved it by its amount;
+			// the whole history is reconciled by ledger verify
+			balance, previous := numericToFloat(account.Balance), numericToFloat(account.PreviousBalance)
+			if math.Round(balance*1e6) != math.Round(previous*1e6)+math.Round(p.amount*1e6) {
+				return fmt.Errorf("%w: account %s went from %.6f to %.6f posting %.6f", errBalanceDrift, account.AccountNumber, previous, balance, p.amount)
+			}
+		}
+	}
+
+	return nil
+}
+
+// postWithSystem posts amount to the account's cash against the system ledger account of kind in its currency,
+// into the account when amount is positive and out of it when negative
+func postWithSystem(ctx context.Context, q *generated.Queries, account generated.Account, kind generated.LedgerAccountKind, amount float64, entry journalEntry) error {
+	cash, err := q.GetCashLedgerAccount(ctx, &account.ID)
+	if err != nil {
+		return fmt.Errorf("get cash ledger account: %w", err)
+	}
+	system, err := q.GetSystemLedgerAccount(ctx, generated.GetSystemLedgerAccountParams{
+		Kind:     kind,
+		Currency: account.Currency,
+	})
+	if err != nil {
+		return fmt.Errorf("get %s ledger account: %w", kind, err)
+	}
+
+	entry.postings = []posting{
+		{account: cash, amount: amount},
+		{account: system, amount: -amount},
+	}
+	return post(ctx, q, entry)
+}
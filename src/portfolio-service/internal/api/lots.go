@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"fafnir/portfolio-service/internal/db/generated"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// below this a sell is considered fully matched against lots
+const lotQuantityTolerance = 1e-9
+
+// closeTaxLots closes the open lots a sell of quantity shares settles against, picked by the account's lot method,
+// and records the profit of each against proceeds (what the shares sold for after fees). avgCost is the holding's
+// average cost before the sell, used by the average cost method and for shares no lot covers. Returns the profit
+// of the whole sell.
+func closeTaxLots(ctx context.Context, q *generated.Queries, account *generated.Account, orderID uuid.UUID, symbol string, quantity, proceeds, avgCost float64) (float64, error) {
+	lots, err := q.ListOpenTaxLotsForUpdate(ctx, generated.ListOpenTaxLotsForUpdateParams{
+		AccountID: account.ID,
+		Symbol:    symbol,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("list open lots: %w", err)
+	}
+
+	switch account.LotMethod {
+	case generated.LotMethodLifo:
+		slices.Reverse(lots)
+	case generated.LotMethodSpecific:
+		selected, err := q.GetLotSelections(ctx, orderID)
+		if err != nil {
+			return 0, fmt.Errorf("get lot selections: %w", err)
+		}
+		lots = selectedLotsFirst(lots, selected)
+	}
+
+	record := func(lotID *uuid.UUID, openedAt pgtype.Timestamptz, closed, costPerShare float64) (float64, error) {
+		share := proceeds * closed / quantity
+		cost := costPerShare * closed
+		_, err := q.InsertRealizedGain(ctx, generated.InsertRealizedGainParams{
+			AccountID:   account.ID,
+			Symbol:      symbol,
+			LotID:       lotID,
+			OrderID:     orderID,
+			Quantity:    floatToNumeric(closed),
+			Proceeds:    floatToNumeric(share),
+			CostBasis:   floatToNumeric(cost),
+			RealizedPnl: floatToNumeric(share - cost),
+			Method:      account.LotMethod,
+			OpenedAt:    openedAt,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("record realized gain: %w", err)
+		}
+		return share - cost, nil
+	}
+
+	var realized float64
+	remaining := quantity
+	for _, lot := range lots {
+		if remaining <= lotQuantityTolerance {
+			break
+		}
+
+		closed := min(remaining, numericToFloat(lot.RemainingQuantity))
+		if _, err := q.CloseTaxLot(ctx, generated.CloseTaxLotParams{
+			ID:       lot.ID,
+			Quantity: floatToNumeric(closed),
+		}); err != nil {
+			return 0, fmt.Errorf("close lot %s: %w", lot.ID, err)
+		}
+
+		cost := numericToFloat(lot.CostBasis)
+		if account.LotMethod == generated.LotMethodAverageCost {
+			cost = avgCost
+		}
+		pnl, err := record(&lot.ID, lot.OpenedAt, closed, cost)
+		if err != nil {
+			return 0, err
+		}
+		realized += pnl
+		remaining -= closed
+	}
+
+	if remaining > lotQuantityTolerance {
+		pnl, err := record(nil, pgtype.Timestamptz{}, remaining, avgCost)
+		if err != nil {
+			return 0, err
+		}
+		realized += pnl
+	}
+
+	// under the average cost method a sell leaves the average alone; otherwise it is the cost of the lots left open
+	if account.LotMethod != generated.LotMethodAverageCost {
+		if err := q.SyncHoldingAvgCost(ctx, generated.SyncHoldingAvgCostParams{
+			AccountID: account.ID,
+			Symbol:    symbol,
+		}); err != nil {
+			return 0, fmt.Errorf("sync holding avg cost: %w", err)
+		}
+	}
+
+	return realized, nil
+}
+
+// selectedLotsFirst moves the lots picked for an order to the front, in the order they were picked;
+// the others follow oldest first
+func selectedLotsFirst(lots []generated.TaxLot, selected []uuid.UUID) []generated.TaxLot {
+	ordered := make([]generated.TaxLot, 0, len(lots))
+	for _, id := range selected {
+		if i := slices.IndexFunc(lots, func(l generated.TaxLot) bool { return l.ID == id }); i >= 0 {
+			ordered = append(ordered, lots[i])
+		}
+	}
+	for _, lot := range lots {
+		if !slices.Contains(selected, lot.ID) {
+			ordered = append(ordered, lot)
+		}
+	}
+	return ordered
+}
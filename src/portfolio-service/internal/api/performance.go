@@ -0,0 +1,133 @@
+package api
+
+import (
+	"math"
+	"time"
+
+	"fafnir/portfolio-service/internal/db/generated"
+	portfoliopb "fafnir/shared/pb/portfolio"
+)
+
+const (
+	// bounds of the period return the money-weighted return is searched between
+	mwrMinRate = -0.999999
+	mwrMaxRate = 1e6
+	// bisection steps, enough to pin the rate well below a basis point
+	mwrIterations = 200
+)
+
+// periodStart is when period began before now, for the periods GetStockHistoricalData accepts
+func periodStart(period string, now time.Time) (time.Time, bool) {
+	switch period {
+	case "1D":
+		return now.AddDate(0, 0, -1), true
+	case "1W":
+		return now.AddDate(0, 0, -7), true
+	case "1M":
+		return now.AddDate(0, -1, 0), true
+	case "3M":
+		return now.AddDate(0, -3, 0), true
+	case "6M":
+		return now.AddDate(0, -6, 0), true
+	case "1Y":
+		return now.AddDate(-1, 0, 0), true
+	case "2Y":
+		return now.AddDate(-2, 0, 0), true
+	case "5Y":
+		return now.AddDate(-5, 0, 0), true
+	case "MAX":
+		return time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// accountPerformance measures an account over its snapshots, oldest first. The first snapshot is where the period
+// starts, so its flow is already part of the starting equity.
+func accountPerformance(account generated.Account, snapshots []generated.PortfolioSnapshot) *portfoliopb.AccountPerformance {
+	first, last := snapshots[0], snapshots[len(snapshots)-1]
+	performance := &portfoliopb.AccountPerformance{
+		AccountId:   account.ID.String(),
+		Currency:    convertCurrencyTypeToProto(account.Currency),
+		StartDate:   first.SnapshotDate.Time.Format(time.DateOnly),
+		EndDate:     last.SnapshotDate.Time.Format(time.DateOnly),
+		StartEquity: numericToFloat(first.TotalEquity),
+		EndEquity:   numericToFloat(last.TotalEquity),
+	}
+
+	growth := 1.0
+	for i, snapshot := range snapshots {
+		equity := numericToFloat(snapshot.TotalEquity)
+		point := &portfoliopb.PerformancePoint{
+			Date:        snapshot.SnapshotDate.Time.Format(time.DateOnly),
+			CashBalance: numericToFloat(snapshot.CashBalance),
+			MarketValue: numericToFloat(snapshot.MarketValue),
+			TotalEquity: equity,
+		}
+
+		if i > 0 {
+			point.NetFlow = numericToFloat(snapshot.NetFlow)
+			performance.NetFlows += point.NetFlow
+
+			// flows are counted at the end of the day, so they earn nothing until the next one; a day that
+			// started empty has no return
+			if previous := numericToFloat(snapshots[i-1].TotalEquity); previous > 0 {
+				growth *= (equity - point.NetFlow) / previous
+			}
+		}
+		point.CumulativeReturnPct = (growth - 1) * 100
+		performance.Points = append(performance.Points, point)
+	}
+
+	performance.Gain = performance.EndEquity - performance.StartEquity - performance.NetFlows
+	performance.TimeWeightedReturnPct = (growth - 1) * 100
+	performance.MoneyWeightedReturnPct = moneyWeightedReturn(snapshots) * 100
+	return performance
+}
+
+// moneyWeightedReturn is the return over the whole period that discounts the starting equity and the flows to
+// the ending equity, with the time of each as the share of the period elapsed. Zero when there is none.
+func moneyWeightedReturn(snapshots []generated.PortfolioSnapshot) float64 {
+	start := snapshots[0].SnapshotDate.Time
+	span := snapshots[len(snapshots)-1].SnapshotDate.Time.Sub(start)
+	if span <= 0 {
+		return 0
+	}
+
+	// what went in is negative and what came out positive, as seen by the investor
+	times := make([]float64, 0, len(snapshots)+1)
+	amounts := make([]float64, 0, len(snapshots)+1)
+	times = append(times, 0)
+	amounts = append(amounts, -numericToFloat(snapshots[0].TotalEquity))
+	for _, snapshot := range snapshots[1:] {
+		if flow := numericToFloat(snapshot.NetFlow); flow != 0 {
+			times = append(times, float64(snapshot.SnapshotDate.Time.Sub(start))/float64(span))
+			amounts = append(amounts, -flow)
+		}
+	}
+	times = append(times, 1)
+	amounts = append(amounts, numericToFloat(snapshots[len(snapshots)-1].TotalEquity))
+
+	presentValue := func(rate float64) float64 {
+		var total float64
+		for i, amount := range amounts {
+			total += amount / math.Pow(1+rate, times[i])
+		}
+		return total
+	}
+
+	// the ending equity weighs less the higher the rate, so the value falls as the rate rises
+	low, high := mwrMinRate, mwrMaxRate
+	if presentValue(low) < 0 || presentValue(high) > 0 {
+		return 0
+	}
+	for range mwrIterations {
+		mid := (low + high) / 2
+		if presentValue(mid) > 0 {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return (low + high) / 2
+}
@@ -0,0 +1,195 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"fafnir/portfolio-service/internal/db/generated"
+	basepb "fafnir/shared/pb/base"
+	portfoliopb "fafnir/shared/pb/portfolio"
+	stockpb "fafnir/shared/pb/stock"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// how often the job looks for a close it has not snapshotted yet
+	snapshotInterval = 5 * time.Minute
+	// the exchange whose close accounts are snapshotted after
+	snapshotExchange = "NYSE"
+	// how many days back the last trading day is looked for, past weekends and holidays
+	snapshotLookbackDays = 7
+)
+
+// RunSnapshots snapshots every account after each close of the exchange, until ctx is done. Each instance runs
+// it; an account gets one snapshot per trading day whichever instance takes it.
+func (h *PortfolioHandler) RunSnapshots(ctx context.Context) error {
+	ticker := time.NewTicker(snapshotInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := h.takeSnapshots(ctx, time.Now()); err != nil {
+			h.logger.Error(ctx, "Failed to take portfolio snapshots", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// takeSnapshots snapshots the accounts that have none for the last trading day that closed before now
+func (h *PortfolioHandler) takeSnapshots(ctx context.Context, now time.Time) error {
+	day, err := h.lastClose(ctx, now)
+	if err != nil {
+		return err
+	}
+	snapshotDate := pgtype.Date{Time: day, Valid: true}
+
+	accounts, err := h.db.GetQueries().ListAccountsWithoutSnapshot(ctx, snapshotDate)
+	if err != nil {
+		return fmt.Errorf("list accounts to snapshot: %w", err)
+	}
+	if len(accounts) == 0 {
+		return nil
+	}
+	// the balances were read now, so flows after now belong to the next snapshot
+	takenAt := time.Now()
+
+	held := make([][]generated.Holding, len(accounts))
+	var symbols []string
+	for i, account := range accounts {
+		holdings, err := h.db.GetQueries().GetHoldingsByAccountId(ctx, account.ID)
+		if err != nil {
+			return fmt.Errorf("get holdings of account %s: %w", account.ID, err)
+		}
+
+		for _, holding := range holdings {
+			if numericToFloat(holding.Quantity) <= 0 {
+				continue
+			}
+			held[i] = append(held[i], holding)
+			if !slices.Contains(symbols, holding.Symbol) {
+				symbols = append(symbols, holding.Symbol)
+			}
+		}
+	}
+
+	quotes := h.latestQuotes(ctx, symbols)
+	rates := &exchangeRates{handler: h, rates: make(map[string]float64)}
+
+	for i, account := range accounts {
+		valuation := valueAccount(ctx, account, held[i], quotes, rates)
+		if err := h.saveSnapshot(ctx, account, valuation, snapshotDate, takenAt); err != nil {
+			// the next run retries the accounts left without one
+			h.logger.Error(ctx, "Failed to snapshot account", "account_id", account.ID, "date", day.Format(time.DateOnly), "error", err)
+		}
+	}
+
+	h.logger.Info(ctx, "Took portfolio snapshots", "date", day.Format(time.DateOnly), "accounts", len(accounts))
+	return nil
+}
+
+// saveSnapshot stores the valuation of an account as its snapshot of the day, with the deposits and transfers
+// made since its previous snapshot
+func (h *PortfolioHandler) saveSnapshot(ctx context.Context, account generated.Account, valuation *portfoliopb.AccountValuation, snapshotDate pgtype.Date, takenAt time.Time) error {
+	return h.db.ExecMultiTx(ctx, func(q *generated.Queries) error {
+		// the first snapshot counts every flow since the account was opened
+		var after time.Time
+		previous, err := q.GetLatestSnapshot(ctx, account.ID)
+		if err == nil {
+			after = previous.TakenAt.Time
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("get previous snapshot: %w", err)
+		}
+
+		netFlow, err := q.GetNetFlowsBetween(ctx, generated.GetNetFlowsBetweenParams{
+			AccountID: account.ID,
+			After:     pgtype.Timestamptz{Time: after, Valid: true},
+			Until:     pgtype.Timestamptz{Time: takenAt, Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("get net flows: %w", err)
+		}
+
+		var unpriced int32
+		for _, holding := range valuation.Holdings {
+			if !holding.Priced {
+				unpriced++
+			}
+		}
+
+		snapshot, err := q.InsertPortfolioSnapshot(ctx, generated.InsertPortfolioSnapshotParams{
+			AccountID:        account.ID,
+			SnapshotDate:     snapshotDate,
+			Currency:         account.Currency,
+			CashBalance:      floatToNumeric(valuation.CashBalance),
+			MarketValue:      floatToNumeric(valuation.MarketValue),
+			CostBasis:        floatToNumeric(valuation.CostBasis),
+			TotalEquity:      floatToNumeric(valuation.TotalEquity),
+			NetFlow:          netFlow,
+			UnpricedHoldings: unpriced,
+			TakenAt:          pgtype.Timestamptz{Time: takenAt, Valid: true},
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			// another instance took it first
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("insert snapshot: %w", err)
+		}
+
+		for _, holding := range valuation.Holdings {
+			if err := q.InsertSnapshotHolding(ctx, generated.InsertSnapshotHoldingParams{
+				SnapshotID:   snapshot.ID,
+				Symbol:       holding.Symbol,
+				Quantity:     floatToNumeric(holding.Quantity),
+				AvgCost:      floatToNumeric(holding.AvgCost),
+				LastPrice:    floatToNumeric(holding.LastPrice),
+				ExchangeRate: floatToNumeric(holding.ExchangeRate),
+				MarketValue:  floatToNumeric(holding.MarketValue),
+				Priced:       holding.Priced,
+			}); err != nil {
+				return fmt.Errorf("insert snapshot holding %s: %w", holding.Symbol, err)
+			}
+		}
+		return nil
+	})
+}
+
+// lastClose is the most recent trading day of the exchange whose session closed before now, as midnight UTC of
+// its date in the exchange's timezone
+func (h *PortfolioHandler) lastClose(ctx context.Context, now time.Time) (time.Time, error) {
+	local := now.In(marketLocation())
+	for i := range snapshotLookbackDays {
+		day := local.AddDate(0, 0, -i)
+		// any trading day, early closes included, is in session at noon
+		noon := time.Date(day.Year(), day.Month(), day.Day(), 12, 0, 0, 0, local.Location())
+
+		resp, err := h.stock.GetMarketSession(ctx, &stockpb.GetMarketSessionRequest{
+			Exchange: snapshotExchange,
+			At:       timestamppb.New(noon),
+		})
+		if err != nil {
+			return time.Time{}, fmt.Errorf("get market session: %w", err)
+		}
+		if resp.GetCode() != basepb.ErrorCode_OK {
+			return time.Time{}, fmt.Errorf("get market session: stock service returned %s", resp.GetCode().String())
+		}
+
+		session := resp.GetData()
+		if session.GetPhase() != stockpb.MarketPhase_MARKET_PHASE_REGULAR || session.GetNextClose().AsTime().After(now) {
+			continue
+		}
+		return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC), nil
+	}
+
+	return time.Time{}, fmt.Errorf("no %s close in the last %d days", snapshotExchange, snapshotLookbackDays)
+}
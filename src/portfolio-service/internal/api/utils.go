@@ -3,6 +3,7 @@ package api
 import (
 	"fafnir/portfolio-service/internal/db/generated"
 	"fmt"
+	"time"
 
 	portfoliopb "fafnir/shared/pb/portfolio"
 
@@ -37,14 +38,16 @@ func convertCurrencyTypeToDB(t portfoliopb.CurrencyType) generated.CurrencyType
 func convertAccountToProto(a generated.Account) *portfoliopb.Account {
 	bal, _ := a.Balance.Float64Value()
 	return &portfoliopb.Account{
-		Id:            a.ID.String(),
-		UserId:        a.UserID.String(),
-		AccountNumber: a.AccountNumber,
-		Type:          convertAccountTypeFromDB(a.AccountType),
-		Currency:      convertCurrencyTypeFromDB(a.Currency),
-		Balance:       bal.Float64,
-		CreatedAt:     convertTime(a.CreatedAt),
-		UpdatedAt:     convertTime(a.UpdatedAt),
+		Id:               a.ID.String(),
+		UserId:           a.UserID.String(),
+		AccountNumber:    a.AccountNumber,
+		Type:             convertAccountTypeFromDB(a.AccountType),
+		Currency:         convertCurrencyTypeFromDB(a.Currency),
+		Balance:          bal.Float64,
+		CreatedAt:        convertTime(a.CreatedAt),
+		UpdatedAt:        convertTime(a.UpdatedAt),
+		AvailableBalance: bal.Float64, // callers subtract what active holds reserve
+		LotMethod:        convertLotMethodToProto(a.LotMethod),
 	}
 }
 
@@ -88,13 +91,137 @@ func convertHoldingToProto(h generated.Holding) *portfoliopb.Holding {
 	avg, _ := h.AvgCost.Float64Value()
 
 	return &portfoliopb.Holding{
-		Id:        h.ID.String(),
-		AccountId: h.AccountID.String(),
-		Symbol:    h.Symbol,
-		Quantity:  qty.Float64,
-		AvgCost:   avg.Float64,
-		CreatedAt: convertTime(h.CreatedAt),
-		UpdatedAt: convertTime(h.UpdatedAt),
+		Id:                h.ID.String(),
+		AccountId:         h.AccountID.String(),
+		Symbol:            h.Symbol,
+		Quantity:          qty.Float64,
+		AvgCost:           avg.Float64,
+		CreatedAt:         convertTime(h.CreatedAt),
+		UpdatedAt:         convertTime(h.UpdatedAt),
+		AvailableQuantity: qty.Float64, // callers subtract what active holds reserve
+	}
+}
+
+func convertLotMethodToDB(m portfoliopb.LotMethod) generated.LotMethod {
+	switch m {
+	case portfoliopb.LotMethod_LOT_METHOD_LIFO:
+		return generated.LotMethodLifo
+	case portfoliopb.LotMethod_LOT_METHOD_SPECIFIC:
+		return generated.LotMethodSpecific
+	case portfoliopb.LotMethod_LOT_METHOD_AVERAGE_COST:
+		return generated.LotMethodAverageCost
+	default:
+		return generated.LotMethodFifo
+	}
+}
+
+func convertLotMethodToProto(m generated.LotMethod) portfoliopb.LotMethod {
+	switch m {
+	case generated.LotMethodFifo:
+		return portfoliopb.LotMethod_LOT_METHOD_FIFO
+	case generated.LotMethodLifo:
+		return portfoliopb.LotMethod_LOT_METHOD_LIFO
+	case generated.LotMethodSpecific:
+		return portfoliopb.LotMethod_LOT_METHOD_SPECIFIC
+	case generated.LotMethodAverageCost:
+		return portfoliopb.LotMethod_LOT_METHOD_AVERAGE_COST
+	default:
+		return portfoliopb.LotMethod_LOT_METHOD_UNSPECIFIED
+	}
+}
+
+func convertTaxLotToProto(l generated.TaxLot) *portfoliopb.TaxLot {
+	var orderID string
+	if l.OrderID != nil {
+		orderID = l.OrderID.String()
+	}
+
+	return &portfoliopb.TaxLot{
+		Id:                l.ID.String(),
+		AccountId:         l.AccountID.String(),
+		Symbol:            l.Symbol,
+		OrderId:           orderID,
+		Quantity:          numericToFloat(l.Quantity),
+		RemainingQuantity: numericToFloat(l.RemainingQuantity),
+		CostBasis:         numericToFloat(l.CostBasis),
+		OpenedAt:          convertTime(l.OpenedAt),
+		ClosedAt:          convertTime(l.ClosedAt),
+	}
+}
+
+func convertRealizedGainToProto(g generated.RealizedGain) *portfoliopb.RealizedGain {
+	var lotID string
+	if g.LotID != nil {
+		lotID = g.LotID.String()
+	}
+
+	return &portfoliopb.RealizedGain{
+		Id:          g.ID.String(),
+		AccountId:   g.AccountID.String(),
+		Symbol:      g.Symbol,
+		LotId:       lotID,
+		OrderId:     g.OrderID.String(),
+		Quantity:    numericToFloat(g.Quantity),
+		Proceeds:    numericToFloat(g.Proceeds),
+		CostBasis:   numericToFloat(g.CostBasis),
+		RealizedPnl: numericToFloat(g.RealizedPnl),
+		Method:      convertLotMethodToProto(g.Method),
+		OpenedAt:    convertTime(g.OpenedAt),
+		RealizedAt:  convertTime(g.RealizedAt),
+	}
+}
+
+func convertHoldKindToDB(k portfoliopb.HoldKind) generated.HoldKind {
+	if k == portfoliopb.HoldKind_HOLD_KIND_SHARES {
+		return generated.HoldKindShares
+	}
+	return generated.HoldKindCash
+}
+
+func convertHoldKindToProto(k generated.HoldKind) portfoliopb.HoldKind {
+	switch k {
+	case generated.HoldKindCash:
+		return portfoliopb.HoldKind_HOLD_KIND_CASH
+	case generated.HoldKindShares:
+		return portfoliopb.HoldKind_HOLD_KIND_SHARES
+	default:
+		return portfoliopb.HoldKind_HOLD_KIND_UNSPECIFIED
+	}
+}
+
+func convertHoldStatusToProto(s generated.HoldStatus) portfoliopb.HoldStatus {
+	switch s {
+	case generated.HoldStatusActive:
+		return portfoliopb.HoldStatus_HOLD_STATUS_ACTIVE
+	case generated.HoldStatusSettled:
+		return portfoliopb.HoldStatus_HOLD_STATUS_SETTLED
+	case generated.HoldStatusReleased:
+		return portfoliopb.HoldStatus_HOLD_STATUS_RELEASED
+	default:
+		return portfoliopb.HoldStatus_HOLD_STATUS_UNSPECIFIED
+	}
+}
+
+func convertHoldToProto(h generated.Hold) *portfoliopb.Hold {
+	var groupID string
+	if h.GroupID != nil {
+		groupID = h.GroupID.String()
+	}
+
+	return &portfoliopb.Hold{
+		Id:                h.ID.String(),
+		OrderId:           h.OrderID.String(),
+		AccountId:         h.AccountID.String(),
+		Kind:              convertHoldKindToProto(h.Kind),
+		Symbol:            h.Symbol,
+		Quantity:          numericToFloat(h.Quantity),
+		RemainingQuantity: numericToFloat(h.RemainingQuantity),
+		Amount:            numericToFloat(h.Amount),
+		RemainingAmount:   numericToFloat(h.RemainingAmount),
+		Status:            convertHoldStatusToProto(h.Status),
+		CreatedAt:         convertTime(h.CreatedAt),
+		UpdatedAt:         convertTime(h.UpdatedAt),
+		GroupId:           groupID,
 	}
 }
 
@@ -110,6 +237,10 @@ func convertTransactionTypeToProto(t generated.TransactionType) portfoliopb.Tran
 		return portfoliopb.TransactionType_TRANSACTION_TYPE_BUY
 	case generated.TransactionTypeSell:
 		return portfoliopb.TransactionType_TRANSACTION_TYPE_SELL
+	case generated.TransactionTypeFee:
+		return portfoliopb.TransactionType_TRANSACTION_TYPE_FEE
+	case generated.TransactionTypeAdjustment:
+		return portfoliopb.TransactionType_TRANSACTION_TYPE_ADJUSTMENT
 	default:
 		return portfoliopb.TransactionType_TRANSACTION_TYPE_UNSPECIFIED
 	}
@@ -135,3 +266,18 @@ func convertTime(t pgtype.Timestamptz) *timestamppb.Timestamp {
 	}
 	return timestamppb.New(t.Time)
 }
+
+// marketLocation is New York, where the trading day is counted
+func marketLocation() *time.Location {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		location = time.FixedZone("EST", -5*60*60)
+	}
+	return location
+}
+
+// tradingDayStart is midnight in New York on the day of now, when the trading day's risk counters reset
+func tradingDayStart(now time.Time) time.Time {
+	local := now.In(marketLocation())
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, local.Location()).UTC()
+}
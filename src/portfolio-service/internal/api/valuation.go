@@ -0,0 +1,119 @@
+package api
+
+import (
+	"context"
+
+	"fafnir/portfolio-service/internal/db/generated"
+	basepb "fafnir/shared/pb/base"
+	portfoliopb "fafnir/shared/pb/portfolio"
+	stockpb "fafnir/shared/pb/stock"
+)
+
+// latestQuotes fetches the quotes of symbols in one batch, keyed by symbol. Symbols the stock service could not
+// quote are missing, and so is everything while it cannot be reached.
+func (h *PortfolioHandler) latestQuotes(ctx context.Context, symbols []string) map[string]*stockpb.StockQuote {
+	quotes := make(map[string]*stockpb.StockQuote, len(symbols))
+	if len(symbols) == 0 {
+		return quotes
+	}
+
+	resp, err := h.stock.GetStockQuoteBatch(ctx, &stockpb.GetStockQuoteBatchRequest{Symbols: symbols})
+	if err != nil || resp.GetCode() != basepb.ErrorCode_OK {
+		h.logger.Error(ctx, "Failed to fetch quotes for valuation", "symbols", len(symbols), "code", resp.GetCode().String(), "error", err)
+		return quotes
+	}
+
+	for _, quote := range resp.Data {
+		quotes[quote.Symbol] = quote
+	}
+	return quotes
+}
+
+// exchangeRates serves the rates of one valuation, asking the FX provider once per currency pair
+type exchangeRates struct {
+	handler *PortfolioHandler
+	rates   map[string]float64
+}
+
+// rate converts from into to; false when no provider had a rate
+func (r *exchangeRates) rate(ctx context.Context, from, to string) (float64, bool) {
+	if from == to {
+		return 1, true
+	}
+
+	pair := from + ":" + to
+	if rate, ok := r.rates[pair]; ok {
+		return rate, rate > 0
+	}
+
+	rate, err := r.handler.fx.Rate(ctx, from, to)
+	if err != nil || rate <= 0 {
+		r.handler.logger.Error(ctx, "No exchange rate for valuation", "from", from, "to", to, "error", err)
+		rate = 0
+	}
+	r.rates[pair] = rate
+	return rate, rate > 0
+}
+
+// valueAccount marks the account's holdings to their quotes and totals them with its cash
+func valueAccount(ctx context.Context, account generated.Account, holdings []generated.Holding, quotes map[string]*stockpb.StockQuote, rates *exchangeRates) *portfoliopb.AccountValuation {
+	valuation := &portfoliopb.AccountValuation{
+		AccountId:   account.ID.String(),
+		Currency:    convertCurrencyTypeToProto(account.Currency),
+		CashBalance: numericToFloat(account.Balance),
+	}
+
+	for _, holding := range holdings {
+		value := valueHolding(ctx, holding, string(account.Currency), quotes[holding.Symbol], rates)
+		valuation.Holdings = append(valuation.Holdings, value)
+		valuation.MarketValue += value.MarketValue
+		valuation.CostBasis += value.CostBasis
+		valuation.DayChange += value.DayChange
+	}
+
+	valuation.UnrealizedPnl = valuation.MarketValue - valuation.CostBasis
+	valuation.UnrealizedPnlPct = percentOf(valuation.UnrealizedPnl, valuation.CostBasis)
+	valuation.DayChangePct = percentOf(valuation.DayChange, valuation.MarketValue-valuation.DayChange)
+	valuation.TotalEquity = valuation.CashBalance + valuation.MarketValue
+	return valuation
+}
+
+// valueHolding prices a holding at quote in the account currency, or at its cost when it cannot be priced
+func valueHolding(ctx context.Context, holding generated.Holding, currency string, quote *stockpb.StockQuote, rates *exchangeRates) *portfoliopb.HoldingValuation {
+	quantity := numericToFloat(holding.Quantity)
+	avgCost := numericToFloat(holding.AvgCost)
+	value := &portfoliopb.HoldingValuation{
+		Symbol:    holding.Symbol,
+		Quantity:  quantity,
+		AvgCost:   avgCost,
+		CostBasis: quantity * avgCost,
+	}
+
+	if quote != nil && quote.LastPrice > 0 {
+		if rate, ok := rates.rate(ctx, quote.Currency, currency); ok {
+			value.Priced = true
+			value.QuoteCurrency = quote.Currency
+			value.ExchangeRate = rate
+			value.LastPrice = quote.LastPrice
+			value.QuoteAsOf = quote.AsOf
+			value.MarketValue = quantity * quote.LastPrice * rate
+			value.DayChange = quantity * quote.Change * rate
+		}
+	}
+	if !value.Priced {
+		value.MarketValue = value.CostBasis
+	}
+
+	value.UnrealizedPnl = value.MarketValue - value.CostBasis
+	value.UnrealizedPnlPct = percentOf(value.UnrealizedPnl, value.CostBasis)
+	value.DayChangePct = percentOf(value.DayChange, value.MarketValue-value.DayChange)
+	return value
+}
+
+// percentOf is part as a percentage of whole, zero when whole is
+func percentOf(part, whole float64) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return part / whole * 100
+}
@@ -3,12 +3,27 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"time"
+
+	"fafnir/shared/pkg/fx"
+	"fafnir/shared/pkg/redis"
 )
 
 type Config struct {
-	PORT string
-	DB   PostgresConfig
-	NATS NatsConfig
+	PORT         string
+	DB           PostgresConfig
+	NATS         NatsConfig
+	StockService StockServiceConfig
+	Cache        redis.CacheConfig
+	// converts holdings into the currency of their account for valuations
+	FX fx.Config
+}
+
+type StockServiceConfig struct {
+	Host string
+	Port string
+	URL  string
 }
 
 type NatsConfig struct {
@@ -28,12 +43,72 @@ type PostgresConfig struct {
 
 func NewConfig() *Config {
 	return &Config{
-		PORT: fmt.Sprintf(":%s", os.Getenv("SERVICE_PORT")),
-		DB:   newPostgresConfig(),
-		NATS: newNatsConfig(),
+		PORT:         fmt.Sprintf(":%s", os.Getenv("SERVICE_PORT")),
+		DB:           newPostgresConfig(),
+		NATS:         newNatsConfig(),
+		StockService: newStockServiceConfig(),
+		Cache:        newRedisConfig(),
+		FX:           newFXConfig(),
+	}
+}
+
+func newStockServiceConfig() StockServiceConfig {
+	host := os.Getenv("STOCK_SERVICE_HOST")
+	port := os.Getenv("STOCK_SERVICE_PORT")
+
+	return StockServiceConfig{
+		Host: host,
+		Port: port,
+		URL:  fmt.Sprintf("%s:%s", host, port),
 	}
 }
 
+func newFXConfig() fx.Config {
+	baseURL := os.Getenv("FX_API_URL")
+	if baseURL == "" {
+		baseURL = "https://api.frankfurter.dev"
+	}
+
+	offline, _ := strconv.ParseBool(os.Getenv("FX_OFFLINE"))
+
+	return fx.Config{
+		BaseURL:    baseURL,
+		Timeout:    durationFromEnv("FX_TIMEOUT", 5*time.Second),
+		TTL:        durationFromEnv("FX_CACHE_TTL", 12*time.Hour),
+		RatesFile:  os.Getenv("FX_RATES_FILE"),
+		Offline:    offline,
+		MaxRateAge: durationFromEnv("FX_MAX_RATE_AGE", 72*time.Hour),
+	}
+}
+
+func newRedisConfig() redis.CacheConfig {
+	host := os.Getenv("REDIS_HOST")
+	port := os.Getenv("REDIS_PORT")
+	password := os.Getenv("REDIS_PASSWORD")
+	db := 2
+
+	return redis.CacheConfig{
+		Host:     host,
+		Port:     port,
+		Password: password,
+		DB:       db,
+	}
+}
+
+func durationFromEnv(name string, fallback time.Duration) time.Duration {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return fallback
+	}
+
+	return duration
+}
+
 func newNatsConfig() NatsConfig {
 	host := os.Getenv("NATS_HOST")
 	port := os.Getenv("NATS_PORT")
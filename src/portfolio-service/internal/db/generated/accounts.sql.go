@@ -22,7 +22,7 @@ func (q *Queries) DeleteAccount(ctx context.Context, id uuid.UUID) error {
 }
 
 const getAccountById = `-- name: GetAccountById :one
-SELECT id, user_id, account_number, account_type, currency, balance, created_at, updated_at FROM accounts WHERE id = $1
+SELECT id, user_id, account_number, account_type, currency, balance, created_at, updated_at, lot_method FROM accounts WHERE id = $1
 `
 
 func (q *Queries) GetAccountById(ctx context.Context, id uuid.UUID) (Account, error) {
@@ -37,12 +37,13 @@ func (q *Queries) GetAccountById(ctx context.Context, id uuid.UUID) (Account, er
 		&i.Balance,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LotMethod,
 	)
 	return i, err
 }
 
 const getAccountByUserId = `-- name: GetAccountByUserId :many
-SELECT id, user_id, account_number, account_type, currency, balance, created_at, updated_at FROM accounts WHERE user_id = $1
+SELECT id, user_id, account_number, account_type, currency, balance, created_at, updated_at, lot_method FROM accounts WHERE user_id = $1
 `
 
 func (q *Queries) GetAccountByUserId(ctx context.Context, userID uuid.UUID) ([]Account, error) {
@@ -63,6 +64,7 @@ func (q *Queries) GetAccountByUserId(ctx context.Context, userID uuid.UUID) ([]A
 			&i.Balance,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.LotMethod,
 		); err != nil {
 			return nil, err
 		}
@@ -75,9 +77,9 @@ func (q *Queries) GetAccountByUserId(ctx context.Context, userID uuid.UUID) ([]A
 }
 
 const insertAccount = `-- name: InsertAccount :one
-INSERT INTO accounts (user_id, account_number, account_type, currency, balance)
-VALUES ( $1, $2, $3, $4, $5)
-RETURNING id, user_id, account_number, account_type, currency, balance, created_at, updated_at
+INSERT INTO accounts (user_id, account_number, account_type, currency, balance, lot_method)
+VALUES ( $1, $2, $3, $4, $5, $6)
+RETURNING id, user_id, account_number, account_type, currency, balance, created_at, updated_at, lot_method
 `
 
 type InsertAccountParams struct {
@@ -86,6 +88,7 @@ type InsertAccountParams struct {
 	AccountType   AccountType    `json:"account_type"`
 	Currency      CurrencyType   `json:"currency"`
 	Balance       pgtype.Numeric `json:"balance"`
+	LotMethod     LotMethod      `json:"lot_method"`
 }
 
 func (q *Queries) InsertAccount(ctx context.Context, arg InsertAccountParams) (Account, error) {
@@ -95,6 +98,7 @@ func (q *Queries) InsertAccount(ctx context.Context, arg InsertAccountParams) (A
 		arg.AccountType,
 		arg.Currency,
 		arg.Balance,
+		arg.LotMethod,
 	)
 	var i Account
 	err := row.Scan(
@@ -106,6 +110,36 @@ func (q *Queries) InsertAccount(ctx context.Context, arg InsertAccountParams) (A
 		&i.Balance,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LotMethod,
+	)
+	return i, err
+}
+
+const setAccountLotMethod = `-- name: SetAccountLotMethod :one
+UPDATE accounts
+SET lot_method = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING id, user_id, account_number, account_type, currency, balance, created_at, updated_at, lot_method
+`
+
+type SetAccountLotMethodParams struct {
+	ID        uuid.UUID `json:"id"`
+	LotMethod LotMethod `json:"lot_method"`
+}
+
+func (q *Queries) SetAccountLotMethod(ctx context.Context, arg SetAccountLotMethodParams) (Account, error) {
+	row := q.db.QueryRow(ctx, setAccountLotMethod, arg.ID, arg.LotMethod)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.AccountNumber,
+		&i.AccountType,
+		&i.Currency,
+		&i.Balance,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LotMethod,
 	)
 	return i, err
 }
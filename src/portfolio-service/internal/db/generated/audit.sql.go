@@ -12,8 +12,53 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const getRealizedPnlSince = `-- name: GetRealizedPnlSince :one
+SELECT COALESCE(SUM(realized_pnl), 0)::NUMERIC AS realized_pnl
+FROM transactions
+WHERE account_id = $1 AND created_at >= $2 AND realized_pnl IS NOT NULL
+`
+
+type GetRealizedPnlSinceParams struct {
+	AccountID uuid.UUID          `json:"account_id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) GetRealizedPnlSince(ctx context.Context, arg GetRealizedPnlSinceParams) (pgtype.Numeric, error) {
+	row := q.db.QueryRow(ctx, getRealizedPnlSince, arg.AccountID, arg.CreatedAt)
+	var realized_pnl pgtype.Numeric
+	err := row.Scan(&realized_pnl)
+	return realized_pnl, err
+}
+
+const getTotalFeesByAccountId = `-- name: GetTotalFeesByAccountId :one
+SELECT COALESCE(SUM(amount), 0)::NUMERIC AS total_fees
+FROM transactions
+WHERE account_id = $1 AND transaction_type = 'fee'
+`
+
+func (q *Queries) GetTotalFeesByAccountId(ctx context.Context, accountID uuid.UUID) (pgtype.Numeric, error) {
+	row := q.db.QueryRow(ctx, getTotalFeesByAccountId, accountID)
+	var total_fees pgtype.Numeric
+	err := row.Scan(&total_fees)
+	return total_fees, err
+}
+
+const getTotalFeesByUserId = `-- name: GetTotalFeesByUserId :one
+SELECT COALESCE(SUM(t.amount), 0)::NUMERIC AS total_fees
+FROM transactions t
+JOIN accounts a ON a.id = t.account_id
+WHERE a.user_id = $1 AND t.transaction_type = 'fee'
+`
+
+func (q *Queries) GetTotalFeesByUserId(ctx context.Context, userID uuid.UUID) (pgtype.Numeric, error) {
+	row := q.db.QueryRow(ctx, getTotalFeesByUserId, userID)
+	var total_fees pgtype.Numeric
+	err := row.Scan(&total_fees)
+	return total_fees, err
+}
+
 const getTransactionsByAccountId = `-- name: GetTransactionsByAccountId :many
-SELECT id, account_id, transaction_type, amount, description, reference_id, created_at FROM transactions
+SELECT id, account_id, transaction_type, amount, description, reference_id, created_at, realized_pnl FROM transactions
 WHERE account_id = $1
 ORDER BY created_at DESC
 `
@@ -35,6 +80,7 @@ func (q *Queries) GetTransactionsByAccountId(ctx context.Context, accountID uuid
 			&i.Description,
 			&i.ReferenceID,
 			&i.CreatedAt,
+			&i.RealizedPnl,
 		); err != nil {
 			return nil, err
 		}
@@ -45,38 +91,3 @@ func (q *Queries) GetTransactionsByAccountId(ctx context.Context, accountID uuid
 	}
 	return items, nil
 }
-
-const insertAuditLog = `-- name: InsertAuditLog :one
-INSERT INTO transactions ( account_id, transaction_type, amount, description, reference_id
-) VALUES ( $1, $2, $3, $4, $5)
-RETURNING id, account_id, transaction_type, amount, description, reference_id, created_at
-`
-
-type InsertAuditLogParams struct {
-	AccountID       uuid.UUID       `json:"account_id"`
-	TransactionType TransactionType `json:"transaction_type"`
-	Amount          pgtype.Numeric  `json:"amount"`
-	Description     string          `json:"description"`
-	ReferenceID     *uuid.UUID      `json:"reference_id"`
-}
-
-func (q *Queries) InsertAuditLog(ctx context.Context, arg InsertAuditLogParams) (Transaction, error) {
-	row := q.db.QueryRow(ctx, insertAuditLog,
-		arg.AccountID,
-		arg.TransactionType,
-		arg.Amount,
-		arg.Description,
-		arg.ReferenceID,
-	)
-	var i Transaction
-	err := row.Scan(
-		&i.ID,
-		&i.AccountID,
-		&i.TransactionType,
-		&i.Amount,
-		&i.Description,
-		&i.ReferenceID,
-		&i.CreatedAt,
-	)
-	return i, err
-}
@@ -0,0 +1,346 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: holds.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const consumeHold = `-- name: ConsumeHold :one
+UPDATE holds
+SET remaining_quantity = GREATEST(remaining_quantity - $1, 0),
+    remaining_amount = CASE
+        WHEN remaining_quantity - $1 <= 0 THEN 0
+        ELSE GREATEST(remaining_amount - $2, 0)
+    END,
+    status = CASE
+        WHEN remaining_quantity - $1 <= 0 THEN 'settled'::hold_status
+        ELSE status
+    END,
+    updated_at = NOW()
+WHERE order_id = $3 AND status = 'active'
+RETURNING id, order_id, account_id, kind, symbol, quantity, remaining_quantity, amount, remaining_amount, status, created_at, updated_at, group_id
+`
+
+type ConsumeHoldParams struct {
+	Quantity pgtype.Numeric `json:"quantity"`
+	Amount   pgtype.Numeric `json:"amount"`
+	OrderID  uuid.UUID      `json:"order_id"`
+}
+
+// Used when a fill settles part of a held order; the hold settles once its quantity is used up
+func (q *Queries) ConsumeHold(ctx context.Context, arg ConsumeHoldParams) (Hold, error) {
+	row := q.db.QueryRow(ctx, consumeHold, arg.Quantity, arg.Amount, arg.OrderID)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.OrderID,
+		&i.AccountID,
+		&i.Kind,
+		&i.Symbol,
+		&i.Quantity,
+		&i.RemainingQuantity,
+		&i.Amount,
+		&i.RemainingAmount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.GroupID,
+	)
+	return i, err
+}
+
+const countActiveHolds = `-- name: CountActiveHolds :one
+SELECT COUNT(DISTINCT order_id)::INT AS open_orders
+FROM holds
+WHERE account_id = $1 AND status = 'active'
+  AND order_id <> ALL($2::UUID[])
+`
+
+type CountActiveHoldsParams struct {
+	AccountID        uuid.UUID   `json:"account_id"`
+	ExcludedOrderIds []uuid.UUID `json:"excluded_order_ids"`
+}
+
+// Every accepted order holds something until it closes, so this counts the account's open orders
+func (q *Queries) CountActiveHolds(ctx context.Context, arg CountActiveHoldsParams) (int32, error) {
+	row := q.db.QueryRow(ctx, countActiveHolds, arg.AccountID, arg.ExcludedOrderIds)
+	var open_orders int32
+	err := row.Scan(&open_orders)
+	return open_orders, err
+}
+
+const getGroupReservation = `-- name: GetGroupReservation :one
+SELECT COALESCE(MAX(remaining_quantity), 0)::NUMERIC AS reserved_quantity,
+       COALESCE(MAX(remaining_amount), 0)::NUMERIC AS reserved_amount
+FROM holds
+WHERE group_id = $1 AND kind = $2 AND status = 'active'
+`
+
+type GetGroupReservationParams struct {
+	GroupID *uuid.UUID `json:"group_id"`
+	Kind    HoldKind   `json:"kind"`
+}
+
+type GetGroupReservationRow struct {
+	ReservedQuantity pgtype.Numeric `json:"reserved_quantity"`
+	ReservedAmount   pgtype.Numeric `json:"reserved_amount"`
+}
+
+// What the active holds of an order group already reserve for one kind
+func (q *Queries) GetGroupReservation(ctx context.Context, arg GetGroupReservationParams) (GetGroupReservationRow, error) {
+	row := q.db.QueryRow(ctx, getGroupReservation, arg.GroupID, arg.Kind)
+	var i GetGroupReservationRow
+	err := row.Scan(&i.ReservedQuantity, &i.ReservedAmount)
+	return i, err
+}
+
+const getHoldByOrderId = `-- name: GetHoldByOrderId :one
+SELECT id, order_id, account_id, kind, symbol, quantity, remaining_quantity, amount, remaining_amount, status, created_at, updated_at, group_id FROM holds
+WHERE order_id = $1
+`
+
+func (q *Queries) GetHoldByOrderId(ctx context.Context, orderID uuid.UUID) (Hold, error) {
+	row := q.db.QueryRow(ctx, getHoldByOrderId, orderID)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.OrderID,
+		&i.AccountID,
+		&i.Kind,
+		&i.Symbol,
+		&i.Quantity,
+		&i.RemainingQuantity,
+		&i.Amount,
+		&i.RemainingAmount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.GroupID,
+	)
+	return i, err
+}
+
+const getReservedBalance = `-- name: GetReservedBalance :one
+SELECT COALESCE(SUM(reserved), 0)::NUMERIC AS reserved
+FROM (
+    SELECT MAX(remaining_amount) AS reserved
+    FROM holds
+    WHERE account_id = $1 AND kind = 'cash' AND status = 'active'
+    GROUP BY COALESCE(group_id, order_id)
+) grouped
+`
+
+// Holds of one order group overlap, so a group only reserves its largest hold
+func (q *Queries) GetReservedBalance(ctx context.Context, accountID uuid.UUID) (pgtype.Numeric, error) {
+	row := q.db.QueryRow(ctx, getReservedBalance, accountID)
+	var reserved pgtype.Numeric
+	err := row.Scan(&reserved)
+	return reserved, err
+}
+
+const getReservedQuantitiesByAccountId = `-- name: GetReservedQuantitiesByAccountId :many
+SELECT symbol, SUM(reserved)::NUMERIC AS reserved
+FROM (
+    SELECT symbol, MAX(remaining_quantity) AS reserved
+    FROM holds
+    WHERE account_id = $1 AND kind = 'shares' AND status = 'active'
+    GROUP BY symbol, COALESCE(group_id, order_id)
+) grouped
+GROUP BY symbol
+`
+
+type GetReservedQuantitiesByAccountIdRow struct {
+	Symbol   string         `json:"symbol"`
+	Reserved pgtype.Numeric `json:"reserved"`
+}
+
+func (q *Queries) GetReservedQuantitiesByAccountId(ctx context.Context, accountID uuid.UUID) ([]GetReservedQuantitiesByAccountIdRow, error) {
+	rows, err := q.db.Query(ctx, getReservedQuantitiesByAccountId, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetReservedQuantitiesByAccountIdRow{}
+	for rows.Next() {
+		var i GetReservedQuantitiesByAccountIdRow
+		if err := rows.Scan(&i.Symbol, &i.Reserved); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReservedQuantity = `-- name: GetReservedQuantity :one
+SELECT COALESCE(SUM(reserved), 0)::NUMERIC AS reserved
+FROM (
+    SELECT MAX(remaining_quantity) AS reserved
+    FROM holds
+    WHERE account_id = $1 AND symbol = $2 AND kind = 'shares' AND status = 'active'
+    GROUP BY COALESCE(group_id, order_id)
+) grouped
+`
+
+type GetReservedQuantityParams struct {
+	AccountID uuid.UUID `json:"account_id"`
+	Symbol    string    `json:"symbol"`
+}
+
+func (q *Queries) GetReservedQuantity(ctx context.Context, arg GetReservedQuantityParams) (pgtype.Numeric, error) {
+	row := q.db.QueryRow(ctx, getReservedQuantity, arg.AccountID, arg.Symbol)
+	var reserved pgtype.Numeric
+	err := row.Scan(&reserved)
+	return reserved, err
+}
+
+const insertHold = `-- name: InsertHold :one
+INSERT INTO holds (order_id, account_id, kind, symbol, quantity, remaining_quantity, amount, remaining_amount, group_id)
+VALUES ($1, $2, $3, $4, $5, $5, $6, $6, $7)
+RETURNING id, order_id, account_id, kind, symbol, quantity, remaining_quantity, amount, remaining_amount, status, created_at, updated_at, group_id
+`
+
+type InsertHoldParams struct {
+	OrderID   uuid.UUID      `json:"order_id"`
+	AccountID uuid.UUID      `json:"account_id"`
+	Kind      HoldKind       `json:"kind"`
+	Symbol    string         `json:"symbol"`
+	Quantity  pgtype.Numeric `json:"quantity"`
+	Amount    pgtype.Numeric `json:"amount"`
+	GroupID   *uuid.UUID     `json:"group_id"`
+}
+
+func (q *Queries) InsertHold(ctx context.Context, arg InsertHoldParams) (Hold, error) {
+	row := q.db.QueryRow(ctx, insertHold,
+		arg.OrderID,
+		arg.AccountID,
+		arg.Kind,
+		arg.Symbol,
+		arg.Quantity,
+		arg.Amount,
+		arg.GroupID,
+	)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.OrderID,
+		&i.AccountID,
+		&i.Kind,
+		&i.Symbol,
+		&i.Quantity,
+		&i.RemainingQuantity,
+		&i.Amount,
+		&i.RemainingAmount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.GroupID,
+	)
+	return i, err
+}
+
+const lockAccount = `-- name: LockAccount :one
+SELECT id, user_id, account_number, account_type, currency, balance, created_at, updated_at, lot_method FROM accounts
+WHERE id = $1
+FOR UPDATE
+`
+
+// Serializes hold placement per account
+func (q *Queries) LockAccount(ctx context.Context, id uuid.UUID) (Account, error) {
+	row := q.db.QueryRow(ctx, lockAccount, id)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.AccountNumber,
+		&i.AccountType,
+		&i.Currency,
+		&i.Balance,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LotMethod,
+	)
+	return i, err
+}
+
+const releaseHold = `-- name: ReleaseHold :one
+UPDATE holds
+SET remaining_quantity = 0,
+    remaining_amount = 0,
+    status = 'released',
+    updated_at = NOW()
+WHERE order_id = $1 AND status = 'active'
+RETURNING id, order_id, account_id, kind, symbol, quantity, remaining_quantity, amount, remaining_amount, status, created_at, updated_at, group_id
+`
+
+func (q *Queries) ReleaseHold(ctx context.Context, orderID uuid.UUID) (Hold, error) {
+	row := q.db.QueryRow(ctx, releaseHold, orderID)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.OrderID,
+		&i.AccountID,
+		&i.Kind,
+		&i.Symbol,
+		&i.Quantity,
+		&i.RemainingQuantity,
+		&i.Amount,
+		&i.RemainingAmount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.GroupID,
+	)
+	return i, err
+}
+
+const resizeHold = `-- name: ResizeHold :one
+UPDATE holds
+SET quantity = quantity + $1,
+    amount = amount + $2,
+    remaining_quantity = remaining_quantity + $1,
+    remaining_amount = remaining_amount + $2,
+    updated_at = NOW()
+WHERE order_id = $3 AND status = 'active'
+  AND remaining_quantity + $1 >= 0
+  AND remaining_amount + $2 >= 0
+RETURNING id, order_id, account_id, kind, symbol, quantity, remaining_quantity, amount, remaining_amount, status, created_at, updated_at, group_id
+`
+
+type ResizeHoldParams struct {
+	QuantityChange pgtype.Numeric `json:"quantity_change"`
+	AmountChange   pgtype.Numeric `json:"amount_change"`
+	OrderID        uuid.UUID      `json:"order_id"`
+}
+
+// Used when an order is amended; the original quantity and amount move by the same change, and what the hold
+// still reserves never goes below zero
+func (q *Queries) ResizeHold(ctx context.Context, arg ResizeHoldParams) (Hold, error) {
+	row := q.db.QueryRow(ctx, resizeHold, arg.QuantityChange, arg.AmountChange, arg.OrderID)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.OrderID,
+		&i.AccountID,
+		&i.Kind,
+		&i.Symbol,
+		&i.Quantity,
+		&i.RemainingQuantity,
+		&i.Amount,
+		&i.RemainingAmount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.GroupID,
+	)
+	return i, err
+}
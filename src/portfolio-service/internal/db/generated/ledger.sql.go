@@ -0,0 +1,254 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: ledger.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getCashLedgerAccount = `-- name: GetCashLedgerAccount :one
+SELECT id, kind, account_id, currency, created_at FROM ledger_accounts WHERE account_id = $1
+`
+
+func (q *Queries) GetCashLedgerAccount(ctx context.Context, accountID *uuid.UUID) (LedgerAccount, error) {
+	row := q.db.QueryRow(ctx, getCashLedgerAccount, accountID)
+	var i LedgerAccount
+	err := row.Scan(
+		&i.ID,
+		&i.Kind,
+		&i.AccountID,
+		&i.Currency,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getSystemLedgerAccount = `-- name: GetSystemLedgerAccount :one
+SELECT id, kind, account_id, currency, created_at FROM ledger_accounts WHERE kind = $1 AND currency = $2 AND account_id IS NULL
+`
+
+type GetSystemLedgerAccountParams struct {
+	Kind     LedgerAccountKind `json:"kind"`
+	Currency CurrencyType      `json:"currency"`
+}
+
+func (q *Queries) GetSystemLedgerAccount(ctx context.Context, arg GetSystemLedgerAccountParams) (LedgerAccount, error) {
+	row := q.db.QueryRow(ctx, getSystemLedgerAccount, arg.Kind, arg.Currency)
+	var i LedgerAccount
+	err := row.Scan(
+		&i.ID,
+		&i.Kind,
+		&i.AccountID,
+		&i.Currency,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const insertJournalEntry = `-- name: InsertJournalEntry :one
+INSERT INTO journal_entries (entry_type, description, reference_id, realized_pnl)
+VALUES ($1, $2, $3, $4)
+RETURNING id, entry_type, description, reference_id, realized_pnl, created_at
+`
+
+type InsertJournalEntryParams struct {
+	EntryType   JournalEntryType `json:"entry_type"`
+	Description string           `json:"description"`
+	ReferenceID *uuid.UUID       `json:"reference_id"`
+	RealizedPnl pgtype.Numeric   `json:"realized_pnl"`
+}
+
+func (q *Queries) InsertJournalEntry(ctx context.Context, arg InsertJournalEntryParams) (JournalEntry, error) {
+	row := q.db.QueryRow(ctx, insertJournalEntry,
+		arg.EntryType,
+		arg.Description,
+		arg.ReferenceID,
+		arg.RealizedPnl,
+	)
+	var i JournalEntry
+	err := row.Scan(
+		&i.ID,
+		&i.EntryType,
+		&i.Description,
+		&i.ReferenceID,
+		&i.RealizedPnl,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const insertLedgerAccount = `-- name: InsertLedgerAccount :one
+INSERT INTO ledger_accounts (kind, account_id, currency)
+VALUES ($1, $2, $3)
+RETURNING id, kind, account_id, currency, created_at
+`
+
+type InsertLedgerAccountParams struct {
+	Kind      LedgerAccountKind `json:"kind"`
+	AccountID *uuid.UUID        `json:"account_id"`
+	Currency  CurrencyType      `json:"currency"`
+}
+
+func (q *Queries) InsertLedgerAccount(ctx context.Context, arg InsertLedgerAccountParams) (LedgerAccount, error) {
+	row := q.db.QueryRow(ctx, insertLedgerAccount, arg.Kind, arg.AccountID, arg.Currency)
+	var i LedgerAccount
+	err := row.Scan(
+		&i.ID,
+		&i.Kind,
+		&i.AccountID,
+		&i.Currency,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const insertLedgerPosting = `-- name: InsertLedgerPosting :exec
+INSERT INTO ledger_postings (entry_id, ledger_account_id, amount, memo)
+VALUES ($1, $2, $3, $4)
+`
+
+type InsertLedgerPostingParams struct {
+	EntryID         uuid.UUID      `json:"entry_id"`
+	LedgerAccountID uuid.UUID      `json:"ledger_account_id"`
+	Amount          pgtype.Numeric `json:"amount"`
+	Memo            pgtype.Text    `json:"memo"`
+}
+
+func (q *Queries) InsertLedgerPosting(ctx context.Context, arg InsertLedgerPostingParams) error {
+	_, err := q.db.Exec(ctx, insertLedgerPosting,
+		arg.EntryID,
+		arg.LedgerAccountID,
+		arg.Amount,
+		arg.Memo,
+	)
+	return err
+}
+
+const listBalanceMismatches = `-- name: ListBalanceMismatches :many
+SELECT a.id, a.account_number, a.balance, COALESCE(SUM(p.amount), 0)::NUMERIC AS ledger_balance
+FROM accounts a
+LEFT JOIN ledger_accounts la ON la.account_id = a.id
+LEFT JOIN ledger_postings p ON p.ledger_account_id = la.id
+GROUP BY a.id, a.account_number, a.balance
+HAVING a.balance <> COALESCE(SUM(p.amount), 0)
+ORDER BY a.account_number
+`
+
+type ListBalanceMismatchesRow struct {
+	ID            uuid.UUID      `json:"id"`
+	AccountNumber string         `json:"account_number"`
+	Balance       pgtype.Numeric `json:"balance"`
+	LedgerBalance pgtype.Numeric `json:"ledger_balance"`
+}
+
+func (q *Queries) ListBalanceMismatches(ctx context.Context) ([]ListBalanceMismatchesRow, error) {
+	rows, err := q.db.Query(ctx, listBalanceMismatches)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListBalanceMismatchesRow{}
+	for rows.Next() {
+		var i ListBalanceMismatchesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountNumber,
+			&i.Balance,
+			&i.LedgerBalance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnbalancedEntries = `-- name: ListUnbalancedEntries :many
+SELECT e.id, e.entry_type, e.created_at, la.currency, SUM(p.amount)::NUMERIC AS imbalance
+FROM journal_entries e
+JOIN ledger_postings p ON p.entry_id = e.id
+JOIN ledger_accounts la ON la.id = p.ledger_account_id
+GROUP BY e.id, e.entry_type, e.created_at, la.currency
+HAVING SUM(p.amount) <> 0
+ORDER BY e.created_at
+`
+
+type ListUnbalancedEntriesRow struct {
+	ID        uuid.UUID          `json:"id"`
+	EntryType JournalEntryType   `json:"entry_type"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	Currency  CurrencyType       `json:"currency"`
+	Imbalance pgtype.Numeric     `json:"imbalance"`
+}
+
+// The commit trigger keeps these out; they can only come from writes that bypassed it
+func (q *Queries) ListUnbalancedEntries(ctx context.Context) ([]ListUnbalancedEntriesRow, error) {
+	rows, err := q.db.Query(ctx, listUnbalancedEntries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUnbalancedEntriesRow{}
+	for rows.Next() {
+		var i ListUnbalancedEntriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EntryType,
+			&i.CreatedAt,
+			&i.Currency,
+			&i.Imbalance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateAccountBalance = `-- name: UpdateAccountBalance :one
+UPDATE accounts a
+SET
+    balance = a.balance + $2,
+    updated_at = NOW()
+FROM (SELECT b.id, b.balance FROM accounts b WHERE b.id = $1 FOR UPDATE) previous
+WHERE a.id = previous.id
+RETURNING a.id, a.account_number, a.balance, previous.balance AS previous_balance
+`
+
+type UpdateAccountBalanceParams struct {
+	ID      uuid.UUID      `json:"id"`
+	Balance pgtype.Numeric `json:"balance"`
+}
+
+type UpdateAccountBalanceRow struct {
+	ID              uuid.UUID      `json:"id"`
+	AccountNumber   string         `json:"account_number"`
+	Balance         pgtype.Numeric `json:"balance"`
+	PreviousBalance pgtype.Numeric `json:"previous_balance"`
+}
+
+// Only posting to the account's cash moves its balance, so that it stays the sum of its ledger; the balance before
+// the posting comes back with it, for checking the posting moved it by exactly its amount
+func (q *Queries) UpdateAccountBalance(ctx context.Context, arg UpdateAccountBalanceParams) (UpdateAccountBalanceRow, error) {
+	row := q.db.QueryRow(ctx, updateAccountBalance, arg.ID, arg.Balance)
+	var i UpdateAccountBalanceRow
+	err := row.Scan(
+		&i.ID,
+		&i.AccountNumber,
+		&i.Balance,
+		&i.PreviousBalance,
+	)
+	return i, err
+}
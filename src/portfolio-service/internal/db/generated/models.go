@@ -97,6 +97,226 @@ func (ns NullCurrencyType) Value() (driver.Value, error) {
 	return string(ns.CurrencyType), nil
 }
 
+type HoldKind string
+
+const (
+	HoldKindCash   HoldKind = "cash"
+	HoldKindShares HoldKind = "shares"
+)
+
+func (e *HoldKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = HoldKind(s)
+	case string:
+		*e = HoldKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for HoldKind: %T", src)
+	}
+	return nil
+}
+
+type NullHoldKind struct {
+	HoldKind HoldKind `json:"hold_kind"`
+	Valid    bool     `json:"valid"` // Valid is true if HoldKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullHoldKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.HoldKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.HoldKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullHoldKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.HoldKind), nil
+}
+
+type HoldStatus string
+
+const (
+	HoldStatusActive   HoldStatus = "active"
+	HoldStatusSettled  HoldStatus = "settled"
+	HoldStatusReleased HoldStatus = "released"
+)
+
+func (e *HoldStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = HoldStatus(s)
+	case string:
+		*e = HoldStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for HoldStatus: %T", src)
+	}
+	return nil
+}
+
+type NullHoldStatus struct {
+	HoldStatus HoldStatus `json:"hold_status"`
+	Valid      bool       `json:"valid"` // Valid is true if HoldStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullHoldStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.HoldStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.HoldStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullHoldStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.HoldStatus), nil
+}
+
+type JournalEntryType string
+
+const (
+	JournalEntryTypeDeposit    JournalEntryType = "deposit"
+	JournalEntryTypeTransfer   JournalEntryType = "transfer"
+	JournalEntryTypeBuy        JournalEntryType = "buy"
+	JournalEntryTypeSell       JournalEntryType = "sell"
+	JournalEntryTypeFee        JournalEntryType = "fee"
+	JournalEntryTypeAdjustment JournalEntryType = "adjustment"
+)
+
+func (e *JournalEntryType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = JournalEntryType(s)
+	case string:
+		*e = JournalEntryType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for JournalEntryType: %T", src)
+	}
+	return nil
+}
+
+type NullJournalEntryType struct {
+	JournalEntryType JournalEntryType `json:"journal_entry_type"`
+	Valid            bool             `json:"valid"` // Valid is true if JournalEntryType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullJournalEntryType) Scan(value interface{}) error {
+	if value == nil {
+		ns.JournalEntryType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.JournalEntryType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullJournalEntryType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.JournalEntryType), nil
+}
+
+type LedgerAccountKind string
+
+const (
+	LedgerAccountKindCash        LedgerAccountKind = "cash"
+	LedgerAccountKindFunding     LedgerAccountKind = "funding"
+	LedgerAccountKindClearing    LedgerAccountKind = "clearing"
+	LedgerAccountKindFees        LedgerAccountKind = "fees"
+	LedgerAccountKindAdjustments LedgerAccountKind = "adjustments"
+)
+
+func (e *LedgerAccountKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LedgerAccountKind(s)
+	case string:
+		*e = LedgerAccountKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LedgerAccountKind: %T", src)
+	}
+	return nil
+}
+
+type NullLedgerAccountKind struct {
+	LedgerAccountKind LedgerAccountKind `json:"ledger_account_kind"`
+	Valid             bool              `json:"valid"` // Valid is true if LedgerAccountKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLedgerAccountKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.LedgerAccountKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.LedgerAccountKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLedgerAccountKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.LedgerAccountKind), nil
+}
+
+type LotMethod string
+
+const (
+	LotMethodFifo        LotMethod = "fifo"
+	LotMethodLifo        LotMethod = "lifo"
+	LotMethodSpecific    LotMethod = "specific"
+	LotMethodAverageCost LotMethod = "average_cost"
+)
+
+func (e *LotMethod) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LotMethod(s)
+	case string:
+		*e = LotMethod(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LotMethod: %T", src)
+	}
+	return nil
+}
+
+type NullLotMethod struct {
+	LotMethod LotMethod `json:"lot_method"`
+	Valid     bool      `json:"valid"` // Valid is true if LotMethod is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLotMethod) Scan(value interface{}) error {
+	if value == nil {
+		ns.LotMethod, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.LotMethod.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLotMethod) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.LotMethod), nil
+}
+
 type TransactionType string
 
 const (
@@ -105,6 +325,8 @@ const (
 	TransactionTypeTransferOut TransactionType = "transfer_out"
 	TransactionTypeBuy         TransactionType = "buy"
 	TransactionTypeSell        TransactionType = "sell"
+	TransactionTypeFee         TransactionType = "fee"
+	TransactionTypeAdjustment  TransactionType = "adjustment"
 )
 
 func (e *TransactionType) Scan(src interface{}) error {
@@ -151,6 +373,23 @@ type Account struct {
 	Balance       pgtype.Numeric     `json:"balance"`
 	CreatedAt     pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	LotMethod     LotMethod          `json:"lot_method"`
+}
+
+type Hold struct {
+	ID                uuid.UUID          `json:"id"`
+	OrderID           uuid.UUID          `json:"order_id"`
+	AccountID         uuid.UUID          `json:"account_id"`
+	Kind              HoldKind           `json:"kind"`
+	Symbol            string             `json:"symbol"`
+	Quantity          pgtype.Numeric     `json:"quantity"`
+	RemainingQuantity pgtype.Numeric     `json:"remaining_quantity"`
+	Amount            pgtype.Numeric     `json:"amount"`
+	RemainingAmount   pgtype.Numeric     `json:"remaining_amount"`
+	Status            HoldStatus         `json:"status"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+	GroupID           *uuid.UUID         `json:"group_id"`
 }
 
 type Holding struct {
@@ -163,6 +402,96 @@ type Holding struct {
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
+type JournalEntry struct {
+	ID          uuid.UUID          `json:"id"`
+	EntryType   JournalEntryType   `json:"entry_type"`
+	Description string             `json:"description"`
+	ReferenceID *uuid.UUID         `json:"reference_id"`
+	RealizedPnl pgtype.Numeric     `json:"realized_pnl"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type LedgerAccount struct {
+	ID        uuid.UUID          `json:"id"`
+	Kind      LedgerAccountKind  `json:"kind"`
+	AccountID *uuid.UUID         `json:"account_id"`
+	Currency  CurrencyType       `json:"currency"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type LedgerPosting struct {
+	ID              uuid.UUID      `json:"id"`
+	EntryID         uuid.UUID      `json:"entry_id"`
+	LedgerAccountID uuid.UUID      `json:"ledger_account_id"`
+	Amount          pgtype.Numeric `json:"amount"`
+	Memo            pgtype.Text    `json:"memo"`
+}
+
+type LotSelection struct {
+	OrderID  uuid.UUID `json:"order_id"`
+	LotID    uuid.UUID `json:"lot_id"`
+	Position int32     `json:"position"`
+}
+
+type PortfolioSnapshot struct {
+	ID               uuid.UUID          `json:"id"`
+	AccountID        uuid.UUID          `json:"account_id"`
+	SnapshotDate     pgtype.Date        `json:"snapshot_date"`
+	Currency         CurrencyType       `json:"currency"`
+	CashBalance      pgtype.Numeric     `json:"cash_balance"`
+	MarketValue      pgtype.Numeric     `json:"market_value"`
+	CostBasis        pgtype.Numeric     `json:"cost_basis"`
+	TotalEquity      pgtype.Numeric     `json:"total_equity"`
+	NetFlow          pgtype.Numeric     `json:"net_flow"`
+	UnpricedHoldings int32              `json:"unpriced_holdings"`
+	TakenAt          pgtype.Timestamptz `json:"taken_at"`
+}
+
+type PortfolioSnapshotHolding struct {
+	SnapshotID   uuid.UUID      `json:"snapshot_id"`
+	Symbol       string         `json:"symbol"`
+	Quantity     pgtype.Numeric `json:"quantity"`
+	AvgCost      pgtype.Numeric `json:"avg_cost"`
+	LastPrice    pgtype.Numeric `json:"last_price"`
+	ExchangeRate pgtype.Numeric `json:"exchange_rate"`
+	MarketValue  pgtype.Numeric `json:"market_value"`
+	Priced       bool           `json:"priced"`
+}
+
+type RealizedGain struct {
+	ID          uuid.UUID          `json:"id"`
+	AccountID   uuid.UUID          `json:"account_id"`
+	Symbol      string             `json:"symbol"`
+	LotID       *uuid.UUID         `json:"lot_id"`
+	OrderID     uuid.UUID          `json:"order_id"`
+	Quantity    pgtype.Numeric     `json:"quantity"`
+	Proceeds    pgtype.Numeric     `json:"proceeds"`
+	CostBasis   pgtype.Numeric     `json:"cost_basis"`
+	RealizedPnl pgtype.Numeric     `json:"realized_pnl"`
+	Method      LotMethod          `json:"method"`
+	OpenedAt    pgtype.Timestamptz `json:"opened_at"`
+	RealizedAt  pgtype.Timestamptz `json:"realized_at"`
+}
+
+type SettledFill struct {
+	OrderID      uuid.UUID          `json:"order_id"`
+	FillSequence int32              `json:"fill_sequence"`
+	TradeID      pgtype.Text        `json:"trade_id"`
+	SettledAt    pgtype.Timestamptz `json:"settled_at"`
+}
+
+type TaxLot struct {
+	ID                uuid.UUID          `json:"id"`
+	AccountID         uuid.UUID          `json:"account_id"`
+	Symbol            string             `json:"symbol"`
+	OrderID           *uuid.UUID         `json:"order_id"`
+	Quantity          pgtype.Numeric     `json:"quantity"`
+	RemainingQuantity pgtype.Numeric     `json:"remaining_quantity"`
+	CostBasis         pgtype.Numeric     `json:"cost_basis"`
+	OpenedAt          pgtype.Timestamptz `json:"opened_at"`
+	ClosedAt          pgtype.Timestamptz `json:"closed_at"`
+}
+
 type Transaction struct {
 	ID              uuid.UUID          `json:"id"`
 	AccountID       uuid.UUID          `json:"account_id"`
@@ -171,6 +500,7 @@ type Transaction struct {
 	Description     string             `json:"description"`
 	ReferenceID     *uuid.UUID         `json:"reference_id"`
 	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	RealizedPnl     pgtype.Numeric     `json:"realized_pnl"`
 }
 
 type Watchlist struct {
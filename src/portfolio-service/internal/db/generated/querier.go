@@ -8,24 +8,81 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
 	AddToWatchlist(ctx context.Context, arg AddToWatchlistParams) error
+	// Takes quantity off a lot, closing it once nothing remains
+	CloseTaxLot(ctx context.Context, arg CloseTaxLotParams) (TaxLot, error)
+	// Used when a fill settles part of a held order; the hold settles once its quantity is used up
+	ConsumeHold(ctx context.Context, arg ConsumeHoldParams) (Hold, error)
+	// Every accepted order holds something until it closes, so this counts the account's open orders
+	CountActiveHolds(ctx context.Context, arg CountActiveHoldsParams) (int32, error)
 	DecreaseHolding(ctx context.Context, arg DecreaseHoldingParams) (Holding, error)
 	DeleteAccount(ctx context.Context, id uuid.UUID) error
+	DeleteLotSelections(ctx context.Context, orderID uuid.UUID) error
 	GetAccountById(ctx context.Context, id uuid.UUID) (Account, error)
 	GetAccountByUserId(ctx context.Context, userID uuid.UUID) ([]Account, error)
+	GetCashLedgerAccount(ctx context.Context, accountID *uuid.UUID) (LedgerAccount, error)
+	// What the active holds of an order group already reserve for one kind
+	GetGroupReservation(ctx context.Context, arg GetGroupReservationParams) (GetGroupReservationRow, error)
+	GetHoldByOrderId(ctx context.Context, orderID uuid.UUID) (Hold, error)
 	GetHoldingByAccountIdAndSymbol(ctx context.Context, arg GetHoldingByAccountIdAndSymbolParams) (Holding, error)
 	GetHoldingsByAccountId(ctx context.Context, accountID uuid.UUID) ([]Holding, error)
+	GetLatestSnapshot(ctx context.Context, accountID uuid.UUID) (PortfolioSnapshot, error)
+	GetLotSelections(ctx context.Context, orderID uuid.UUID) ([]uuid.UUID, error)
+	// Cash that entered (or left) the account from outside it; trades and fees move value within it
+	GetNetFlowsBetween(ctx context.Context, arg GetNetFlowsBetweenParams) (pgtype.Numeric, error)
+	GetRealizedPnlSince(ctx context.Context, arg GetRealizedPnlSinceParams) (pgtype.Numeric, error)
+	// Holds of one order group overlap, so a group only reserves its largest hold
+	GetReservedBalance(ctx context.Context, accountID uuid.UUID) (pgtype.Numeric, error)
+	GetReservedQuantitiesByAccountId(ctx context.Context, accountID uuid.UUID) ([]GetReservedQuantitiesByAccountIdRow, error)
+	GetReservedQuantity(ctx context.Context, arg GetReservedQuantityParams) (pgtype.Numeric, error)
+	GetSystemLedgerAccount(ctx context.Context, arg GetSystemLedgerAccountParams) (LedgerAccount, error)
+	GetTaxLotById(ctx context.Context, id uuid.UUID) (TaxLot, error)
+	GetTotalFeesByAccountId(ctx context.Context, accountID uuid.UUID) (pgtype.Numeric, error)
+	GetTotalFeesByUserId(ctx context.Context, userID uuid.UUID) (pgtype.Numeric, error)
 	GetTransactionsByAccountId(ctx context.Context, accountID uuid.UUID) ([]Transaction, error)
 	GetWatchlist(ctx context.Context, userID uuid.UUID) ([]GetWatchlistRow, error)
 	InsertAccount(ctx context.Context, arg InsertAccountParams) (Account, error)
-	InsertAuditLog(ctx context.Context, arg InsertAuditLogParams) (Transaction, error)
+	InsertHold(ctx context.Context, arg InsertHoldParams) (Hold, error)
 	// Used when buying for the FIRST time
 	InsertHolding(ctx context.Context, arg InsertHoldingParams) (Holding, error)
+	InsertJournalEntry(ctx context.Context, arg InsertJournalEntryParams) (JournalEntry, error)
+	InsertLedgerAccount(ctx context.Context, arg InsertLedgerAccountParams) (LedgerAccount, error)
+	InsertLedgerPosting(ctx context.Context, arg InsertLedgerPostingParams) error
+	InsertLotSelection(ctx context.Context, arg InsertLotSelectionParams) error
+	// No row when another instance already took the account's snapshot of the day
+	InsertPortfolioSnapshot(ctx context.Context, arg InsertPortfolioSnapshotParams) (PortfolioSnapshot, error)
+	InsertRealizedGain(ctx context.Context, arg InsertRealizedGainParams) (RealizedGain, error)
+	// Claims a fill for settlement; no row means it has already settled
+	InsertSettledFill(ctx context.Context, arg InsertSettledFillParams) (int64, error)
+	InsertSnapshotHolding(ctx context.Context, arg InsertSnapshotHoldingParams) error
+	InsertTaxLot(ctx context.Context, arg InsertTaxLotParams) (TaxLot, error)
+	ListAccountsWithoutSnapshot(ctx context.Context, snapshotDate pgtype.Date) ([]Account, error)
+	ListBalanceMismatches(ctx context.Context) ([]ListBalanceMismatchesRow, error)
+	// Oldest first; sells reorder them by the account's lot method
+	ListOpenTaxLotsForUpdate(ctx context.Context, arg ListOpenTaxLotsForUpdateParams) ([]TaxLot, error)
+	ListRealizedGains(ctx context.Context, arg ListRealizedGainsParams) ([]RealizedGain, error)
+	// Starts from the last snapshot on or before since, which the returns of the period are measured from
+	ListSnapshotsSince(ctx context.Context, arg ListSnapshotsSinceParams) ([]PortfolioSnapshot, error)
+	ListTaxLots(ctx context.Context, arg ListTaxLotsParams) ([]TaxLot, error)
+	// The commit trigger keeps these out; they can only come from writes that bypassed it
+	ListUnbalancedEntries(ctx context.Context) ([]ListUnbalancedEntriesRow, error)
+	// Serializes hold placement per account
+	LockAccount(ctx context.Context, id uuid.UUID) (Account, error)
+	ReleaseHold(ctx context.Context, orderID uuid.UUID) (Hold, error)
 	RemoveFromWatchlist(ctx context.Context, arg RemoveFromWatchlistParams) error
-	UpdateAccountBalance(ctx context.Context, arg UpdateAccountBalanceParams) (Account, error)
+	// Used when an order is amended; the original quantity and amount move by the same change, and what the hold
+	// still reserves never goes below zero
+	ResizeHold(ctx context.Context, arg ResizeHoldParams) (Hold, error)
+	SetAccountLotMethod(ctx context.Context, arg SetAccountLotMethodParams) (Account, error)
+	// Sets the average cost of a holding to that of the lots it still has open, if any
+	SyncHoldingAvgCost(ctx context.Context, arg SyncHoldingAvgCostParams) error
+	// Only posting to the account's cash moves its balance, so that it stays the sum of its ledger; the balance before
+	// the posting comes back with it, for checking the posting moved it by exactly its amount
+	UpdateAccountBalance(ctx context.Context, arg UpdateAccountBalanceParams) (UpdateAccountBalanceRow, error)
 	// Used when buying MORE or selling some
 	UpdateHolding(ctx context.Context, arg UpdateHoldingParams) (Holding, error)
 	UpsertHolding(ctx context.Context, arg UpsertHoldingParams) (Holding, error)
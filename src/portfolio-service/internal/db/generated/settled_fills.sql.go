@@ -0,0 +1,34 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: settled_fills.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const insertSettledFill = `-- name: InsertSettledFill :execrows
+INSERT INTO settled_fills (order_id, fill_sequence, trade_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (order_id, fill_sequence) DO NOTHING
+`
+
+type InsertSettledFillParams struct {
+	OrderID      uuid.UUID   `json:"order_id"`
+	FillSequence int32       `json:"fill_sequence"`
+	TradeID      pgtype.Text `json:"trade_id"`
+}
+
+// Claims a fill for settlement; no row means it has already settled
+func (q *Queries) InsertSettledFill(ctx context.Context, arg InsertSettledFillParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertSettledFill, arg.OrderID, arg.FillSequence, arg.TradeID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -40,36 +40,6 @@ func (q *Queries) DecreaseHolding(ctx context.Context, arg DecreaseHoldingParams
 	return i, err
 }
 
-const updateAccountBalance = `-- name: UpdateAccountBalance :one
-UPDATE accounts
-SET 
-    balance = balance + $2, 
-    updated_at = NOW()
-WHERE id = $1
-RETURNING id, user_id, account_number, account_type, currency, balance, created_at, updated_at
-`
-
-type UpdateAccountBalanceParams struct {
-	ID      uuid.UUID      `json:"id"`
-	Balance pgtype.Numeric `json:"balance"`
-}
-
-func (q *Queries) UpdateAccountBalance(ctx context.Context, arg UpdateAccountBalanceParams) (Account, error) {
-	row := q.db.QueryRow(ctx, updateAccountBalance, arg.ID, arg.Balance)
-	var i Account
-	err := row.Scan(
-		&i.ID,
-		&i.UserID,
-		&i.AccountNumber,
-		&i.AccountType,
-		&i.Currency,
-		&i.Balance,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-	)
-	return i, err
-}
-
 const upsertHolding = `-- name: UpsertHolding :one
 INSERT INTO holdings (account_id, symbol, quantity, avg_cost, created_at, updated_at)
 VALUES ($1, $2, $3, $4, NOW(), NOW())
@@ -0,0 +1,233 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: snapshots.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getLatestSnapshot = `-- name: GetLatestSnapshot :one
+SELECT id, account_id, snapshot_date, currency, cash_balance, market_value, cost_basis, total_equity, net_flow, unpriced_holdings, taken_at FROM portfolio_snapshots
+WHERE account_id = $1
+ORDER BY snapshot_date DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestSnapshot(ctx context.Context, accountID uuid.UUID) (PortfolioSnapshot, error) {
+	row := q.db.QueryRow(ctx, getLatestSnapshot, accountID)
+	var i PortfolioSnapshot
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.SnapshotDate,
+		&i.Currency,
+		&i.CashBalance,
+		&i.MarketValue,
+		&i.CostBasis,
+		&i.TotalEquity,
+		&i.NetFlow,
+		&i.UnpricedHoldings,
+		&i.TakenAt,
+	)
+	return i, err
+}
+
+const getNetFlowsBetween = `-- name: GetNetFlowsBetween :one
+SELECT COALESCE(SUM(
+    CASE transaction_type
+        WHEN 'transfer_out' THEN -amount
+        ELSE amount
+    END
+), 0)::NUMERIC AS net_flow
+FROM transactions
+WHERE account_id = $1
+    AND transaction_type IN ('deposit', 'transfer_in', 'transfer_out')
+    AND created_at > $2 AND created_at <= $3
+`
+
+type GetNetFlowsBetweenParams struct {
+	AccountID uuid.UUID          `json:"account_id"`
+	After     pgtype.Timestamptz `json:"after"`
+	Until     pgtype.Timestamptz `json:"until"`
+}
+
+// Cash that entered (or left) the account from outside it; trades and fees move value within it
+func (q *Queries) GetNetFlowsBetween(ctx context.Context, arg GetNetFlowsBetweenParams) (pgtype.Numeric, error) {
+	row := q.db.QueryRow(ctx, getNetFlowsBetween, arg.AccountID, arg.After, arg.Until)
+	var net_flow pgtype.Numeric
+	err := row.Scan(&net_flow)
+	return net_flow, err
+}
+
+const insertPortfolioSnapshot = `-- name: InsertPortfolioSnapshot :one
+INSERT INTO portfolio_snapshots (account_id, snapshot_date, currency, cash_balance, market_value, cost_basis, total_equity, net_flow, unpriced_holdings, taken_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (account_id, snapshot_date) DO NOTHING
+RETURNING id, account_id, snapshot_date, currency, cash_balance, market_value, cost_basis, total_equity, net_flow, unpriced_holdings, taken_at
+`
+
+type InsertPortfolioSnapshotParams struct {
+	AccountID        uuid.UUID          `json:"account_id"`
+	SnapshotDate     pgtype.Date        `json:"snapshot_date"`
+	Currency         CurrencyType       `json:"currency"`
+	CashBalance      pgtype.Numeric     `json:"cash_balance"`
+	MarketValue      pgtype.Numeric     `json:"market_value"`
+	CostBasis        pgtype.Numeric     `json:"cost_basis"`
+	TotalEquity      pgtype.Numeric     `json:"total_equity"`
+	NetFlow          pgtype.Numeric     `json:"net_flow"`
+	UnpricedHoldings int32              `json:"unpriced_holdings"`
+	TakenAt          pgtype.Timestamptz `json:"taken_at"`
+}
+
+// No row when another instance already took the account's snapshot of the day
+func (q *Queries) InsertPortfolioSnapshot(ctx context.Context, arg InsertPortfolioSnapshotParams) (PortfolioSnapshot, error) {
+	row := q.db.QueryRow(ctx, insertPortfolioSnapshot,
+		arg.AccountID,
+		arg.SnapshotDate,
+		arg.Currency,
+		arg.CashBalance,
+		arg.MarketValue,
+		arg.CostBasis,
+		arg.TotalEquity,
+		arg.NetFlow,
+		arg.UnpricedHoldings,
+		arg.TakenAt,
+	)
+	var i PortfolioSnapshot
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.SnapshotDate,
+		&i.Currency,
+		&i.CashBalance,
+		&i.MarketValue,
+		&i.CostBasis,
+		&i.TotalEquity,
+		&i.NetFlow,
+		&i.UnpricedHoldings,
+		&i.TakenAt,
+	)
+	return i, err
+}
+
+const insertSnapshotHolding = `-- name: InsertSnapshotHolding :exec
+INSERT INTO portfolio_snapshot_holdings (snapshot_id, symbol, quantity, avg_cost, last_price, exchange_rate, market_value, priced)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type InsertSnapshotHoldingParams struct {
+	SnapshotID   uuid.UUID      `json:"snapshot_id"`
+	Symbol       string         `json:"symbol"`
+	Quantity     pgtype.Numeric `json:"quantity"`
+	AvgCost      pgtype.Numeric `json:"avg_cost"`
+	LastPrice    pgtype.Numeric `json:"last_price"`
+	ExchangeRate pgtype.Numeric `json:"exchange_rate"`
+	MarketValue  pgtype.Numeric `json:"market_value"`
+	Priced       bool           `json:"priced"`
+}
+
+func (q *Queries) InsertSnapshotHolding(ctx context.Context, arg InsertSnapshotHoldingParams) error {
+	_, err := q.db.Exec(ctx, insertSnapshotHolding,
+		arg.SnapshotID,
+		arg.Symbol,
+		arg.Quantity,
+		arg.AvgCost,
+		arg.LastPrice,
+		arg.ExchangeRate,
+		arg.MarketValue,
+		arg.Priced,
+	)
+	return err
+}
+
+const listAccountsWithoutSnapshot = `-- name: ListAccountsWithoutSnapshot :many
+SELECT id, user_id, account_number, account_type, currency, balance, created_at, updated_at, lot_method FROM accounts a
+WHERE NOT EXISTS (
+    SELECT 1 FROM portfolio_snapshots s
+    WHERE s.account_id = a.id AND s.snapshot_date = $1
+)
+`
+
+func (q *Queries) ListAccountsWithoutSnapshot(ctx context.Context, snapshotDate pgtype.Date) ([]Account, error) {
+	rows, err := q.db.Query(ctx, listAccountsWithoutSnapshot, snapshotDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.AccountNumber,
+			&i.AccountType,
+			&i.Currency,
+			&i.Balance,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.LotMethod,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSnapshotsSince = `-- name: ListSnapshotsSince :many
+SELECT s.id, s.account_id, s.snapshot_date, s.currency, s.cash_balance, s.market_value, s.cost_basis, s.total_equity, s.net_flow, s.unpriced_holdings, s.taken_at FROM portfolio_snapshots s
+WHERE s.account_id = $1
+    AND s.snapshot_date >= COALESCE((
+        SELECT MAX(p.snapshot_date) FROM portfolio_snapshots p
+        WHERE p.account_id = $1 AND p.snapshot_date <= $2::DATE
+    ), $2::DATE)
+ORDER BY s.snapshot_date
+`
+
+type ListSnapshotsSinceParams struct {
+	AccountID uuid.UUID   `json:"account_id"`
+	Since     pgtype.Date `json:"since"`
+}
+
+// Starts from the last snapshot on or before since, which the returns of the period are measured from
+func (q *Queries) ListSnapshotsSince(ctx context.Context, arg ListSnapshotsSinceParams) ([]PortfolioSnapshot, error) {
+	rows, err := q.db.Query(ctx, listSnapshotsSince, arg.AccountID, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PortfolioSnapshot{}
+	for rows.Next() {
+		var i PortfolioSnapshot
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.SnapshotDate,
+			&i.Currency,
+			&i.CashBalance,
+			&i.MarketValue,
+			&i.CostBasis,
+			&i.TotalEquity,
+			&i.NetFlow,
+			&i.UnpricedHoldings,
+			&i.TakenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
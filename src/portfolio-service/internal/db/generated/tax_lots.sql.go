@@ -0,0 +1,371 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: tax_lots.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const closeTaxLot = `-- name: CloseTaxLot :one
+UPDATE tax_lots
+SET remaining_quantity = GREATEST(remaining_quantity - $1, 0),
+    closed_at = CASE
+        WHEN remaining_quantity - $1 <= 0 THEN NOW()
+        ELSE closed_at
+    END
+WHERE id = $2
+RETURNING id, account_id, symbol, order_id, quantity, remaining_quantity, cost_basis, opened_at, closed_at
+`
+
+type CloseTaxLotParams struct {
+	Quantity pgtype.Numeric `json:"quantity"`
+	ID       uuid.UUID      `json:"id"`
+}
+
+// Takes quantity off a lot, closing it once nothing remains
+func (q *Queries) CloseTaxLot(ctx context.Context, arg CloseTaxLotParams) (TaxLot, error) {
+	row := q.db.QueryRow(ctx, closeTaxLot, arg.Quantity, arg.ID)
+	var i TaxLot
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Symbol,
+		&i.OrderID,
+		&i.Quantity,
+		&i.RemainingQuantity,
+		&i.CostBasis,
+		&i.OpenedAt,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const deleteLotSelections = `-- name: DeleteLotSelections :exec
+DELETE FROM lot_selections
+WHERE order_id = $1
+`
+
+func (q *Queries) DeleteLotSelections(ctx context.Context, orderID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteLotSelections, orderID)
+	return err
+}
+
+const getLotSelections = `-- name: GetLotSelections :many
+SELECT lot_id FROM lot_selections
+WHERE order_id = $1
+ORDER BY position
+`
+
+func (q *Queries) GetLotSelections(ctx context.Context, orderID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getLotSelections, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var lot_id uuid.UUID
+		if err := rows.Scan(&lot_id); err != nil {
+			return nil, err
+		}
+		items = append(items, lot_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaxLotById = `-- name: GetTaxLotById :one
+SELECT id, account_id, symbol, order_id, quantity, remaining_quantity, cost_basis, opened_at, closed_at FROM tax_lots
+WHERE id = $1
+`
+
+func (q *Queries) GetTaxLotById(ctx context.Context, id uuid.UUID) (TaxLot, error) {
+	row := q.db.QueryRow(ctx, getTaxLotById, id)
+	var i TaxLot
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Symbol,
+		&i.OrderID,
+		&i.Quantity,
+		&i.RemainingQuantity,
+		&i.CostBasis,
+		&i.OpenedAt,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const insertLotSelection = `-- name: InsertLotSelection :exec
+INSERT INTO lot_selections (order_id, lot_id, position)
+VALUES ($1, $2, $3)
+`
+
+type InsertLotSelectionParams struct {
+	OrderID  uuid.UUID `json:"order_id"`
+	LotID    uuid.UUID `json:"lot_id"`
+	Position int32     `json:"position"`
+}
+
+func (q *Queries) InsertLotSelection(ctx context.Context, arg InsertLotSelectionParams) error {
+	_, err := q.db.Exec(ctx, insertLotSelection, arg.OrderID, arg.LotID, arg.Position)
+	return err
+}
+
+const insertRealizedGain = `-- name: InsertRealizedGain :one
+INSERT INTO realized_gains (account_id, symbol, lot_id, order_id, quantity, proceeds, cost_basis, realized_pnl, method, opened_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id, account_id, symbol, lot_id, order_id, quantity, proceeds, cost_basis, realized_pnl, method, opened_at, realized_at
+`
+
+type InsertRealizedGainParams struct {
+	AccountID   uuid.UUID          `json:"account_id"`
+	Symbol      string             `json:"symbol"`
+	LotID       *uuid.UUID         `json:"lot_id"`
+	OrderID     uuid.UUID          `json:"order_id"`
+	Quantity    pgtype.Numeric     `json:"quantity"`
+	Proceeds    pgtype.Numeric     `json:"proceeds"`
+	CostBasis   pgtype.Numeric     `json:"cost_basis"`
+	RealizedPnl pgtype.Numeric     `json:"realized_pnl"`
+	Method      LotMethod          `json:"method"`
+	OpenedAt    pgtype.Timestamptz `json:"opened_at"`
+}
+
+func (q *Queries) InsertRealizedGain(ctx context.Context, arg InsertRealizedGainParams) (RealizedGain, error) {
+	row := q.db.QueryRow(ctx, insertRealizedGain,
+		arg.AccountID,
+		arg.Symbol,
+		arg.LotID,
+		arg.OrderID,
+		arg.Quantity,
+		arg.Proceeds,
+		arg.CostBasis,
+		arg.RealizedPnl,
+		arg.Method,
+		arg.OpenedAt,
+	)
+	var i RealizedGain
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Symbol,
+		&i.LotID,
+		&i.OrderID,
+		&i.Quantity,
+		&i.Proceeds,
+		&i.CostBasis,
+		&i.RealizedPnl,
+		&i.Method,
+		&i.OpenedAt,
+		&i.RealizedAt,
+	)
+	return i, err
+}
+
+const insertTaxLot = `-- name: InsertTaxLot :one
+INSERT INTO tax_lots (account_id, symbol, order_id, quantity, remaining_quantity, cost_basis)
+VALUES ($1, $2, $3, $4, $4, $5)
+RETURNING id, account_id, symbol, order_id, quantity, remaining_quantity, cost_basis, opened_at, closed_at
+`
+
+type InsertTaxLotParams struct {
+	AccountID uuid.UUID      `json:"account_id"`
+	Symbol    string         `json:"symbol"`
+	OrderID   *uuid.UUID     `json:"order_id"`
+	Quantity  pgtype.Numeric `json:"quantity"`
+	CostBasis pgtype.Numeric `json:"cost_basis"`
+}
+
+func (q *Queries) InsertTaxLot(ctx context.Context, arg InsertTaxLotParams) (TaxLot, error) {
+	row := q.db.QueryRow(ctx, insertTaxLot,
+		arg.AccountID,
+		arg.Symbol,
+		arg.OrderID,
+		arg.Quantity,
+		arg.CostBasis,
+	)
+	var i TaxLot
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Symbol,
+		&i.OrderID,
+		&i.Quantity,
+		&i.RemainingQuantity,
+		&i.CostBasis,
+		&i.OpenedAt,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const listOpenTaxLotsForUpdate = `-- name: ListOpenTaxLotsForUpdate :many
+SELECT id, account_id, symbol, order_id, quantity, remaining_quantity, cost_basis, opened_at, closed_at FROM tax_lots
+WHERE account_id = $1 AND symbol = $2 AND remaining_quantity > 0
+ORDER BY opened_at, id
+FOR UPDATE
+`
+
+type ListOpenTaxLotsForUpdateParams struct {
+	AccountID uuid.UUID `json:"account_id"`
+	Symbol    string    `json:"symbol"`
+}
+
+// Oldest first; sells reorder them by the account's lot method
+func (q *Queries) ListOpenTaxLotsForUpdate(ctx context.Context, arg ListOpenTaxLotsForUpdateParams) ([]TaxLot, error) {
+	rows, err := q.db.Query(ctx, listOpenTaxLotsForUpdate, arg.AccountID, arg.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TaxLot{}
+	for rows.Next() {
+		var i TaxLot
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Symbol,
+			&i.OrderID,
+			&i.Quantity,
+			&i.RemainingQuantity,
+			&i.CostBasis,
+			&i.OpenedAt,
+			&i.ClosedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRealizedGains = `-- name: ListRealizedGains :many
+SELECT id, account_id, symbol, lot_id, order_id, quantity, proceeds, cost_basis, realized_pnl, method, opened_at, realized_at FROM realized_gains
+WHERE account_id = $1
+    AND ($2::TEXT = '' OR symbol = $2)
+    AND ($3::TIMESTAMPTZ IS NULL OR realized_at >= $3)
+    AND ($4::TIMESTAMPTZ IS NULL OR realized_at < $4)
+ORDER BY realized_at, id
+`
+
+type ListRealizedGainsParams struct {
+	AccountID    uuid.UUID          `json:"account_id"`
+	Symbol       string             `json:"symbol"`
+	RealizedFrom pgtype.Timestamptz `json:"realized_from"`
+	RealizedTo   pgtype.Timestamptz `json:"realized_to"`
+}
+
+func (q *Queries) ListRealizedGains(ctx context.Context, arg ListRealizedGainsParams) ([]RealizedGain, error) {
+	rows, err := q.db.Query(ctx, listRealizedGains,
+		arg.AccountID,
+		arg.Symbol,
+		arg.RealizedFrom,
+		arg.RealizedTo,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RealizedGain{}
+	for rows.Next() {
+		var i RealizedGain
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Symbol,
+			&i.LotID,
+			&i.OrderID,
+			&i.Quantity,
+			&i.Proceeds,
+			&i.CostBasis,
+			&i.RealizedPnl,
+			&i.Method,
+			&i.OpenedAt,
+			&i.RealizedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTaxLots = `-- name: ListTaxLots :many
+SELECT id, account_id, symbol, order_id, quantity, remaining_quantity, cost_basis, opened_at, closed_at FROM tax_lots
+WHERE account_id = $1
+    AND ($2::TEXT = '' OR symbol = $2)
+    AND ($3::BOOLEAN OR remaining_quantity > 0)
+ORDER BY symbol, opened_at, id
+`
+
+type ListTaxLotsParams struct {
+	AccountID     uuid.UUID `json:"account_id"`
+	Symbol        string    `json:"symbol"`
+	IncludeClosed bool      `json:"include_closed"`
+}
+
+func (q *Queries) ListTaxLots(ctx context.Context, arg ListTaxLotsParams) ([]TaxLot, error) {
+	rows, err := q.db.Query(ctx, listTaxLots, arg.AccountID, arg.Symbol, arg.IncludeClosed)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TaxLot{}
+	for rows.Next() {
+		var i TaxLot
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Symbol,
+			&i.OrderID,
+			&i.Quantity,
+			&i.RemainingQuantity,
+			&i.CostBasis,
+			&i.OpenedAt,
+			&i.ClosedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const syncHoldingAvgCost = `-- name: SyncHoldingAvgCost :exec
+UPDATE holdings
+SET avg_cost = lots.avg_cost, updated_at = NOW()
+FROM (
+    SELECT SUM(remaining_quantity * cost_basis) / SUM(remaining_quantity) AS avg_cost
+    FROM tax_lots
+    WHERE tax_lots.account_id = $1 AND tax_lots.symbol = $2 AND remaining_quantity > 0
+) lots
+WHERE holdings.account_id = $1 AND holdings.symbol = $2 AND lots.avg_cost IS NOT NULL
+`
+
+type SyncHoldingAvgCostParams struct {
+	AccountID uuid.UUID `json:"account_id"`
+	Symbol    string    `json:"symbol"`
+}
+
+// Sets the average cost of a holding to that of the lots it still has open, if any
+func (q *Queries) SyncHoldingAvgCost(ctx context.Context, arg SyncHoldingAvgCostParams) error {
+	_, err := q.db.Exec(ctx, syncHoldingAvgCost, arg.AccountID, arg.Symbol)
+	return err
+}
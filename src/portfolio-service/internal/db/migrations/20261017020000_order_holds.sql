@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+-- cash or shares set aside for an open order, so other orders cannot spend them as well
+CREATE TYPE hold_kind AS ENUM ('cash', 'shares');
+CREATE TYPE hold_status AS ENUM ('active', 'settled', 'released');
+
+CREATE TABLE holds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_id UUID NOT NULL UNIQUE, -- references orders table ID from orders-service
+    account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    kind hold_kind NOT NULL,
+    symbol VARCHAR(10) NOT NULL,
+    quantity NUMERIC(20,6) NOT NULL CHECK (quantity >= 0),
+    remaining_quantity NUMERIC(20,6) NOT NULL CHECK (remaining_quantity >= 0),
+    amount NUMERIC(20,6) NOT NULL CHECK (amount >= 0), -- in the account currency, 0 for share holds
+    remaining_amount NUMERIC(20,6) NOT NULL CHECK (remaining_amount >= 0),
+    status hold_status NOT NULL DEFAULT 'active',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- available balance and quantity only ever sum the active holds of one account
+CREATE INDEX holds_active_account_idx ON holds (account_id, kind, symbol) WHERE status = 'active';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS holds;
+DROP TYPE IF EXISTS hold_status;
+DROP TYPE IF EXISTS hold_kind;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- orders of one one-cancels-other group share what they reserve: at most one of them can fill
+ALTER TABLE holds ADD COLUMN group_id UUID;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE holds DROP COLUMN IF EXISTS group_id;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- profit of a sell against the average cost of the shares it sold, so daily loss limits can be checked
+ALTER TABLE transactions ADD COLUMN realized_pnl NUMERIC(20, 6);
+CREATE INDEX IF NOT EXISTS idx_transactions_account_created ON transactions(account_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_transactions_account_created;
+ALTER TABLE transactions DROP COLUMN IF EXISTS realized_pnl;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- commissions and charges of a fill, recorded next to the buy or sell they belong to
+ALTER TYPE transaction_type ADD VALUE IF NOT EXISTS 'fee';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- postgres cannot drop an enum value, so fees stay in the ledger and the type is kept
+SELECT 1;
+-- +goose StatementEnd
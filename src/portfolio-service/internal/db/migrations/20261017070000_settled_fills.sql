@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- one row per fill that settled, so a redelivered orders.filled event cannot settle twice
+CREATE TABLE IF NOT EXISTS settled_fills (
+    order_id UUID NOT NULL,
+    fill_sequence INT NOT NULL,
+    trade_id TEXT,
+    settled_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (order_id, fill_sequence)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS settled_fills;
+-- +goose StatementEnd
@@ -0,0 +1,60 @@
+-- +goose Up
+-- +goose StatementBegin
+-- how sells pick the tax lots they close, chosen per account
+CREATE TYPE lot_method AS ENUM ('fifo', 'lifo', 'specific', 'average_cost');
+ALTER TABLE accounts ADD COLUMN lot_method lot_method NOT NULL DEFAULT 'fifo';
+
+-- shares bought by one fill; sells close them by the account's lot method
+CREATE TABLE tax_lots (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    symbol VARCHAR(10) NOT NULL,
+    order_id UUID, -- the buy order, unset for lots opened from holdings that predate lots
+    quantity NUMERIC(20,6) NOT NULL CHECK (quantity > 0),
+    remaining_quantity NUMERIC(20,6) NOT NULL CHECK (remaining_quantity >= 0),
+    cost_basis NUMERIC(20,6) NOT NULL CHECK (cost_basis >= 0), -- per share, fees of the buy included
+    opened_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    closed_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_tax_lots_open ON tax_lots(account_id, symbol, opened_at) WHERE remaining_quantity > 0;
+
+-- one row per lot a sell closed (part of), with the profit measured against that lot
+CREATE TABLE realized_gains (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    symbol VARCHAR(10) NOT NULL,
+    lot_id UUID REFERENCES tax_lots(id) ON DELETE SET NULL, -- unset for shares no lot covered
+    order_id UUID NOT NULL, -- the sell order
+    quantity NUMERIC(20,6) NOT NULL,
+    proceeds NUMERIC(20,6) NOT NULL, -- after the sell's fees
+    cost_basis NUMERIC(20,6) NOT NULL,
+    realized_pnl NUMERIC(20,6) NOT NULL,
+    method lot_method NOT NULL,
+    opened_at TIMESTAMPTZ, -- when the lot was opened
+    realized_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_realized_gains_account_realized ON realized_gains(account_id, realized_at);
+
+-- lots a sell order closes first, for accounts using specific-lot identification
+CREATE TABLE lot_selections (
+    order_id UUID NOT NULL,
+    lot_id UUID NOT NULL REFERENCES tax_lots(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    PRIMARY KEY (order_id, lot_id)
+);
+
+-- shares held before lots existed become one lot per holding, at its average cost
+INSERT INTO tax_lots (account_id, symbol, quantity, remaining_quantity, cost_basis, opened_at)
+SELECT account_id, symbol, quantity, quantity, avg_cost, created_at
+FROM holdings
+WHERE quantity > 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS lot_selections;
+DROP TABLE IF EXISTS realized_gains;
+DROP TABLE IF EXISTS tax_lots;
+ALTER TABLE accounts DROP COLUMN IF EXISTS lot_method;
+DROP TYPE IF EXISTS lot_method;
+-- +goose StatementEnd
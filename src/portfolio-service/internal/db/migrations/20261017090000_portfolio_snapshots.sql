@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+-- what each account held and was worth at the close of a trading day, for performance over time
+CREATE TABLE portfolio_snapshots (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    snapshot_date DATE NOT NULL, -- the trading day whose close it was taken after
+    currency currency_type NOT NULL,
+    cash_balance NUMERIC(20,6) NOT NULL,
+    market_value NUMERIC(20,6) NOT NULL,
+    cost_basis NUMERIC(20,6) NOT NULL,
+    total_equity NUMERIC(20,6) NOT NULL,
+    net_flow NUMERIC(20,6) NOT NULL DEFAULT 0, -- deposits and transfers since the previous snapshot
+    unpriced_holdings INT NOT NULL DEFAULT 0, -- holdings valued at cost for lack of a quote or rate
+    taken_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (account_id, snapshot_date)
+);
+
+CREATE TABLE portfolio_snapshot_holdings (
+    snapshot_id UUID NOT NULL REFERENCES portfolio_snapshots(id) ON DELETE CASCADE,
+    symbol VARCHAR(10) NOT NULL,
+    quantity NUMERIC(20,6) NOT NULL,
+    avg_cost NUMERIC(20,6) NOT NULL,
+    last_price NUMERIC(20,6) NOT NULL, -- in the quote currency
+    exchange_rate NUMERIC(20,10) NOT NULL,
+    market_value NUMERIC(20,6) NOT NULL,
+    priced BOOLEAN NOT NULL,
+    PRIMARY KEY (snapshot_id, symbol)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS portfolio_snapshot_holdings;
+DROP TABLE IF EXISTS portfolio_snapshots;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- corrections of a balance its history does not explain, booked when the ledger replaced the transactions table;
+-- added on its own so that the ledger migration can use it
+ALTER TYPE transaction_type ADD VALUE IF NOT EXISTS 'adjustment';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- postgres cannot drop an enum value, so the type is kept
+SELECT 1;
+-- +goose StatementEnd
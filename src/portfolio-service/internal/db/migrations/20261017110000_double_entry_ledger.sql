@@ -0,0 +1,201 @@
+-- +goose Up
+-- +goose StatementBegin
+-- cash accounts belong to one portfolio account each; the rest are the system's side of deposits, fills and
+-- fees, one per currency
+CREATE TYPE ledger_account_kind AS ENUM ('cash', 'funding', 'clearing', 'fees', 'adjustments');
+CREATE TYPE journal_entry_type AS ENUM ('deposit', 'transfer', 'buy', 'sell', 'fee', 'adjustment');
+
+CREATE TABLE ledger_accounts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    kind ledger_account_kind NOT NULL,
+    -- unset for system accounts, and for the cash of deleted accounts, which stays so the journal keeps balancing
+    account_id UUID UNIQUE REFERENCES accounts(id) ON DELETE SET NULL,
+    currency currency_type NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (kind = 'cash' OR account_id IS NULL)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_ledger_accounts_system ON ledger_accounts(kind, currency) WHERE kind <> 'cash';
+
+-- one movement of money, e.g. a deposit or the settlement of a fill
+CREATE TABLE journal_entries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entry_type journal_entry_type NOT NULL,
+    description TEXT NOT NULL,
+    reference_id UUID, -- the order of a fill or its fees
+    realized_pnl NUMERIC(20,6), -- set for sells
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_journal_entries_created ON journal_entries(created_at);
+
+-- the debits (positive) and credits (negative) of an entry, which sum to zero per currency
+CREATE TABLE ledger_postings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entry_id UUID NOT NULL REFERENCES journal_entries(id),
+    ledger_account_id UUID NOT NULL REFERENCES ledger_accounts(id),
+    amount NUMERIC(20,6) NOT NULL,
+    memo TEXT -- what the account sees in place of the entry's description, e.g. either side of a transfer
+);
+CREATE INDEX IF NOT EXISTS idx_ledger_postings_entry ON ledger_postings(entry_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_postings_account ON ledger_postings(ledger_account_id);
+
+-- checked at commit, once every posting of the entry is in; an update or delete checks the entry the posting
+-- left as well as the one it is in
+CREATE FUNCTION check_journal_entry_balanced() RETURNS trigger AS $$
+DECLARE
+    unbalanced UUID;
+BEGIN
+    SELECT p.entry_id INTO unbalanced
+    FROM ledger_postings p
+    JOIN ledger_accounts a ON a.id = p.ledger_account_id
+    WHERE p.entry_id IN (NEW.entry_id, OLD.entry_id)
+    GROUP BY p.entry_id, a.currency
+    HAVING SUM(p.amount) <> 0
+    LIMIT 1;
+
+    IF unbalanced IS NOT NULL THEN
+        RAISE EXCEPTION 'journal entry % does not balance', unbalanced;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER journal_entry_balanced
+AFTER INSERT OR UPDATE OR DELETE ON ledger_postings
+DEFERRABLE INITIALLY DEFERRED
+FOR EACH ROW EXECUTE FUNCTION check_journal_entry_balanced();
+
+INSERT INTO ledger_accounts (kind, account_id, currency)
+SELECT 'cash', id, currency FROM accounts;
+
+INSERT INTO ledger_accounts (kind, currency)
+SELECT kind, currency
+FROM unnest(enum_range(NULL::ledger_account_kind)) AS k(kind), unnest(enum_range(NULL::currency_type)) AS c(currency)
+WHERE kind <> 'cash';
+
+-- both sides of a transfer were written in one transaction, so they share their time
+CREATE TEMPORARY TABLE transfer_pairs AS
+SELECT DISTINCT ON (o.id) o.id AS out_id, i.id AS in_id
+FROM transactions o
+JOIN transactions i ON i.transaction_type = 'transfer_in'
+    AND i.account_id = o.reference_id AND i.reference_id = o.account_id
+    AND i.amount = o.amount AND i.created_at = o.created_at
+WHERE o.transaction_type = 'transfer_out'
+ORDER BY o.id, i.id;
+
+-- each transaction becomes an entry, a transfer taking the id of its outgoing side; a transfer whose other side
+-- is gone came from or went to outside, like a deposit
+INSERT INTO journal_entries (id, entry_type, description, reference_id, realized_pnl, created_at)
+SELECT t.id,
+    (CASE WHEN t.transaction_type IN ('transfer_in', 'transfer_out') THEN 'transfer' ELSE t.transaction_type::TEXT END)::journal_entry_type,
+    t.description,
+    CASE WHEN t.transaction_type IN ('transfer_in', 'transfer_out') THEN NULL ELSE t.reference_id END,
+    t.realized_pnl,
+    t.created_at
+FROM transactions t
+WHERE t.id NOT IN (SELECT in_id FROM transfer_pairs);
+
+-- the account's side keeps the id of its transaction
+INSERT INTO ledger_postings (id, entry_id, ledger_account_id, amount, memo)
+SELECT t.id,
+    COALESCE(tp.out_id, t.id),
+    la.id,
+    CASE WHEN t.transaction_type IN ('deposit', 'sell', 'transfer_in') THEN t.amount ELSE -t.amount END,
+    t.description
+FROM transactions t
+JOIN ledger_accounts la ON la.account_id = t.account_id
+LEFT JOIN transfer_pairs tp ON tp.in_id = t.id;
+
+-- and the system takes the other side of whatever did not move between two accounts
+INSERT INTO ledger_postings (entry_id, ledger_account_id, amount)
+SELECT t.id,
+    sa.id,
+    CASE WHEN t.transaction_type IN ('deposit', 'sell', 'transfer_in') THEN -t.amount ELSE t.amount END
+FROM transactions t
+JOIN accounts a ON a.id = t.account_id
+JOIN ledger_accounts sa ON sa.currency = a.currency AND sa.kind = (
+    CASE t.transaction_type
+        WHEN 'buy' THEN 'clearing'
+        WHEN 'sell' THEN 'clearing'
+        WHEN 'fee' THEN 'fees'
+        ELSE 'funding'
+    END)::ledger_account_kind
+WHERE t.id NOT IN (SELECT out_id FROM transfer_pairs)
+    AND t.id NOT IN (SELECT in_id FROM transfer_pairs);
+
+-- balances the history does not explain are booked once, so every balance starts out as the sum of its ledger
+CREATE TEMPORARY TABLE balance_adjustments AS
+SELECT gen_random_uuid() AS entry_id, a.id AS account_id, la.id AS cash_id, sa.id AS adjustments_id,
+    a.balance - COALESCE((SELECT SUM(p.amount) FROM ledger_postings p WHERE p.ledger_account_id = la.id), 0) AS amount
+FROM accounts a
+JOIN ledger_accounts la ON la.account_id = a.id
+JOIN ledger_accounts sa ON sa.kind = 'adjustments' AND sa.currency = a.currency;
+
+DELETE FROM balance_adjustments WHERE amount = 0;
+
+INSERT INTO journal_entries (id, entry_type, description)
+SELECT entry_id, 'adjustment', 'Balance carried over from before the ledger'
+FROM balance_adjustments;
+
+INSERT INTO ledger_postings (entry_id, ledger_account_id, amount)
+SELECT entry_id, cash_id, amount FROM balance_adjustments
+UNION ALL
+SELECT entry_id, adjustments_id, -amount FROM balance_adjustments;
+
+DROP TABLE transfer_pairs;
+DROP TABLE balance_adjustments;
+
+DROP TABLE transactions;
+
+-- what each account saw of the journal, in the shape of the table it replaced
+CREATE VIEW transactions AS
+SELECT
+    p.id,
+    a.id AS account_id,
+    (CASE
+        WHEN e.entry_type = 'transfer' AND p.amount < 0 THEN 'transfer_out'
+        WHEN e.entry_type = 'transfer' THEN 'transfer_in'
+        ELSE e.entry_type::TEXT
+    END)::transaction_type AS transaction_type,
+    ABS(p.amount)::NUMERIC(20,6) AS amount,
+    COALESCE(p.memo, e.description) AS description,
+    -- transfers reference the account on the other side
+    COALESCE(e.reference_id, (
+        SELECT oa.account_id FROM ledger_postings o
+        JOIN ledger_accounts oa ON oa.id = o.ledger_account_id
+        WHERE e.entry_type = 'transfer' AND o.entry_id = e.id AND o.id <> p.id
+        LIMIT 1
+    )) AS reference_id,
+    e.created_at,
+    e.realized_pnl
+FROM ledger_postings p
+JOIN journal_entries e ON e.id = p.entry_id
+JOIN ledger_accounts la ON la.id = p.ledger_account_id
+JOIN accounts a ON a.id = la.account_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE TABLE transactions_restored AS SELECT * FROM transactions;
+DROP VIEW transactions;
+ALTER TABLE transactions_restored RENAME TO transactions;
+ALTER TABLE transactions
+    ADD PRIMARY KEY (id),
+    ADD FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+    ADD CHECK (amount >= 0),
+    ALTER COLUMN id SET DEFAULT gen_random_uuid(),
+    ALTER COLUMN account_id SET NOT NULL,
+    ALTER COLUMN transaction_type SET NOT NULL,
+    ALTER COLUMN amount SET NOT NULL,
+    ALTER COLUMN description SET NOT NULL,
+    ALTER COLUMN created_at SET NOT NULL,
+    ALTER COLUMN created_at SET DEFAULT NOW();
+CREATE INDEX IF NOT EXISTS idx_transactions_account_created ON transactions(account_id, created_at);
+
+DROP TRIGGER IF EXISTS journal_entry_balanced ON ledger_postings;
+DROP FUNCTION IF EXISTS check_journal_entry_balanced();
+DROP TABLE IF EXISTS ledger_postings;
+DROP TABLE IF EXISTS journal_entries;
+DROP TABLE IF EXISTS ledger_accounts;
+DROP TYPE IF EXISTS journal_entry_type;
+DROP TYPE IF EXISTS ledger_account_kind;
+-- +goose StatementEnd
@@ -5,9 +5,15 @@ SELECT * FROM accounts WHERE user_id = $1;
 SELECT * FROM accounts WHERE id = $1;
 
 -- name: InsertAccount :one
-INSERT INTO accounts (user_id, account_number, account_type, currency, balance)
-VALUES ( $1, $2, $3, $4, $5)
+INSERT INTO accounts (user_id, account_number, account_type, currency, balance, lot_method)
+VALUES ( $1, $2, $3, $4, $5, $6)
 RETURNING *;
 
 -- name: DeleteAccount :exec
 DELETE FROM accounts WHERE id = $1;
+
+-- name: SetAccountLotMethod :one
+UPDATE accounts
+SET lot_method = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
@@ -1,9 +1,20 @@
--- name: InsertAuditLog :one
-INSERT INTO transactions ( account_id, transaction_type, amount, description, reference_id
-) VALUES ( $1, $2, $3, $4, $5)
-RETURNING *;
-
 -- name: GetTransactionsByAccountId :many
 SELECT * FROM transactions
 WHERE account_id = $1
 ORDER BY created_at DESC;
+
+-- name: GetRealizedPnlSince :one
+SELECT COALESCE(SUM(realized_pnl), 0)::NUMERIC AS realized_pnl
+FROM transactions
+WHERE account_id = $1 AND created_at >= $2 AND realized_pnl IS NOT NULL;
+
+-- name: GetTotalFeesByAccountId :one
+SELECT COALESCE(SUM(amount), 0)::NUMERIC AS total_fees
+FROM transactions
+WHERE account_id = $1 AND transaction_type = 'fee';
+
+-- name: GetTotalFeesByUserId :one
+SELECT COALESCE(SUM(t.amount), 0)::NUMERIC AS total_fees
+FROM transactions t
+JOIN accounts a ON a.id = t.account_id
+WHERE a.user_id = $1 AND t.transaction_type = 'fee';
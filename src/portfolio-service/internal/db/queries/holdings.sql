@@ -20,3 +20,4 @@ SET quantity = $3,
     updated_at = NOW()
 WHERE account_id = $1 AND symbol = $2
 RETURNING *;
+
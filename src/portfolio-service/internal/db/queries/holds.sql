@@ -0,0 +1,96 @@
+-- name: LockAccount :one
+-- Serializes hold placement per account
+SELECT * FROM accounts
+WHERE id = $1
+FOR UPDATE;
+
+-- name: GetHoldByOrderId :one
+SELECT * FROM holds
+WHERE order_id = $1;
+
+-- name: InsertHold :one
+INSERT INTO holds (order_id, account_id, kind, symbol, quantity, remaining_quantity, amount, remaining_amount, group_id)
+VALUES ($1, $2, $3, $4, $5, $5, $6, $6, $7)
+RETURNING *;
+
+-- name: GetReservedBalance :one
+-- Holds of one order group overlap, so a group only reserves its largest hold
+SELECT COALESCE(SUM(reserved), 0)::NUMERIC AS reserved
+FROM (
+    SELECT MAX(remaining_amount) AS reserved
+    FROM holds
+    WHERE account_id = $1 AND kind = 'cash' AND status = 'active'
+    GROUP BY COALESCE(group_id, order_id)
+) grouped;
+
+-- name: GetReservedQuantity :one
+SELECT COALESCE(SUM(reserved), 0)::NUMERIC AS reserved
+FROM (
+    SELECT MAX(remaining_quantity) AS reserved
+    FROM holds
+    WHERE account_id = $1 AND symbol = $2 AND kind = 'shares' AND status = 'active'
+    GROUP BY COALESCE(group_id, order_id)
+) grouped;
+
+-- name: GetReservedQuantitiesByAccountId :many
+SELECT symbol, SUM(reserved)::NUMERIC AS reserved
+FROM (
+    SELECT symbol, MAX(remaining_quantity) AS reserved
+    FROM holds
+    WHERE account_id = $1 AND kind = 'shares' AND status = 'active'
+    GROUP BY symbol, COALESCE(group_id, order_id)
+) grouped
+GROUP BY symbol;
+
+-- name: ConsumeHold :one
+-- Used when a fill settles part of a held order; the hold settles once its quantity is used up
+UPDATE holds
+SET remaining_quantity = GREATEST(remaining_quantity - sqlc.arg('quantity'), 0),
+    remaining_amount = CASE
+        WHEN remaining_quantity - sqlc.arg('quantity') <= 0 THEN 0
+        ELSE GREATEST(remaining_amount - sqlc.arg('amount'), 0)
+    END,
+    status = CASE
+        WHEN remaining_quantity - sqlc.arg('quantity') <= 0 THEN 'settled'::hold_status
+        ELSE status
+    END,
+    updated_at = NOW()
+WHERE order_id = sqlc.arg('order_id') AND status = 'active'
+RETURNING *;
+
+-- name: ReleaseHold :one
+UPDATE holds
+SET remaining_quantity = 0,
+    remaining_amount = 0,
+    status = 'released',
+    updated_at = NOW()
+WHERE order_id = $1 AND status = 'active'
+RETURNING *;
+
+-- name: GetGroupReservation :one
+-- What the active holds of an order group already reserve for one kind
+SELECT COALESCE(MAX(remaining_quantity), 0)::NUMERIC AS reserved_quantity,
+       COALESCE(MAX(remaining_amount), 0)::NUMERIC AS reserved_amount
+FROM holds
+WHERE group_id = $1 AND kind = $2 AND status = 'active';
+
+-- name: CountActiveHolds :one
+-- Every accepted order holds something until it closes, so this counts the account's open orders
+SELECT COUNT(DISTINCT order_id)::INT AS open_orders
+FROM holds
+WHERE account_id = $1 AND status = 'active'
+  AND order_id <> ALL(sqlc.arg('excluded_order_ids')::UUID[]);
+
+-- name: ResizeHold :one
+-- Used when an order is amended; the original quantity and amount move by the same change, and what the hold
+-- still reserves never goes below zero
+UPDATE holds
+SET quantity = quantity + sqlc.arg('quantity_change'),
+    amount = amount + sqlc.arg('amount_change'),
+    remaining_quantity = remaining_quantity + sqlc.arg('quantity_change'),
+    remaining_amount = remaining_amount + sqlc.arg('amount_change'),
+    updated_at = NOW()
+WHERE order_id = sqlc.arg('order_id') AND status = 'active'
+  AND remaining_quantity + sqlc.arg('quantity_change') >= 0
+  AND remaining_amount + sqlc.arg('amount_change') >= 0
+RETURNING *;
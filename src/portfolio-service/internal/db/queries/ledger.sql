@@ -0,0 +1,49 @@
+-- name: InsertLedgerAccount :one
+INSERT INTO ledger_accounts (kind, account_id, currency)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetCashLedgerAccount :one
+SELECT * FROM ledger_accounts WHERE account_id = $1;
+
+-- name: GetSystemLedgerAccount :one
+SELECT * FROM ledger_accounts WHERE kind = $1 AND currency = $2 AND account_id IS NULL;
+
+-- name: InsertJournalEntry :one
+INSERT INTO journal_entries (entry_type, description, reference_id, realized_pnl)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: InsertLedgerPosting :exec
+INSERT INTO ledger_postings (entry_id, ledger_account_id, amount, memo)
+VALUES ($1, $2, $3, $4);
+
+-- name: UpdateAccountBalance :one
+-- Only posting to the account's cash moves its balance, so that it stays the sum of its ledger; the balance before
+-- the posting comes back with it, for checking the posting moved it by exactly its amount
+UPDATE accounts a
+SET
+    balance = a.balance + $2,
+    updated_at = NOW()
+FROM (SELECT b.id, b.balance FROM accounts b WHERE b.id = $1 FOR UPDATE) previous
+WHERE a.id = previous.id
+RETURNING a.id, a.account_number, a.balance, previous.balance AS previous_balance;
+
+-- name: ListBalanceMismatches :many
+SELECT a.id, a.account_number, a.balance, COALESCE(SUM(p.amount), 0)::NUMERIC AS ledger_balance
+FROM accounts a
+LEFT JOIN ledger_accounts la ON la.account_id = a.id
+LEFT JOIN ledger_postings p ON p.ledger_account_id = la.id
+GROUP BY a.id, a.account_number, a.balance
+HAVING a.balance <> COALESCE(SUM(p.amount), 0)
+ORDER BY a.account_number;
+
+-- name: ListUnbalancedEntries :many
+-- The commit trigger keeps these out; they can only come from writes that bypassed it
+SELECT e.id, e.entry_type, e.created_at, la.currency, SUM(p.amount)::NUMERIC AS imbalance
+FROM journal_entries e
+JOIN ledger_postings p ON p.entry_id = e.id
+JOIN ledger_accounts la ON la.id = p.ledger_account_id
+GROUP BY e.id, e.entry_type, e.created_at, la.currency
+HAVING SUM(p.amount) <> 0
+ORDER BY e.created_at;
@@ -0,0 +1,5 @@
+-- name: InsertSettledFill :execrows
+-- Claims a fill for settlement; no row means it has already settled
+INSERT INTO settled_fills (order_id, fill_sequence, trade_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (order_id, fill_sequence) DO NOTHING;
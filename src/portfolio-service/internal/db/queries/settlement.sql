@@ -1,11 +1,3 @@
--- name: UpdateAccountBalance :one
-UPDATE accounts
-SET 
-    balance = balance + $2, 
-    updated_at = NOW()
-WHERE id = $1
-RETURNING *;
-
 -- name: UpsertHolding :one
 INSERT INTO holdings (account_id, symbol, quantity, avg_cost, created_at, updated_at)
 VALUES ($1, $2, $3, $4, NOW(), NOW())
@@ -0,0 +1,46 @@
+-- name: ListAccountsWithoutSnapshot :many
+SELECT * FROM accounts a
+WHERE NOT EXISTS (
+    SELECT 1 FROM portfolio_snapshots s
+    WHERE s.account_id = a.id AND s.snapshot_date = $1
+);
+
+-- name: GetLatestSnapshot :one
+SELECT * FROM portfolio_snapshots
+WHERE account_id = $1
+ORDER BY snapshot_date DESC
+LIMIT 1;
+
+-- name: GetNetFlowsBetween :one
+-- Cash that entered (or left) the account from outside it; trades and fees move value within it
+SELECT COALESCE(SUM(
+    CASE transaction_type
+        WHEN 'transfer_out' THEN -amount
+        ELSE amount
+    END
+), 0)::NUMERIC AS net_flow
+FROM transactions
+WHERE account_id = $1
+    AND transaction_type IN ('deposit', 'transfer_in', 'transfer_out')
+    AND created_at > sqlc.arg('after') AND created_at <= sqlc.arg('until');
+
+-- name: InsertPortfolioSnapshot :one
+-- No row when another instance already took the account's snapshot of the day
+INSERT INTO portfolio_snapshots (account_id, snapshot_date, currency, cash_balance, market_value, cost_basis, total_equity, net_flow, unpriced_holdings, taken_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (account_id, snapshot_date) DO NOTHING
+RETURNING *;
+
+-- name: InsertSnapshotHolding :exec
+INSERT INTO portfolio_snapshot_holdings (snapshot_id, symbol, quantity, avg_cost, last_price, exchange_rate, market_value, priced)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: ListSnapshotsSince :many
+-- Starts from the last snapshot on or before since, which the returns of the period are measured from
+SELECT s.* FROM portfolio_snapshots s
+WHERE s.account_id = sqlc.arg('account_id')
+    AND s.snapshot_date >= COALESCE((
+        SELECT MAX(p.snapshot_date) FROM portfolio_snapshots p
+        WHERE p.account_id = sqlc.arg('account_id') AND p.snapshot_date <= sqlc.arg('since')::DATE
+    ), sqlc.arg('since')::DATE)
+ORDER BY s.snapshot_date;
@@ -0,0 +1,70 @@
+-- name: InsertTaxLot :one
+INSERT INTO tax_lots (account_id, symbol, order_id, quantity, remaining_quantity, cost_basis)
+VALUES ($1, $2, $3, $4, $4, $5)
+RETURNING *;
+
+-- name: ListOpenTaxLotsForUpdate :many
+-- Oldest first; sells reorder them by the account's lot method
+SELECT * FROM tax_lots
+WHERE account_id = $1 AND symbol = $2 AND remaining_quantity > 0
+ORDER BY opened_at, id
+FOR UPDATE;
+
+-- name: CloseTaxLot :one
+-- Takes quantity off a lot, closing it once nothing remains
+UPDATE tax_lots
+SET remaining_quantity = GREATEST(remaining_quantity - sqlc.arg('quantity'), 0),
+    closed_at = CASE
+        WHEN remaining_quantity - sqlc.arg('quantity') <= 0 THEN NOW()
+        ELSE closed_at
+    END
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: ListTaxLots :many
+SELECT * FROM tax_lots
+WHERE account_id = sqlc.arg('account_id')
+    AND (sqlc.arg('symbol')::TEXT = '' OR symbol = sqlc.arg('symbol'))
+    AND (sqlc.arg('include_closed')::BOOLEAN OR remaining_quantity > 0)
+ORDER BY symbol, opened_at, id;
+
+-- name: SyncHoldingAvgCost :exec
+-- Sets the average cost of a holding to that of the lots it still has open, if any
+UPDATE holdings
+SET avg_cost = lots.avg_cost, updated_at = NOW()
+FROM (
+    SELECT SUM(remaining_quantity * cost_basis) / SUM(remaining_quantity) AS avg_cost
+    FROM tax_lots
+    WHERE tax_lots.account_id = $1 AND tax_lots.symbol = $2 AND remaining_quantity > 0
+) lots
+WHERE holdings.account_id = $1 AND holdings.symbol = $2 AND lots.avg_cost IS NOT NULL;
+
+-- name: InsertRealizedGain :one
+INSERT INTO realized_gains (account_id, symbol, lot_id, order_id, quantity, proceeds, cost_basis, realized_pnl, method, opened_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING *;
+
+-- name: ListRealizedGains :many
+SELECT * FROM realized_gains
+WHERE account_id = sqlc.arg('account_id')
+    AND (sqlc.arg('symbol')::TEXT = '' OR symbol = sqlc.arg('symbol'))
+    AND (sqlc.narg('realized_from')::TIMESTAMPTZ IS NULL OR realized_at >= sqlc.narg('realized_from'))
+    AND (sqlc.narg('realized_to')::TIMESTAMPTZ IS NULL OR realized_at < sqlc.narg('realized_to'))
+ORDER BY realized_at, id;
+
+-- name: InsertLotSelection :exec
+INSERT INTO lot_selections (order_id, lot_id, position)
+VALUES ($1, $2, $3);
+
+-- name: DeleteLotSelections :exec
+DELETE FROM lot_selections
+WHERE order_id = $1;
+
+-- name: GetLotSelections :many
+SELECT lot_id FROM lot_selections
+WHERE order_id = $1
+ORDER BY position;
+
+-- name: GetTaxLotById :one
+SELECT * FROM tax_lots
+WHERE id = $1;
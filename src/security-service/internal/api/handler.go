@@ -101,6 +101,28 @@ func (h *SecurityHandler) CheckPermission(ctx context.Context, req *pb.CheckPerm
 	}, nil
 }
 
+// GetUserRoles implements the gRPC GetUserRoles method, in the order the roles were granted
+func (h *SecurityHandler) GetUserRoles(ctx context.Context, req *pb.GetUserRolesRequest) (*pb.GetUserRolesResponse, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return &pb.GetUserRolesResponse{
+			Code: basepb.ErrorCode_INVALID_ARGUMENT,
+		}, err
+	}
+
+	roles, err := h.db.GetQueries().GetUserRoles(ctx, userId)
+	if err != nil {
+		return &pb.GetUserRolesResponse{
+			Code: basepb.ErrorCode_INTERNAL,
+		}, err
+	}
+
+	return &pb.GetUserRolesResponse{
+		Roles: roles,
+		Code:  basepb.ErrorCode_OK,
+	}, nil
+}
+
 func (h *SecurityHandler) RegisterSubscribeHandlers() {
 	_, err := h.natsClient.QueueSubscribe("users.>", "security-service-main", "security-users-consumer", h.handleUserEvents)
 	if err != nil {
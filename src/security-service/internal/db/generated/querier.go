@@ -13,6 +13,7 @@ import (
 type Querier interface {
 	CheckUserPermission(ctx context.Context, arg CheckUserPermissionParams) (bool, error)
 	DeleteUserRoleWithID(ctx context.Context, userID uuid.UUID) error
+	GetUserRoles(ctx context.Context, userID uuid.UUID) ([]string, error)
 	// this is for seeding
 	InsertUserRoleWithID(ctx context.Context, arg InsertUserRoleWithIDParams) (InsertUserRoleWithIDRow, error)
 }
@@ -42,6 +42,32 @@ func (q *Queries) DeleteUserRoleWithID(ctx context.Context, userID uuid.UUID) er
 	return err
 }
 
+const getUserRoles = `-- name: GetUserRoles :many
+SELECT role_name FROM users_roles
+WHERE user_id = $1
+ORDER BY granted_at, role_name
+`
+
+func (q *Queries) GetUserRoles(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	rows, err := q.db.Query(ctx, getUserRoles, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var role_name string
+		if err := rows.Scan(&role_name); err != nil {
+			return nil, err
+		}
+		items = append(items, role_name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const insertUserRoleWithID = `-- name: InsertUserRoleWithID :one
 INSERT INTO users_roles (user_id, role_name)
 VALUES ($1, $2)
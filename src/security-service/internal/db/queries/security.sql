@@ -15,3 +15,8 @@ SELECT EXISTS (
 -- name: DeleteUserRoleWithID :exec
 DELETE FROM users_roles
 WHERE user_id = $1;
+
+-- name: GetUserRoles :many
+SELECT role_name FROM users_roles
+WHERE user_id = $1
+ORDER BY granted_at, role_name;
@@ -80,6 +80,8 @@ const (
 	OrderType_ORDER_TYPE_LIMIT       OrderType = 2
 	OrderType_ORDER_TYPE_STOP        OrderType = 3
 	OrderType_ORDER_TYPE_STOP_LIMIT  OrderType = 4
+	// stop whose trigger follows the best price by trail_amount or trail_percent, then executes at market
+	OrderType_ORDER_TYPE_TRAILING_STOP OrderType = 5
 )
 
 // Enum value maps for OrderType.
@@ -90,13 +92,15 @@ var (
 		2: "ORDER_TYPE_LIMIT",
 		3: "ORDER_TYPE_STOP",
 		4: "ORDER_TYPE_STOP_LIMIT",
+		5: "ORDER_TYPE_TRAILING_STOP",
 	}
 	OrderType_value = map[string]int32{
-		"ORDER_TYPE_UNSPECIFIED": 0,
-		"ORDER_TYPE_MARKET":      1,
-		"ORDER_TYPE_LIMIT":       2,
-		"ORDER_TYPE_STOP":        3,
-		"ORDER_TYPE_STOP_LIMIT":  4,
+		"ORDER_TYPE_UNSPECIFIED":   0,
+		"ORDER_TYPE_MARKET":        1,
+		"ORDER_TYPE_LIMIT":         2,
+		"ORDER_TYPE_STOP":          3,
+		"ORDER_TYPE_STOP_LIMIT":    4,
+		"ORDER_TYPE_TRAILING_STOP": 5,
 	}
 )
 
@@ -136,6 +140,11 @@ const (
 	OrderStatus_ORDER_STATUS_FILLED       OrderStatus = 3
 	OrderStatus_ORDER_STATUS_CANCELED     OrderStatus = 4
 	OrderStatus_ORDER_STATUS_REJECTED     OrderStatus = 5
+	OrderStatus_ORDER_STATUS_EXPIRED      OrderStatus = 6
+	// bracket exit waiting for its entry to fill
+	OrderStatus_ORDER_STATUS_HELD OrderStatus = 7
+	// the user asked to cancel; the order keeps working until the engine confirms or denies the cancel
+	OrderStatus_ORDER_STATUS_PENDING_CANCEL OrderStatus = 8
 )
 
 // Enum value maps for OrderStatus.
@@ -147,14 +156,20 @@ var (
 		3: "ORDER_STATUS_FILLED",
 		4: "ORDER_STATUS_CANCELED",
 		5: "ORDER_STATUS_REJECTED",
+		6: "ORDER_STATUS_EXPIRED",
+		7: "ORDER_STATUS_HELD",
+		8: "ORDER_STATUS_PENDING_CANCEL",
 	}
 	OrderStatus_value = map[string]int32{
-		"ORDER_STATUS_UNSPECIFIED":  0,
-		"ORDER_STATUS_PENDING":      1,
-		"ORDER_STATUS_PARTIAL_FILL": 2,
-		"ORDER_STATUS_FILLED":       3,
-		"ORDER_STATUS_CANCELED":     4,
-		"ORDER_STATUS_REJECTED":     5,
+		"ORDER_STATUS_UNSPECIFIED":    0,
+		"ORDER_STATUS_PENDING":        1,
+		"ORDER_STATUS_PARTIAL_FILL":   2,
+		"ORDER_STATUS_FILLED":         3,
+		"ORDER_STATUS_CANCELED":       4,
+		"ORDER_STATUS_REJECTED":       5,
+		"ORDER_STATUS_EXPIRED":        6,
+		"ORDER_STATUS_HELD":           7,
+		"ORDER_STATUS_PENDING_CANCEL": 8,
 	}
 )
 
@@ -185,6 +200,223 @@ func (OrderStatus) EnumDescriptor() ([]byte, []int) {
 	return file_order_proto_rawDescGZIP(), []int{2}
 }
 
+type TimeInForce int32
+
+const (
+	TimeInForce_TIME_IN_FORCE_UNSPECIFIED TimeInForce = 0
+	TimeInForce_TIME_IN_FORCE_DAY         TimeInForce = 1
+	TimeInForce_TIME_IN_FORCE_GTC         TimeInForce = 2
+	TimeInForce_TIME_IN_FORCE_IOC         TimeInForce = 3
+	TimeInForce_TIME_IN_FORCE_FOK         TimeInForce = 4
+)
+
+// Enum value maps for TimeInForce.
+var (
+	TimeInForce_name = map[int32]string{
+		0: "TIME_IN_FORCE_UNSPECIFIED",
+		1: "TIME_IN_FORCE_DAY",
+		2: "TIME_IN_FORCE_GTC",
+		3: "TIME_IN_FORCE_IOC",
+		4: "TIME_IN_FORCE_FOK",
+	}
+	TimeInForce_value = map[string]int32{
+		"TIME_IN_FORCE_UNSPECIFIED": 0,
+		"TIME_IN_FORCE_DAY":         1,
+		"TIME_IN_FORCE_GTC":         2,
+		"TIME_IN_FORCE_IOC":         3,
+		"TIME_IN_FORCE_FOK":         4,
+	}
+)
+
+func (x TimeInForce) Enum() *TimeInForce {
+	p := new(TimeInForce)
+	*p = x
+	return p
+}
+
+func (x TimeInForce) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeInForce) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_proto_enumTypes[3].Descriptor()
+}
+
+func (TimeInForce) Type() protoreflect.EnumType {
+	return &file_order_proto_enumTypes[3]
+}
+
+func (x TimeInForce) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeInForce.Descriptor instead.
+func (TimeInForce) EnumDescriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{3}
+}
+
+type OrderGroupType int32
+
+const (
+	OrderGroupType_ORDER_GROUP_TYPE_UNSPECIFIED OrderGroupType = 0
+	// an entry with take-profit and/or stop-loss exits that are armed once the entry fills
+	OrderGroupType_ORDER_GROUP_TYPE_BRACKET OrderGroupType = 1
+	// legs that rest together until the first one fills and cancels the others
+	OrderGroupType_ORDER_GROUP_TYPE_OCO OrderGroupType = 2
+)
+
+// Enum value maps for OrderGroupType.
+var (
+	OrderGroupType_name = map[int32]string{
+		0: "ORDER_GROUP_TYPE_UNSPECIFIED",
+		1: "ORDER_GROUP_TYPE_BRACKET",
+		2: "ORDER_GROUP_TYPE_OCO",
+	}
+	OrderGroupType_value = map[string]int32{
+		"ORDER_GROUP_TYPE_UNSPECIFIED": 0,
+		"ORDER_GROUP_TYPE_BRACKET":     1,
+		"ORDER_GROUP_TYPE_OCO":         2,
+	}
+)
+
+func (x OrderGroupType) Enum() *OrderGroupType {
+	p := new(OrderGroupType)
+	*p = x
+	return p
+}
+
+func (x OrderGroupType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderGroupType) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_proto_enumTypes[4].Descriptor()
+}
+
+func (OrderGroupType) Type() protoreflect.EnumType {
+	return &file_order_proto_enumTypes[4]
+}
+
+func (x OrderGroupType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderGroupType.Descriptor instead.
+func (OrderGroupType) EnumDescriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{4}
+}
+
+// why a pre-trade risk check refused an order; rejections for other reasons carry UNSPECIFIED
+type RejectCode int32
+
+const (
+	RejectCode_REJECT_CODE_UNSPECIFIED        RejectCode = 0
+	RejectCode_REJECT_CODE_MAX_ORDER_NOTIONAL RejectCode = 1
+	RejectCode_REJECT_CODE_MAX_POSITION_SIZE  RejectCode = 2
+	RejectCode_REJECT_CODE_MAX_OPEN_ORDERS    RejectCode = 3
+	RejectCode_REJECT_CODE_DAILY_LOSS_LIMIT   RejectCode = 4
+	RejectCode_REJECT_CODE_PRICE_COLLAR       RejectCode = 5
+)
+
+// Enum value maps for RejectCode.
+var (
+	RejectCode_name = map[int32]string{
+		0: "REJECT_CODE_UNSPECIFIED",
+		1: "REJECT_CODE_MAX_ORDER_NOTIONAL",
+		2: "REJECT_CODE_MAX_POSITION_SIZE",
+		3: "REJECT_CODE_MAX_OPEN_ORDERS",
+		4: "REJECT_CODE_DAILY_LOSS_LIMIT",
+		5: "REJECT_CODE_PRICE_COLLAR",
+	}
+	RejectCode_value = map[string]int32{
+		"REJECT_CODE_UNSPECIFIED":        0,
+		"REJECT_CODE_MAX_ORDER_NOTIONAL": 1,
+		"REJECT_CODE_MAX_POSITION_SIZE":  2,
+		"REJECT_CODE_MAX_OPEN_ORDERS":    3,
+		"REJECT_CODE_DAILY_LOSS_LIMIT":   4,
+		"REJECT_CODE_PRICE_COLLAR":       5,
+	}
+)
+
+func (x RejectCode) Enum() *RejectCode {
+	p := new(RejectCode)
+	*p = x
+	return p
+}
+
+func (x RejectCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RejectCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_proto_enumTypes[5].Descriptor()
+}
+
+func (RejectCode) Type() protoreflect.EnumType {
+	return &file_order_proto_enumTypes[5]
+}
+
+func (x RejectCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RejectCode.Descriptor instead.
+func (RejectCode) EnumDescriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{5}
+}
+
+type AmendmentStatus int32
+
+const (
+	AmendmentStatus_AMENDMENT_STATUS_UNSPECIFIED AmendmentStatus = 0
+	AmendmentStatus_AMENDMENT_STATUS_PENDING     AmendmentStatus = 1
+	AmendmentStatus_AMENDMENT_STATUS_APPLIED     AmendmentStatus = 2
+	AmendmentStatus_AMENDMENT_STATUS_REJECTED    AmendmentStatus = 3
+)
+
+// Enum value maps for AmendmentStatus.
+var (
+	AmendmentStatus_name = map[int32]string{
+		0: "AMENDMENT_STATUS_UNSPECIFIED",
+		1: "AMENDMENT_STATUS_PENDING",
+		2: "AMENDMENT_STATUS_APPLIED",
+		3: "AMENDMENT_STATUS_REJECTED",
+	}
+	AmendmentStatus_value = map[string]int32{
+		"AMENDMENT_STATUS_UNSPECIFIED": 0,
+		"AMENDMENT_STATUS_PENDING":     1,
+		"AMENDMENT_STATUS_APPLIED":     2,
+		"AMENDMENT_STATUS_REJECTED":    3,
+	}
+)
+
+func (x AmendmentStatus) Enum() *AmendmentStatus {
+	p := new(AmendmentStatus)
+	*p = x
+	return p
+}
+
+func (x AmendmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AmendmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_proto_enumTypes[6].Descriptor()
+}
+
+func (AmendmentStatus) Type() protoreflect.EnumType {
+	return &file_order_proto_enumTypes[6]
+}
+
+func (x AmendmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AmendmentStatus.Descriptor instead.
+func (AmendmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{6}
+}
+
 type Order struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -200,6 +432,16 @@ type Order struct {
 	AvgFillPrice   float64                `protobuf:"fixed64,11,opt,name=avg_fill_price,json=avgFillPrice,proto3" json:"avg_fill_price,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	TimeInForce    TimeInForce            `protobuf:"varint,14,opt,name=time_in_force,json=timeInForce,proto3,enum=order.TimeInForce" json:"time_in_force,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	FillCount      int32                  `protobuf:"varint,16,opt,name=fill_count,json=fillCount,proto3" json:"fill_count,omitempty"`
+	TrailAmount    float64                `protobuf:"fixed64,17,opt,name=trail_amount,json=trailAmount,proto3" json:"trail_amount,omitempty"`
+	TrailPercent   float64                `protobuf:"fixed64,18,opt,name=trail_percent,json=trailPercent,proto3" json:"trail_percent,omitempty"`
+	GroupId        string                 `protobuf:"bytes,19,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupType      OrderGroupType         `protobuf:"varint,20,opt,name=group_type,json=groupType,proto3,enum=order.OrderGroupType" json:"group_type,omitempty"`
+	ParentOrderId  string                 `protobuf:"bytes,21,opt,name=parent_order_id,json=parentOrderId,proto3" json:"parent_order_id,omitempty"`
+	RejectCode     RejectCode             `protobuf:"varint,22,opt,name=reject_code,json=rejectCode,proto3,enum=order.RejectCode" json:"reject_code,omitempty"`
+	RejectReason   string                 `protobuf:"bytes,23,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -325,13 +567,89 @@ func (x *Order) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Order) GetTimeInForce() TimeInForce {
+	if x != nil {
+		return x.TimeInForce
+	}
+	return TimeInForce_TIME_IN_FORCE_UNSPECIFIED
+}
+
+func (x *Order) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Order) GetFillCount() int32 {
+	if x != nil {
+		return x.FillCount
+	}
+	return 0
+}
+
+func (x *Order) GetTrailAmount() float64 {
+	if x != nil {
+		return x.TrailAmount
+	}
+	return 0
+}
+
+func (x *Order) GetTrailPercent() float64 {
+	if x != nil {
+		return x.TrailPercent
+	}
+	return 0
+}
+
+func (x *Order) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *Order) GetGroupType() OrderGroupType {
+	if x != nil {
+		return x.GroupType
+	}
+	return OrderGroupType_ORDER_GROUP_TYPE_UNSPECIFIED
+}
+
+func (x *Order) GetParentOrderId() string {
+	if x != nil {
+		return x.ParentOrderId
+	}
+	return ""
+}
+
+func (x *Order) GetRejectCode() RejectCode {
+	if x != nil {
+		return x.RejectCode
+	}
+	return RejectCode_REJECT_CODE_UNSPECIFIED
+}
+
+func (x *Order) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
 type OrderFill struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	FillQuantity  float64                `protobuf:"fixed64,3,opt,name=fill_quantity,json=fillQuantity,proto3" json:"fill_quantity,omitempty"`
-	FillPrice     float64                `protobuf:"fixed64,4,opt,name=fill_price,json=fillPrice,proto3" json:"fill_price,omitempty"`
-	FilledAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=filled_at,json=filledAt,proto3" json:"filled_at,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId      string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	FillQuantity float64                `protobuf:"fixed64,3,opt,name=fill_quantity,json=fillQuantity,proto3" json:"fill_quantity,omitempty"`
+	FillPrice    float64                `protobuf:"fixed64,4,opt,name=fill_price,json=fillPrice,proto3" json:"fill_price,omitempty"`
+	FilledAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=filled_at,json=filledAt,proto3" json:"filled_at,omitempty"`
+	Sequence     int32                  `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// how the fill was priced against the quote; unset for fills against another user's order
+	Pricing *ExecutionPricing `protobuf:"bytes,7,opt,name=pricing,proto3" json:"pricing,omitempty"`
+	// the provider and time of the quote the fill was made against
+	QuoteSource   string                 `protobuf:"bytes,8,opt,name=quote_source,json=quoteSource,proto3" json:"quote_source,omitempty"`
+	QuoteAsOf     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=quote_as_of,json=quoteAsOf,proto3" json:"quote_as_of,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -401,6 +719,34 @@ func (x *OrderFill) GetFilledAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *OrderFill) GetSequence() int32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *OrderFill) GetPricing() *ExecutionPricing {
+	if x != nil {
+		return x.Pricing
+	}
+	return nil
+}
+
+func (x *OrderFill) GetQuoteSource() string {
+	if x != nil {
+		return x.QuoteSource
+	}
+	return ""
+}
+
+func (x *OrderFill) GetQuoteAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QuoteAsOf
+	}
+	return nil
+}
+
 type GetOrderByIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
@@ -457,6 +803,8 @@ type GetOrderByIdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
 	Code          base.ErrorCode         `protobuf:"varint,2,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	Fills         []*OrderFill           `protobuf:"bytes,3,rep,name=fills,proto3" json:"fills,omitempty"`
+	Amendments    []*OrderAmendment      `protobuf:"bytes,4,rep,name=amendments,proto3" json:"amendments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -505,6 +853,20 @@ func (x *GetOrderByIdResponse) GetCode() base.ErrorCode {
 	return base.ErrorCode(0)
 }
 
+func (x *GetOrderByIdResponse) GetFills() []*OrderFill {
+	if x != nil {
+		return x.Fills
+	}
+	return nil
+}
+
+func (x *GetOrderByIdResponse) GetAmendments() []*OrderAmendment {
+	if x != nil {
+		return x.Amendments
+	}
+	return nil
+}
+
 type GetOrdersByUserIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -625,34 +987,27 @@ func (x *GetOrdersByUserIdResponse) GetCode() base.ErrorCode {
 	return base.ErrorCode(0)
 }
 
-type InsertOrderRequest struct {
+type ListOpenOrdersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Side          OrderSide              `protobuf:"varint,3,opt,name=side,proto3,enum=order.OrderSide" json:"side,omitempty"`
-	Type          OrderType              `protobuf:"varint,4,opt,name=type,proto3,enum=order.OrderType" json:"type,omitempty"`
-	Status        OrderStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
-	Quantity      float64                `protobuf:"fixed64,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Price         float64                `protobuf:"fixed64,7,opt,name=price,proto3" json:"price,omitempty"`
-	StopPrice     float64                `protobuf:"fixed64,8,opt,name=stop_price,json=stopPrice,proto3" json:"stop_price,omitempty"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InsertOrderRequest) Reset() {
-	*x = InsertOrderRequest{}
+func (x *ListOpenOrdersRequest) Reset() {
+	*x = ListOpenOrdersRequest{}
 	mi := &file_order_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsertOrderRequest) String() string {
+func (x *ListOpenOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertOrderRequest) ProtoMessage() {}
+func (*ListOpenOrdersRequest) ProtoMessage() {}
 
-func (x *InsertOrderRequest) ProtoReflect() protoreflect.Message {
+func (x *ListOpenOrdersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_order_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -664,89 +1019,40 @@ func (x *InsertOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertOrderRequest.ProtoReflect.Descriptor instead.
-func (*InsertOrderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListOpenOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOpenOrdersRequest) Descriptor() ([]byte, []int) {
 	return file_order_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *InsertOrderRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *InsertOrderRequest) GetSymbol() string {
+func (x *ListOpenOrdersRequest) GetSymbol() string {
 	if x != nil {
 		return x.Symbol
 	}
 	return ""
 }
 
-func (x *InsertOrderRequest) GetSide() OrderSide {
-	if x != nil {
-		return x.Side
-	}
-	return OrderSide_ORDER_SIDE_UNSPECIFIED
-}
-
-func (x *InsertOrderRequest) GetType() OrderType {
-	if x != nil {
-		return x.Type
-	}
-	return OrderType_ORDER_TYPE_UNSPECIFIED
-}
-
-func (x *InsertOrderRequest) GetStatus() OrderStatus {
-	if x != nil {
-		return x.Status
-	}
-	return OrderStatus_ORDER_STATUS_UNSPECIFIED
-}
-
-func (x *InsertOrderRequest) GetQuantity() float64 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
-}
-
-func (x *InsertOrderRequest) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-func (x *InsertOrderRequest) GetStopPrice() float64 {
-	if x != nil {
-		return x.StopPrice
-	}
-	return 0
-}
-
-type InsertOrderResponse struct {
+type ListOpenOrdersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
 	Code          base.ErrorCode         `protobuf:"varint,2,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InsertOrderResponse) Reset() {
-	*x = InsertOrderResponse{}
+func (x *ListOpenOrdersResponse) Reset() {
+	*x = ListOpenOrdersResponse{}
 	mi := &file_order_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsertOrderResponse) String() string {
+func (x *ListOpenOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertOrderResponse) ProtoMessage() {}
+func (*ListOpenOrdersResponse) ProtoMessage() {}
 
-func (x *InsertOrderResponse) ProtoReflect() protoreflect.Message {
+func (x *ListOpenOrdersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_order_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -758,27 +1064,307 @@ func (x *InsertOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertOrderResponse.ProtoReflect.Descriptor instead.
-func (*InsertOrderResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListOpenOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOpenOrdersResponse) Descriptor() ([]byte, []int) {
 	return file_order_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *InsertOrderResponse) GetOrder() *Order {
+func (x *ListOpenOrdersResponse) GetOrders() []*Order {
 	if x != nil {
-		return x.Order
+		return x.Orders
 	}
 	return nil
 }
 
-func (x *InsertOrderResponse) GetCode() base.ErrorCode {
+func (x *ListOpenOrdersResponse) GetCode() base.ErrorCode {
 	if x != nil {
 		return x.Code
 	}
 	return base.ErrorCode(0)
 }
 
-type CancelOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type InsertOrderRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Symbol      string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Side        OrderSide              `protobuf:"varint,3,opt,name=side,proto3,enum=order.OrderSide" json:"side,omitempty"`
+	Type        OrderType              `protobuf:"varint,4,opt,name=type,proto3,enum=order.OrderType" json:"type,omitempty"`
+	Status      OrderStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
+	Quantity    float64                `protobuf:"fixed64,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price       float64                `protobuf:"fixed64,7,opt,name=price,proto3" json:"price,omitempty"`
+	StopPrice   float64                `protobuf:"fixed64,8,opt,name=stop_price,json=stopPrice,proto3" json:"stop_price,omitempty"`
+	TimeInForce TimeInForce            `protobuf:"varint,9,opt,name=time_in_force,json=timeInForce,proto3,enum=order.TimeInForce" json:"time_in_force,omitempty"`
+	// trailing stops set exactly one of these
+	TrailAmount  float64 `protobuf:"fixed64,10,opt,name=trail_amount,json=trailAmount,proto3" json:"trail_amount,omitempty"`
+	TrailPercent float64 `protobuf:"fixed64,11,opt,name=trail_percent,json=trailPercent,proto3" json:"trail_percent,omitempty"`
+	// bracket: this order is the entry and attached_orders its exits (on the opposite side)
+	// oco: this order and attached_orders are legs on the same side
+	GroupType      OrderGroupType   `protobuf:"varint,12,opt,name=group_type,json=groupType,proto3,enum=order.OrderGroupType" json:"group_type,omitempty"`
+	AttachedOrders []*AttachedOrder `protobuf:"bytes,13,rep,name=attached_orders,json=attachedOrders,proto3" json:"attached_orders,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InsertOrderRequest) Reset() {
+	*x = InsertOrderRequest{}
+	mi := &file_order_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertOrderRequest) ProtoMessage() {}
+
+func (x *InsertOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertOrderRequest.ProtoReflect.Descriptor instead.
+func (*InsertOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InsertOrderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InsertOrderRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *InsertOrderRequest) GetSide() OrderSide {
+	if x != nil {
+		return x.Side
+	}
+	return OrderSide_ORDER_SIDE_UNSPECIFIED
+}
+
+func (x *InsertOrderRequest) GetType() OrderType {
+	if x != nil {
+		return x.Type
+	}
+	return OrderType_ORDER_TYPE_UNSPECIFIED
+}
+
+func (x *InsertOrderRequest) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *InsertOrderRequest) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InsertOrderRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *InsertOrderRequest) GetStopPrice() float64 {
+	if x != nil {
+		return x.StopPrice
+	}
+	return 0
+}
+
+func (x *InsertOrderRequest) GetTimeInForce() TimeInForce {
+	if x != nil {
+		return x.TimeInForce
+	}
+	return TimeInForce_TIME_IN_FORCE_UNSPECIFIED
+}
+
+func (x *InsertOrderRequest) GetTrailAmount() float64 {
+	if x != nil {
+		return x.TrailAmount
+	}
+	return 0
+}
+
+func (x *InsertOrderRequest) GetTrailPercent() float64 {
+	if x != nil {
+		return x.TrailPercent
+	}
+	return 0
+}
+
+func (x *InsertOrderRequest) GetGroupType() OrderGroupType {
+	if x != nil {
+		return x.GroupType
+	}
+	return OrderGroupType_ORDER_GROUP_TYPE_UNSPECIFIED
+}
+
+func (x *InsertOrderRequest) GetAttachedOrders() []*AttachedOrder {
+	if x != nil {
+		return x.AttachedOrders
+	}
+	return nil
+}
+
+// a leg placed together with an order; it shares the order's symbol and quantity
+type AttachedOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          OrderType              `protobuf:"varint,1,opt,name=type,proto3,enum=order.OrderType" json:"type,omitempty"`
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	StopPrice     float64                `protobuf:"fixed64,3,opt,name=stop_price,json=stopPrice,proto3" json:"stop_price,omitempty"`
+	TrailAmount   float64                `protobuf:"fixed64,4,opt,name=trail_amount,json=trailAmount,proto3" json:"trail_amount,omitempty"`
+	TrailPercent  float64                `protobuf:"fixed64,5,opt,name=trail_percent,json=trailPercent,proto3" json:"trail_percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachedOrder) Reset() {
+	*x = AttachedOrder{}
+	mi := &file_order_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachedOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachedOrder) ProtoMessage() {}
+
+func (x *AttachedOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachedOrder.ProtoReflect.Descriptor instead.
+func (*AttachedOrder) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AttachedOrder) GetType() OrderType {
+	if x != nil {
+		return x.Type
+	}
+	return OrderType_ORDER_TYPE_UNSPECIFIED
+}
+
+func (x *AttachedOrder) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *AttachedOrder) GetStopPrice() float64 {
+	if x != nil {
+		return x.StopPrice
+	}
+	return 0
+}
+
+func (x *AttachedOrder) GetTrailAmount() float64 {
+	if x != nil {
+		return x.TrailAmount
+	}
+	return 0
+}
+
+func (x *AttachedOrder) GetTrailPercent() float64 {
+	if x != nil {
+		return x.TrailPercent
+	}
+	return 0
+}
+
+type InsertOrderResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Order          *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Code           base.ErrorCode         `protobuf:"varint,2,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	AttachedOrders []*Order               `protobuf:"bytes,3,rep,name=attached_orders,json=attachedOrders,proto3" json:"attached_orders,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InsertOrderResponse) Reset() {
+	*x = InsertOrderResponse{}
+	mi := &file_order_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertOrderResponse) ProtoMessage() {}
+
+func (x *InsertOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertOrderResponse.ProtoReflect.Descriptor instead.
+func (*InsertOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *InsertOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *InsertOrderResponse) GetCode() base.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return base.ErrorCode(0)
+}
+
+func (x *InsertOrderResponse) GetAttachedOrders() []*Order {
+	if x != nil {
+		return x.AttachedOrders
+	}
+	return nil
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -787,7 +1373,7 @@ type CancelOrderRequest struct {
 
 func (x *CancelOrderRequest) Reset() {
 	*x = CancelOrderRequest{}
-	mi := &file_order_proto_msgTypes[8]
+	mi := &file_order_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -799,7 +1385,7 @@ func (x *CancelOrderRequest) String() string {
 func (*CancelOrderRequest) ProtoMessage() {}
 
 func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[8]
+	mi := &file_order_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -812,7 +1398,7 @@ func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
 func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{8}
+	return file_order_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CancelOrderRequest) GetOrderId() string {
@@ -839,7 +1425,7 @@ type CancelOrderResponse struct {
 
 func (x *CancelOrderResponse) Reset() {
 	*x = CancelOrderResponse{}
-	mi := &file_order_proto_msgTypes[9]
+	mi := &file_order_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -851,7 +1437,7 @@ func (x *CancelOrderResponse) String() string {
 func (*CancelOrderResponse) ProtoMessage() {}
 
 func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[9]
+	mi := &file_order_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -864,7 +1450,7 @@ func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
 func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{9}
+	return file_order_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CancelOrderResponse) GetOrder() *Order {
@@ -874,44 +1460,1175 @@ func (x *CancelOrderResponse) GetOrder() *Order {
 	return nil
 }
 
-func (x *CancelOrderResponse) GetCode() base.ErrorCode {
+func (x *CancelOrderResponse) GetCode() base.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return base.ErrorCode(0)
+}
+
+// changes the quantity or limit price of a resting order; 0 keeps the current value
+type ModifyOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModifyOrderRequest) Reset() {
+	*x = ModifyOrderRequest{}
+	mi := &file_order_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyOrderRequest) ProtoMessage() {}
+
+func (x *ModifyOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyOrderRequest.ProtoReflect.Descriptor instead.
+func (*ModifyOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ModifyOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ModifyOrderRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+// the order as it stands until the engine applies the amendment, which starts out pending
+type ModifyOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Code          base.ErrorCode         `protobuf:"varint,2,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	Amendment     *OrderAmendment        `protobuf:"bytes,3,opt,name=amendment,proto3" json:"amendment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModifyOrderResponse) Reset() {
+	*x = ModifyOrderResponse{}
+	mi := &file_order_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyOrderResponse) ProtoMessage() {}
+
+func (x *ModifyOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyOrderResponse.ProtoReflect.Descriptor instead.
+func (*ModifyOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ModifyOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *ModifyOrderResponse) GetCode() base.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return base.ErrorCode(0)
+}
+
+func (x *ModifyOrderResponse) GetAmendment() *OrderAmendment {
+	if x != nil {
+		return x.Amendment
+	}
+	return nil
+}
+
+// a requested change to a resting order, applied only if the engine finds the order still resting in the book
+type OrderAmendment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Status        AmendmentStatus        `protobuf:"varint,5,opt,name=status,proto3,enum=order.AmendmentStatus" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ResolvedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderAmendment) Reset() {
+	*x = OrderAmendment{}
+	mi := &file_order_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderAmendment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderAmendment) ProtoMessage() {}
+
+func (x *OrderAmendment) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderAmendment.ProtoReflect.Descriptor instead.
+func (*OrderAmendment) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *OrderAmendment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OrderAmendment) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderAmendment) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderAmendment) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OrderAmendment) GetStatus() AmendmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AmendmentStatus_AMENDMENT_STATUS_UNSPECIFIED
+}
+
+func (x *OrderAmendment) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderAmendment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OrderAmendment) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+type OrderCreatedEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Symbol         string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Side           OrderSide              `protobuf:"varint,4,opt,name=side,proto3,enum=order.OrderSide" json:"side,omitempty"`
+	Type           OrderType              `protobuf:"varint,5,opt,name=type,proto3,enum=order.OrderType" json:"type,omitempty"`
+	Status         OrderStatus            `protobuf:"varint,6,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
+	Quantity       float64                `protobuf:"fixed64,7,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price          float64                `protobuf:"fixed64,8,opt,name=price,proto3" json:"price,omitempty"`
+	StopPrice      float64                `protobuf:"fixed64,9,opt,name=stop_price,json=stopPrice,proto3" json:"stop_price,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FilledQuantity float64                `protobuf:"fixed64,11,opt,name=filled_quantity,json=filledQuantity,proto3" json:"filled_quantity,omitempty"`
+	FillCount      int32                  `protobuf:"varint,12,opt,name=fill_count,json=fillCount,proto3" json:"fill_count,omitempty"`
+	TimeInForce    TimeInForce            `protobuf:"varint,13,opt,name=time_in_force,json=timeInForce,proto3,enum=order.TimeInForce" json:"time_in_force,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	TrailAmount    float64                `protobuf:"fixed64,15,opt,name=trail_amount,json=trailAmount,proto3" json:"trail_amount,omitempty"`
+	TrailPercent   float64                `protobuf:"fixed64,16,opt,name=trail_percent,json=trailPercent,proto3" json:"trail_percent,omitempty"`
+	// best price seen since a trailing stop was accepted (highest for sells, lowest for buys)
+	TrailWatermark float64        `protobuf:"fixed64,17,opt,name=trail_watermark,json=trailWatermark,proto3" json:"trail_watermark,omitempty"`
+	GroupId        string         `protobuf:"bytes,18,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupType      OrderGroupType `protobuf:"varint,19,opt,name=group_type,json=groupType,proto3,enum=order.OrderGroupType" json:"group_type,omitempty"`
+	ParentOrderId  string         `protobuf:"bytes,20,opt,name=parent_order_id,json=parentOrderId,proto3" json:"parent_order_id,omitempty"`
+	// bracket entry: the exits armed once it fills; oco: the other legs, accepted together with this one
+	AttachedOrders []*OrderCreatedEvent `protobuf:"bytes,21,rep,name=attached_orders,json=attachedOrders,proto3" json:"attached_orders,omitempty"`
+	// legs that are cancelled as soon as this one fills
+	OcoOrderIds []string `protobuf:"bytes,22,rep,name=oco_order_ids,json=ocoOrderIds,proto3" json:"oco_order_ids,omitempty"`
+	// when the order last joined the queue at its price, if an amendment sent it to the back since it was created
+	QueuedAt      *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=queued_at,json=queuedAt,proto3" json:"queued_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderCreatedEvent) Reset() {
+	*x = OrderCreatedEvent{}
+	mi := &file_order_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCreatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCreatedEvent) ProtoMessage() {}
+
+func (x *OrderCreatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCreatedEvent.ProtoReflect.Descriptor instead.
+func (*OrderCreatedEvent) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OrderCreatedEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderCreatedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OrderCreatedEvent) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *OrderCreatedEvent) GetSide() OrderSide {
+	if x != nil {
+		return x.Side
+	}
+	return OrderSide_ORDER_SIDE_UNSPECIFIED
+}
+
+func (x *OrderCreatedEvent) GetType() OrderType {
+	if x != nil {
+		return x.Type
+	}
+	return OrderType_ORDER_TYPE_UNSPECIFIED
+}
+
+func (x *OrderCreatedEvent) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *OrderCreatedEvent) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderCreatedEvent) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OrderCreatedEvent) GetStopPrice() float64 {
+	if x != nil {
+		return x.StopPrice
+	}
+	return 0
+}
+
+func (x *OrderCreatedEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OrderCreatedEvent) GetFilledQuantity() float64 {
+	if x != nil {
+		return x.FilledQuantity
+	}
+	return 0
+}
+
+func (x *OrderCreatedEvent) GetFillCount() int32 {
+	if x != nil {
+		return x.FillCount
+	}
+	return 0
+}
+
+func (x *OrderCreatedEvent) GetTimeInForce() TimeInForce {
+	if x != nil {
+		return x.TimeInForce
+	}
+	return TimeInForce_TIME_IN_FORCE_UNSPECIFIED
+}
+
+func (x *OrderCreatedEvent) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *OrderCreatedEvent) GetTrailAmount() float64 {
+	if x != nil {
+		return x.TrailAmount
+	}
+	return 0
+}
+
+func (x *OrderCreatedEvent) GetTrailPercent() float64 {
+	if x != nil {
+		return x.TrailPercent
+	}
+	return 0
+}
+
+func (x *OrderCreatedEvent) GetTrailWatermark() float64 {
+	if x != nil {
+		return x.TrailWatermark
+	}
+	return 0
+}
+
+func (x *OrderCreatedEvent) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *OrderCreatedEvent) GetGroupType() OrderGroupType {
+	if x != nil {
+		return x.GroupType
+	}
+	return OrderGroupType_ORDER_GROUP_TYPE_UNSPECIFIED
+}
+
+func (x *OrderCreatedEvent) GetParentOrderId() string {
+	if x != nil {
+		return x.ParentOrderId
+	}
+	return ""
+}
+
+func (x *OrderCreatedEvent) GetAttachedOrders() []*OrderCreatedEvent {
+	if x != nil {
+		return x.AttachedOrders
+	}
+	return nil
+}
+
+func (x *OrderCreatedEvent) GetOcoOrderIds() []string {
+	if x != nil {
+		return x.OcoOrderIds
+	}
+	return nil
+}
+
+func (x *OrderCreatedEvent) GetQueuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QueuedAt
+	}
+	return nil
+}
+
+type OrderFilledEvent struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	OrderId             string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId              string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Symbol              string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Side                OrderSide              `protobuf:"varint,4,opt,name=side,proto3,enum=order.OrderSide" json:"side,omitempty"`
+	FillQuantity        float64                `protobuf:"fixed64,5,opt,name=fill_quantity,json=fillQuantity,proto3" json:"fill_quantity,omitempty"`
+	FillPrice           float64                `protobuf:"fixed64,6,opt,name=fill_price,json=fillPrice,proto3" json:"fill_price,omitempty"`
+	ExchangeRate        float64                `protobuf:"fixed64,7,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	SettlementAmount    float64                `protobuf:"fixed64,8,opt,name=settlement_amount,json=settlementAmount,proto3" json:"settlement_amount,omitempty"`
+	SettlementCurrency  string                 `protobuf:"bytes,9,opt,name=settlement_currency,json=settlementCurrency,proto3" json:"settlement_currency,omitempty"`
+	FilledAt            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=filled_at,json=filledAt,proto3" json:"filled_at,omitempty"`
+	FillSequence        int32                  `protobuf:"varint,11,opt,name=fill_sequence,json=fillSequence,proto3" json:"fill_sequence,omitempty"`
+	TradeId             string                 `protobuf:"bytes,12,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	CounterpartyOrderId string                 `protobuf:"bytes,13,opt,name=counterparty_order_id,json=counterpartyOrderId,proto3" json:"counterparty_order_id,omitempty"`
+	// quantity of the order still open after this fill
+	RemainingQuantity float64 `protobuf:"fixed64,14,opt,name=remaining_quantity,json=remainingQuantity,proto3" json:"remaining_quantity,omitempty"`
+	// commissions and charges of this fill in the settlement currency, on top of settlement_amount
+	Fee float64 `protobuf:"fixed64,15,opt,name=fee,proto3" json:"fee,omitempty"`
+	// how the fill price was derived from the quote; unset for fills against another user's order
+	Pricing *ExecutionPricing `protobuf:"bytes,16,opt,name=pricing,proto3" json:"pricing,omitempty"`
+	// the provider that served the quote the fill was made against and when that quote was current, for audits;
+	// fills against another user's order carry source "internal" and the time of the trade
+	QuoteSource   string                 `protobuf:"bytes,17,opt,name=quote_source,json=quoteSource,proto3" json:"quote_source,omitempty"`
+	QuoteAsOf     *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=quote_as_of,json=quoteAsOf,proto3" json:"quote_as_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderFilledEvent) Reset() {
+	*x = OrderFilledEvent{}
+	mi := &file_order_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderFilledEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderFilledEvent) ProtoMessage() {}
+
+func (x *OrderFilledEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderFilledEvent.ProtoReflect.Descriptor instead.
+func (*OrderFilledEvent) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *OrderFilledEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderFilledEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OrderFilledEvent) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *OrderFilledEvent) GetSide() OrderSide {
+	if x != nil {
+		return x.Side
+	}
+	return OrderSide_ORDER_SIDE_UNSPECIFIED
+}
+
+func (x *OrderFilledEvent) GetFillQuantity() float64 {
+	if x != nil {
+		return x.FillQuantity
+	}
+	return 0
+}
+
+func (x *OrderFilledEvent) GetFillPrice() float64 {
+	if x != nil {
+		return x.FillPrice
+	}
+	return 0
+}
+
+func (x *OrderFilledEvent) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
+}
+
+func (x *OrderFilledEvent) GetSettlementAmount() float64 {
+	if x != nil {
+		return x.SettlementAmount
+	}
+	return 0
+}
+
+func (x *OrderFilledEvent) GetSettlementCurrency() string {
+	if x != nil {
+		return x.SettlementCurrency
+	}
+	return ""
+}
+
+func (x *OrderFilledEvent) GetFilledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FilledAt
+	}
+	return nil
+}
+
+func (x *OrderFilledEvent) GetFillSequence() int32 {
+	if x != nil {
+		return x.FillSequence
+	}
+	return 0
+}
+
+func (x *OrderFilledEvent) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *OrderFilledEvent) GetCounterpartyOrderId() string {
+	if x != nil {
+		return x.CounterpartyOrderId
+	}
+	return ""
+}
+
+func (x *OrderFilledEvent) GetRemainingQuantity() float64 {
+	if x != nil {
+		return x.RemainingQuantity
+	}
+	return 0
+}
+
+func (x *OrderFilledEvent) GetFee() float64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *OrderFilledEvent) GetPricing() *ExecutionPricing {
+	if x != nil {
+		return x.Pricing
+	}
+	return nil
+}
+
+func (x *OrderFilledEvent) GetQuoteSource() string {
+	if x != nil {
+		return x.QuoteSource
+	}
+	return ""
+}
+
+func (x *OrderFilledEvent) GetQuoteAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QuoteAsOf
+	}
+	return nil
+}
+
+// how the engine priced a fill against a quote, so users can see what they paid above or below the last price
+type ExecutionPricing struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "last" fills at the last price, "spread" pays half the estimated bid/ask spread on top,
+	// "spread_impact" also the market impact of the order's size
+	Model string `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	// last price of the quote the fill was priced from
+	ReferencePrice float64 `protobuf:"fixed64,2,opt,name=reference_price,json=referencePrice,proto3" json:"reference_price,omitempty"`
+	// estimated bid/ask spread as a share of the reference price; a fill pays half of it
+	Spread float64 `protobuf:"fixed64,3,opt,name=spread,proto3" json:"spread,omitempty"`
+	// market impact as a share of the reference price
+	Impact float64 `protobuf:"fixed64,4,opt,name=impact,proto3" json:"impact,omitempty"`
+	// fill quantity as a share of the quote's volume, which the impact grows with
+	Participation float64 `protobuf:"fixed64,5,opt,name=participation,proto3" json:"participation,omitempty"`
+	// model parameters the fill was priced with
+	MinSpread         float64 `protobuf:"fixed64,6,opt,name=min_spread,json=minSpread,proto3" json:"min_spread,omitempty"`
+	SpreadRangeShare  float64 `protobuf:"fixed64,7,opt,name=spread_range_share,json=spreadRangeShare,proto3" json:"spread_range_share,omitempty"`
+	ImpactCoefficient float64 `protobuf:"fixed64,8,opt,name=impact_coefficient,json=impactCoefficient,proto3" json:"impact_coefficient,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ExecutionPricing) Reset() {
+	*x = ExecutionPricing{}
+	mi := &file_order_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionPricing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionPricing) ProtoMessage() {}
+
+func (x *ExecutionPricing) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionPricing.ProtoReflect.Descriptor instead.
+func (*ExecutionPricing) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExecutionPricing) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *ExecutionPricing) GetReferencePrice() float64 {
+	if x != nil {
+		return x.ReferencePrice
+	}
+	return 0
+}
+
+func (x *ExecutionPricing) GetSpread() float64 {
+	if x != nil {
+		return x.Spread
+	}
+	return 0
+}
+
+func (x *ExecutionPricing) GetImpact() float64 {
+	if x != nil {
+		return x.Impact
+	}
+	return 0
+}
+
+func (x *ExecutionPricing) GetParticipation() float64 {
+	if x != nil {
+		return x.Participation
+	}
+	return 0
+}
+
+func (x *ExecutionPricing) GetMinSpread() float64 {
+	if x != nil {
+		return x.MinSpread
+	}
+	return 0
+}
+
+func (x *ExecutionPricing) GetSpreadRangeShare() float64 {
+	if x != nil {
+		return x.SpreadRangeShare
+	}
+	return 0
+}
+
+func (x *ExecutionPricing) GetImpactCoefficient() float64 {
+	if x != nil {
+		return x.ImpactCoefficient
+	}
+	return 0
+}
+
+// published on orders.amended; the engine answers on orders.amendment_applied or orders.amendment_rejected
+type OrderAmendedEvent struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AmendmentId string                 `protobuf:"bytes,1,opt,name=amendment_id,json=amendmentId,proto3" json:"amendment_id,omitempty"`
+	OrderId     string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId      string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Symbol      string                 `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// the new total quantity and limit price, both always set
+	Quantity      float64                `protobuf:"fixed64,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	AmendedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=amended_at,json=amendedAt,proto3" json:"amended_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderAmendedEvent) Reset() {
+	*x = OrderAmendedEvent{}
+	mi := &file_order_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderAmendedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderAmendedEvent) ProtoMessage() {}
+
+func (x *OrderAmendedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderAmendedEvent.ProtoReflect.Descriptor instead.
+func (*OrderAmendedEvent) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OrderAmendedEvent) GetAmendmentId() string {
+	if x != nil {
+		return x.AmendmentId
+	}
+	return ""
+}
+
+func (x *OrderAmendedEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderAmendedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OrderAmendedEvent) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *OrderAmendedEvent) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderAmendedEvent) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OrderAmendedEvent) GetAmendedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AmendedAt
+	}
+	return nil
+}
+
+type OrderAmendmentResolvedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AmendmentId   string                 `protobuf:"bytes,1,opt,name=amendment_id,json=amendmentId,proto3" json:"amendment_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Symbol        string                 `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	Applied       bool                   `protobuf:"varint,7,opt,name=applied,proto3" json:"applied,omitempty"`
+	Reason        string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	ResolvedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderAmendmentResolvedEvent) Reset() {
+	*x = OrderAmendmentResolvedEvent{}
+	mi := &file_order_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderAmendmentResolvedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderAmendmentResolvedEvent) ProtoMessage() {}
+
+func (x *OrderAmendmentResolvedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderAmendmentResolvedEvent.ProtoReflect.Descriptor instead.
+func (*OrderAmendmentResolvedEvent) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *OrderAmendmentResolvedEvent) GetAmendmentId() string {
+	if x != nil {
+		return x.AmendmentId
+	}
+	return ""
+}
+
+func (x *OrderAmendmentResolvedEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderAmendmentResolvedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OrderAmendmentResolvedEvent) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *OrderAmendmentResolvedEvent) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderAmendmentResolvedEvent) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OrderAmendmentResolvedEvent) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *OrderAmendmentResolvedEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderAmendmentResolvedEvent) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+type OrderCancelledEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Symbol         string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Side           OrderSide              `protobuf:"varint,4,opt,name=side,proto3,enum=order.OrderSide" json:"side,omitempty"`
+	Status         OrderStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
+	CancelledAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	FilledQuantity float64                `protobuf:"fixed64,7,opt,name=filled_quantity,json=filledQuantity,proto3" json:"filled_quantity,omitempty"`
+	Reason         string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrderCancelledEvent) Reset() {
+	*x = OrderCancelledEvent{}
+	mi := &file_order_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCancelledEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCancelledEvent) ProtoMessage() {}
+
+func (x *OrderCancelledEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCancelledEvent.ProtoReflect.Descriptor instead.
+func (*OrderCancelledEvent) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *OrderCancelledEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderCancelledEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OrderCancelledEvent) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *OrderCancelledEvent) GetSide() OrderSide {
+	if x != nil {
+		return x.Side
+	}
+	return OrderSide_ORDER_SIDE_UNSPECIFIED
+}
+
+func (x *OrderCancelledEvent) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *OrderCancelledEvent) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *OrderCancelledEvent) GetFilledQuantity() float64 {
+	if x != nil {
+		return x.FilledQuantity
+	}
+	return 0
+}
+
+func (x *OrderCancelledEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// published on orders.cancel_requested; the engine confirms on orders.cancelled once the order is out of the book,
+// or denies on orders.cancel_rejected when there is nothing left to cancel
+type OrderCancelRequestedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Symbol        string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Side          OrderSide              `protobuf:"varint,4,opt,name=side,proto3,enum=order.OrderSide" json:"side,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderCancelRequestedEvent) Reset() {
+	*x = OrderCancelRequestedEvent{}
+	mi := &file_order_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCancelRequestedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCancelRequestedEvent) ProtoMessage() {}
+
+func (x *OrderCancelRequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCancelRequestedEvent.ProtoReflect.Descriptor instead.
+func (*OrderCancelRequestedEvent) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *OrderCancelRequestedEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderCancelRequestedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OrderCancelRequestedEvent) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *OrderCancelRequestedEvent) GetSide() OrderSide {
+	if x != nil {
+		return x.Side
+	}
+	return OrderSide_ORDER_SIDE_UNSPECIFIED
+}
+
+func (x *OrderCancelRequestedEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderCancelRequestedEvent) GetRequestedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Code
+		return x.RequestedAt
 	}
-	return base.ErrorCode(0)
+	return nil
 }
 
-type OrderCreatedEvent struct {
+type OrderCancelRejectedEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Symbol        string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Side          OrderSide              `protobuf:"varint,4,opt,name=side,proto3,enum=order.OrderSide" json:"side,omitempty"`
-	Type          OrderType              `protobuf:"varint,5,opt,name=type,proto3,enum=order.OrderType" json:"type,omitempty"`
-	Status        OrderStatus            `protobuf:"varint,6,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
-	Quantity      float64                `protobuf:"fixed64,7,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Price         float64                `protobuf:"fixed64,8,opt,name=price,proto3" json:"price,omitempty"`
-	StopPrice     float64                `protobuf:"fixed64,9,opt,name=stop_price,json=stopPrice,proto3" json:"stop_price,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	RejectedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=rejected_at,json=rejectedAt,proto3" json:"rejected_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderCreatedEvent) Reset() {
-	*x = OrderCreatedEvent{}
-	mi := &file_order_proto_msgTypes[10]
+func (x *OrderCancelRejectedEvent) Reset() {
+	*x = OrderCancelRejectedEvent{}
+	mi := &file_order_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderCreatedEvent) String() string {
+func (x *OrderCancelRejectedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderCreatedEvent) ProtoMessage() {}
+func (*OrderCancelRejectedEvent) ProtoMessage() {}
 
-func (x *OrderCreatedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[10]
+func (x *OrderCancelRejectedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,112 +2639,74 @@ func (x *OrderCreatedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderCreatedEvent.ProtoReflect.Descriptor instead.
-func (*OrderCreatedEvent) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use OrderCancelRejectedEvent.ProtoReflect.Descriptor instead.
+func (*OrderCancelRejectedEvent) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *OrderCreatedEvent) GetOrderId() string {
+func (x *OrderCancelRejectedEvent) GetOrderId() string {
 	if x != nil {
 		return x.OrderId
 	}
 	return ""
 }
 
-func (x *OrderCreatedEvent) GetUserId() string {
+func (x *OrderCancelRejectedEvent) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *OrderCreatedEvent) GetSymbol() string {
+func (x *OrderCancelRejectedEvent) GetSymbol() string {
 	if x != nil {
 		return x.Symbol
 	}
 	return ""
 }
 
-func (x *OrderCreatedEvent) GetSide() OrderSide {
-	if x != nil {
-		return x.Side
-	}
-	return OrderSide_ORDER_SIDE_UNSPECIFIED
-}
-
-func (x *OrderCreatedEvent) GetType() OrderType {
-	if x != nil {
-		return x.Type
-	}
-	return OrderType_ORDER_TYPE_UNSPECIFIED
-}
-
-func (x *OrderCreatedEvent) GetStatus() OrderStatus {
-	if x != nil {
-		return x.Status
-	}
-	return OrderStatus_ORDER_STATUS_UNSPECIFIED
-}
-
-func (x *OrderCreatedEvent) GetQuantity() float64 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
-}
-
-func (x *OrderCreatedEvent) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-func (x *OrderCreatedEvent) GetStopPrice() float64 {
+func (x *OrderCancelRejectedEvent) GetReason() string {
 	if x != nil {
-		return x.StopPrice
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-func (x *OrderCreatedEvent) GetCreatedAt() *timestamppb.Timestamp {
+func (x *OrderCancelRejectedEvent) GetRejectedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.RejectedAt
 	}
 	return nil
 }
 
-type OrderFilledEvent struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	OrderId            string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId             string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Symbol             string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Side               OrderSide              `protobuf:"varint,4,opt,name=side,proto3,enum=order.OrderSide" json:"side,omitempty"`
-	FillQuantity       float64                `protobuf:"fixed64,5,opt,name=fill_quantity,json=fillQuantity,proto3" json:"fill_quantity,omitempty"`
-	FillPrice          float64                `protobuf:"fixed64,6,opt,name=fill_price,json=fillPrice,proto3" json:"fill_price,omitempty"`
-	ExchangeRate       float64                `protobuf:"fixed64,7,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
-	SettlementAmount   float64                `protobuf:"fixed64,8,opt,name=settlement_amount,json=settlementAmount,proto3" json:"settlement_amount,omitempty"`
-	SettlementCurrency string                 `protobuf:"bytes,9,opt,name=settlement_currency,json=settlementCurrency,proto3" json:"settlement_currency,omitempty"`
-	FilledAt           *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=filled_at,json=filledAt,proto3" json:"filled_at,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+// a bracket exit that became a working order once its entry filled
+type OrderArmedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Symbol        string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	ParentOrderId string                 `protobuf:"bytes,4,opt,name=parent_order_id,json=parentOrderId,proto3" json:"parent_order_id,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ArmedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=armed_at,json=armedAt,proto3" json:"armed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderFilledEvent) Reset() {
-	*x = OrderFilledEvent{}
-	mi := &file_order_proto_msgTypes[11]
+func (x *OrderArmedEvent) Reset() {
+	*x = OrderArmedEvent{}
+	mi := &file_order_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderFilledEvent) String() string {
+func (x *OrderArmedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderFilledEvent) ProtoMessage() {}
+func (*OrderArmedEvent) ProtoMessage() {}
 
-func (x *OrderFilledEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[11]
+func (x *OrderArmedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1038,108 +2717,81 @@ func (x *OrderFilledEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderFilledEvent.ProtoReflect.Descriptor instead.
-func (*OrderFilledEvent) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use OrderArmedEvent.ProtoReflect.Descriptor instead.
+func (*OrderArmedEvent) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *OrderFilledEvent) GetOrderId() string {
+func (x *OrderArmedEvent) GetOrderId() string {
 	if x != nil {
 		return x.OrderId
 	}
 	return ""
 }
 
-func (x *OrderFilledEvent) GetUserId() string {
+func (x *OrderArmedEvent) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *OrderFilledEvent) GetSymbol() string {
+func (x *OrderArmedEvent) GetSymbol() string {
 	if x != nil {
 		return x.Symbol
 	}
 	return ""
 }
 
-func (x *OrderFilledEvent) GetSide() OrderSide {
-	if x != nil {
-		return x.Side
-	}
-	return OrderSide_ORDER_SIDE_UNSPECIFIED
-}
-
-func (x *OrderFilledEvent) GetFillQuantity() float64 {
-	if x != nil {
-		return x.FillQuantity
-	}
-	return 0
-}
-
-func (x *OrderFilledEvent) GetFillPrice() float64 {
-	if x != nil {
-		return x.FillPrice
-	}
-	return 0
-}
-
-func (x *OrderFilledEvent) GetExchangeRate() float64 {
+func (x *OrderArmedEvent) GetParentOrderId() string {
 	if x != nil {
-		return x.ExchangeRate
+		return x.ParentOrderId
 	}
-	return 0
+	return ""
 }
 
-func (x *OrderFilledEvent) GetSettlementAmount() float64 {
+func (x *OrderArmedEvent) GetQuantity() float64 {
 	if x != nil {
-		return x.SettlementAmount
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *OrderFilledEvent) GetSettlementCurrency() string {
-	if x != nil {
-		return x.SettlementCurrency
-	}
-	return ""
-}
-
-func (x *OrderFilledEvent) GetFilledAt() *timestamppb.Timestamp {
+func (x *OrderArmedEvent) GetArmedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FilledAt
+		return x.ArmedAt
 	}
 	return nil
 }
 
-type OrderCancelledEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Symbol        string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Side          OrderSide              `protobuf:"varint,4,opt,name=side,proto3,enum=order.OrderSide" json:"side,omitempty"`
-	Status        OrderStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=order.OrderStatus" json:"status,omitempty"`
-	CancelledAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type OrderRejectedEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Symbol         string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Reason         string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	RejectedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=rejected_at,json=rejectedAt,proto3" json:"rejected_at,omitempty"`
+	FilledQuantity float64                `protobuf:"fixed64,6,opt,name=filled_quantity,json=filledQuantity,proto3" json:"filled_quantity,omitempty"`
+	ReasonCode     RejectCode             `protobuf:"varint,7,opt,name=reason_code,json=reasonCode,proto3,enum=order.RejectCode" json:"reason_code,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *OrderCancelledEvent) Reset() {
-	*x = OrderCancelledEvent{}
-	mi := &file_order_proto_msgTypes[12]
+func (x *OrderRejectedEvent) Reset() {
+	*x = OrderRejectedEvent{}
+	mi := &file_order_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderCancelledEvent) String() string {
+func (x *OrderRejectedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderCancelledEvent) ProtoMessage() {}
+func (*OrderRejectedEvent) ProtoMessage() {}
 
-func (x *OrderCancelledEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[12]
+func (x *OrderRejectedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1150,79 +2802,89 @@ func (x *OrderCancelledEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderCancelledEvent.ProtoReflect.Descriptor instead.
-func (*OrderCancelledEvent) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use OrderRejectedEvent.ProtoReflect.Descriptor instead.
+func (*OrderRejectedEvent) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *OrderCancelledEvent) GetOrderId() string {
+func (x *OrderRejectedEvent) GetOrderId() string {
 	if x != nil {
 		return x.OrderId
 	}
 	return ""
 }
 
-func (x *OrderCancelledEvent) GetUserId() string {
+func (x *OrderRejectedEvent) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *OrderCancelledEvent) GetSymbol() string {
+func (x *OrderRejectedEvent) GetSymbol() string {
 	if x != nil {
 		return x.Symbol
 	}
 	return ""
 }
 
-func (x *OrderCancelledEvent) GetSide() OrderSide {
+func (x *OrderRejectedEvent) GetReason() string {
 	if x != nil {
-		return x.Side
+		return x.Reason
 	}
-	return OrderSide_ORDER_SIDE_UNSPECIFIED
+	return ""
 }
 
-func (x *OrderCancelledEvent) GetStatus() OrderStatus {
+func (x *OrderRejectedEvent) GetRejectedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Status
+		return x.RejectedAt
 	}
-	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+	return nil
 }
 
-func (x *OrderCancelledEvent) GetCancelledAt() *timestamppb.Timestamp {
+func (x *OrderRejectedEvent) GetFilledQuantity() float64 {
 	if x != nil {
-		return x.CancelledAt
+		return x.FilledQuantity
 	}
-	return nil
+	return 0
 }
 
-type OrderRejectedEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Symbol        string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
-	RejectedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=rejected_at,json=rejectedAt,proto3" json:"rejected_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OrderRejectedEvent) GetReasonCode() RejectCode {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return RejectCode_REJECT_CODE_UNSPECIFIED
 }
 
-func (x *OrderRejectedEvent) Reset() {
-	*x = OrderRejectedEvent{}
-	mi := &file_order_proto_msgTypes[13]
+type OrderExpiredEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Symbol         string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Side           OrderSide              `protobuf:"varint,4,opt,name=side,proto3,enum=order.OrderSide" json:"side,omitempty"`
+	TimeInForce    TimeInForce            `protobuf:"varint,5,opt,name=time_in_force,json=timeInForce,proto3,enum=order.TimeInForce" json:"time_in_force,omitempty"`
+	FilledQuantity float64                `protobuf:"fixed64,6,opt,name=filled_quantity,json=filledQuantity,proto3" json:"filled_quantity,omitempty"`
+	Reason         string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpiredAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrderExpiredEvent) Reset() {
+	*x = OrderExpiredEvent{}
+	mi := &file_order_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderRejectedEvent) String() string {
+func (x *OrderExpiredEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderRejectedEvent) ProtoMessage() {}
+func (*OrderExpiredEvent) ProtoMessage() {}
 
-func (x *OrderRejectedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[13]
+func (x *OrderExpiredEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1233,42 +2895,63 @@ func (x *OrderRejectedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderRejectedEvent.ProtoReflect.Descriptor instead.
-func (*OrderRejectedEvent) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use OrderExpiredEvent.ProtoReflect.Descriptor instead.
+func (*OrderExpiredEvent) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *OrderRejectedEvent) GetOrderId() string {
+func (x *OrderExpiredEvent) GetOrderId() string {
 	if x != nil {
 		return x.OrderId
 	}
 	return ""
 }
 
-func (x *OrderRejectedEvent) GetUserId() string {
+func (x *OrderExpiredEvent) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *OrderRejectedEvent) GetSymbol() string {
+func (x *OrderExpiredEvent) GetSymbol() string {
 	if x != nil {
 		return x.Symbol
 	}
 	return ""
 }
 
-func (x *OrderRejectedEvent) GetReason() string {
+func (x *OrderExpiredEvent) GetSide() OrderSide {
+	if x != nil {
+		return x.Side
+	}
+	return OrderSide_ORDER_SIDE_UNSPECIFIED
+}
+
+func (x *OrderExpiredEvent) GetTimeInForce() TimeInForce {
+	if x != nil {
+		return x.TimeInForce
+	}
+	return TimeInForce_TIME_IN_FORCE_UNSPECIFIED
+}
+
+func (x *OrderExpiredEvent) GetFilledQuantity() float64 {
+	if x != nil {
+		return x.FilledQuantity
+	}
+	return 0
+}
+
+func (x *OrderExpiredEvent) GetReason() string {
 	if x != nil {
 		return x.Reason
 	}
 	return ""
 }
 
-func (x *OrderRejectedEvent) GetRejectedAt() *timestamppb.Timestamp {
+func (x *OrderExpiredEvent) GetExpiredAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RejectedAt
+		return x.ExpiredAt
 	}
 	return nil
 }
@@ -1278,7 +2961,7 @@ var File_order_proto protoreflect.FileDescriptor
 const file_order_proto_rawDesc = "" +
 	"\n" +
 	"\vorder.proto\x12\x05order\x1a\n" +
-	"base.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd6\x03\n" +
+	"base.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x82\a\n" +
 	"\x05Order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
@@ -1296,20 +2979,42 @@ const file_order_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb3\x01\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x126\n" +
+	"\rtime_in_force\x18\x0e \x01(\x0e2\x12.order.TimeInForceR\vtimeInForce\x129\n" +
+	"\n" +
+	"expires_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"fill_count\x18\x10 \x01(\x05R\tfillCount\x12!\n" +
+	"\ftrail_amount\x18\x11 \x01(\x01R\vtrailAmount\x12#\n" +
+	"\rtrail_percent\x18\x12 \x01(\x01R\ftrailPercent\x12\x19\n" +
+	"\bgroup_id\x18\x13 \x01(\tR\agroupId\x124\n" +
+	"\n" +
+	"group_type\x18\x14 \x01(\x0e2\x15.order.OrderGroupTypeR\tgroupType\x12&\n" +
+	"\x0fparent_order_id\x18\x15 \x01(\tR\rparentOrderId\x122\n" +
+	"\vreject_code\x18\x16 \x01(\x0e2\x11.order.RejectCodeR\n" +
+	"rejectCode\x12#\n" +
+	"\rreject_reason\x18\x17 \x01(\tR\frejectReason\"\xe1\x02\n" +
 	"\tOrderFill\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\tR\aorderId\x12#\n" +
 	"\rfill_quantity\x18\x03 \x01(\x01R\ffillQuantity\x12\x1d\n" +
 	"\n" +
 	"fill_price\x18\x04 \x01(\x01R\tfillPrice\x127\n" +
-	"\tfilled_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bfilledAt\"I\n" +
+	"\tfilled_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bfilledAt\x12\x1a\n" +
+	"\bsequence\x18\x06 \x01(\x05R\bsequence\x121\n" +
+	"\apricing\x18\a \x01(\v2\x17.order.ExecutionPricingR\apricing\x12!\n" +
+	"\fquote_source\x18\b \x01(\tR\vquoteSource\x12:\n" +
+	"\vquote_as_of\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tquoteAsOf\"I\n" +
 	"\x13GetOrderByIdRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"_\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xbe\x01\n" +
 	"\x14GetOrderByIdResponse\x12\"\n" +
 	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\x12#\n" +
-	"\x04code\x18\x02 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\"a\n" +
+	"\x04code\x18\x02 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\x12&\n" +
+	"\x05fills\x18\x03 \x03(\v2\x10.order.OrderFillR\x05fills\x125\n" +
+	"\n" +
+	"amendments\x18\x04 \x03(\v2\x15.order.OrderAmendmentR\n" +
+	"amendments\"a\n" +
 	"\x18GetOrdersByUserIdRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
@@ -1318,7 +3023,12 @@ const file_order_proto_rawDesc = "" +
 	"\x06orders\x18\x01 \x03(\v2\f.order.OrderR\x06orders\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12#\n" +
-	"\x04code\x18\x03 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\"\x8e\x02\n" +
+	"\x04code\x18\x03 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\"/\n" +
+	"\x15ListOpenOrdersRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\"c\n" +
+	"\x16ListOpenOrdersResponse\x12$\n" +
+	"\x06orders\x18\x01 \x03(\v2\f.order.OrderR\x06orders\x12#\n" +
+	"\x04code\x18\x02 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\"\x83\x04\n" +
 	"\x12InsertOrderRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12$\n" +
@@ -1328,16 +3038,51 @@ const file_order_proto_rawDesc = "" +
 	"\bquantity\x18\x06 \x01(\x01R\bquantity\x12\x14\n" +
 	"\x05price\x18\a \x01(\x01R\x05price\x12\x1d\n" +
 	"\n" +
-	"stop_price\x18\b \x01(\x01R\tstopPrice\"^\n" +
+	"stop_price\x18\b \x01(\x01R\tstopPrice\x126\n" +
+	"\rtime_in_force\x18\t \x01(\x0e2\x12.order.TimeInForceR\vtimeInForce\x12!\n" +
+	"\ftrail_amount\x18\n" +
+	" \x01(\x01R\vtrailAmount\x12#\n" +
+	"\rtrail_percent\x18\v \x01(\x01R\ftrailPercent\x124\n" +
+	"\n" +
+	"group_type\x18\f \x01(\x0e2\x15.order.OrderGroupTypeR\tgroupType\x12=\n" +
+	"\x0fattached_orders\x18\r \x03(\v2\x14.order.AttachedOrderR\x0eattachedOrders\"\xb2\x01\n" +
+	"\rAttachedOrder\x12$\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x10.order.OrderTypeR\x04type\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x1d\n" +
+	"\n" +
+	"stop_price\x18\x03 \x01(\x01R\tstopPrice\x12!\n" +
+	"\ftrail_amount\x18\x04 \x01(\x01R\vtrailAmount\x12#\n" +
+	"\rtrail_percent\x18\x05 \x01(\x01R\ftrailPercent\"\x95\x01\n" +
 	"\x13InsertOrderResponse\x12\"\n" +
 	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\x12#\n" +
-	"\x04code\x18\x02 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\"H\n" +
+	"\x04code\x18\x02 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\x125\n" +
+	"\x0fattached_orders\x18\x03 \x03(\v2\f.order.OrderR\x0eattachedOrders\"H\n" +
 	"\x12CancelOrderRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"^\n" +
 	"\x13CancelOrderResponse\x12\"\n" +
 	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\x12#\n" +
-	"\x04code\x18\x02 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\"\xe3\x02\n" +
+	"\x04code\x18\x02 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\"z\n" +
+	"\x12ModifyOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x01R\bquantity\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\"\x93\x01\n" +
+	"\x13ModifyOrderResponse\x12\"\n" +
+	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\x12#\n" +
+	"\x04code\x18\x02 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\x123\n" +
+	"\tamendment\x18\x03 \x01(\v2\x15.order.OrderAmendmentR\tamendment\"\xad\x02\n" +
+	"\x0eOrderAmendment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x01R\bquantity\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12.\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x16.order.AmendmentStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vresolved_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\"\xa8\a\n" +
 	"\x11OrderCreatedEvent\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
@@ -1351,7 +3096,23 @@ const file_order_proto_rawDesc = "" +
 	"stop_price\x18\t \x01(\x01R\tstopPrice\x129\n" +
 	"\n" +
 	"created_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x84\x03\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12'\n" +
+	"\x0ffilled_quantity\x18\v \x01(\x01R\x0efilledQuantity\x12\x1d\n" +
+	"\n" +
+	"fill_count\x18\f \x01(\x05R\tfillCount\x126\n" +
+	"\rtime_in_force\x18\r \x01(\x0e2\x12.order.TimeInForceR\vtimeInForce\x129\n" +
+	"\n" +
+	"expires_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12!\n" +
+	"\ftrail_amount\x18\x0f \x01(\x01R\vtrailAmount\x12#\n" +
+	"\rtrail_percent\x18\x10 \x01(\x01R\ftrailPercent\x12'\n" +
+	"\x0ftrail_watermark\x18\x11 \x01(\x01R\x0etrailWatermark\x12\x19\n" +
+	"\bgroup_id\x18\x12 \x01(\tR\agroupId\x124\n" +
+	"\n" +
+	"group_type\x18\x13 \x01(\x0e2\x15.order.OrderGroupTypeR\tgroupType\x12&\n" +
+	"\x0fparent_order_id\x18\x14 \x01(\tR\rparentOrderId\x12A\n" +
+	"\x0fattached_orders\x18\x15 \x03(\v2\x18.order.OrderCreatedEventR\x0eattachedOrders\x12\"\n" +
+	"\roco_order_ids\x18\x16 \x03(\tR\vocoOrderIds\x127\n" +
+	"\tqueued_at\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\bqueuedAt\"\xcb\x05\n" +
 	"\x10OrderFilledEvent\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
@@ -1364,43 +3125,146 @@ const file_order_proto_rawDesc = "" +
 	"\x11settlement_amount\x18\b \x01(\x01R\x10settlementAmount\x12/\n" +
 	"\x13settlement_currency\x18\t \x01(\tR\x12settlementCurrency\x127\n" +
 	"\tfilled_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\bfilledAt\"\xf2\x01\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\bfilledAt\x12#\n" +
+	"\rfill_sequence\x18\v \x01(\x05R\ffillSequence\x12\x19\n" +
+	"\btrade_id\x18\f \x01(\tR\atradeId\x122\n" +
+	"\x15counterparty_order_id\x18\r \x01(\tR\x13counterpartyOrderId\x12-\n" +
+	"\x12remaining_quantity\x18\x0e \x01(\x01R\x11remainingQuantity\x12\x10\n" +
+	"\x03fee\x18\x0f \x01(\x01R\x03fee\x121\n" +
+	"\apricing\x18\x10 \x01(\v2\x17.order.ExecutionPricingR\apricing\x12!\n" +
+	"\fquote_source\x18\x11 \x01(\tR\vquoteSource\x12:\n" +
+	"\vquote_as_of\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tquoteAsOf\"\xa3\x02\n" +
+	"\x10ExecutionPricing\x12\x14\n" +
+	"\x05model\x18\x01 \x01(\tR\x05model\x12'\n" +
+	"\x0freference_price\x18\x02 \x01(\x01R\x0ereferencePrice\x12\x16\n" +
+	"\x06spread\x18\x03 \x01(\x01R\x06spread\x12\x16\n" +
+	"\x06impact\x18\x04 \x01(\x01R\x06impact\x12$\n" +
+	"\rparticipation\x18\x05 \x01(\x01R\rparticipation\x12\x1d\n" +
+	"\n" +
+	"min_spread\x18\x06 \x01(\x01R\tminSpread\x12,\n" +
+	"\x12spread_range_share\x18\a \x01(\x01R\x10spreadRangeShare\x12-\n" +
+	"\x12impact_coefficient\x18\b \x01(\x01R\x11impactCoefficient\"\xef\x01\n" +
+	"\x11OrderAmendedEvent\x12!\n" +
+	"\famendment_id\x18\x01 \x01(\tR\vamendmentId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06symbol\x18\x04 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x01R\bquantity\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x01R\x05price\x129\n" +
+	"\n" +
+	"amended_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tamendedAt\"\xad\x02\n" +
+	"\x1bOrderAmendmentResolvedEvent\x12!\n" +
+	"\famendment_id\x18\x01 \x01(\tR\vamendmentId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06symbol\x18\x04 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x01R\bquantity\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x01R\x05price\x12\x18\n" +
+	"\aapplied\x18\a \x01(\bR\aapplied\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12;\n" +
+	"\vresolved_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\"\xb3\x02\n" +
 	"\x13OrderCancelledEvent\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12$\n" +
 	"\x04side\x18\x04 \x01(\x0e2\x10.order.OrderSideR\x04side\x12*\n" +
 	"\x06status\x18\x05 \x01(\x0e2\x12.order.OrderStatusR\x06status\x12=\n" +
-	"\fcancelled_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\"\xb5\x01\n" +
+	"\fcancelled_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\x12'\n" +
+	"\x0ffilled_quantity\x18\a \x01(\x01R\x0efilledQuantity\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\"\xe4\x01\n" +
+	"\x19OrderCancelRequestedEvent\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12$\n" +
+	"\x04side\x18\x04 \x01(\x0e2\x10.order.OrderSideR\x04side\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12=\n" +
+	"\frequested_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\"\xbb\x01\n" +
+	"\x18OrderCancelRejectedEvent\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12;\n" +
+	"\vrejected_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"rejectedAt\"\xd8\x01\n" +
+	"\x0fOrderArmedEvent\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12&\n" +
+	"\x0fparent_order_id\x18\x04 \x01(\tR\rparentOrderId\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x01R\bquantity\x125\n" +
+	"\barmed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aarmedAt\"\x92\x02\n" +
 	"\x12OrderRejectedEvent\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12\x16\n" +
 	"\x06reason\x18\x04 \x01(\tR\x06reason\x12;\n" +
 	"\vrejected_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"rejectedAt*P\n" +
+	"rejectedAt\x12'\n" +
+	"\x0ffilled_quantity\x18\x06 \x01(\x01R\x0efilledQuantity\x122\n" +
+	"\vreason_code\x18\a \x01(\x0e2\x11.order.RejectCodeR\n" +
+	"reasonCode\"\xb9\x02\n" +
+	"\x11OrderExpiredEvent\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12$\n" +
+	"\x04side\x18\x04 \x01(\x0e2\x10.order.OrderSideR\x04side\x126\n" +
+	"\rtime_in_force\x18\x05 \x01(\x0e2\x12.order.TimeInForceR\vtimeInForce\x12'\n" +
+	"\x0ffilled_quantity\x18\x06 \x01(\x01R\x0efilledQuantity\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"expired_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiredAt*P\n" +
 	"\tOrderSide\x12\x1a\n" +
 	"\x16ORDER_SIDE_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eORDER_SIDE_BUY\x10\x01\x12\x13\n" +
-	"\x0fORDER_SIDE_SELL\x10\x02*\x84\x01\n" +
+	"\x0fORDER_SIDE_SELL\x10\x02*\xa2\x01\n" +
 	"\tOrderType\x12\x1a\n" +
 	"\x16ORDER_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11ORDER_TYPE_MARKET\x10\x01\x12\x14\n" +
 	"\x10ORDER_TYPE_LIMIT\x10\x02\x12\x13\n" +
 	"\x0fORDER_TYPE_STOP\x10\x03\x12\x19\n" +
-	"\x15ORDER_TYPE_STOP_LIMIT\x10\x04*\xb3\x01\n" +
+	"\x15ORDER_TYPE_STOP_LIMIT\x10\x04\x12\x1c\n" +
+	"\x18ORDER_TYPE_TRAILING_STOP\x10\x05*\x85\x02\n" +
 	"\vOrderStatus\x12\x1c\n" +
 	"\x18ORDER_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14ORDER_STATUS_PENDING\x10\x01\x12\x1d\n" +
 	"\x19ORDER_STATUS_PARTIAL_FILL\x10\x02\x12\x17\n" +
 	"\x13ORDER_STATUS_FILLED\x10\x03\x12\x19\n" +
 	"\x15ORDER_STATUS_CANCELED\x10\x04\x12\x19\n" +
-	"\x15ORDER_STATUS_REJECTED\x10\x052\xbb\x02\n" +
+	"\x15ORDER_STATUS_REJECTED\x10\x05\x12\x18\n" +
+	"\x14ORDER_STATUS_EXPIRED\x10\x06\x12\x15\n" +
+	"\x11ORDER_STATUS_HELD\x10\a\x12\x1f\n" +
+	"\x1bORDER_STATUS_PENDING_CANCEL\x10\b*\x88\x01\n" +
+	"\vTimeInForce\x12\x1d\n" +
+	"\x19TIME_IN_FORCE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TIME_IN_FORCE_DAY\x10\x01\x12\x15\n" +
+	"\x11TIME_IN_FORCE_GTC\x10\x02\x12\x15\n" +
+	"\x11TIME_IN_FORCE_IOC\x10\x03\x12\x15\n" +
+	"\x11TIME_IN_FORCE_FOK\x10\x04*j\n" +
+	"\x0eOrderGroupType\x12 \n" +
+	"\x1cORDER_GROUP_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18ORDER_GROUP_TYPE_BRACKET\x10\x01\x12\x18\n" +
+	"\x14ORDER_GROUP_TYPE_OCO\x10\x02*\xd1\x01\n" +
+	"\n" +
+	"RejectCode\x12\x1b\n" +
+	"\x17REJECT_CODE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eREJECT_CODE_MAX_ORDER_NOTIONAL\x10\x01\x12!\n" +
+	"\x1dREJECT_CODE_MAX_POSITION_SIZE\x10\x02\x12\x1f\n" +
+	"\x1bREJECT_CODE_MAX_OPEN_ORDERS\x10\x03\x12 \n" +
+	"\x1cREJECT_CODE_DAILY_LOSS_LIMIT\x10\x04\x12\x1c\n" +
+	"\x18REJECT_CODE_PRICE_COLLAR\x10\x05*\x8e\x01\n" +
+	"\x0fAmendmentStatus\x12 \n" +
+	"\x1cAMENDMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18AMENDMENT_STATUS_PENDING\x10\x01\x12\x1c\n" +
+	"\x18AMENDMENT_STATUS_APPLIED\x10\x02\x12\x1d\n" +
+	"\x19AMENDMENT_STATUS_REJECTED\x10\x032\xd0\x03\n" +
 	"\fOrderService\x12G\n" +
 	"\fGetOrderById\x12\x1a.order.GetOrderByIdRequest\x1a\x1b.order.GetOrderByIdResponse\x12V\n" +
 	"\x11GetOrdersByUserId\x12\x1f.order.GetOrdersByUserIdRequest\x1a .order.GetOrdersByUserIdResponse\x12D\n" +
 	"\vInsertOrder\x12\x19.order.InsertOrderRequest\x1a\x1a.order.InsertOrderResponse\x12D\n" +
-	"\vCancelOrder\x12\x19.order.CancelOrderRequest\x1a\x1a.order.CancelOrderResponseB\x18Z\x16fafnir/shared/pb/orderb\x06proto3"
+	"\vCancelOrder\x12\x19.order.CancelOrderRequest\x1a\x1a.order.CancelOrderResponse\x12M\n" +
+	"\x0eListOpenOrders\x12\x1c.order.ListOpenOrdersRequest\x1a\x1d.order.ListOpenOrdersResponse\x12D\n" +
+	"\vModifyOrder\x12\x19.order.ModifyOrderRequest\x1a\x1a.order.ModifyOrderResponseB\x18Z\x16fafnir/shared/pb/orderb\x06proto3"
 
 var (
 	file_order_proto_rawDescOnce sync.Once
@@ -1414,70 +3278,129 @@ func file_order_proto_rawDescGZIP() []byte {
 	return file_order_proto_rawDescData
 }
 
-var file_order_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_order_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_order_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_order_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_order_proto_goTypes = []any{
-	(OrderSide)(0),                    // 0: order.OrderSide
-	(OrderType)(0),                    // 1: order.OrderType
-	(OrderStatus)(0),                  // 2: order.OrderStatus
-	(*Order)(nil),                     // 3: order.Order
-	(*OrderFill)(nil),                 // 4: order.OrderFill
-	(*GetOrderByIdRequest)(nil),       // 5: order.GetOrderByIdRequest
-	(*GetOrderByIdResponse)(nil),      // 6: order.GetOrderByIdResponse
-	(*GetOrdersByUserIdRequest)(nil),  // 7: order.GetOrdersByUserIdRequest
-	(*GetOrdersByUserIdResponse)(nil), // 8: order.GetOrdersByUserIdResponse
-	(*InsertOrderRequest)(nil),        // 9: order.InsertOrderRequest
-	(*InsertOrderResponse)(nil),       // 10: order.InsertOrderResponse
-	(*CancelOrderRequest)(nil),        // 11: order.CancelOrderRequest
-	(*CancelOrderResponse)(nil),       // 12: order.CancelOrderResponse
-	(*OrderCreatedEvent)(nil),         // 13: order.OrderCreatedEvent
-	(*OrderFilledEvent)(nil),          // 14: order.OrderFilledEvent
-	(*OrderCancelledEvent)(nil),       // 15: order.OrderCancelledEvent
-	(*OrderRejectedEvent)(nil),        // 16: order.OrderRejectedEvent
-	(*timestamppb.Timestamp)(nil),     // 17: google.protobuf.Timestamp
-	(base.ErrorCode)(0),               // 18: base.ErrorCode
+	(OrderSide)(0),                      // 0: order.OrderSide
+	(OrderType)(0),                      // 1: order.OrderType
+	(OrderStatus)(0),                    // 2: order.OrderStatus
+	(TimeInForce)(0),                    // 3: order.TimeInForce
+	(OrderGroupType)(0),                 // 4: order.OrderGroupType
+	(RejectCode)(0),                     // 5: order.RejectCode
+	(AmendmentStatus)(0),                // 6: order.AmendmentStatus
+	(*Order)(nil),                       // 7: order.Order
+	(*OrderFill)(nil),                   // 8: order.OrderFill
+	(*GetOrderByIdRequest)(nil),         // 9: order.GetOrderByIdRequest
+	(*GetOrderByIdResponse)(nil),        // 10: order.GetOrderByIdResponse
+	(*GetOrdersByUserIdRequest)(nil),    // 11: order.GetOrdersByUserIdRequest
+	(*GetOrdersByUserIdResponse)(nil),   // 12: order.GetOrdersByUserIdResponse
+	(*ListOpenOrdersRequest)(nil),       // 13: order.ListOpenOrdersRequest
+	(*ListOpenOrdersResponse)(nil),      // 14: order.ListOpenOrdersResponse
+	(*InsertOrderRequest)(nil),          // 15: order.InsertOrderRequest
+	(*AttachedOrder)(nil),               // 16: order.AttachedOrder
+	(*InsertOrderResponse)(nil),         // 17: order.InsertOrderResponse
+	(*CancelOrderRequest)(nil),          // 18: order.CancelOrderRequest
+	(*CancelOrderResponse)(nil),         // 19: order.CancelOrderResponse
+	(*ModifyOrderRequest)(nil),          // 20: order.ModifyOrderRequest
+	(*ModifyOrderResponse)(nil),         // 21: order.ModifyOrderResponse
+	(*OrderAmendment)(nil),              // 22: order.OrderAmendment
+	(*OrderCreatedEvent)(nil),           // 23: order.OrderCreatedEvent
+	(*OrderFilledEvent)(nil),            // 24: order.OrderFilledEvent
+	(*ExecutionPricing)(nil),            // 25: order.ExecutionPricing
+	(*OrderAmendedEvent)(nil),           // 26: order.OrderAmendedEvent
+	(*OrderAmendmentResolvedEvent)(nil), // 27: order.OrderAmendmentResolvedEvent
+	(*OrderCancelledEvent)(nil),         // 28: order.OrderCancelledEvent
+	(*OrderCancelRequestedEvent)(nil),   // 29: order.OrderCancelRequestedEvent
+	(*OrderCancelRejectedEvent)(nil),    // 30: order.OrderCancelRejectedEvent
+	(*OrderArmedEvent)(nil),             // 31: order.OrderArmedEvent
+	(*OrderRejectedEvent)(nil),          // 32: order.OrderRejectedEvent
+	(*OrderExpiredEvent)(nil),           // 33: order.OrderExpiredEvent
+	(*timestamppb.Timestamp)(nil),       // 34: google.protobuf.Timestamp
+	(base.ErrorCode)(0),                 // 35: base.ErrorCode
 }
 var file_order_proto_depIdxs = []int32{
 	0,  // 0: order.Order.side:type_name -> order.OrderSide
 	1,  // 1: order.Order.type:type_name -> order.OrderType
 	2,  // 2: order.Order.status:type_name -> order.OrderStatus
-	17, // 3: order.Order.created_at:type_name -> google.protobuf.Timestamp
-	17, // 4: order.Order.updated_at:type_name -> google.protobuf.Timestamp
-	17, // 5: order.OrderFill.filled_at:type_name -> google.protobuf.Timestamp
-	3,  // 6: order.GetOrderByIdResponse.order:type_name -> order.Order
-	18, // 7: order.GetOrderByIdResponse.code:type_name -> base.ErrorCode
-	3,  // 8: order.GetOrdersByUserIdResponse.orders:type_name -> order.Order
-	18, // 9: order.GetOrdersByUserIdResponse.code:type_name -> base.ErrorCode
-	0,  // 10: order.InsertOrderRequest.side:type_name -> order.OrderSide
-	1,  // 11: order.InsertOrderRequest.type:type_name -> order.OrderType
-	2,  // 12: order.InsertOrderRequest.status:type_name -> order.OrderStatus
-	3,  // 13: order.InsertOrderResponse.order:type_name -> order.Order
-	18, // 14: order.InsertOrderResponse.code:type_name -> base.ErrorCode
-	3,  // 15: order.CancelOrderResponse.order:type_name -> order.Order
-	18, // 16: order.CancelOrderResponse.code:type_name -> base.ErrorCode
-	0,  // 17: order.OrderCreatedEvent.side:type_name -> order.OrderSide
-	1,  // 18: order.OrderCreatedEvent.type:type_name -> order.OrderType
-	2,  // 19: order.OrderCreatedEvent.status:type_name -> order.OrderStatus
-	17, // 20: order.OrderCreatedEvent.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 21: order.OrderFilledEvent.side:type_name -> order.OrderSide
-	17, // 22: order.OrderFilledEvent.filled_at:type_name -> google.protobuf.Timestamp
-	0,  // 23: order.OrderCancelledEvent.side:type_name -> order.OrderSide
-	2,  // 24: order.OrderCancelledEvent.status:type_name -> order.OrderStatus
-	17, // 25: order.OrderCancelledEvent.cancelled_at:type_name -> google.protobuf.Timestamp
-	17, // 26: order.OrderRejectedEvent.rejected_at:type_name -> google.protobuf.Timestamp
-	5,  // 27: order.OrderService.GetOrderById:input_type -> order.GetOrderByIdRequest
-	7,  // 28: order.OrderService.GetOrdersByUserId:input_type -> order.GetOrdersByUserIdRequest
-	9,  // 29: order.OrderService.InsertOrder:input_type -> order.InsertOrderRequest
-	11, // 30: order.OrderService.CancelOrder:input_type -> order.CancelOrderRequest
-	6,  // 31: order.OrderService.GetOrderById:output_type -> order.GetOrderByIdResponse
-	8,  // 32: order.OrderService.GetOrdersByUserId:output_type -> order.GetOrdersByUserIdResponse
-	10, // 33: order.OrderService.InsertOrder:output_type -> order.InsertOrderResponse
-	12, // 34: order.OrderService.CancelOrder:output_type -> order.CancelOrderResponse
-	31, // [31:35] is the sub-list for method output_type
-	27, // [27:31] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	34, // 3: order.Order.created_at:type_name -> google.protobuf.Timestamp
+	34, // 4: order.Order.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 5: order.Order.time_in_force:type_name -> order.TimeInForce
+	34, // 6: order.Order.expires_at:type_name -> google.protobuf.Timestamp
+	4,  // 7: order.Order.group_type:type_name -> order.OrderGroupType
+	5,  // 8: order.Order.reject_code:type_name -> order.RejectCode
+	34, // 9: order.OrderFill.filled_at:type_name -> google.protobuf.Timestamp
+	25, // 10: order.OrderFill.pricing:type_name -> order.ExecutionPricing
+	34, // 11: order.OrderFill.quote_as_of:type_name -> google.protobuf.Timestamp
+	7,  // 12: order.GetOrderByIdResponse.order:type_name -> order.Order
+	35, // 13: order.GetOrderByIdResponse.code:type_name -> base.ErrorCode
+	8,  // 14: order.GetOrderByIdResponse.fills:type_name -> order.OrderFill
+	22, // 15: order.GetOrderByIdResponse.amendments:type_name -> order.OrderAmendment
+	7,  // 16: order.GetOrdersByUserIdResponse.orders:type_name -> order.Order
+	35, // 17: order.GetOrdersByUserIdResponse.code:type_name -> base.ErrorCode
+	7,  // 18: order.ListOpenOrdersResponse.orders:type_name -> order.Order
+	35, // 19: order.ListOpenOrdersResponse.code:type_name -> base.ErrorCode
+	0,  // 20: order.InsertOrderRequest.side:type_name -> order.OrderSide
+	1,  // 21: order.InsertOrderRequest.type:type_name -> order.OrderType
+	2,  // 22: order.InsertOrderRequest.status:type_name -> order.OrderStatus
+	3,  // 23: order.InsertOrderRequest.time_in_force:type_name -> order.TimeInForce
+	4,  // 24: order.InsertOrderRequest.group_type:type_name -> order.OrderGroupType
+	16, // 25: order.InsertOrderRequest.attached_orders:type_name -> order.AttachedOrder
+	1,  // 26: order.AttachedOrder.type:type_name -> order.OrderType
+	7,  // 27: order.InsertOrderResponse.order:type_name -> order.Order
+	35, // 28: order.InsertOrderResponse.code:type_name -> base.ErrorCode
+	7,  // 29: order.InsertOrderResponse.attached_orders:type_name -> order.Order
+	7,  // 30: order.CancelOrderResponse.order:type_name -> order.Order
+	35, // 31: order.CancelOrderResponse.code:type_name -> base.ErrorCode
+	7,  // 32: order.ModifyOrderResponse.order:type_name -> order.Order
+	35, // 33: order.ModifyOrderResponse.code:type_name -> base.ErrorCode
+	22, // 34: order.ModifyOrderResponse.amendment:type_name -> order.OrderAmendment
+	6,  // 35: order.OrderAmendment.status:type_name -> order.AmendmentStatus
+	34, // 36: order.OrderAmendment.created_at:type_name -> google.protobuf.Timestamp
+	34, // 37: order.OrderAmendment.resolved_at:type_name -> google.protobuf.Timestamp
+	0,  // 38: order.OrderCreatedEvent.side:type_name -> order.OrderSide
+	1,  // 39: order.OrderCreatedEvent.type:type_name -> order.OrderType
+	2,  // 40: order.OrderCreatedEvent.status:type_name -> order.OrderStatus
+	34, // 41: order.OrderCreatedEvent.created_at:type_name -> google.protobuf.Timestamp
+	3,  // 42: order.OrderCreatedEvent.time_in_force:type_name -> order.TimeInForce
+	34, // 43: order.OrderCreatedEvent.expires_at:type_name -> google.protobuf.Timestamp
+	4,  // 44: order.OrderCreatedEvent.group_type:type_name -> order.OrderGroupType
+	23, // 45: order.OrderCreatedEvent.attached_orders:type_name -> order.OrderCreatedEvent
+	34, // 46: order.OrderCreatedEvent.queued_at:type_name -> google.protobuf.Timestamp
+	0,  // 47: order.OrderFilledEvent.side:type_name -> order.OrderSide
+	34, // 48: order.OrderFilledEvent.filled_at:type_name -> google.protobuf.Timestamp
+	25, // 49: order.OrderFilledEvent.pricing:type_name -> order.ExecutionPricing
+	34, // 50: order.OrderFilledEvent.quote_as_of:type_name -> google.protobuf.Timestamp
+	34, // 51: order.OrderAmendedEvent.amended_at:type_name -> google.protobuf.Timestamp
+	34, // 52: order.OrderAmendmentResolvedEvent.resolved_at:type_name -> google.protobuf.Timestamp
+	0,  // 53: order.OrderCancelledEvent.side:type_name -> order.OrderSide
+	2,  // 54: order.OrderCancelledEvent.status:type_name -> order.OrderStatus
+	34, // 55: order.OrderCancelledEvent.cancelled_at:type_name -> google.protobuf.Timestamp
+	0,  // 56: order.OrderCancelRequestedEvent.side:type_name -> order.OrderSide
+	34, // 57: order.OrderCancelRequestedEvent.requested_at:type_name -> google.protobuf.Timestamp
+	34, // 58: order.OrderCancelRejectedEvent.rejected_at:type_name -> google.protobuf.Timestamp
+	34, // 59: order.OrderArmedEvent.armed_at:type_name -> google.protobuf.Timestamp
+	34, // 60: order.OrderRejectedEvent.rejected_at:type_name -> google.protobuf.Timestamp
+	5,  // 61: order.OrderRejectedEvent.reason_code:type_name -> order.RejectCode
+	0,  // 62: order.OrderExpiredEvent.side:type_name -> order.OrderSide
+	3,  // 63: order.OrderExpiredEvent.time_in_force:type_name -> order.TimeInForce
+	34, // 64: order.OrderExpiredEvent.expired_at:type_name -> google.protobuf.Timestamp
+	9,  // 65: order.OrderService.GetOrderById:input_type -> order.GetOrderByIdRequest
+	11, // 66: order.OrderService.GetOrdersByUserId:input_type -> order.GetOrdersByUserIdRequest
+	15, // 67: order.OrderService.InsertOrder:input_type -> order.InsertOrderRequest
+	18, // 68: order.OrderService.CancelOrder:input_type -> order.CancelOrderRequest
+	13, // 69: order.OrderService.ListOpenOrders:input_type -> order.ListOpenOrdersRequest
+	20, // 70: order.OrderService.ModifyOrder:input_type -> order.ModifyOrderRequest
+	10, // 71: order.OrderService.GetOrderById:output_type -> order.GetOrderByIdResponse
+	12, // 72: order.OrderService.GetOrdersByUserId:output_type -> order.GetOrdersByUserIdResponse
+	17, // 73: order.OrderService.InsertOrder:output_type -> order.InsertOrderResponse
+	19, // 74: order.OrderService.CancelOrder:output_type -> order.CancelOrderResponse
+	14, // 75: order.OrderService.ListOpenOrders:output_type -> order.ListOpenOrdersResponse
+	21, // 76: order.OrderService.ModifyOrder:output_type -> order.ModifyOrderResponse
+	71, // [71:77] is the sub-list for method output_type
+	65, // [65:71] is the sub-list for method input_type
+	65, // [65:65] is the sub-list for extension type_name
+	65, // [65:65] is the sub-list for extension extendee
+	0,  // [0:65] is the sub-list for field type_name
 }
 
 func init() { file_order_proto_init() }
@@ -1490,8 +3413,8 @@ func file_order_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_proto_rawDesc), len(file_order_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   14,
+			NumEnums:      7,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -133,6 +133,9 @@ const (
 	TransactionType_TRANSACTION_TYPE_SELL         TransactionType = 4
 	TransactionType_TRANSACTION_TYPE_TRANSFER_IN  TransactionType = 5
 	TransactionType_TRANSACTION_TYPE_TRANSFER_OUT TransactionType = 6
+	TransactionType_TRANSACTION_TYPE_FEE          TransactionType = 7
+	// a balance carried over from before the ledger, that the history recorded until then did not explain
+	TransactionType_TRANSACTION_TYPE_ADJUSTMENT TransactionType = 8
 )
 
 // Enum value maps for TransactionType.
@@ -144,6 +147,8 @@ var (
 		4: "TRANSACTION_TYPE_SELL",
 		5: "TRANSACTION_TYPE_TRANSFER_IN",
 		6: "TRANSACTION_TYPE_TRANSFER_OUT",
+		7: "TRANSACTION_TYPE_FEE",
+		8: "TRANSACTION_TYPE_ADJUSTMENT",
 	}
 	TransactionType_value = map[string]int32{
 		"TRANSACTION_TYPE_UNSPECIFIED":  0,
@@ -152,6 +157,8 @@ var (
 		"TRANSACTION_TYPE_SELL":         4,
 		"TRANSACTION_TYPE_TRANSFER_IN":  5,
 		"TRANSACTION_TYPE_TRANSFER_OUT": 6,
+		"TRANSACTION_TYPE_FEE":          7,
+		"TRANSACTION_TYPE_ADJUSTMENT":   8,
 	}
 )
 
@@ -182,18 +189,179 @@ func (TransactionType) EnumDescriptor() ([]byte, []int) {
 	return file_portfolio_proto_rawDescGZIP(), []int{2}
 }
 
+// which tax lots a sell closes, and so the cost its realized profit is measured against
+type LotMethod int32
+
+const (
+	LotMethod_LOT_METHOD_UNSPECIFIED LotMethod = 0
+	LotMethod_LOT_METHOD_FIFO        LotMethod = 1
+	LotMethod_LOT_METHOD_LIFO        LotMethod = 2
+	// lots picked per order with SelectTaxLots, oldest first for whatever is not picked
+	LotMethod_LOT_METHOD_SPECIFIC LotMethod = 3
+	// lots close oldest first, at the average cost of the holding
+	LotMethod_LOT_METHOD_AVERAGE_COST LotMethod = 4
+)
+
+// Enum value maps for LotMethod.
+var (
+	LotMethod_name = map[int32]string{
+		0: "LOT_METHOD_UNSPECIFIED",
+		1: "LOT_METHOD_FIFO",
+		2: "LOT_METHOD_LIFO",
+		3: "LOT_METHOD_SPECIFIC",
+		4: "LOT_METHOD_AVERAGE_COST",
+	}
+	LotMethod_value = map[string]int32{
+		"LOT_METHOD_UNSPECIFIED":  0,
+		"LOT_METHOD_FIFO":         1,
+		"LOT_METHOD_LIFO":         2,
+		"LOT_METHOD_SPECIFIC":     3,
+		"LOT_METHOD_AVERAGE_COST": 4,
+	}
+)
+
+func (x LotMethod) Enum() *LotMethod {
+	p := new(LotMethod)
+	*p = x
+	return p
+}
+
+func (x LotMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LotMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_portfolio_proto_enumTypes[3].Descriptor()
+}
+
+func (LotMethod) Type() protoreflect.EnumType {
+	return &file_portfolio_proto_enumTypes[3]
+}
+
+func (x LotMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LotMethod.Descriptor instead.
+func (LotMethod) EnumDescriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{3}
+}
+
+type HoldKind int32
+
+const (
+	HoldKind_HOLD_KIND_UNSPECIFIED HoldKind = 0
+	HoldKind_HOLD_KIND_CASH        HoldKind = 1
+	HoldKind_HOLD_KIND_SHARES      HoldKind = 2
+)
+
+// Enum value maps for HoldKind.
+var (
+	HoldKind_name = map[int32]string{
+		0: "HOLD_KIND_UNSPECIFIED",
+		1: "HOLD_KIND_CASH",
+		2: "HOLD_KIND_SHARES",
+	}
+	HoldKind_value = map[string]int32{
+		"HOLD_KIND_UNSPECIFIED": 0,
+		"HOLD_KIND_CASH":        1,
+		"HOLD_KIND_SHARES":      2,
+	}
+)
+
+func (x HoldKind) Enum() *HoldKind {
+	p := new(HoldKind)
+	*p = x
+	return p
+}
+
+func (x HoldKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HoldKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_portfolio_proto_enumTypes[4].Descriptor()
+}
+
+func (HoldKind) Type() protoreflect.EnumType {
+	return &file_portfolio_proto_enumTypes[4]
+}
+
+func (x HoldKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HoldKind.Descriptor instead.
+func (HoldKind) EnumDescriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{4}
+}
+
+type HoldStatus int32
+
+const (
+	HoldStatus_HOLD_STATUS_UNSPECIFIED HoldStatus = 0
+	HoldStatus_HOLD_STATUS_ACTIVE      HoldStatus = 1
+	HoldStatus_HOLD_STATUS_SETTLED     HoldStatus = 2
+	HoldStatus_HOLD_STATUS_RELEASED    HoldStatus = 3
+)
+
+// Enum value maps for HoldStatus.
+var (
+	HoldStatus_name = map[int32]string{
+		0: "HOLD_STATUS_UNSPECIFIED",
+		1: "HOLD_STATUS_ACTIVE",
+		2: "HOLD_STATUS_SETTLED",
+		3: "HOLD_STATUS_RELEASED",
+	}
+	HoldStatus_value = map[string]int32{
+		"HOLD_STATUS_UNSPECIFIED": 0,
+		"HOLD_STATUS_ACTIVE":      1,
+		"HOLD_STATUS_SETTLED":     2,
+		"HOLD_STATUS_RELEASED":    3,
+	}
+)
+
+func (x HoldStatus) Enum() *HoldStatus {
+	p := new(HoldStatus)
+	*p = x
+	return p
+}
+
+func (x HoldStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HoldStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_portfolio_proto_enumTypes[5].Descriptor()
+}
+
+func (HoldStatus) Type() protoreflect.EnumType {
+	return &file_portfolio_proto_enumTypes[5]
+}
+
+func (x HoldStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HoldStatus.Descriptor instead.
+func (HoldStatus) EnumDescriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{5}
+}
+
 type Account struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	AccountNumber string                 `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
-	Type          AccountType            `protobuf:"varint,4,opt,name=type,proto3,enum=portfolio.AccountType" json:"type,omitempty"`
-	Currency      CurrencyType           `protobuf:"varint,5,opt,name=currency,proto3,enum=portfolio.CurrencyType" json:"currency,omitempty"`
-	Balance       float64                `protobuf:"fixed64,6,opt,name=balance,proto3" json:"balance,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountNumber    string                 `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Type             AccountType            `protobuf:"varint,4,opt,name=type,proto3,enum=portfolio.AccountType" json:"type,omitempty"`
+	Currency         CurrencyType           `protobuf:"varint,5,opt,name=currency,proto3,enum=portfolio.CurrencyType" json:"currency,omitempty"`
+	Balance          float64                `protobuf:"fixed64,6,opt,name=balance,proto3" json:"balance,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	AvailableBalance float64                `protobuf:"fixed64,9,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+	LotMethod        LotMethod              `protobuf:"varint,10,opt,name=lot_method,json=lotMethod,proto3,enum=portfolio.LotMethod" json:"lot_method,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Account) Reset() {
@@ -282,17 +450,32 @@ func (x *Account) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Account) GetAvailableBalance() float64 {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return 0
+}
+
+func (x *Account) GetLotMethod() LotMethod {
+	if x != nil {
+		return x.LotMethod
+	}
+	return LotMethod_LOT_METHOD_UNSPECIFIED
+}
+
 type Holding struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Symbol        string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Quantity      float64                `protobuf:"fixed64,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	AvgCost       float64                `protobuf:"fixed64,5,opt,name=avg_cost,json=avgCost,proto3" json:"avg_cost,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId         string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Symbol            string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Quantity          float64                `protobuf:"fixed64,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	AvgCost           float64                `protobuf:"fixed64,5,opt,name=avg_cost,json=avgCost,proto3" json:"avg_cost,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	AvailableQuantity float64                `protobuf:"fixed64,8,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Holding) Reset() {
@@ -374,28 +557,46 @@ func (x *Holding) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type WatchlistItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Holding) GetAvailableQuantity() float64 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
 }
 
-func (x *WatchlistItem) Reset() {
-	*x = WatchlistItem{}
+type Hold struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId           string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	AccountId         string                 `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Kind              HoldKind               `protobuf:"varint,4,opt,name=kind,proto3,enum=portfolio.HoldKind" json:"kind,omitempty"`
+	Symbol            string                 `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Quantity          float64                `protobuf:"fixed64,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	RemainingQuantity float64                `protobuf:"fixed64,7,opt,name=remaining_quantity,json=remainingQuantity,proto3" json:"remaining_quantity,omitempty"`
+	Amount            float64                `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	RemainingAmount   float64                `protobuf:"fixed64,9,opt,name=remaining_amount,json=remainingAmount,proto3" json:"remaining_amount,omitempty"`
+	Status            HoldStatus             `protobuf:"varint,10,opt,name=status,proto3,enum=portfolio.HoldStatus" json:"status,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	GroupId           string                 `protobuf:"bytes,13,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Hold) Reset() {
+	*x = Hold{}
 	mi := &file_portfolio_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WatchlistItem) String() string {
+func (x *Hold) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WatchlistItem) ProtoMessage() {}
+func (*Hold) ProtoMessage() {}
 
-func (x *WatchlistItem) ProtoReflect() protoreflect.Message {
+func (x *Hold) ProtoReflect() protoreflect.Message {
 	mi := &file_portfolio_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -407,48 +608,133 @@ func (x *WatchlistItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WatchlistItem.ProtoReflect.Descriptor instead.
-func (*WatchlistItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use Hold.ProtoReflect.Descriptor instead.
+func (*Hold) Descriptor() ([]byte, []int) {
 	return file_portfolio_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *WatchlistItem) GetSymbol() string {
+func (x *Hold) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Hold) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Hold) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Hold) GetKind() HoldKind {
+	if x != nil {
+		return x.Kind
+	}
+	return HoldKind_HOLD_KIND_UNSPECIFIED
+}
+
+func (x *Hold) GetSymbol() string {
 	if x != nil {
 		return x.Symbol
 	}
 	return ""
 }
 
-func (x *WatchlistItem) GetAddedAt() *timestamppb.Timestamp {
+func (x *Hold) GetQuantity() float64 {
 	if x != nil {
-		return x.AddedAt
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Hold) GetRemainingQuantity() float64 {
+	if x != nil {
+		return x.RemainingQuantity
+	}
+	return 0
+}
+
+func (x *Hold) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Hold) GetRemainingAmount() float64 {
+	if x != nil {
+		return x.RemainingAmount
+	}
+	return 0
+}
+
+func (x *Hold) GetStatus() HoldStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HoldStatus_HOLD_STATUS_UNSPECIFIED
+}
+
+func (x *Hold) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type CreateAccountRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Type          AccountType            `protobuf:"varint,2,opt,name=type,proto3,enum=portfolio.AccountType" json:"type,omitempty"`
-	Currency      CurrencyType           `protobuf:"varint,3,opt,name=currency,proto3,enum=portfolio.CurrencyType" json:"currency,omitempty"`
+func (x *Hold) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Hold) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+// shares bought by one fill, closed by later sells
+type TaxLot struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId         string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Symbol            string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	OrderId           string                 `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Quantity          float64                `protobuf:"fixed64,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	RemainingQuantity float64                `protobuf:"fixed64,6,opt,name=remaining_quantity,json=remainingQuantity,proto3" json:"remaining_quantity,omitempty"`
+	// per share, fees of the buy included
+	CostBasis     float64                `protobuf:"fixed64,7,opt,name=cost_basis,json=costBasis,proto3" json:"cost_basis,omitempty"`
+	OpenedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	ClosedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAccountRequest) Reset() {
-	*x = CreateAccountRequest{}
+func (x *TaxLot) Reset() {
+	*x = TaxLot{}
 	mi := &file_portfolio_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAccountRequest) String() string {
+func (x *TaxLot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAccountRequest) ProtoMessage() {}
+func (*TaxLot) ProtoMessage() {}
 
-func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *TaxLot) ProtoReflect() protoreflect.Message {
 	mi := &file_portfolio_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -460,106 +746,110 @@ func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
-func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaxLot.ProtoReflect.Descriptor instead.
+func (*TaxLot) Descriptor() ([]byte, []int) {
 	return file_portfolio_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateAccountRequest) GetUserId() string {
+func (x *TaxLot) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateAccountRequest) GetType() AccountType {
+func (x *TaxLot) GetAccountId() string {
 	if x != nil {
-		return x.Type
+		return x.AccountId
 	}
-	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *CreateAccountRequest) GetCurrency() CurrencyType {
+func (x *TaxLot) GetSymbol() string {
 	if x != nil {
-		return x.Currency
+		return x.Symbol
 	}
-	return CurrencyType_CURRENCY_TYPE_UNSPECIFIED
-}
-
-type CreateAccountResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
-	Account       *Account               `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *CreateAccountResponse) Reset() {
-	*x = CreateAccountResponse{}
-	mi := &file_portfolio_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TaxLot) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
 }
 
-func (x *CreateAccountResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TaxLot) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
 }
 
-func (*CreateAccountResponse) ProtoMessage() {}
-
-func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[4]
+func (x *TaxLot) GetRemainingQuantity() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RemainingQuantity
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
-func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{4}
+func (x *TaxLot) GetCostBasis() float64 {
+	if x != nil {
+		return x.CostBasis
+	}
+	return 0
 }
 
-func (x *CreateAccountResponse) GetCode() base.ErrorCode {
+func (x *TaxLot) GetOpenedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Code
+		return x.OpenedAt
 	}
-	return base.ErrorCode(0)
+	return nil
 }
 
-func (x *CreateAccountResponse) GetAccount() *Account {
+func (x *TaxLot) GetClosedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Account
+		return x.ClosedAt
 	}
 	return nil
 }
 
-type GetPortfolioSummaryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+// the part of a sell that closed one tax lot
+type RealizedGain struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Symbol    string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// unset for shares that were not covered by a lot
+	LotId    string  `protobuf:"bytes,4,opt,name=lot_id,json=lotId,proto3" json:"lot_id,omitempty"`
+	OrderId  string  `protobuf:"bytes,5,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Quantity float64 `protobuf:"fixed64,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// after the sell's fees
+	Proceeds      float64                `protobuf:"fixed64,7,opt,name=proceeds,proto3" json:"proceeds,omitempty"`
+	CostBasis     float64                `protobuf:"fixed64,8,opt,name=cost_basis,json=costBasis,proto3" json:"cost_basis,omitempty"`
+	RealizedPnl   float64                `protobuf:"fixed64,9,opt,name=realized_pnl,json=realizedPnl,proto3" json:"realized_pnl,omitempty"`
+	Method        LotMethod              `protobuf:"varint,10,opt,name=method,proto3,enum=portfolio.LotMethod" json:"method,omitempty"`
+	OpenedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	RealizedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=realized_at,json=realizedAt,proto3" json:"realized_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPortfolioSummaryRequest) Reset() {
-	*x = GetPortfolioSummaryRequest{}
-	mi := &file_portfolio_proto_msgTypes[5]
+func (x *RealizedGain) Reset() {
+	*x = RealizedGain{}
+	mi := &file_portfolio_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPortfolioSummaryRequest) String() string {
+func (x *RealizedGain) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPortfolioSummaryRequest) ProtoMessage() {}
+func (*RealizedGain) ProtoMessage() {}
 
-func (x *GetPortfolioSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[5]
+func (x *RealizedGain) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -570,42 +860,1779 @@ func (x *GetPortfolioSummaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPortfolioSummaryRequest.ProtoReflect.Descriptor instead.
-func (*GetPortfolioSummaryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RealizedGain.ProtoReflect.Descriptor instead.
+func (*RealizedGain) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RealizedGain) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RealizedGain) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *RealizedGain) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *RealizedGain) GetLotId() string {
+	if x != nil {
+		return x.LotId
+	}
+	return ""
+}
+
+func (x *RealizedGain) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RealizedGain) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetProceeds() float64 {
+	if x != nil {
+		return x.Proceeds
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetCostBasis() float64 {
+	if x != nil {
+		return x.CostBasis
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetRealizedPnl() float64 {
+	if x != nil {
+		return x.RealizedPnl
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetMethod() LotMethod {
+	if x != nil {
+		return x.Method
+	}
+	return LotMethod_LOT_METHOD_UNSPECIFIED
+}
+
+func (x *RealizedGain) GetOpenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return nil
+}
+
+func (x *RealizedGain) GetRealizedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RealizedAt
+	}
+	return nil
+}
+
+type SymbolRealizedGain struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Proceeds      float64                `protobuf:"fixed64,3,opt,name=proceeds,proto3" json:"proceeds,omitempty"`
+	CostBasis     float64                `protobuf:"fixed64,4,opt,name=cost_basis,json=costBasis,proto3" json:"cost_basis,omitempty"`
+	RealizedPnl   float64                `protobuf:"fixed64,5,opt,name=realized_pnl,json=realizedPnl,proto3" json:"realized_pnl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SymbolRealizedGain) Reset() {
+	*x = SymbolRealizedGain{}
+	mi := &file_portfolio_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SymbolRealizedGain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolRealizedGain) ProtoMessage() {}
+
+func (x *SymbolRealizedGain) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymbolRealizedGain.ProtoReflect.Descriptor instead.
+func (*SymbolRealizedGain) Descriptor() ([]byte, []int) {
 	return file_portfolio_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetPortfolioSummaryRequest) GetUserId() string {
+func (x *SymbolRealizedGain) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *SymbolRealizedGain) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *SymbolRealizedGain) GetProceeds() float64 {
+	if x != nil {
+		return x.Proceeds
+	}
+	return 0
+}
+
+func (x *SymbolRealizedGain) GetCostBasis() float64 {
+	if x != nil {
+		return x.CostBasis
+	}
+	return 0
+}
+
+func (x *SymbolRealizedGain) GetRealizedPnl() float64 {
+	if x != nil {
+		return x.RealizedPnl
+	}
+	return 0
+}
+
+type WatchlistItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchlistItem) Reset() {
+	*x = WatchlistItem{}
+	mi := &file_portfolio_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistItem) ProtoMessage() {}
+
+func (x *WatchlistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistItem.ProtoReflect.Descriptor instead.
+func (*WatchlistItem) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WatchlistItem) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *WatchlistItem) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+type CreateAccountRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type     AccountType            `protobuf:"varint,2,opt,name=type,proto3,enum=portfolio.AccountType" json:"type,omitempty"`
+	Currency CurrencyType           `protobuf:"varint,3,opt,name=currency,proto3,enum=portfolio.CurrencyType" json:"currency,omitempty"`
+	// FIFO when unspecified
+	LotMethod     LotMethod `protobuf:"varint,4,opt,name=lot_method,json=lotMethod,proto3,enum=portfolio.LotMethod" json:"lot_method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
+	mi := &file_portfolio_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountRequest) ProtoMessage() {}
+
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetType() AccountType {
+	if x != nil {
+		return x.Type
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *CreateAccountRequest) GetCurrency() CurrencyType {
+	if x != nil {
+		return x.Currency
+	}
+	return CurrencyType_CURRENCY_TYPE_UNSPECIFIED
+}
+
+func (x *CreateAccountRequest) GetLotMethod() LotMethod {
+	if x != nil {
+		return x.LotMethod
+	}
+	return LotMethod_LOT_METHOD_UNSPECIFIED
+}
+
+type CreateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	Account       *Account               `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountResponse) Reset() {
+	*x = CreateAccountResponse{}
+	mi := &file_portfolio_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountResponse) ProtoMessage() {}
+
+func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateAccountResponse) GetCode() base.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return base.ErrorCode(0)
+}
+
+func (x *CreateAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type GetPortfolioSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortfolioSummaryRequest) Reset() {
+	*x = GetPortfolioSummaryRequest{}
+	mi := &file_portfolio_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortfolioSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioSummaryRequest) ProtoMessage() {}
+
+func (x *GetPortfolioSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetPortfolioSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetPortfolioSummaryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetPortfolioSummaryResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Code         base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	Accounts     []*Account             `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	TotalBalance float64                `protobuf:"fixed64,3,opt,name=total_balance,json=totalBalance,proto3" json:"total_balance,omitempty"`
+	// trading fees charged across all accounts
+	TotalFees     float64 `protobuf:"fixed64,4,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortfolioSummaryResponse) Reset() {
+	*x = GetPortfolioSummaryResponse{}
+	mi := &file_portfolio_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortfolioSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioSummaryResponse) ProtoMessage() {}
+
+func (x *GetPortfolioSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetPortfolioSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPortfolioSummaryResponse) GetCode() base.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return base.ErrorCode(0)
+}
+
+func (x *GetPortfolioSummaryResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *GetPortfolioSummaryResponse) GetTotalBalance() float64 {
+	if x != nil {
+		return x.TotalBalance
+	}
+	return 0
+}
+
+func (x *GetPortfolioSummaryResponse) GetTotalFees() float64 {
+	if x != nil {
+		return x.TotalFees
+	}
+	return 0
+}
+
+type GetHoldingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHoldingsRequest) Reset() {
+	*x = GetHoldingsRequest{}
+	mi := &file_portfolio_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHoldingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHoldingsRequest) ProtoMessage() {}
+
+func (x *GetHoldingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHoldingsRequest.ProtoReflect.Descriptor instead.
+func (*GetHoldingsRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetHoldingsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type GetHoldingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	Holdings      []*Holding             `protobuf:"bytes,2,rep,name=holdings,proto3" json:"holdings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHoldingsResponse) Reset() {
+	*x = GetHoldingsResponse{}
+	mi := &file_portfolio_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHoldingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHoldingsResponse) ProtoMessage() {}
+
+func (x *GetHoldingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHoldingsResponse.ProtoReflect.Descriptor instead.
+func (*GetHoldingsResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetHoldingsResponse) GetCode() base.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return base.ErrorCode(0)
+}
+
+func (x *GetHoldingsResponse) GetHoldings() []*Holding {
+	if x != nil {
+		return x.Holdings
+	}
+	return nil
+}
+
+type GetHoldingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHoldingRequest) Reset() {
+	*x = GetHoldingRequest{}
+	mi := &file_portfolio_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHoldingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHoldingRequest) ProtoMessage() {}
+
+func (x *GetHoldingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHoldingRequest.ProtoReflect.Descriptor instead.
+func (*GetHoldingRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetHoldingRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GetHoldingRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type GetHoldingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	Holding       *Holding               `protobuf:"bytes,2,opt,name=holding,proto3" json:"holding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHoldingResponse) Reset() {
+	*x = GetHoldingResponse{}
+	mi := &file_portfolio_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHoldingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHoldingResponse) ProtoMessage() {}
+
+func (x *GetHoldingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHoldingResponse.ProtoReflect.Descriptor instead.
+func (*GetHoldingResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetHoldingResponse) GetCode() base.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return base.ErrorCode(0)
+}
+
+func (x *GetHoldingResponse) GetHolding() *Holding {
+	if x != nil {
+		return x.Holding
+	}
+	return nil
+}
+
+type GetWatchlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWatchlistRequest) Reset() {
+	*x = GetWatchlistRequest{}
+	mi := &file_portfolio_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWatchlistRequest) ProtoMessage() {}
+
+func (x *GetWatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWatchlistRequest.ProtoReflect.Descriptor instead.
+func (*GetWatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetWatchlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetWatchlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	Items         []*WatchlistItem       `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWatchlistResponse) Reset() {
+	*x = GetWatchlistResponse{}
+	mi := &file_portfolio_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWatchlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWatchlistResponse) ProtoMessage() {}
+
+func (x *GetWatchlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWatchlistResponse.ProtoReflect.Descriptor instead.
+func (*GetWatchlistResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetWatchlistResponse) GetCode() base.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return base.ErrorCode(0)
+}
+
+func (x *GetWatchlistResponse) GetItems() []*WatchlistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AddToWatchlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToWatchlistRequest) Reset() {
+	*x = AddToWatchlistRequest{}
+	mi := &file_portfolio_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToWatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToWatchlistRequest) ProtoMessage() {}
+
+func (x *AddToWatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToWatchlistRequest.ProtoReflect.Descriptor instead.
+func (*AddToWatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AddToWatchlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddToWatchlistRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type AddToWatchlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToWatchlistResponse) Reset() {
+	*x = AddToWatchlistResponse{}
+	mi := &file_portfolio_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToWatchlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToWatchlistResponse) ProtoMessage() {}
+
+func (x *AddToWatchlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToWatchlistResponse.ProtoReflect.Descriptor instead.
+func (*AddToWatchlistResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AddToWatchlistResponse) GetCode() base.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return base.ErrorCode(0)
+}
+
+type RemoveFromWatchlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromWatchlistRequest) Reset() {
+	*x = RemoveFromWatchlistRequest{}
+	mi := &file_portfolio_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromWatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromWatchlistRequest) ProtoMessage() {}
+
+func (x *RemoveFromWatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromWatchlistRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromWatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RemoveFromWatchlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveFromWatchlistRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type RemoveFromWatchlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromWatchlistResponse) Reset() {
+	*x = RemoveFromWatchlistResponse{}
+	mi := &file_portfolio_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromWatchlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromWatchlistResponse) ProtoMessage() {}
+
+func (x *RemoveFromWatchlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromWatchlistResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFromWatchlistResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RemoveFromWatchlistResponse) GetCode() base.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return base.ErrorCode(0)
+}
+
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_portfolio_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type DeleteAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_portfolio_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteAccountResponse) GetCode() base.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return base.ErrorCode(0)
+}
+
+type Transaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Type          TransactionType        `protobuf:"varint,3,opt,name=type,proto3,enum=portfolio.TransactionType" json:"type,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ReferenceId   string                 `protobuf:"bytes,6,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_portfolio_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Transaction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Transaction) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Transaction) GetType() TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *Transaction) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Transaction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Transaction) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *Transaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionsRequest) Reset() {
+	*x = GetTransactionsRequest{}
+	mi := &file_portfolio_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsRequest) ProtoMessage() {}
+
+func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetTransactionsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type GetTransactionsResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Code         base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	Transactions []*Transaction         `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// trading fees charged to the account
+	TotalFees     float64 `protobuf:"fixed64,3,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionsResponse) Reset() {
+	*x = GetTransactionsResponse{}
+	mi := &file_portfolio_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsResponse) ProtoMessage() {}
+
+func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetTransactionsResponse) GetCode() base.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return base.ErrorCode(0)
+}
+
+func (x *GetTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *GetTransactionsResponse) GetTotalFees() float64 {
+	if x != nil {
+		return x.TotalFees
+	}
+	return 0
+}
+
+type DepositRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      CurrencyType           `protobuf:"varint,3,opt,name=currency,proto3,enum=portfolio.CurrencyType" json:"currency,omitempty"`
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepositRequest) Reset() {
+	*x = DepositRequest{}
+	mi := &file_portfolio_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositRequest) ProtoMessage() {}
+
+func (x *DepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
+func (*DepositRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DepositRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *DepositRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *DepositRequest) GetCurrency() CurrencyType {
+	if x != nil {
+		return x.Currency
+	}
+	return CurrencyType_CURRENCY_TYPE_UNSPECIFIED
+}
+
+func (x *DepositRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DepositResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	NewBalance    float64                `protobuf:"fixed64,2,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepositResponse) Reset() {
+	*x = DepositResponse{}
+	mi := &file_portfolio_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositResponse) ProtoMessage() {}
+
+func (x *DepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositResponse.ProtoReflect.Descriptor instead.
+func (*DepositResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DepositResponse) GetCode() base.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return base.ErrorCode(0)
+}
+
+func (x *DepositResponse) GetNewBalance() float64 {
+	if x != nil {
+		return x.NewBalance
+	}
+	return 0
+}
+
+type TransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromAccountId string                 `protobuf:"bytes,1,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	ToAccountId   string                 `protobuf:"bytes,2,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      CurrencyType           `protobuf:"varint,4,opt,name=currency,proto3,enum=portfolio.CurrencyType" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferRequest) Reset() {
+	*x = TransferRequest{}
+	mi := &file_portfolio_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferRequest) ProtoMessage() {}
+
+func (x *TransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferRequest.ProtoReflect.Descriptor instead.
+func (*TransferRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TransferRequest) GetFromAccountId() string {
+	if x != nil {
+		return x.FromAccountId
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetToAccountId() string {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransferRequest) GetCurrency() CurrencyType {
+	if x != nil {
+		return x.Currency
+	}
+	return CurrencyType_CURRENCY_TYPE_UNSPECIFIED
+}
+
+type TransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferResponse) Reset() {
+	*x = TransferResponse{}
+	mi := &file_portfolio_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferResponse) ProtoMessage() {}
+
+func (x *TransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferResponse.ProtoReflect.Descriptor instead.
+func (*TransferResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TransferResponse) GetCode() base.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return base.ErrorCode(0)
+}
+
+type ReserveHoldRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	OrderId  string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId   string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind     HoldKind               `protobuf:"varint,3,opt,name=kind,proto3,enum=portfolio.HoldKind" json:"kind,omitempty"`
+	Symbol   string                 `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Quantity float64                `protobuf:"fixed64,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Amount   float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// orders of one one-cancels-other group can never all fill, so their holds only count once
+	GroupId string `protobuf:"bytes,7,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// the currency amount is in, converted into the account's currency; empty means the account's currency
+	Currency      string `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveHoldRequest) Reset() {
+	*x = ReserveHoldRequest{}
+	mi := &file_portfolio_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveHoldRequest) ProtoMessage() {}
+
+func (x *ReserveHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveHoldRequest.ProtoReflect.Descriptor instead.
+func (*ReserveHoldRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReserveHoldRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ReserveHoldRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReserveHoldRequest) GetKind() HoldKind {
+	if x != nil {
+		return x.Kind
+	}
+	return HoldKind_HOLD_KIND_UNSPECIFIED
+}
+
+func (x *ReserveHoldRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ReserveHoldRequest) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReserveHoldRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ReserveHoldRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ReserveHoldRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ReserveHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	Hold          *Hold                  `protobuf:"bytes,2,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveHoldResponse) Reset() {
+	*x = ReserveHoldResponse{}
+	mi := &file_portfolio_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveHoldResponse) ProtoMessage() {}
+
+func (x *ReserveHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveHoldResponse.ProtoReflect.Descriptor instead.
+func (*ReserveHoldResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ReserveHoldResponse) GetCode() base.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return base.ErrorCode(0)
+}
+
+func (x *ReserveHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+type ReleaseHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseHoldRequest) Reset() {
+	*x = ReleaseHoldRequest{}
+	mi := &file_portfolio_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHoldRequest) ProtoMessage() {}
+
+func (x *ReleaseHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHoldRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseHoldRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReleaseHoldRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type ReleaseHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	Hold          *Hold                  `protobuf:"bytes,2,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseHoldResponse) Reset() {
+	*x = ReleaseHoldResponse{}
+	mi := &file_portfolio_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHoldResponse) ProtoMessage() {}
+
+func (x *ReleaseHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHoldResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseHoldResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReleaseHoldResponse) GetCode() base.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return base.ErrorCode(0)
+}
+
+func (x *ReleaseHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+type GetHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHoldRequest) Reset() {
+	*x = GetHoldRequest{}
+	mi := &file_portfolio_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHoldRequest) ProtoMessage() {}
+
+func (x *GetHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[34]
 	if x != nil {
-		return x.UserId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHoldRequest.ProtoReflect.Descriptor instead.
+func (*GetHoldRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetHoldRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
 	}
 	return ""
 }
 
-type GetPortfolioSummaryResponse struct {
+type GetHoldResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
-	Accounts      []*Account             `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
-	TotalBalance  float64                `protobuf:"fixed64,3,opt,name=total_balance,json=totalBalance,proto3" json:"total_balance,omitempty"`
+	Hold          *Hold                  `protobuf:"bytes,2,opt,name=hold,proto3" json:"hold,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPortfolioSummaryResponse) Reset() {
-	*x = GetPortfolioSummaryResponse{}
-	mi := &file_portfolio_proto_msgTypes[6]
+func (x *GetHoldResponse) Reset() {
+	*x = GetHoldResponse{}
+	mi := &file_portfolio_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPortfolioSummaryResponse) String() string {
+func (x *GetHoldResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPortfolioSummaryResponse) ProtoMessage() {}
+func (*GetHoldResponse) ProtoMessage() {}
 
-func (x *GetPortfolioSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[6]
+func (x *GetHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,54 +2643,111 @@ func (x *GetPortfolioSummaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPortfolioSummaryResponse.ProtoReflect.Descriptor instead.
-func (*GetPortfolioSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetHoldResponse.ProtoReflect.Descriptor instead.
+func (*GetHoldResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetPortfolioSummaryResponse) GetCode() base.ErrorCode {
+func (x *GetHoldResponse) GetCode() base.ErrorCode {
 	if x != nil {
 		return x.Code
 	}
 	return base.ErrorCode(0)
 }
 
-func (x *GetPortfolioSummaryResponse) GetAccounts() []*Account {
+func (x *GetHoldResponse) GetHold() *Hold {
 	if x != nil {
-		return x.Accounts
+		return x.Hold
 	}
 	return nil
 }
 
-func (x *GetPortfolioSummaryResponse) GetTotalBalance() float64 {
+// moves what an active hold still reserves by the given changes, for an amended order, so whatever fills consume
+// in the meantime stays consumed; growing it needs the buying power or shares, and it cannot shrink below zero.
+// Applying the negated changes undoes a resize.
+type ResizeHoldRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	QuantityChange float64                `protobuf:"fixed64,2,opt,name=quantity_change,json=quantityChange,proto3" json:"quantity_change,omitempty"`
+	AmountChange   float64                `protobuf:"fixed64,3,opt,name=amount_change,json=amountChange,proto3" json:"amount_change,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResizeHoldRequest) Reset() {
+	*x = ResizeHoldRequest{}
+	mi := &file_portfolio_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResizeHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizeHoldRequest) ProtoMessage() {}
+
+func (x *ResizeHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[36]
 	if x != nil {
-		return x.TotalBalance
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizeHoldRequest.ProtoReflect.Descriptor instead.
+func (*ResizeHoldRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ResizeHoldRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ResizeHoldRequest) GetQuantityChange() float64 {
+	if x != nil {
+		return x.QuantityChange
 	}
 	return 0
 }
 
-type GetHoldingsRequest struct {
+func (x *ResizeHoldRequest) GetAmountChange() float64 {
+	if x != nil {
+		return x.AmountChange
+	}
+	return 0
+}
+
+type ResizeHoldResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	Hold          *Hold                  `protobuf:"bytes,2,opt,name=hold,proto3" json:"hold,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHoldingsRequest) Reset() {
-	*x = GetHoldingsRequest{}
-	mi := &file_portfolio_proto_msgTypes[7]
+func (x *ResizeHoldResponse) Reset() {
+	*x = ResizeHoldResponse{}
+	mi := &file_portfolio_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHoldingsRequest) String() string {
+func (x *ResizeHoldResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHoldingsRequest) ProtoMessage() {}
+func (*ResizeHoldResponse) ProtoMessage() {}
 
-func (x *GetHoldingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[7]
+func (x *ResizeHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -674,41 +2758,197 @@ func (x *GetHoldingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHoldingsRequest.ProtoReflect.Descriptor instead.
-func (*GetHoldingsRequest) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ResizeHoldResponse.ProtoReflect.Descriptor instead.
+func (*ResizeHoldResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetHoldingsRequest) GetAccountId() string {
+func (x *ResizeHoldResponse) GetCode() base.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return base.ErrorCode(0)
+}
+
+func (x *ResizeHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+type GetRiskExposureRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Symbol string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// orders left out of the open order count, such as the order being checked once its hold is reserved
+	ExcludedOrderIds []string `protobuf:"bytes,3,rep,name=excluded_order_ids,json=excludedOrderIds,proto3" json:"excluded_order_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetRiskExposureRequest) Reset() {
+	*x = GetRiskExposureRequest{}
+	mi := &file_portfolio_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRiskExposureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRiskExposureRequest) ProtoMessage() {}
+
+func (x *GetRiskExposureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRiskExposureRequest.ProtoReflect.Descriptor instead.
+func (*GetRiskExposureRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetRiskExposureRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetRiskExposureRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetRiskExposureRequest) GetExcludedOrderIds() []string {
+	if x != nil {
+		return x.ExcludedOrderIds
+	}
+	return nil
+}
+
+// what pre-trade risk checks need to know about a user's investment account, in the account's currency
+type RiskExposure struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Currency  CurrencyType           `protobuf:"varint,2,opt,name=currency,proto3,enum=portfolio.CurrencyType" json:"currency,omitempty"`
+	// cash plus holdings at market value, or at cost for holdings without a quote
+	AccountValue     float64 `protobuf:"fixed64,3,opt,name=account_value,json=accountValue,proto3" json:"account_value,omitempty"`
+	PositionQuantity float64 `protobuf:"fixed64,4,opt,name=position_quantity,json=positionQuantity,proto3" json:"position_quantity,omitempty"`
+	// realized profit (negative for a loss) of sells settled since the start of the trading day
+	RealizedPnlToday float64 `protobuf:"fixed64,5,opt,name=realized_pnl_today,json=realizedPnlToday,proto3" json:"realized_pnl_today,omitempty"`
+	// orders holding cash or shares: every working order except bracket exits that are not armed yet
+	OpenOrders    int32 `protobuf:"varint,6,opt,name=open_orders,json=openOrders,proto3" json:"open_orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RiskExposure) Reset() {
+	*x = RiskExposure{}
+	mi := &file_portfolio_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiskExposure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskExposure) ProtoMessage() {}
+
+func (x *RiskExposure) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskExposure.ProtoReflect.Descriptor instead.
+func (*RiskExposure) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RiskExposure) GetAccountId() string {
 	if x != nil {
 		return x.AccountId
 	}
 	return ""
 }
 
-type GetHoldingsResponse struct {
+func (x *RiskExposure) GetCurrency() CurrencyType {
+	if x != nil {
+		return x.Currency
+	}
+	return CurrencyType_CURRENCY_TYPE_UNSPECIFIED
+}
+
+func (x *RiskExposure) GetAccountValue() float64 {
+	if x != nil {
+		return x.AccountValue
+	}
+	return 0
+}
+
+func (x *RiskExposure) GetPositionQuantity() float64 {
+	if x != nil {
+		return x.PositionQuantity
+	}
+	return 0
+}
+
+func (x *RiskExposure) GetRealizedPnlToday() float64 {
+	if x != nil {
+		return x.RealizedPnlToday
+	}
+	return 0
+}
+
+func (x *RiskExposure) GetOpenOrders() int32 {
+	if x != nil {
+		return x.OpenOrders
+	}
+	return 0
+}
+
+type GetRiskExposureResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
-	Holdings      []*Holding             `protobuf:"bytes,2,rep,name=holdings,proto3" json:"holdings,omitempty"`
+	Exposure      *RiskExposure          `protobuf:"bytes,2,opt,name=exposure,proto3" json:"exposure,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHoldingsResponse) Reset() {
-	*x = GetHoldingsResponse{}
-	mi := &file_portfolio_proto_msgTypes[8]
+func (x *GetRiskExposureResponse) Reset() {
+	*x = GetRiskExposureResponse{}
+	mi := &file_portfolio_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHoldingsResponse) String() string {
+func (x *GetRiskExposureResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHoldingsResponse) ProtoMessage() {}
+func (*GetRiskExposureResponse) ProtoMessage() {}
 
-func (x *GetHoldingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[8]
+func (x *GetRiskExposureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -719,48 +2959,49 @@ func (x *GetHoldingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHoldingsResponse.ProtoReflect.Descriptor instead.
-func (*GetHoldingsResponse) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetRiskExposureResponse.ProtoReflect.Descriptor instead.
+func (*GetRiskExposureResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetHoldingsResponse) GetCode() base.ErrorCode {
+func (x *GetRiskExposureResponse) GetCode() base.ErrorCode {
 	if x != nil {
 		return x.Code
 	}
 	return base.ErrorCode(0)
 }
 
-func (x *GetHoldingsResponse) GetHoldings() []*Holding {
+func (x *GetRiskExposureResponse) GetExposure() *RiskExposure {
 	if x != nil {
-		return x.Holdings
+		return x.Exposure
 	}
 	return nil
 }
 
-type GetHoldingRequest struct {
+// applies to sells that settle from now on; lots closed before keep the cost they were closed at
+type SetLotMethodRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Method        LotMethod              `protobuf:"varint,2,opt,name=method,proto3,enum=portfolio.LotMethod" json:"method,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHoldingRequest) Reset() {
-	*x = GetHoldingRequest{}
-	mi := &file_portfolio_proto_msgTypes[9]
+func (x *SetLotMethodRequest) Reset() {
+	*x = SetLotMethodRequest{}
+	mi := &file_portfolio_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHoldingRequest) String() string {
+func (x *SetLotMethodRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHoldingRequest) ProtoMessage() {}
+func (*SetLotMethodRequest) ProtoMessage() {}
 
-func (x *GetHoldingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[9]
+func (x *SetLotMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -771,48 +3012,48 @@ func (x *GetHoldingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHoldingRequest.ProtoReflect.Descriptor instead.
-func (*GetHoldingRequest) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use SetLotMethodRequest.ProtoReflect.Descriptor instead.
+func (*SetLotMethodRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetHoldingRequest) GetAccountId() string {
+func (x *SetLotMethodRequest) GetAccountId() string {
 	if x != nil {
 		return x.AccountId
 	}
 	return ""
 }
 
-func (x *GetHoldingRequest) GetSymbol() string {
+func (x *SetLotMethodRequest) GetMethod() LotMethod {
 	if x != nil {
-		return x.Symbol
+		return x.Method
 	}
-	return ""
+	return LotMethod_LOT_METHOD_UNSPECIFIED
 }
 
-type GetHoldingResponse struct {
+type SetLotMethodResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
-	Holding       *Holding               `protobuf:"bytes,2,opt,name=holding,proto3" json:"holding,omitempty"`
+	Account       *Account               `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHoldingResponse) Reset() {
-	*x = GetHoldingResponse{}
-	mi := &file_portfolio_proto_msgTypes[10]
+func (x *SetLotMethodResponse) Reset() {
+	*x = SetLotMethodResponse{}
+	mi := &file_portfolio_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHoldingResponse) String() string {
+func (x *SetLotMethodResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHoldingResponse) ProtoMessage() {}
+func (*SetLotMethodResponse) ProtoMessage() {}
 
-func (x *GetHoldingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[10]
+func (x *SetLotMethodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,47 +3064,50 @@ func (x *GetHoldingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHoldingResponse.ProtoReflect.Descriptor instead.
-func (*GetHoldingResponse) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use SetLotMethodResponse.ProtoReflect.Descriptor instead.
+func (*SetLotMethodResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetHoldingResponse) GetCode() base.ErrorCode {
+func (x *SetLotMethodResponse) GetCode() base.ErrorCode {
 	if x != nil {
 		return x.Code
 	}
 	return base.ErrorCode(0)
 }
 
-func (x *GetHoldingResponse) GetHolding() *Holding {
+func (x *SetLotMethodResponse) GetAccount() *Account {
 	if x != nil {
-		return x.Holding
+		return x.Account
 	}
 	return nil
 }
 
-type GetWatchlistRequest struct {
+// picks the lots a sell order closes, in order, for accounts using LOT_METHOD_SPECIFIC
+type SelectTaxLotsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	LotIds        []string               `protobuf:"bytes,3,rep,name=lot_ids,json=lotIds,proto3" json:"lot_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWatchlistRequest) Reset() {
-	*x = GetWatchlistRequest{}
-	mi := &file_portfolio_proto_msgTypes[11]
+func (x *SelectTaxLotsRequest) Reset() {
+	*x = SelectTaxLotsRequest{}
+	mi := &file_portfolio_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWatchlistRequest) String() string {
+func (x *SelectTaxLotsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWatchlistRequest) ProtoMessage() {}
+func (*SelectTaxLotsRequest) ProtoMessage() {}
 
-func (x *GetWatchlistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[11]
+func (x *SelectTaxLotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,41 +3118,54 @@ func (x *GetWatchlistRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWatchlistRequest.ProtoReflect.Descriptor instead.
-func (*GetWatchlistRequest) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SelectTaxLotsRequest.ProtoReflect.Descriptor instead.
+func (*SelectTaxLotsRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetWatchlistRequest) GetUserId() string {
+func (x *SelectTaxLotsRequest) GetAccountId() string {
 	if x != nil {
-		return x.UserId
+		return x.AccountId
 	}
 	return ""
 }
 
-type GetWatchlistResponse struct {
+func (x *SelectTaxLotsRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *SelectTaxLotsRequest) GetLotIds() []string {
+	if x != nil {
+		return x.LotIds
+	}
+	return nil
+}
+
+type SelectTaxLotsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
-	Items         []*WatchlistItem       `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWatchlistResponse) Reset() {
-	*x = GetWatchlistResponse{}
-	mi := &file_portfolio_proto_msgTypes[12]
+func (x *SelectTaxLotsResponse) Reset() {
+	*x = SelectTaxLotsResponse{}
+	mi := &file_portfolio_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWatchlistResponse) String() string {
+func (x *SelectTaxLotsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWatchlistResponse) ProtoMessage() {}
+func (*SelectTaxLotsResponse) ProtoMessage() {}
 
-func (x *GetWatchlistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[12]
+func (x *SelectTaxLotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -919,48 +3176,43 @@ func (x *GetWatchlistResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWatchlistResponse.ProtoReflect.Descriptor instead.
-func (*GetWatchlistResponse) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SelectTaxLotsResponse.ProtoReflect.Descriptor instead.
+func (*SelectTaxLotsResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetWatchlistResponse) GetCode() base.ErrorCode {
+func (x *SelectTaxLotsResponse) GetCode() base.ErrorCode {
 	if x != nil {
 		return x.Code
 	}
 	return base.ErrorCode(0)
 }
 
-func (x *GetWatchlistResponse) GetItems() []*WatchlistItem {
-	if x != nil {
-		return x.Items
-	}
-	return nil
-}
-
-type AddToWatchlistRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+type GetTaxLotsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// all symbols when empty
+	Symbol        string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	IncludeClosed bool   `protobuf:"varint,3,opt,name=include_closed,json=includeClosed,proto3" json:"include_closed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddToWatchlistRequest) Reset() {
-	*x = AddToWatchlistRequest{}
-	mi := &file_portfolio_proto_msgTypes[13]
+func (x *GetTaxLotsRequest) Reset() {
+	*x = GetTaxLotsRequest{}
+	mi := &file_portfolio_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddToWatchlistRequest) String() string {
+func (x *GetTaxLotsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddToWatchlistRequest) ProtoMessage() {}
+func (*GetTaxLotsRequest) ProtoMessage() {}
 
-func (x *AddToWatchlistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[13]
+func (x *GetTaxLotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -971,47 +3223,55 @@ func (x *AddToWatchlistRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddToWatchlistRequest.ProtoReflect.Descriptor instead.
-func (*AddToWatchlistRequest) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetTaxLotsRequest.ProtoReflect.Descriptor instead.
+func (*GetTaxLotsRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *AddToWatchlistRequest) GetUserId() string {
+func (x *GetTaxLotsRequest) GetAccountId() string {
 	if x != nil {
-		return x.UserId
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *AddToWatchlistRequest) GetSymbol() string {
+func (x *GetTaxLotsRequest) GetSymbol() string {
 	if x != nil {
 		return x.Symbol
 	}
 	return ""
 }
 
-type AddToWatchlistResponse struct {
+func (x *GetTaxLotsRequest) GetIncludeClosed() bool {
+	if x != nil {
+		return x.IncludeClosed
+	}
+	return false
+}
+
+type GetTaxLotsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	Lots          []*TaxLot              `protobuf:"bytes,2,rep,name=lots,proto3" json:"lots,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddToWatchlistResponse) Reset() {
-	*x = AddToWatchlistResponse{}
-	mi := &file_portfolio_proto_msgTypes[14]
+func (x *GetTaxLotsResponse) Reset() {
+	*x = GetTaxLotsResponse{}
+	mi := &file_portfolio_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddToWatchlistResponse) String() string {
+func (x *GetTaxLotsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddToWatchlistResponse) ProtoMessage() {}
+func (*GetTaxLotsResponse) ProtoMessage() {}
 
-func (x *AddToWatchlistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[14]
+func (x *GetTaxLotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1022,41 +3282,52 @@ func (x *AddToWatchlistResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddToWatchlistResponse.ProtoReflect.Descriptor instead.
-func (*AddToWatchlistResponse) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetTaxLotsResponse.ProtoReflect.Descriptor instead.
+func (*GetTaxLotsResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *AddToWatchlistResponse) GetCode() base.ErrorCode {
+func (x *GetTaxLotsResponse) GetCode() base.ErrorCode {
 	if x != nil {
 		return x.Code
 	}
 	return base.ErrorCode(0)
 }
 
-type RemoveFromWatchlistRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+func (x *GetTaxLotsResponse) GetLots() []*TaxLot {
+	if x != nil {
+		return x.Lots
+	}
+	return nil
+}
+
+type GetRealizedGainsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// all symbols when empty
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// the period the sells settled in; unbounded on a side that is unset
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveFromWatchlistRequest) Reset() {
-	*x = RemoveFromWatchlistRequest{}
-	mi := &file_portfolio_proto_msgTypes[15]
+func (x *GetRealizedGainsRequest) Reset() {
+	*x = GetRealizedGainsRequest{}
+	mi := &file_portfolio_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveFromWatchlistRequest) String() string {
+func (x *GetRealizedGainsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveFromWatchlistRequest) ProtoMessage() {}
+func (*GetRealizedGainsRequest) ProtoMessage() {}
 
-func (x *RemoveFromWatchlistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[15]
+func (x *GetRealizedGainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1067,47 +3338,64 @@ func (x *RemoveFromWatchlistRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveFromWatchlistRequest.ProtoReflect.Descriptor instead.
-func (*RemoveFromWatchlistRequest) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetRealizedGainsRequest.ProtoReflect.Descriptor instead.
+func (*GetRealizedGainsRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *RemoveFromWatchlistRequest) GetUserId() string {
+func (x *GetRealizedGainsRequest) GetAccountId() string {
 	if x != nil {
-		return x.UserId
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *RemoveFromWatchlistRequest) GetSymbol() string {
+func (x *GetRealizedGainsRequest) GetSymbol() string {
 	if x != nil {
 		return x.Symbol
 	}
 	return ""
 }
 
-type RemoveFromWatchlistResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetRealizedGainsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
 }
 
-func (x *RemoveFromWatchlistResponse) Reset() {
-	*x = RemoveFromWatchlistResponse{}
-	mi := &file_portfolio_proto_msgTypes[16]
+func (x *GetRealizedGainsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetRealizedGainsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Code             base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	Gains            []*RealizedGain        `protobuf:"bytes,2,rep,name=gains,proto3" json:"gains,omitempty"`
+	BySymbol         []*SymbolRealizedGain  `protobuf:"bytes,3,rep,name=by_symbol,json=bySymbol,proto3" json:"by_symbol,omitempty"`
+	TotalRealizedPnl float64                `protobuf:"fixed64,4,opt,name=total_realized_pnl,json=totalRealizedPnl,proto3" json:"total_realized_pnl,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetRealizedGainsResponse) Reset() {
+	*x = GetRealizedGainsResponse{}
+	mi := &file_portfolio_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveFromWatchlistResponse) String() string {
+func (x *GetRealizedGainsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveFromWatchlistResponse) ProtoMessage() {}
+func (*GetRealizedGainsResponse) ProtoMessage() {}
 
-func (x *RemoveFromWatchlistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[16]
+func (x *GetRealizedGainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1118,40 +3406,61 @@ func (x *RemoveFromWatchlistResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveFromWatchlistResponse.ProtoReflect.Descriptor instead.
-func (*RemoveFromWatchlistResponse) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetRealizedGainsResponse.ProtoReflect.Descriptor instead.
+func (*GetRealizedGainsResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *RemoveFromWatchlistResponse) GetCode() base.ErrorCode {
+func (x *GetRealizedGainsResponse) GetCode() base.ErrorCode {
 	if x != nil {
 		return x.Code
 	}
 	return base.ErrorCode(0)
 }
 
-type DeleteAccountRequest struct {
+func (x *GetRealizedGainsResponse) GetGains() []*RealizedGain {
+	if x != nil {
+		return x.Gains
+	}
+	return nil
+}
+
+func (x *GetRealizedGainsResponse) GetBySymbol() []*SymbolRealizedGain {
+	if x != nil {
+		return x.BySymbol
+	}
+	return nil
+}
+
+func (x *GetRealizedGainsResponse) GetTotalRealizedPnl() float64 {
+	if x != nil {
+		return x.TotalRealizedPnl
+	}
+	return 0
+}
+
+type GetPortfolioValuationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAccountRequest) Reset() {
-	*x = DeleteAccountRequest{}
-	mi := &file_portfolio_proto_msgTypes[17]
+func (x *GetPortfolioValuationRequest) Reset() {
+	*x = GetPortfolioValuationRequest{}
+	mi := &file_portfolio_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAccountRequest) String() string {
+func (x *GetPortfolioValuationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAccountRequest) ProtoMessage() {}
+func (*GetPortfolioValuationRequest) ProtoMessage() {}
 
-func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[17]
+func (x *GetPortfolioValuationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1162,40 +3471,58 @@ func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetPortfolioValuationRequest.ProtoReflect.Descriptor instead.
+func (*GetPortfolioValuationRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *DeleteAccountRequest) GetAccountId() string {
+func (x *GetPortfolioValuationRequest) GetUserId() string {
 	if x != nil {
-		return x.AccountId
+		return x.UserId
 	}
 	return ""
 }
 
-type DeleteAccountResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+// a holding marked to its latest quote; amounts are in the account currency unless noted
+type HoldingValuation struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Symbol   string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Quantity float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	AvgCost  float64                `protobuf:"fixed64,3,opt,name=avg_cost,json=avgCost,proto3" json:"avg_cost,omitempty"`
+	// the quote's currency, and the rate it was converted into the account currency at
+	QuoteCurrency string  `protobuf:"bytes,4,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
+	ExchangeRate  float64 `protobuf:"fixed64,5,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	// in the quote currency
+	LastPrice        float64 `protobuf:"fixed64,6,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+	MarketValue      float64 `protobuf:"fixed64,7,opt,name=market_value,json=marketValue,proto3" json:"market_value,omitempty"`
+	CostBasis        float64 `protobuf:"fixed64,8,opt,name=cost_basis,json=costBasis,proto3" json:"cost_basis,omitempty"`
+	UnrealizedPnl    float64 `protobuf:"fixed64,9,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"`
+	UnrealizedPnlPct float64 `protobuf:"fixed64,10,opt,name=unrealized_pnl_pct,json=unrealizedPnlPct,proto3" json:"unrealized_pnl_pct,omitempty"`
+	// change in market value since the previous close
+	DayChange    float64 `protobuf:"fixed64,11,opt,name=day_change,json=dayChange,proto3" json:"day_change,omitempty"`
+	DayChangePct float64 `protobuf:"fixed64,12,opt,name=day_change_pct,json=dayChangePct,proto3" json:"day_change_pct,omitempty"`
+	// false when no quote or exchange rate was available; the holding is then valued at cost
+	Priced        bool                   `protobuf:"varint,13,opt,name=priced,proto3" json:"priced,omitempty"`
+	QuoteAsOf     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=quote_as_of,json=quoteAsOf,proto3" json:"quote_as_of,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAccountResponse) Reset() {
-	*x = DeleteAccountResponse{}
-	mi := &file_portfolio_proto_msgTypes[18]
+func (x *HoldingValuation) Reset() {
+	*x = HoldingValuation{}
+	mi := &file_portfolio_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAccountResponse) String() string {
+func (x *HoldingValuation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAccountResponse) ProtoMessage() {}
+func (*HoldingValuation) ProtoMessage() {}
 
-func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[18]
+func (x *HoldingValuation) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1206,46 +3533,142 @@ func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
-func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use HoldingValuation.ProtoReflect.Descriptor instead.
+func (*HoldingValuation) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *DeleteAccountResponse) GetCode() base.ErrorCode {
+func (x *HoldingValuation) GetSymbol() string {
 	if x != nil {
-		return x.Code
+		return x.Symbol
 	}
-	return base.ErrorCode(0)
+	return ""
 }
 
-type Transaction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Type          TransactionType        `protobuf:"varint,3,opt,name=type,proto3,enum=portfolio.TransactionType" json:"type,omitempty"`
-	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	ReferenceId   string                 `protobuf:"bytes,6,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+func (x *HoldingValuation) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *HoldingValuation) GetAvgCost() float64 {
+	if x != nil {
+		return x.AvgCost
+	}
+	return 0
+}
+
+func (x *HoldingValuation) GetQuoteCurrency() string {
+	if x != nil {
+		return x.QuoteCurrency
+	}
+	return ""
+}
+
+func (x *HoldingValuation) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
+}
+
+func (x *HoldingValuation) GetLastPrice() float64 {
+	if x != nil {
+		return x.LastPrice
+	}
+	return 0
+}
+
+func (x *HoldingValuation) GetMarketValue() float64 {
+	if x != nil {
+		return x.MarketValue
+	}
+	return 0
+}
+
+func (x *HoldingValuation) GetCostBasis() float64 {
+	if x != nil {
+		return x.CostBasis
+	}
+	return 0
+}
+
+func (x *HoldingValuation) GetUnrealizedPnl() float64 {
+	if x != nil {
+		return x.UnrealizedPnl
+	}
+	return 0
+}
+
+func (x *HoldingValuation) GetUnrealizedPnlPct() float64 {
+	if x != nil {
+		return x.UnrealizedPnlPct
+	}
+	return 0
+}
+
+func (x *HoldingValuation) GetDayChange() float64 {
+	if x != nil {
+		return x.DayChange
+	}
+	return 0
+}
+
+func (x *HoldingValuation) GetDayChangePct() float64 {
+	if x != nil {
+		return x.DayChangePct
+	}
+	return 0
+}
+
+func (x *HoldingValuation) GetPriced() bool {
+	if x != nil {
+		return x.Priced
+	}
+	return false
+}
+
+func (x *HoldingValuation) GetQuoteAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QuoteAsOf
+	}
+	return nil
+}
+
+type AccountValuation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccountId        string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Currency         CurrencyType           `protobuf:"varint,2,opt,name=currency,proto3,enum=portfolio.CurrencyType" json:"currency,omitempty"`
+	CashBalance      float64                `protobuf:"fixed64,3,opt,name=cash_balance,json=cashBalance,proto3" json:"cash_balance,omitempty"`
+	MarketValue      float64                `protobuf:"fixed64,4,opt,name=market_value,json=marketValue,proto3" json:"market_value,omitempty"`
+	CostBasis        float64                `protobuf:"fixed64,5,opt,name=cost_basis,json=costBasis,proto3" json:"cost_basis,omitempty"`
+	UnrealizedPnl    float64                `protobuf:"fixed64,6,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"`
+	UnrealizedPnlPct float64                `protobuf:"fixed64,7,opt,name=unrealized_pnl_pct,json=unrealizedPnlPct,proto3" json:"unrealized_pnl_pct,omitempty"`
+	DayChange        float64                `protobuf:"fixed64,8,opt,name=day_change,json=dayChange,proto3" json:"day_change,omitempty"`
+	DayChangePct     float64                `protobuf:"fixed64,9,opt,name=day_change_pct,json=dayChangePct,proto3" json:"day_change_pct,omitempty"`
+	// cash plus the market value of the holdings
+	TotalEquity   float64             `protobuf:"fixed64,10,opt,name=total_equity,json=totalEquity,proto3" json:"total_equity,omitempty"`
+	Holdings      []*HoldingValuation `protobuf:"bytes,11,rep,name=holdings,proto3" json:"holdings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Transaction) Reset() {
-	*x = Transaction{}
-	mi := &file_portfolio_proto_msgTypes[19]
+func (x *AccountValuation) Reset() {
+	*x = AccountValuation{}
+	mi := &file_portfolio_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transaction) String() string {
+func (x *AccountValuation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transaction) ProtoMessage() {}
+func (*AccountValuation) ProtoMessage() {}
 
-func (x *Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[19]
+func (x *AccountValuation) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1256,82 +3679,112 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
-func (*Transaction) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use AccountValuation.ProtoReflect.Descriptor instead.
+func (*AccountValuation) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AccountValuation) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AccountValuation) GetCurrency() CurrencyType {
+	if x != nil {
+		return x.Currency
+	}
+	return CurrencyType_CURRENCY_TYPE_UNSPECIFIED
+}
+
+func (x *AccountValuation) GetCashBalance() float64 {
+	if x != nil {
+		return x.CashBalance
+	}
+	return 0
+}
+
+func (x *AccountValuation) GetMarketValue() float64 {
+	if x != nil {
+		return x.MarketValue
+	}
+	return 0
 }
 
-func (x *Transaction) GetId() string {
+func (x *AccountValuation) GetCostBasis() float64 {
 	if x != nil {
-		return x.Id
+		return x.CostBasis
 	}
-	return ""
+	return 0
 }
 
-func (x *Transaction) GetAccountId() string {
+func (x *AccountValuation) GetUnrealizedPnl() float64 {
 	if x != nil {
-		return x.AccountId
+		return x.UnrealizedPnl
 	}
-	return ""
+	return 0
 }
 
-func (x *Transaction) GetType() TransactionType {
+func (x *AccountValuation) GetUnrealizedPnlPct() float64 {
 	if x != nil {
-		return x.Type
+		return x.UnrealizedPnlPct
 	}
-	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *Transaction) GetAmount() float64 {
+func (x *AccountValuation) GetDayChange() float64 {
 	if x != nil {
-		return x.Amount
+		return x.DayChange
 	}
 	return 0
 }
 
-func (x *Transaction) GetDescription() string {
+func (x *AccountValuation) GetDayChangePct() float64 {
 	if x != nil {
-		return x.Description
+		return x.DayChangePct
 	}
-	return ""
+	return 0
 }
 
-func (x *Transaction) GetReferenceId() string {
+func (x *AccountValuation) GetTotalEquity() float64 {
 	if x != nil {
-		return x.ReferenceId
+		return x.TotalEquity
 	}
-	return ""
+	return 0
 }
 
-func (x *Transaction) GetCreatedAt() *timestamppb.Timestamp {
+func (x *AccountValuation) GetHoldings() []*HoldingValuation {
 	if x != nil {
-		return x.CreatedAt
+		return x.Holdings
 	}
 	return nil
 }
 
-type GetTransactionsRequest struct {
+type GetPortfolioValuationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	Accounts      []*AccountValuation    `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	ValuedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=valued_at,json=valuedAt,proto3" json:"valued_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionsRequest) Reset() {
-	*x = GetTransactionsRequest{}
-	mi := &file_portfolio_proto_msgTypes[20]
+func (x *GetPortfolioValuationResponse) Reset() {
+	*x = GetPortfolioValuationResponse{}
+	mi := &file_portfolio_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionsRequest) String() string {
+func (x *GetPortfolioValuationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionsRequest) ProtoMessage() {}
+func (*GetPortfolioValuationResponse) ProtoMessage() {}
 
-func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[20]
+func (x *GetPortfolioValuationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1342,41 +3795,56 @@ func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetPortfolioValuationResponse.ProtoReflect.Descriptor instead.
+func (*GetPortfolioValuationResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetTransactionsRequest) GetAccountId() string {
+func (x *GetPortfolioValuationResponse) GetCode() base.ErrorCode {
 	if x != nil {
-		return x.AccountId
+		return x.Code
 	}
-	return ""
+	return base.ErrorCode(0)
 }
 
-type GetTransactionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
-	Transactions  []*Transaction         `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+func (x *GetPortfolioValuationResponse) GetAccounts() []*AccountValuation {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *GetPortfolioValuationResponse) GetValuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValuedAt
+	}
+	return nil
+}
+
+type GetPortfolioPerformanceRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 1D, 1W, 1M, 3M, 6M, 1Y, 2Y, 5Y or MAX, as for stock history
+	Period        string `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionsResponse) Reset() {
-	*x = GetTransactionsResponse{}
-	mi := &file_portfolio_proto_msgTypes[21]
+func (x *GetPortfolioPerformanceRequest) Reset() {
+	*x = GetPortfolioPerformanceRequest{}
+	mi := &file_portfolio_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionsResponse) String() string {
+func (x *GetPortfolioPerformanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionsResponse) ProtoMessage() {}
+func (*GetPortfolioPerformanceRequest) ProtoMessage() {}
 
-func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[21]
+func (x *GetPortfolioPerformanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1387,50 +3855,55 @@ func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetPortfolioPerformanceRequest.ProtoReflect.Descriptor instead.
+func (*GetPortfolioPerformanceRequest) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetTransactionsResponse) GetCode() base.ErrorCode {
+func (x *GetPortfolioPerformanceRequest) GetUserId() string {
 	if x != nil {
-		return x.Code
+		return x.UserId
 	}
-	return base.ErrorCode(0)
+	return ""
 }
 
-func (x *GetTransactionsResponse) GetTransactions() []*Transaction {
+func (x *GetPortfolioPerformanceRequest) GetPeriod() string {
 	if x != nil {
-		return x.Transactions
+		return x.Period
 	}
-	return nil
-}
-
-type DepositRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency      CurrencyType           `protobuf:"varint,3,opt,name=currency,proto3,enum=portfolio.CurrencyType" json:"currency,omitempty"`
-	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *DepositRequest) Reset() {
-	*x = DepositRequest{}
-	mi := &file_portfolio_proto_msgTypes[22]
+// an account at the close of a trading day; amounts are in the account currency
+type PerformancePoint struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Date        string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	CashBalance float64                `protobuf:"fixed64,2,opt,name=cash_balance,json=cashBalance,proto3" json:"cash_balance,omitempty"`
+	MarketValue float64                `protobuf:"fixed64,3,opt,name=market_value,json=marketValue,proto3" json:"market_value,omitempty"`
+	TotalEquity float64                `protobuf:"fixed64,4,opt,name=total_equity,json=totalEquity,proto3" json:"total_equity,omitempty"`
+	// deposits and transfers since the previous point
+	NetFlow float64 `protobuf:"fixed64,5,opt,name=net_flow,json=netFlow,proto3" json:"net_flow,omitempty"`
+	// time-weighted return from the start of the period up to this point
+	CumulativeReturnPct float64 `protobuf:"fixed64,6,opt,name=cumulative_return_pct,json=cumulativeReturnPct,proto3" json:"cumulative_return_pct,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PerformancePoint) Reset() {
+	*x = PerformancePoint{}
+	mi := &file_portfolio_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepositRequest) String() string {
+func (x *PerformancePoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositRequest) ProtoMessage() {}
+func (*PerformancePoint) ProtoMessage() {}
 
-func (x *DepositRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[22]
+func (x *PerformancePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1441,62 +3914,89 @@ func (x *DepositRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
-func (*DepositRequest) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use PerformancePoint.ProtoReflect.Descriptor instead.
+func (*PerformancePoint) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *DepositRequest) GetAccountId() string {
+func (x *PerformancePoint) GetDate() string {
 	if x != nil {
-		return x.AccountId
+		return x.Date
 	}
 	return ""
 }
 
-func (x *DepositRequest) GetAmount() float64 {
+func (x *PerformancePoint) GetCashBalance() float64 {
 	if x != nil {
-		return x.Amount
+		return x.CashBalance
 	}
 	return 0
 }
 
-func (x *DepositRequest) GetCurrency() CurrencyType {
+func (x *PerformancePoint) GetMarketValue() float64 {
 	if x != nil {
-		return x.Currency
+		return x.MarketValue
 	}
-	return CurrencyType_CURRENCY_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *DepositRequest) GetUserId() string {
+func (x *PerformancePoint) GetTotalEquity() float64 {
 	if x != nil {
-		return x.UserId
+		return x.TotalEquity
 	}
-	return ""
+	return 0
 }
 
-type DepositResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
-	NewBalance    float64                `protobuf:"fixed64,2,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PerformancePoint) GetNetFlow() float64 {
+	if x != nil {
+		return x.NetFlow
+	}
+	return 0
 }
 
-func (x *DepositResponse) Reset() {
-	*x = DepositResponse{}
-	mi := &file_portfolio_proto_msgTypes[23]
+func (x *PerformancePoint) GetCumulativeReturnPct() float64 {
+	if x != nil {
+		return x.CumulativeReturnPct
+	}
+	return 0
+}
+
+type AccountPerformance struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Currency  CurrencyType           `protobuf:"varint,2,opt,name=currency,proto3,enum=portfolio.CurrencyType" json:"currency,omitempty"`
+	// the closes the period is measured between
+	StartDate   string  `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate     string  `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	StartEquity float64 `protobuf:"fixed64,5,opt,name=start_equity,json=startEquity,proto3" json:"start_equity,omitempty"`
+	EndEquity   float64 `protobuf:"fixed64,6,opt,name=end_equity,json=endEquity,proto3" json:"end_equity,omitempty"`
+	NetFlows    float64 `protobuf:"fixed64,7,opt,name=net_flows,json=netFlows,proto3" json:"net_flows,omitempty"`
+	// change in equity that the flows do not account for
+	Gain float64 `protobuf:"fixed64,8,opt,name=gain,proto3" json:"gain,omitempty"`
+	// compounds the daily returns, so deposits and transfers do not count as performance
+	TimeWeightedReturnPct float64 `protobuf:"fixed64,9,opt,name=time_weighted_return_pct,json=timeWeightedReturnPct,proto3" json:"time_weighted_return_pct,omitempty"`
+	// the rate that grows the starting equity and the flows into the ending equity over the period
+	MoneyWeightedReturnPct float64             `protobuf:"fixed64,10,opt,name=money_weighted_return_pct,json=moneyWeightedReturnPct,proto3" json:"money_weighted_return_pct,omitempty"`
+	Points                 []*PerformancePoint `protobuf:"bytes,11,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AccountPerformance) Reset() {
+	*x = AccountPerformance{}
+	mi := &file_portfolio_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepositResponse) String() string {
+func (x *AccountPerformance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositResponse) ProtoMessage() {}
+func (*AccountPerformance) ProtoMessage() {}
 
-func (x *DepositResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[23]
+func (x *AccountPerformance) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1507,115 +4007,112 @@ func (x *DepositResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositResponse.ProtoReflect.Descriptor instead.
-func (*DepositResponse) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use AccountPerformance.ProtoReflect.Descriptor instead.
+func (*AccountPerformance) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *DepositResponse) GetCode() base.ErrorCode {
+func (x *AccountPerformance) GetAccountId() string {
 	if x != nil {
-		return x.Code
+		return x.AccountId
 	}
-	return base.ErrorCode(0)
+	return ""
 }
 
-func (x *DepositResponse) GetNewBalance() float64 {
+func (x *AccountPerformance) GetCurrency() CurrencyType {
 	if x != nil {
-		return x.NewBalance
+		return x.Currency
 	}
-	return 0
+	return CurrencyType_CURRENCY_TYPE_UNSPECIFIED
 }
 
-type TransferRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FromAccountId string                 `protobuf:"bytes,1,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
-	ToAccountId   string                 `protobuf:"bytes,2,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
-	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency      CurrencyType           `protobuf:"varint,4,opt,name=currency,proto3,enum=portfolio.CurrencyType" json:"currency,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AccountPerformance) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
 }
 
-func (x *TransferRequest) Reset() {
-	*x = TransferRequest{}
-	mi := &file_portfolio_proto_msgTypes[24]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AccountPerformance) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
 }
 
-func (x *TransferRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AccountPerformance) GetStartEquity() float64 {
+	if x != nil {
+		return x.StartEquity
+	}
+	return 0
 }
 
-func (*TransferRequest) ProtoMessage() {}
-
-func (x *TransferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[24]
+func (x *AccountPerformance) GetEndEquity() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.EndEquity
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use TransferRequest.ProtoReflect.Descriptor instead.
-func (*TransferRequest) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{24}
+func (x *AccountPerformance) GetNetFlows() float64 {
+	if x != nil {
+		return x.NetFlows
+	}
+	return 0
 }
 
-func (x *TransferRequest) GetFromAccountId() string {
+func (x *AccountPerformance) GetGain() float64 {
 	if x != nil {
-		return x.FromAccountId
+		return x.Gain
 	}
-	return ""
+	return 0
 }
 
-func (x *TransferRequest) GetToAccountId() string {
+func (x *AccountPerformance) GetTimeWeightedReturnPct() float64 {
 	if x != nil {
-		return x.ToAccountId
+		return x.TimeWeightedReturnPct
 	}
-	return ""
+	return 0
 }
 
-func (x *TransferRequest) GetAmount() float64 {
+func (x *AccountPerformance) GetMoneyWeightedReturnPct() float64 {
 	if x != nil {
-		return x.Amount
+		return x.MoneyWeightedReturnPct
 	}
 	return 0
 }
 
-func (x *TransferRequest) GetCurrency() CurrencyType {
+func (x *AccountPerformance) GetPoints() []*PerformancePoint {
 	if x != nil {
-		return x.Currency
+		return x.Points
 	}
-	return CurrencyType_CURRENCY_TYPE_UNSPECIFIED
+	return nil
 }
 
-type TransferResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+type GetPortfolioPerformanceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  base.ErrorCode         `protobuf:"varint,1,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	// accounts without snapshots in the period are left out
+	Accounts      []*AccountPerformance `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransferResponse) Reset() {
-	*x = TransferResponse{}
-	mi := &file_portfolio_proto_msgTypes[25]
+func (x *GetPortfolioPerformanceResponse) Reset() {
+	*x = GetPortfolioPerformanceResponse{}
+	mi := &file_portfolio_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransferResponse) String() string {
+func (x *GetPortfolioPerformanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferResponse) ProtoMessage() {}
+func (*GetPortfolioPerformanceResponse) ProtoMessage() {}
 
-func (x *TransferResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_portfolio_proto_msgTypes[25]
+func (x *GetPortfolioPerformanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_portfolio_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1626,24 +4123,31 @@ func (x *TransferResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferResponse.ProtoReflect.Descriptor instead.
-func (*TransferResponse) Descriptor() ([]byte, []int) {
-	return file_portfolio_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetPortfolioPerformanceResponse.ProtoReflect.Descriptor instead.
+func (*GetPortfolioPerformanceResponse) Descriptor() ([]byte, []int) {
+	return file_portfolio_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *TransferResponse) GetCode() base.ErrorCode {
+func (x *GetPortfolioPerformanceResponse) GetCode() base.ErrorCode {
 	if x != nil {
 		return x.Code
 	}
 	return base.ErrorCode(0)
 }
 
+func (x *GetPortfolioPerformanceResponse) GetAccounts() []*AccountPerformance {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
 var File_portfolio_proto protoreflect.FileDescriptor
 
 const file_portfolio_proto_rawDesc = "" +
 	"\n" +
 	"\x0fportfolio.proto\x12\tportfolio\x1a\n" +
-	"base.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xca\x02\n" +
+	"base.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xac\x03\n" +
 	"\aAccount\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12%\n" +
@@ -1654,7 +4158,11 @@ const file_portfolio_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xfd\x01\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12+\n" +
+	"\x11available_balance\x18\t \x01(\x01R\x10availableBalance\x123\n" +
+	"\n" +
+	"lot_method\x18\n" +
+	" \x01(\x0e2\x14.portfolio.LotMethodR\tlotMethod\"\xac\x02\n" +
 	"\aHolding\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -1665,23 +4173,82 @@ const file_portfolio_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"^\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12-\n" +
+	"\x12available_quantity\x18\b \x01(\x01R\x11availableQuantity\"\xdf\x03\n" +
+	"\x04Hold\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tR\taccountId\x12'\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x13.portfolio.HoldKindR\x04kind\x12\x16\n" +
+	"\x06symbol\x18\x05 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x01R\bquantity\x12-\n" +
+	"\x12remaining_quantity\x18\a \x01(\x01R\x11remainingQuantity\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x01R\x06amount\x12)\n" +
+	"\x10remaining_amount\x18\t \x01(\x01R\x0fremainingAmount\x12-\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2\x15.portfolio.HoldStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x19\n" +
+	"\bgroup_id\x18\r \x01(\tR\agroupId\"\xc6\x02\n" +
+	"\x06TaxLot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\tR\aorderId\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x01R\bquantity\x12-\n" +
+	"\x12remaining_quantity\x18\x06 \x01(\x01R\x11remainingQuantity\x12\x1d\n" +
+	"\n" +
+	"cost_basis\x18\a \x01(\x01R\tcostBasis\x127\n" +
+	"\topened_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bopenedAt\x127\n" +
+	"\tclosed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\"\xa5\x03\n" +
+	"\fRealizedGain\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12\x15\n" +
+	"\x06lot_id\x18\x04 \x01(\tR\x05lotId\x12\x19\n" +
+	"\border_id\x18\x05 \x01(\tR\aorderId\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x01R\bquantity\x12\x1a\n" +
+	"\bproceeds\x18\a \x01(\x01R\bproceeds\x12\x1d\n" +
+	"\n" +
+	"cost_basis\x18\b \x01(\x01R\tcostBasis\x12!\n" +
+	"\frealized_pnl\x18\t \x01(\x01R\vrealizedPnl\x12,\n" +
+	"\x06method\x18\n" +
+	" \x01(\x0e2\x14.portfolio.LotMethodR\x06method\x127\n" +
+	"\topened_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\bopenedAt\x12;\n" +
+	"\vrealized_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"realizedAt\"\xa6\x01\n" +
+	"\x12SymbolRealizedGain\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x01R\bquantity\x12\x1a\n" +
+	"\bproceeds\x18\x03 \x01(\x01R\bproceeds\x12\x1d\n" +
+	"\n" +
+	"cost_basis\x18\x04 \x01(\x01R\tcostBasis\x12!\n" +
+	"\frealized_pnl\x18\x05 \x01(\x01R\vrealizedPnl\"^\n" +
 	"\rWatchlistItem\x12\x16\n" +
 	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x125\n" +
-	"\badded_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\"\x90\x01\n" +
+	"\badded_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\"\xc5\x01\n" +
 	"\x14CreateAccountRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12*\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x16.portfolio.AccountTypeR\x04type\x123\n" +
-	"\bcurrency\x18\x03 \x01(\x0e2\x17.portfolio.CurrencyTypeR\bcurrency\"j\n" +
+	"\bcurrency\x18\x03 \x01(\x0e2\x17.portfolio.CurrencyTypeR\bcurrency\x123\n" +
+	"\n" +
+	"lot_method\x18\x04 \x01(\x0e2\x14.portfolio.LotMethodR\tlotMethod\"j\n" +
 	"\x15CreateAccountResponse\x12#\n" +
 	"\x04code\x18\x01 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\x12,\n" +
 	"\aaccount\x18\x02 \x01(\v2\x12.portfolio.AccountR\aaccount\"5\n" +
 	"\x1aGetPortfolioSummaryRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x97\x01\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xb6\x01\n" +
 	"\x1bGetPortfolioSummaryResponse\x12#\n" +
 	"\x04code\x18\x01 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\x12.\n" +
 	"\baccounts\x18\x02 \x03(\v2\x12.portfolio.AccountR\baccounts\x12#\n" +
-	"\rtotal_balance\x18\x03 \x01(\x01R\ftotalBalance\"3\n" +
+	"\rtotal_balance\x18\x03 \x01(\x01R\ftotalBalance\x12\x1d\n" +
+	"\n" +
+	"total_fees\x18\x04 \x01(\x01R\ttotalFees\"3\n" +
 	"\x12GetHoldingsRequest\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\tR\taccountId\"j\n" +
@@ -1727,10 +4294,12 @@ const file_portfolio_proto_rawDesc = "" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"7\n" +
 	"\x16GetTransactionsRequest\x12\x1d\n" +
 	"\n" +
-	"account_id\x18\x01 \x01(\tR\taccountId\"z\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"\x99\x01\n" +
 	"\x17GetTransactionsResponse\x12#\n" +
 	"\x04code\x18\x01 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\x12:\n" +
-	"\ftransactions\x18\x02 \x03(\v2\x16.portfolio.TransactionR\ftransactions\"\x95\x01\n" +
+	"\ftransactions\x18\x02 \x03(\v2\x16.portfolio.TransactionR\ftransactions\x12\x1d\n" +
+	"\n" +
+	"total_fees\x18\x03 \x01(\x01R\ttotalFees\"\x95\x01\n" +
 	"\x0eDepositRequest\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
@@ -1747,7 +4316,155 @@ const file_portfolio_proto_rawDesc = "" +
 	"\x06amount\x18\x03 \x01(\x01R\x06amount\x123\n" +
 	"\bcurrency\x18\x04 \x01(\x0e2\x17.portfolio.CurrencyTypeR\bcurrency\"7\n" +
 	"\x10TransferResponse\x12#\n" +
-	"\x04code\x18\x01 \x01(\x0e2\x0f.base.ErrorCodeR\x04code*}\n" +
+	"\x04code\x18\x01 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\"\xf4\x01\n" +
+	"\x12ReserveHoldRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12'\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x13.portfolio.HoldKindR\x04kind\x12\x16\n" +
+	"\x06symbol\x18\x04 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x01R\bquantity\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12\x19\n" +
+	"\bgroup_id\x18\a \x01(\tR\agroupId\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\"_\n" +
+	"\x13ReserveHoldResponse\x12#\n" +
+	"\x04code\x18\x01 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\x12#\n" +
+	"\x04hold\x18\x02 \x01(\v2\x0f.portfolio.HoldR\x04hold\"/\n" +
+	"\x12ReleaseHoldRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"_\n" +
+	"\x13ReleaseHoldResponse\x12#\n" +
+	"\x04code\x18\x01 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\x12#\n" +
+	"\x04hold\x18\x02 \x01(\v2\x0f.portfolio.HoldR\x04hold\"+\n" +
+	"\x0eGetHoldRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"[\n" +
+	"\x0fGetHoldResponse\x12#\n" +
+	"\x04code\x18\x01 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\x12#\n" +
+	"\x04hold\x18\x02 \x01(\v2\x0f.portfolio.HoldR\x04hold\"|\n" +
+	"\x11ResizeHoldRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12'\n" +
+	"\x0fquantity_change\x18\x02 \x01(\x01R\x0equantityChange\x12#\n" +
+	"\ramount_change\x18\x03 \x01(\x01R\famountChange\"^\n" +
+	"\x12ResizeHoldResponse\x12#\n" +
+	"\x04code\x18\x01 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\x12#\n" +
+	"\x04hold\x18\x02 \x01(\v2\x0f.portfolio.HoldR\x04hold\"w\n" +
+	"\x16GetRiskExposureRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12,\n" +
+	"\x12excluded_order_ids\x18\x03 \x03(\tR\x10excludedOrderIds\"\x83\x02\n" +
+	"\fRiskExposure\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x123\n" +
+	"\bcurrency\x18\x02 \x01(\x0e2\x17.portfolio.CurrencyTypeR\bcurrency\x12#\n" +
+	"\raccount_value\x18\x03 \x01(\x01R\faccountValue\x12+\n" +
+	"\x11position_quantity\x18\x04 \x01(\x01R\x10positionQuantity\x12,\n" +
+	"\x12realized_pnl_today\x18\x05 \x01(\x01R\x10realizedPnlToday\x12\x1f\n" +
+	"\vopen_orders\x18\x06 \x01(\x05R\n" +
+	"openOrders\"s\n" +
+	"\x17GetRiskExposureResponse\x12#\n" +
+	"\x04code\x18\x01 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\x123\n" +
+	"\bexposure\x18\x02 \x01(\v2\x17.portfolio.RiskExposureR\bexposure\"b\n" +
+	"\x13SetLotMethodRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12,\n" +
+	"\x06method\x18\x02 \x01(\x0e2\x14.portfolio.LotMethodR\x06method\"i\n" +
+	"\x14SetLotMethodResponse\x12#\n" +
+	"\x04code\x18\x01 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\x12,\n" +
+	"\aaccount\x18\x02 \x01(\v2\x12.portfolio.AccountR\aaccount\"i\n" +
+	"\x14SelectTaxLotsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x17\n" +
+	"\alot_ids\x18\x03 \x03(\tR\x06lotIds\"<\n" +
+	"\x15SelectTaxLotsResponse\x12#\n" +
+	"\x04code\x18\x01 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\"q\n" +
+	"\x11GetTaxLotsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12%\n" +
+	"\x0einclude_closed\x18\x03 \x01(\bR\rincludeClosed\"`\n" +
+	"\x12GetTaxLotsResponse\x12#\n" +
+	"\x04code\x18\x01 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\x12%\n" +
+	"\x04lots\x18\x02 \x03(\v2\x11.portfolio.TaxLotR\x04lots\"\xac\x01\n" +
+	"\x17GetRealizedGainsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\xd8\x01\n" +
+	"\x18GetRealizedGainsResponse\x12#\n" +
+	"\x04code\x18\x01 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\x12-\n" +
+	"\x05gains\x18\x02 \x03(\v2\x17.portfolio.RealizedGainR\x05gains\x12:\n" +
+	"\tby_symbol\x18\x03 \x03(\v2\x1d.portfolio.SymbolRealizedGainR\bbySymbol\x12,\n" +
+	"\x12total_realized_pnl\x18\x04 \x01(\x01R\x10totalRealizedPnl\"7\n" +
+	"\x1cGetPortfolioValuationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xfc\x03\n" +
+	"\x10HoldingValuation\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x01R\bquantity\x12\x19\n" +
+	"\bavg_cost\x18\x03 \x01(\x01R\aavgCost\x12%\n" +
+	"\x0equote_currency\x18\x04 \x01(\tR\rquoteCurrency\x12#\n" +
+	"\rexchange_rate\x18\x05 \x01(\x01R\fexchangeRate\x12\x1d\n" +
+	"\n" +
+	"last_price\x18\x06 \x01(\x01R\tlastPrice\x12!\n" +
+	"\fmarket_value\x18\a \x01(\x01R\vmarketValue\x12\x1d\n" +
+	"\n" +
+	"cost_basis\x18\b \x01(\x01R\tcostBasis\x12%\n" +
+	"\x0eunrealized_pnl\x18\t \x01(\x01R\runrealizedPnl\x12,\n" +
+	"\x12unrealized_pnl_pct\x18\n" +
+	" \x01(\x01R\x10unrealizedPnlPct\x12\x1d\n" +
+	"\n" +
+	"day_change\x18\v \x01(\x01R\tdayChange\x12$\n" +
+	"\x0eday_change_pct\x18\f \x01(\x01R\fdayChangePct\x12\x16\n" +
+	"\x06priced\x18\r \x01(\bR\x06priced\x12:\n" +
+	"\vquote_as_of\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tquoteAsOf\"\xc1\x03\n" +
+	"\x10AccountValuation\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x123\n" +
+	"\bcurrency\x18\x02 \x01(\x0e2\x17.portfolio.CurrencyTypeR\bcurrency\x12!\n" +
+	"\fcash_balance\x18\x03 \x01(\x01R\vcashBalance\x12!\n" +
+	"\fmarket_value\x18\x04 \x01(\x01R\vmarketValue\x12\x1d\n" +
+	"\n" +
+	"cost_basis\x18\x05 \x01(\x01R\tcostBasis\x12%\n" +
+	"\x0eunrealized_pnl\x18\x06 \x01(\x01R\runrealizedPnl\x12,\n" +
+	"\x12unrealized_pnl_pct\x18\a \x01(\x01R\x10unrealizedPnlPct\x12\x1d\n" +
+	"\n" +
+	"day_change\x18\b \x01(\x01R\tdayChange\x12$\n" +
+	"\x0eday_change_pct\x18\t \x01(\x01R\fdayChangePct\x12!\n" +
+	"\ftotal_equity\x18\n" +
+	" \x01(\x01R\vtotalEquity\x127\n" +
+	"\bholdings\x18\v \x03(\v2\x1b.portfolio.HoldingValuationR\bholdings\"\xb6\x01\n" +
+	"\x1dGetPortfolioValuationResponse\x12#\n" +
+	"\x04code\x18\x01 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\x127\n" +
+	"\baccounts\x18\x02 \x03(\v2\x1b.portfolio.AccountValuationR\baccounts\x127\n" +
+	"\tvalued_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bvaluedAt\"Q\n" +
+	"\x1eGetPortfolioPerformanceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\"\xde\x01\n" +
+	"\x10PerformancePoint\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12!\n" +
+	"\fcash_balance\x18\x02 \x01(\x01R\vcashBalance\x12!\n" +
+	"\fmarket_value\x18\x03 \x01(\x01R\vmarketValue\x12!\n" +
+	"\ftotal_equity\x18\x04 \x01(\x01R\vtotalEquity\x12\x19\n" +
+	"\bnet_flow\x18\x05 \x01(\x01R\anetFlow\x122\n" +
+	"\x15cumulative_return_pct\x18\x06 \x01(\x01R\x13cumulativeReturnPct\"\xbe\x03\n" +
+	"\x12AccountPerformance\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x123\n" +
+	"\bcurrency\x18\x02 \x01(\x0e2\x17.portfolio.CurrencyTypeR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12!\n" +
+	"\fstart_equity\x18\x05 \x01(\x01R\vstartEquity\x12\x1d\n" +
+	"\n" +
+	"end_equity\x18\x06 \x01(\x01R\tendEquity\x12\x1b\n" +
+	"\tnet_flows\x18\a \x01(\x01R\bnetFlows\x12\x12\n" +
+	"\x04gain\x18\b \x01(\x01R\x04gain\x127\n" +
+	"\x18time_weighted_return_pct\x18\t \x01(\x01R\x15timeWeightedReturnPct\x129\n" +
+	"\x19money_weighted_return_pct\x18\n" +
+	" \x01(\x01R\x16moneyWeightedReturnPct\x123\n" +
+	"\x06points\x18\v \x03(\v2\x1b.portfolio.PerformancePointR\x06points\"\x81\x01\n" +
+	"\x1fGetPortfolioPerformanceResponse\x12#\n" +
+	"\x04code\x18\x01 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\x129\n" +
+	"\baccounts\x18\x02 \x03(\v2\x1d.portfolio.AccountPerformanceR\baccounts*}\n" +
 	"\vAccountType\x12\x1c\n" +
 	"\x18ACCOUNT_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14ACCOUNT_TYPE_SAVINGS\x10\x01\x12\x1b\n" +
@@ -1756,14 +4473,32 @@ const file_portfolio_proto_rawDesc = "" +
 	"\fCurrencyType\x12\x1d\n" +
 	"\x19CURRENCY_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11CURRENCY_TYPE_USD\x10\x01\x12\x15\n" +
-	"\x11CURRENCY_TYPE_CAD\x10\x02*\xcb\x01\n" +
+	"\x11CURRENCY_TYPE_CAD\x10\x02*\x86\x02\n" +
 	"\x0fTransactionType\x12 \n" +
 	"\x1cTRANSACTION_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18TRANSACTION_TYPE_DEPOSIT\x10\x01\x12\x18\n" +
 	"\x14TRANSACTION_TYPE_BUY\x10\x03\x12\x19\n" +
 	"\x15TRANSACTION_TYPE_SELL\x10\x04\x12 \n" +
 	"\x1cTRANSACTION_TYPE_TRANSFER_IN\x10\x05\x12!\n" +
-	"\x1dTRANSACTION_TYPE_TRANSFER_OUT\x10\x062\xa8\a\n" +
+	"\x1dTRANSACTION_TYPE_TRANSFER_OUT\x10\x06\x12\x18\n" +
+	"\x14TRANSACTION_TYPE_FEE\x10\a\x12\x1f\n" +
+	"\x1bTRANSACTION_TYPE_ADJUSTMENT\x10\b*\x87\x01\n" +
+	"\tLotMethod\x12\x1a\n" +
+	"\x16LOT_METHOD_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fLOT_METHOD_FIFO\x10\x01\x12\x13\n" +
+	"\x0fLOT_METHOD_LIFO\x10\x02\x12\x17\n" +
+	"\x13LOT_METHOD_SPECIFIC\x10\x03\x12\x1b\n" +
+	"\x17LOT_METHOD_AVERAGE_COST\x10\x04*O\n" +
+	"\bHoldKind\x12\x19\n" +
+	"\x15HOLD_KIND_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eHOLD_KIND_CASH\x10\x01\x12\x14\n" +
+	"\x10HOLD_KIND_SHARES\x10\x02*t\n" +
+	"\n" +
+	"HoldStatus\x12\x1b\n" +
+	"\x17HOLD_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12HOLD_STATUS_ACTIVE\x10\x01\x12\x17\n" +
+	"\x13HOLD_STATUS_SETTLED\x10\x02\x12\x18\n" +
+	"\x14HOLD_STATUS_RELEASED\x10\x032\xd6\x0e\n" +
 	"\x10PortfolioService\x12R\n" +
 	"\rCreateAccount\x12\x1f.portfolio.CreateAccountRequest\x1a .portfolio.CreateAccountResponse\x12d\n" +
 	"\x13GetPortfolioSummary\x12%.portfolio.GetPortfolioSummaryRequest\x1a&.portfolio.GetPortfolioSummaryResponse\x12L\n" +
@@ -1776,7 +4511,20 @@ const file_portfolio_proto_rawDesc = "" +
 	"\rDeleteAccount\x12\x1f.portfolio.DeleteAccountRequest\x1a .portfolio.DeleteAccountResponse\x12X\n" +
 	"\x0fGetTransactions\x12!.portfolio.GetTransactionsRequest\x1a\".portfolio.GetTransactionsResponse\x12@\n" +
 	"\aDeposit\x12\x19.portfolio.DepositRequest\x1a\x1a.portfolio.DepositResponse\x12C\n" +
-	"\bTransfer\x12\x1a.portfolio.TransferRequest\x1a\x1b.portfolio.TransferResponseB\x1cZ\x1afafnir/shared/pb/portfoliob\x06proto3"
+	"\bTransfer\x12\x1a.portfolio.TransferRequest\x1a\x1b.portfolio.TransferResponse\x12L\n" +
+	"\vReserveHold\x12\x1d.portfolio.ReserveHoldRequest\x1a\x1e.portfolio.ReserveHoldResponse\x12L\n" +
+	"\vReleaseHold\x12\x1d.portfolio.ReleaseHoldRequest\x1a\x1e.portfolio.ReleaseHoldResponse\x12@\n" +
+	"\aGetHold\x12\x19.portfolio.GetHoldRequest\x1a\x1a.portfolio.GetHoldResponse\x12I\n" +
+	"\n" +
+	"ResizeHold\x12\x1c.portfolio.ResizeHoldRequest\x1a\x1d.portfolio.ResizeHoldResponse\x12X\n" +
+	"\x0fGetRiskExposure\x12!.portfolio.GetRiskExposureRequest\x1a\".portfolio.GetRiskExposureResponse\x12O\n" +
+	"\fSetLotMethod\x12\x1e.portfolio.SetLotMethodRequest\x1a\x1f.portfolio.SetLotMethodResponse\x12R\n" +
+	"\rSelectTaxLots\x12\x1f.portfolio.SelectTaxLotsRequest\x1a .portfolio.SelectTaxLotsResponse\x12I\n" +
+	"\n" +
+	"GetTaxLots\x12\x1c.portfolio.GetTaxLotsRequest\x1a\x1d.portfolio.GetTaxLotsResponse\x12[\n" +
+	"\x10GetRealizedGains\x12\".portfolio.GetRealizedGainsRequest\x1a#.portfolio.GetRealizedGainsResponse\x12j\n" +
+	"\x15GetPortfolioValuation\x12'.portfolio.GetPortfolioValuationRequest\x1a(.portfolio.GetPortfolioValuationResponse\x12p\n" +
+	"\x17GetPortfolioPerformance\x12).portfolio.GetPortfolioPerformanceRequest\x1a*.portfolio.GetPortfolioPerformanceResponseB\x1cZ\x1afafnir/shared/pb/portfoliob\x06proto3"
 
 var (
 	file_portfolio_proto_rawDescOnce sync.Once
@@ -1790,99 +4538,199 @@ func file_portfolio_proto_rawDescGZIP() []byte {
 	return file_portfolio_proto_rawDescData
 }
 
-var file_portfolio_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_portfolio_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_portfolio_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_portfolio_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
 var file_portfolio_proto_goTypes = []any{
-	(AccountType)(0),                    // 0: portfolio.AccountType
-	(CurrencyType)(0),                   // 1: portfolio.CurrencyType
-	(TransactionType)(0),                // 2: portfolio.TransactionType
-	(*Account)(nil),                     // 3: portfolio.Account
-	(*Holding)(nil),                     // 4: portfolio.Holding
-	(*WatchlistItem)(nil),               // 5: portfolio.WatchlistItem
-	(*CreateAccountRequest)(nil),        // 6: portfolio.CreateAccountRequest
-	(*CreateAccountResponse)(nil),       // 7: portfolio.CreateAccountResponse
-	(*GetPortfolioSummaryRequest)(nil),  // 8: portfolio.GetPortfolioSummaryRequest
-	(*GetPortfolioSummaryResponse)(nil), // 9: portfolio.GetPortfolioSummaryResponse
-	(*GetHoldingsRequest)(nil),          // 10: portfolio.GetHoldingsRequest
-	(*GetHoldingsResponse)(nil),         // 11: portfolio.GetHoldingsResponse
-	(*GetHoldingRequest)(nil),           // 12: portfolio.GetHoldingRequest
-	(*GetHoldingResponse)(nil),          // 13: portfolio.GetHoldingResponse
-	(*GetWatchlistRequest)(nil),         // 14: portfolio.GetWatchlistRequest
-	(*GetWatchlistResponse)(nil),        // 15: portfolio.GetWatchlistResponse
-	(*AddToWatchlistRequest)(nil),       // 16: portfolio.AddToWatchlistRequest
-	(*AddToWatchlistResponse)(nil),      // 17: portfolio.AddToWatchlistResponse
-	(*RemoveFromWatchlistRequest)(nil),  // 18: portfolio.RemoveFromWatchlistRequest
-	(*RemoveFromWatchlistResponse)(nil), // 19: portfolio.RemoveFromWatchlistResponse
-	(*DeleteAccountRequest)(nil),        // 20: portfolio.DeleteAccountRequest
-	(*DeleteAccountResponse)(nil),       // 21: portfolio.DeleteAccountResponse
-	(*Transaction)(nil),                 // 22: portfolio.Transaction
-	(*GetTransactionsRequest)(nil),      // 23: portfolio.GetTransactionsRequest
-	(*GetTransactionsResponse)(nil),     // 24: portfolio.GetTransactionsResponse
-	(*DepositRequest)(nil),              // 25: portfolio.DepositRequest
-	(*DepositResponse)(nil),             // 26: portfolio.DepositResponse
-	(*TransferRequest)(nil),             // 27: portfolio.TransferRequest
-	(*TransferResponse)(nil),            // 28: portfolio.TransferResponse
-	(*timestamppb.Timestamp)(nil),       // 29: google.protobuf.Timestamp
-	(base.ErrorCode)(0),                 // 30: base.ErrorCode
+	(AccountType)(0),                        // 0: portfolio.AccountType
+	(CurrencyType)(0),                       // 1: portfolio.CurrencyType
+	(TransactionType)(0),                    // 2: portfolio.TransactionType
+	(LotMethod)(0),                          // 3: portfolio.LotMethod
+	(HoldKind)(0),                           // 4: portfolio.HoldKind
+	(HoldStatus)(0),                         // 5: portfolio.HoldStatus
+	(*Account)(nil),                         // 6: portfolio.Account
+	(*Holding)(nil),                         // 7: portfolio.Holding
+	(*Hold)(nil),                            // 8: portfolio.Hold
+	(*TaxLot)(nil),                          // 9: portfolio.TaxLot
+	(*RealizedGain)(nil),                    // 10: portfolio.RealizedGain
+	(*SymbolRealizedGain)(nil),              // 11: portfolio.SymbolRealizedGain
+	(*WatchlistItem)(nil),                   // 12: portfolio.WatchlistItem
+	(*CreateAccountRequest)(nil),            // 13: portfolio.CreateAccountRequest
+	(*CreateAccountResponse)(nil),           // 14: portfolio.CreateAccountResponse
+	(*GetPortfolioSummaryRequest)(nil),      // 15: portfolio.GetPortfolioSummaryRequest
+	(*GetPortfolioSummaryResponse)(nil),     // 16: portfolio.GetPortfolioSummaryResponse
+	(*GetHoldingsRequest)(nil),              // 17: portfolio.GetHoldingsRequest
+	(*GetHoldingsResponse)(nil),             // 18: portfolio.GetHoldingsResponse
+	(*GetHoldingRequest)(nil),               // 19: portfolio.GetHoldingRequest
+	(*GetHoldingResponse)(nil),              // 20: portfolio.GetHoldingResponse
+	(*GetWatchlistRequest)(nil),             // 21: portfolio.GetWatchlistRequest
+	(*GetWatchlistResponse)(nil),            // 22: portfolio.GetWatchlistResponse
+	(*AddToWatchlistRequest)(nil),           // 23: portfolio.AddToWatchlistRequest
+	(*AddToWatchlistResponse)(nil),          // 24: portfolio.AddToWatchlistResponse
+	(*RemoveFromWatchlistRequest)(nil),      // 25: portfolio.RemoveFromWatchlistRequest
+	(*RemoveFromWatchlistResponse)(nil),     // 26: portfolio.RemoveFromWatchlistResponse
+	(*DeleteAccountRequest)(nil),            // 27: portfolio.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),           // 28: portfolio.DeleteAccountResponse
+	(*Transaction)(nil),                     // 29: portfolio.Transaction
+	(*GetTransactionsRequest)(nil),          // 30: portfolio.GetTransactionsRequest
+	(*GetTransactionsResponse)(nil),         // 31: portfolio.GetTransactionsResponse
+	(*DepositRequest)(nil),                  // 32: portfolio.DepositRequest
+	(*DepositResponse)(nil),                 // 33: portfolio.DepositResponse
+	(*TransferRequest)(nil),                 // 34: portfolio.TransferRequest
+	(*TransferResponse)(nil),                // 35: portfolio.TransferResponse
+	(*ReserveHoldRequest)(nil),              // 36: portfolio.ReserveHoldRequest
+	(*ReserveHoldResponse)(nil),             // 37: portfolio.ReserveHoldResponse
+	(*ReleaseHoldRequest)(nil),              // 38: portfolio.ReleaseHoldRequest
+	(*ReleaseHoldResponse)(nil),             // 39: portfolio.ReleaseHoldResponse
+	(*GetHoldRequest)(nil),                  // 40: portfolio.GetHoldRequest
+	(*GetHoldResponse)(nil),                 // 41: portfolio.GetHoldResponse
+	(*ResizeHoldRequest)(nil),               // 42: portfolio.ResizeHoldRequest
+	(*ResizeHoldResponse)(nil),              // 43: portfolio.ResizeHoldResponse
+	(*GetRiskExposureRequest)(nil),          // 44: portfolio.GetRiskExposureRequest
+	(*RiskExposure)(nil),                    // 45: portfolio.RiskExposure
+	(*GetRiskExposureResponse)(nil),         // 46: portfolio.GetRiskExposureResponse
+	(*SetLotMethodRequest)(nil),             // 47: portfolio.SetLotMethodRequest
+	(*SetLotMethodResponse)(nil),            // 48: portfolio.SetLotMethodResponse
+	(*SelectTaxLotsRequest)(nil),            // 49: portfolio.SelectTaxLotsRequest
+	(*SelectTaxLotsResponse)(nil),           // 50: portfolio.SelectTaxLotsResponse
+	(*GetTaxLotsRequest)(nil),               // 51: portfolio.GetTaxLotsRequest
+	(*GetTaxLotsResponse)(nil),              // 52: portfolio.GetTaxLotsResponse
+	(*GetRealizedGainsRequest)(nil),         // 53: portfolio.GetRealizedGainsRequest
+	(*GetRealizedGainsResponse)(nil),        // 54: portfolio.GetRealizedGainsResponse
+	(*GetPortfolioValuationRequest)(nil),    // 55: portfolio.GetPortfolioValuationRequest
+	(*HoldingValuation)(nil),                // 56: portfolio.HoldingValuation
+	(*AccountValuation)(nil),                // 57: portfolio.AccountValuation
+	(*GetPortfolioValuationResponse)(nil),   // 58: portfolio.GetPortfolioValuationResponse
+	(*GetPortfolioPerformanceRequest)(nil),  // 59: portfolio.GetPortfolioPerformanceRequest
+	(*PerformancePoint)(nil),                // 60: portfolio.PerformancePoint
+	(*AccountPerformance)(nil),              // 61: portfolio.AccountPerformance
+	(*GetPortfolioPerformanceResponse)(nil), // 62: portfolio.GetPortfolioPerformanceResponse
+	(*timestamppb.Timestamp)(nil),           // 63: google.protobuf.Timestamp
+	(base.ErrorCode)(0),                     // 64: base.ErrorCode
 }
 var file_portfolio_proto_depIdxs = []int32{
 	0,  // 0: portfolio.Account.type:type_name -> portfolio.AccountType
 	1,  // 1: portfolio.Account.currency:type_name -> portfolio.CurrencyType
-	29, // 2: portfolio.Account.created_at:type_name -> google.protobuf.Timestamp
-	29, // 3: portfolio.Account.updated_at:type_name -> google.protobuf.Timestamp
-	29, // 4: portfolio.Holding.created_at:type_name -> google.protobuf.Timestamp
-	29, // 5: portfolio.Holding.updated_at:type_name -> google.protobuf.Timestamp
-	29, // 6: portfolio.WatchlistItem.added_at:type_name -> google.protobuf.Timestamp
-	0,  // 7: portfolio.CreateAccountRequest.type:type_name -> portfolio.AccountType
-	1,  // 8: portfolio.CreateAccountRequest.currency:type_name -> portfolio.CurrencyType
-	30, // 9: portfolio.CreateAccountResponse.code:type_name -> base.ErrorCode
-	3,  // 10: portfolio.CreateAccountResponse.account:type_name -> portfolio.Account
-	30, // 11: portfolio.GetPortfolioSummaryResponse.code:type_name -> base.ErrorCode
-	3,  // 12: portfolio.GetPortfolioSummaryResponse.accounts:type_name -> portfolio.Account
-	30, // 13: portfolio.GetHoldingsResponse.code:type_name -> base.ErrorCode
-	4,  // 14: portfolio.GetHoldingsResponse.holdings:type_name -> portfolio.Holding
-	30, // 15: portfolio.GetHoldingResponse.code:type_name -> base.ErrorCode
-	4,  // 16: portfolio.GetHoldingResponse.holding:type_name -> portfolio.Holding
-	30, // 17: portfolio.GetWatchlistResponse.code:type_name -> base.ErrorCode
-	5,  // 18: portfolio.GetWatchlistResponse.items:type_name -> portfolio.WatchlistItem
-	30, // 19: portfolio.AddToWatchlistResponse.code:type_name -> base.ErrorCode
-	30, // 20: portfolio.RemoveFromWatchlistResponse.code:type_name -> base.ErrorCode
-	30, // 21: portfolio.DeleteAccountResponse.code:type_name -> base.ErrorCode
-	2,  // 22: portfolio.Transaction.type:type_name -> portfolio.TransactionType
-	29, // 23: portfolio.Transaction.created_at:type_name -> google.protobuf.Timestamp
-	30, // 24: portfolio.GetTransactionsResponse.code:type_name -> base.ErrorCode
-	22, // 25: portfolio.GetTransactionsResponse.transactions:type_name -> portfolio.Transaction
-	1,  // 26: portfolio.DepositRequest.currency:type_name -> portfolio.CurrencyType
-	30, // 27: portfolio.DepositResponse.code:type_name -> base.ErrorCode
-	1,  // 28: portfolio.TransferRequest.currency:type_name -> portfolio.CurrencyType
-	30, // 29: portfolio.TransferResponse.code:type_name -> base.ErrorCode
-	6,  // 30: portfolio.PortfolioService.CreateAccount:input_type -> portfolio.CreateAccountRequest
-	8,  // 31: portfolio.PortfolioService.GetPortfolioSummary:input_type -> portfolio.GetPortfolioSummaryRequest
-	10, // 32: portfolio.PortfolioService.GetHoldings:input_type -> portfolio.GetHoldingsRequest
-	12, // 33: portfolio.PortfolioService.GetHolding:input_type -> portfolio.GetHoldingRequest
-	14, // 34: portfolio.PortfolioService.GetWatchlist:input_type -> portfolio.GetWatchlistRequest
-	16, // 35: portfolio.PortfolioService.AddToWatchlist:input_type -> portfolio.AddToWatchlistRequest
-	18, // 36: portfolio.PortfolioService.RemoveFromWatchlist:input_type -> portfolio.RemoveFromWatchlistRequest
-	20, // 37: portfolio.PortfolioService.DeleteAccount:input_type -> portfolio.DeleteAccountRequest
-	23, // 38: portfolio.PortfolioService.GetTransactions:input_type -> portfolio.GetTransactionsRequest
-	25, // 39: portfolio.PortfolioService.Deposit:input_type -> portfolio.DepositRequest
-	27, // 40: portfolio.PortfolioService.Transfer:input_type -> portfolio.TransferRequest
-	7,  // 41: portfolio.PortfolioService.CreateAccount:output_type -> portfolio.CreateAccountResponse
-	9,  // 42: portfolio.PortfolioService.GetPortfolioSummary:output_type -> portfolio.GetPortfolioSummaryResponse
-	11, // 43: portfolio.PortfolioService.GetHoldings:output_type -> portfolio.GetHoldingsResponse
-	13, // 44: portfolio.PortfolioService.GetHolding:output_type -> portfolio.GetHoldingResponse
-	15, // 45: portfolio.PortfolioService.GetWatchlist:output_type -> portfolio.GetWatchlistResponse
-	17, // 46: portfolio.PortfolioService.AddToWatchlist:output_type -> portfolio.AddToWatchlistResponse
-	19, // 47: portfolio.PortfolioService.RemoveFromWatchlist:output_type -> portfolio.RemoveFromWatchlistResponse
-	21, // 48: portfolio.PortfolioService.DeleteAccount:output_type -> portfolio.DeleteAccountResponse
-	24, // 49: portfolio.PortfolioService.GetTransactions:output_type -> portfolio.GetTransactionsResponse
-	26, // 50: portfolio.PortfolioService.Deposit:output_type -> portfolio.DepositResponse
-	28, // 51: portfolio.PortfolioService.Transfer:output_type -> portfolio.TransferResponse
-	41, // [41:52] is the sub-list for method output_type
-	30, // [30:41] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	63, // 2: portfolio.Account.created_at:type_name -> google.protobuf.Timestamp
+	63, // 3: portfolio.Account.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 4: portfolio.Account.lot_method:type_name -> portfolio.LotMethod
+	63, // 5: portfolio.Holding.created_at:type_name -> google.protobuf.Timestamp
+	63, // 6: portfolio.Holding.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 7: portfolio.Hold.kind:type_name -> portfolio.HoldKind
+	5,  // 8: portfolio.Hold.status:type_name -> portfolio.HoldStatus
+	63, // 9: portfolio.Hold.created_at:type_name -> google.protobuf.Timestamp
+	63, // 10: portfolio.Hold.updated_at:type_name -> google.protobuf.Timestamp
+	63, // 11: portfolio.TaxLot.opened_at:type_name -> google.protobuf.Timestamp
+	63, // 12: portfolio.TaxLot.closed_at:type_name -> google.protobuf.Timestamp
+	3,  // 13: portfolio.RealizedGain.method:type_name -> portfolio.LotMethod
+	63, // 14: portfolio.RealizedGain.opened_at:type_name -> google.protobuf.Timestamp
+	63, // 15: portfolio.RealizedGain.realized_at:type_name -> google.protobuf.Timestamp
+	63, // 16: portfolio.WatchlistItem.added_at:type_name -> google.protobuf.Timestamp
+	0,  // 17: portfolio.CreateAccountRequest.type:type_name -> portfolio.AccountType
+	1,  // 18: portfolio.CreateAccountRequest.currency:type_name -> portfolio.CurrencyType
+	3,  // 19: portfolio.CreateAccountRequest.lot_method:type_name -> portfolio.LotMethod
+	64, // 20: portfolio.CreateAccountResponse.code:type_name -> base.ErrorCode
+	6,  // 21: portfolio.CreateAccountResponse.account:type_name -> portfolio.Account
+	64, // 22: portfolio.GetPortfolioSummaryResponse.code:type_name -> base.ErrorCode
+	6,  // 23: portfolio.GetPortfolioSummaryResponse.accounts:type_name -> portfolio.Account
+	64, // 24: portfolio.GetHoldingsResponse.code:type_name -> base.ErrorCode
+	7,  // 25: portfolio.GetHoldingsResponse.holdings:type_name -> portfolio.Holding
+	64, // 26: portfolio.GetHoldingResponse.code:type_name -> base.ErrorCode
+	7,  // 27: portfolio.GetHoldingResponse.holding:type_name -> portfolio.Holding
+	64, // 28: portfolio.GetWatchlistResponse.code:type_name -> base.ErrorCode
+	12, // 29: portfolio.GetWatchlistResponse.items:type_name -> portfolio.WatchlistItem
+	64, // 30: portfolio.AddToWatchlistResponse.code:type_name -> base.ErrorCode
+	64, // 31: portfolio.RemoveFromWatchlistResponse.code:type_name -> base.ErrorCode
+	64, // 32: portfolio.DeleteAccountResponse.code:type_name -> base.ErrorCode
+	2,  // 33: portfolio.Transaction.type:type_name -> portfolio.TransactionType
+	63, // 34: portfolio.Transaction.created_at:type_name -> google.protobuf.Timestamp
+	64, // 35: portfolio.GetTransactionsResponse.code:type_name -> base.ErrorCode
+	29, // 36: portfolio.GetTransactionsResponse.transactions:type_name -> portfolio.Transaction
+	1,  // 37: portfolio.DepositRequest.currency:type_name -> portfolio.CurrencyType
+	64, // 38: portfolio.DepositResponse.code:type_name -> base.ErrorCode
+	1,  // 39: portfolio.TransferRequest.currency:type_name -> portfolio.CurrencyType
+	64, // 40: portfolio.TransferResponse.code:type_name -> base.ErrorCode
+	4,  // 41: portfolio.ReserveHoldRequest.kind:type_name -> portfolio.HoldKind
+	64, // 42: portfolio.ReserveHoldResponse.code:type_name -> base.ErrorCode
+	8,  // 43: portfolio.ReserveHoldResponse.hold:type_name -> portfolio.Hold
+	64, // 44: portfolio.ReleaseHoldResponse.code:type_name -> base.ErrorCode
+	8,  // 45: portfolio.ReleaseHoldResponse.hold:type_name -> portfolio.Hold
+	64, // 46: portfolio.GetHoldResponse.code:type_name -> base.ErrorCode
+	8,  // 47: portfolio.GetHoldResponse.hold:type_name -> portfolio.Hold
+	64, // 48: portfolio.ResizeHoldResponse.code:type_name -> base.ErrorCode
+	8,  // 49: portfolio.ResizeHoldResponse.hold:type_name -> portfolio.Hold
+	1,  // 50: portfolio.RiskExposure.currency:type_name -> portfolio.CurrencyType
+	64, // 51: portfolio.GetRiskExposureResponse.code:type_name -> base.ErrorCode
+	45, // 52: portfolio.GetRiskExposureResponse.exposure:type_name -> portfolio.RiskExposure
+	3,  // 53: portfolio.SetLotMethodRequest.method:type_name -> portfolio.LotMethod
+	64, // 54: portfolio.SetLotMethodResponse.code:type_name -> base.ErrorCode
+	6,  // 55: portfolio.SetLotMethodResponse.account:type_name -> portfolio.Account
+	64, // 56: portfolio.SelectTaxLotsResponse.code:type_name -> base.ErrorCode
+	64, // 57: portfolio.GetTaxLotsResponse.code:type_name -> base.ErrorCode
+	9,  // 58: portfolio.GetTaxLotsResponse.lots:type_name -> portfolio.TaxLot
+	63, // 59: portfolio.GetRealizedGainsRequest.from:type_name -> google.protobuf.Timestamp
+	63, // 60: portfolio.GetRealizedGainsRequest.to:type_name -> google.protobuf.Timestamp
+	64, // 61: portfolio.GetRealizedGainsResponse.code:type_name -> base.ErrorCode
+	10, // 62: portfolio.GetRealizedGainsResponse.gains:type_name -> portfolio.RealizedGain
+	11, // 63: portfolio.GetRealizedGainsResponse.by_symbol:type_name -> portfolio.SymbolRealizedGain
+	63, // 64: portfolio.HoldingValuation.quote_as_of:type_name -> google.protobuf.Timestamp
+	1,  // 65: portfolio.AccountValuation.currency:type_name -> portfolio.CurrencyType
+	56, // 66: portfolio.AccountValuation.holdings:type_name -> portfolio.HoldingValuation
+	64, // 67: portfolio.GetPortfolioValuationResponse.code:type_name -> base.ErrorCode
+	57, // 68: portfolio.GetPortfolioValuationResponse.accounts:type_name -> portfolio.AccountValuation
+	63, // 69: portfolio.GetPortfolioValuationResponse.valued_at:type_name -> google.protobuf.Timestamp
+	1,  // 70: portfolio.AccountPerformance.currency:type_name -> portfolio.CurrencyType
+	60, // 71: portfolio.AccountPerformance.points:type_name -> portfolio.PerformancePoint
+	64, // 72: portfolio.GetPortfolioPerformanceResponse.code:type_name -> base.ErrorCode
+	61, // 73: portfolio.GetPortfolioPerformanceResponse.accounts:type_name -> portfolio.AccountPerformance
+	13, // 74: portfolio.PortfolioService.CreateAccount:input_type -> portfolio.CreateAccountRequest
+	15, // 75: portfolio.PortfolioService.GetPortfolioSummary:input_type -> portfolio.GetPortfolioSummaryRequest
+	17, // 76: portfolio.PortfolioService.GetHoldings:input_type -> portfolio.GetHoldingsRequest
+	19, // 77: portfolio.PortfolioService.GetHolding:input_type -> portfolio.GetHoldingRequest
+	21, // 78: portfolio.PortfolioService.GetWatchlist:input_type -> portfolio.GetWatchlistRequest
+	23, // 79: portfolio.PortfolioService.AddToWatchlist:input_type -> portfolio.AddToWatchlistRequest
+	25, // 80: portfolio.PortfolioService.RemoveFromWatchlist:input_type -> portfolio.RemoveFromWatchlistRequest
+	27, // 81: portfolio.PortfolioService.DeleteAccount:input_type -> portfolio.DeleteAccountRequest
+	30, // 82: portfolio.PortfolioService.GetTransactions:input_type -> portfolio.GetTransactionsRequest
+	32, // 83: portfolio.PortfolioService.Deposit:input_type -> portfolio.DepositRequest
+	34, // 84: portfolio.PortfolioService.Transfer:input_type -> portfolio.TransferRequest
+	36, // 85: portfolio.PortfolioService.ReserveHold:input_type -> portfolio.ReserveHoldRequest
+	38, // 86: portfolio.PortfolioService.ReleaseHold:input_type -> portfolio.ReleaseHoldRequest
+	40, // 87: portfolio.PortfolioService.GetHold:input_type -> portfolio.GetHoldRequest
+	42, // 88: portfolio.PortfolioService.ResizeHold:input_type -> portfolio.ResizeHoldRequest
+	44, // 89: portfolio.PortfolioService.GetRiskExposure:input_type -> portfolio.GetRiskExposureRequest
+	47, // 90: portfolio.PortfolioService.SetLotMethod:input_type -> portfolio.SetLotMethodRequest
+	49, // 91: portfolio.PortfolioService.SelectTaxLots:input_type -> portfolio.SelectTaxLotsRequest
+	51, // 92: portfolio.PortfolioService.GetTaxLots:input_type -> portfolio.GetTaxLotsRequest
+	53, // 93: portfolio.PortfolioService.GetRealizedGains:input_type -> portfolio.GetRealizedGainsRequest
+	55, // 94: portfolio.PortfolioService.GetPortfolioValuation:input_type -> portfolio.GetPortfolioValuationRequest
+	59, // 95: portfolio.PortfolioService.GetPortfolioPerformance:input_type -> portfolio.GetPortfolioPerformanceRequest
+	14, // 96: portfolio.PortfolioService.CreateAccount:output_type -> portfolio.CreateAccountResponse
+	16, // 97: portfolio.PortfolioService.GetPortfolioSummary:output_type -> portfolio.GetPortfolioSummaryResponse
+	18, // 98: portfolio.PortfolioService.GetHoldings:output_type -> portfolio.GetHoldingsResponse
+	20, // 99: portfolio.PortfolioService.GetHolding:output_type -> portfolio.GetHoldingResponse
+	22, // 100: portfolio.PortfolioService.GetWatchlist:output_type -> portfolio.GetWatchlistResponse
+	24, // 101: portfolio.PortfolioService.AddToWatchlist:output_type -> portfolio.AddToWatchlistResponse
+	26, // 102: portfolio.PortfolioService.RemoveFromWatchlist:output_type -> portfolio.RemoveFromWatchlistResponse
+	28, // 103: portfolio.PortfolioService.DeleteAccount:output_type -> portfolio.DeleteAccountResponse
+	31, // 104: portfolio.PortfolioService.GetTransactions:output_type -> portfolio.GetTransactionsResponse
+	33, // 105: portfolio.PortfolioService.Deposit:output_type -> portfolio.DepositResponse
+	35, // 106: portfolio.PortfolioService.Transfer:output_type -> portfolio.TransferResponse
+	37, // 107: portfolio.PortfolioService.ReserveHold:output_type -> portfolio.ReserveHoldResponse
+	39, // 108: portfolio.PortfolioService.ReleaseHold:output_type -> portfolio.ReleaseHoldResponse
+	41, // 109: portfolio.PortfolioService.GetHold:output_type -> portfolio.GetHoldResponse
+	43, // 110: portfolio.PortfolioService.ResizeHold:output_type -> portfolio.ResizeHoldResponse
+	46, // 111: portfolio.PortfolioService.GetRiskExposure:output_type -> portfolio.GetRiskExposureResponse
+	48, // 112: portfolio.PortfolioService.SetLotMethod:output_type -> portfolio.SetLotMethodResponse
+	50, // 113: portfolio.PortfolioService.SelectTaxLots:output_type -> portfolio.SelectTaxLotsResponse
+	52, // 114: portfolio.PortfolioService.GetTaxLots:output_type -> portfolio.GetTaxLotsResponse
+	54, // 115: portfolio.PortfolioService.GetRealizedGains:output_type -> portfolio.GetRealizedGainsResponse
+	58, // 116: portfolio.PortfolioService.GetPortfolioValuation:output_type -> portfolio.GetPortfolioValuationResponse
+	62, // 117: portfolio.PortfolioService.GetPortfolioPerformance:output_type -> portfolio.GetPortfolioPerformanceResponse
+	96, // [96:118] is the sub-list for method output_type
+	74, // [74:96] is the sub-list for method input_type
+	74, // [74:74] is the sub-list for extension type_name
+	74, // [74:74] is the sub-list for extension extendee
+	0,  // [0:74] is the sub-list for field type_name
 }
 
 func init() { file_portfolio_proto_init() }
@@ -1895,8 +4743,8 @@ func file_portfolio_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_portfolio_proto_rawDesc), len(file_portfolio_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   26,
+			NumEnums:      6,
+			NumMessages:   57,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
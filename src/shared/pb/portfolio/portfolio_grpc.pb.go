@@ -19,17 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PortfolioService_CreateAccount_FullMethodName       = "/portfolio.PortfolioService/CreateAccount"
-	PortfolioService_GetPortfolioSummary_FullMethodName = "/portfolio.PortfolioService/GetPortfolioSummary"
-	PortfolioService_GetHoldings_FullMethodName         = "/portfolio.PortfolioService/GetHoldings"
-	PortfolioService_GetHolding_FullMethodName          = "/portfolio.PortfolioService/GetHolding"
-	PortfolioService_GetWatchlist_FullMethodName        = "/portfolio.PortfolioService/GetWatchlist"
-	PortfolioService_AddToWatchlist_FullMethodName      = "/portfolio.PortfolioService/AddToWatchlist"
-	PortfolioService_RemoveFromWatchlist_FullMethodName = "/portfolio.PortfolioService/RemoveFromWatchlist"
-	PortfolioService_DeleteAccount_FullMethodName       = "/portfolio.PortfolioService/DeleteAccount"
-	PortfolioService_GetTransactions_FullMethodName     = "/portfolio.PortfolioService/GetTransactions"
-	PortfolioService_Deposit_FullMethodName             = "/portfolio.PortfolioService/Deposit"
-	PortfolioService_Transfer_FullMethodName            = "/portfolio.PortfolioService/Transfer"
+	PortfolioService_CreateAccount_FullMethodName           = "/portfolio.PortfolioService/CreateAccount"
+	PortfolioService_GetPortfolioSummary_FullMethodName     = "/portfolio.PortfolioService/GetPortfolioSummary"
+	PortfolioService_GetHoldings_FullMethodName             = "/portfolio.PortfolioService/GetHoldings"
+	PortfolioService_GetHolding_FullMethodName              = "/portfolio.PortfolioService/GetHolding"
+	PortfolioService_GetWatchlist_FullMethodName            = "/portfolio.PortfolioService/GetWatchlist"
+	PortfolioService_AddToWatchlist_FullMethodName          = "/portfolio.PortfolioService/AddToWatchlist"
+	PortfolioService_RemoveFromWatchlist_FullMethodName     = "/portfolio.PortfolioService/RemoveFromWatchlist"
+	PortfolioService_DeleteAccount_FullMethodName           = "/portfolio.PortfolioService/DeleteAccount"
+	PortfolioService_GetTransactions_FullMethodName         = "/portfolio.PortfolioService/GetTransactions"
+	PortfolioService_Deposit_FullMethodName                 = "/portfolio.PortfolioService/Deposit"
+	PortfolioService_Transfer_FullMethodName                = "/portfolio.PortfolioService/Transfer"
+	PortfolioService_ReserveHold_FullMethodName             = "/portfolio.PortfolioService/ReserveHold"
+	PortfolioService_ReleaseHold_FullMethodName             = "/portfolio.PortfolioService/ReleaseHold"
+	PortfolioService_GetHold_FullMethodName                 = "/portfolio.PortfolioService/GetHold"
+	PortfolioService_ResizeHold_FullMethodName              = "/portfolio.PortfolioService/ResizeHold"
+	PortfolioService_GetRiskExposure_FullMethodName         = "/portfolio.PortfolioService/GetRiskExposure"
+	PortfolioService_SetLotMethod_FullMethodName            = "/portfolio.PortfolioService/SetLotMethod"
+	PortfolioService_SelectTaxLots_FullMethodName           = "/portfolio.PortfolioService/SelectTaxLots"
+	PortfolioService_GetTaxLots_FullMethodName              = "/portfolio.PortfolioService/GetTaxLots"
+	PortfolioService_GetRealizedGains_FullMethodName        = "/portfolio.PortfolioService/GetRealizedGains"
+	PortfolioService_GetPortfolioValuation_FullMethodName   = "/portfolio.PortfolioService/GetPortfolioValuation"
+	PortfolioService_GetPortfolioPerformance_FullMethodName = "/portfolio.PortfolioService/GetPortfolioPerformance"
 )
 
 // PortfolioServiceClient is the client API for PortfolioService service.
@@ -47,6 +58,17 @@ type PortfolioServiceClient interface {
 	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error)
 	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error)
 	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error)
+	ReserveHold(ctx context.Context, in *ReserveHoldRequest, opts ...grpc.CallOption) (*ReserveHoldResponse, error)
+	ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*ReleaseHoldResponse, error)
+	GetHold(ctx context.Context, in *GetHoldRequest, opts ...grpc.CallOption) (*GetHoldResponse, error)
+	ResizeHold(ctx context.Context, in *ResizeHoldRequest, opts ...grpc.CallOption) (*ResizeHoldResponse, error)
+	GetRiskExposure(ctx context.Context, in *GetRiskExposureRequest, opts ...grpc.CallOption) (*GetRiskExposureResponse, error)
+	SetLotMethod(ctx context.Context, in *SetLotMethodRequest, opts ...grpc.CallOption) (*SetLotMethodResponse, error)
+	SelectTaxLots(ctx context.Context, in *SelectTaxLotsRequest, opts ...grpc.CallOption) (*SelectTaxLotsResponse, error)
+	GetTaxLots(ctx context.Context, in *GetTaxLotsRequest, opts ...grpc.CallOption) (*GetTaxLotsResponse, error)
+	GetRealizedGains(ctx context.Context, in *GetRealizedGainsRequest, opts ...grpc.CallOption) (*GetRealizedGainsResponse, error)
+	GetPortfolioValuation(ctx context.Context, in *GetPortfolioValuationRequest, opts ...grpc.CallOption) (*GetPortfolioValuationResponse, error)
+	GetPortfolioPerformance(ctx context.Context, in *GetPortfolioPerformanceRequest, opts ...grpc.CallOption) (*GetPortfolioPerformanceResponse, error)
 }
 
 type portfolioServiceClient struct {
@@ -167,6 +189,116 @@ func (c *portfolioServiceClient) Transfer(ctx context.Context, in *TransferReque
 	return out, nil
 }
 
+func (c *portfolioServiceClient) ReserveHold(ctx context.Context, in *ReserveHoldRequest, opts ...grpc.CallOption) (*ReserveHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveHoldResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_ReserveHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*ReleaseHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseHoldResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_ReleaseHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetHold(ctx context.Context, in *GetHoldRequest, opts ...grpc.CallOption) (*GetHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHoldResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_GetHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) ResizeHold(ctx context.Context, in *ResizeHoldRequest, opts ...grpc.CallOption) (*ResizeHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResizeHoldResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_ResizeHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetRiskExposure(ctx context.Context, in *GetRiskExposureRequest, opts ...grpc.CallOption) (*GetRiskExposureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRiskExposureResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_GetRiskExposure_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) SetLotMethod(ctx context.Context, in *SetLotMethodRequest, opts ...grpc.CallOption) (*SetLotMethodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLotMethodResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_SetLotMethod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) SelectTaxLots(ctx context.Context, in *SelectTaxLotsRequest, opts ...grpc.CallOption) (*SelectTaxLotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SelectTaxLotsResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_SelectTaxLots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetTaxLots(ctx context.Context, in *GetTaxLotsRequest, opts ...grpc.CallOption) (*GetTaxLotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTaxLotsResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_GetTaxLots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetRealizedGains(ctx context.Context, in *GetRealizedGainsRequest, opts ...grpc.CallOption) (*GetRealizedGainsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRealizedGainsResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_GetRealizedGains_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetPortfolioValuation(ctx context.Context, in *GetPortfolioValuationRequest, opts ...grpc.CallOption) (*GetPortfolioValuationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPortfolioValuationResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_GetPortfolioValuation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioServiceClient) GetPortfolioPerformance(ctx context.Context, in *GetPortfolioPerformanceRequest, opts ...grpc.CallOption) (*GetPortfolioPerformanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPortfolioPerformanceResponse)
+	err := c.cc.Invoke(ctx, PortfolioService_GetPortfolioPerformance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PortfolioServiceServer is the server API for PortfolioService service.
 // All implementations must embed UnimplementedPortfolioServiceServer
 // for forward compatibility.
@@ -182,6 +314,17 @@ type PortfolioServiceServer interface {
 	GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error)
 	Deposit(context.Context, *DepositRequest) (*DepositResponse, error)
 	Transfer(context.Context, *TransferRequest) (*TransferResponse, error)
+	ReserveHold(context.Context, *ReserveHoldRequest) (*ReserveHoldResponse, error)
+	ReleaseHold(context.Context, *ReleaseHoldRequest) (*ReleaseHoldResponse, error)
+	GetHold(context.Context, *GetHoldRequest) (*GetHoldResponse, error)
+	ResizeHold(context.Context, *ResizeHoldRequest) (*ResizeHoldResponse, error)
+	GetRiskExposure(context.Context, *GetRiskExposureRequest) (*GetRiskExposureResponse, error)
+	SetLotMethod(context.Context, *SetLotMethodRequest) (*SetLotMethodResponse, error)
+	SelectTaxLots(context.Context, *SelectTaxLotsRequest) (*SelectTaxLotsResponse, error)
+	GetTaxLots(context.Context, *GetTaxLotsRequest) (*GetTaxLotsResponse, error)
+	GetRealizedGains(context.Context, *GetRealizedGainsRequest) (*GetRealizedGainsResponse, error)
+	GetPortfolioValuation(context.Context, *GetPortfolioValuationRequest) (*GetPortfolioValuationResponse, error)
+	GetPortfolioPerformance(context.Context, *GetPortfolioPerformanceRequest) (*GetPortfolioPerformanceResponse, error)
 	mustEmbedUnimplementedPortfolioServiceServer()
 }
 
@@ -225,6 +368,39 @@ func (UnimplementedPortfolioServiceServer) Deposit(context.Context, *DepositRequ
 func (UnimplementedPortfolioServiceServer) Transfer(context.Context, *TransferRequest) (*TransferResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Transfer not implemented")
 }
+func (UnimplementedPortfolioServiceServer) ReserveHold(context.Context, *ReserveHoldRequest) (*ReserveHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReserveHold not implemented")
+}
+func (UnimplementedPortfolioServiceServer) ReleaseHold(context.Context, *ReleaseHoldRequest) (*ReleaseHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseHold not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetHold(context.Context, *GetHoldRequest) (*GetHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHold not implemented")
+}
+func (UnimplementedPortfolioServiceServer) ResizeHold(context.Context, *ResizeHoldRequest) (*ResizeHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResizeHold not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetRiskExposure(context.Context, *GetRiskExposureRequest) (*GetRiskExposureResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRiskExposure not implemented")
+}
+func (UnimplementedPortfolioServiceServer) SetLotMethod(context.Context, *SetLotMethodRequest) (*SetLotMethodResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetLotMethod not implemented")
+}
+func (UnimplementedPortfolioServiceServer) SelectTaxLots(context.Context, *SelectTaxLotsRequest) (*SelectTaxLotsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SelectTaxLots not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetTaxLots(context.Context, *GetTaxLotsRequest) (*GetTaxLotsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTaxLots not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetRealizedGains(context.Context, *GetRealizedGainsRequest) (*GetRealizedGainsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRealizedGains not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetPortfolioValuation(context.Context, *GetPortfolioValuationRequest) (*GetPortfolioValuationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPortfolioValuation not implemented")
+}
+func (UnimplementedPortfolioServiceServer) GetPortfolioPerformance(context.Context, *GetPortfolioPerformanceRequest) (*GetPortfolioPerformanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPortfolioPerformance not implemented")
+}
 func (UnimplementedPortfolioServiceServer) mustEmbedUnimplementedPortfolioServiceServer() {}
 func (UnimplementedPortfolioServiceServer) testEmbeddedByValue()                          {}
 
@@ -444,6 +620,204 @@ func _PortfolioService_Transfer_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PortfolioService_ReserveHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).ReserveHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_ReserveHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).ReserveHold(ctx, req.(*ReserveHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_ReleaseHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).ReleaseHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_ReleaseHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).ReleaseHold(ctx, req.(*ReleaseHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_GetHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetHold(ctx, req.(*GetHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_ResizeHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).ResizeHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_ResizeHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).ResizeHold(ctx, req.(*ResizeHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_GetRiskExposure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRiskExposureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetRiskExposure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetRiskExposure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetRiskExposure(ctx, req.(*GetRiskExposureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_SetLotMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLotMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).SetLotMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_SetLotMethod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).SetLotMethod(ctx, req.(*SetLotMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_SelectTaxLots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectTaxLotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).SelectTaxLots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_SelectTaxLots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).SelectTaxLots(ctx, req.(*SelectTaxLotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_GetTaxLots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaxLotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetTaxLots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetTaxLots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetTaxLots(ctx, req.(*GetTaxLotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_GetRealizedGains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRealizedGainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetRealizedGains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetRealizedGains_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetRealizedGains(ctx, req.(*GetRealizedGainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_GetPortfolioValuation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioValuationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetPortfolioValuation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetPortfolioValuation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetPortfolioValuation(ctx, req.(*GetPortfolioValuationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioService_GetPortfolioPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioServiceServer).GetPortfolioPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioService_GetPortfolioPerformance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioServiceServer).GetPortfolioPerformance(ctx, req.(*GetPortfolioPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PortfolioService_ServiceDesc is the grpc.ServiceDesc for PortfolioService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -495,6 +869,50 @@ var PortfolioService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Transfer",
 			Handler:    _PortfolioService_Transfer_Handler,
 		},
+		{
+			MethodName: "ReserveHold",
+			Handler:    _PortfolioService_ReserveHold_Handler,
+		},
+		{
+			MethodName: "ReleaseHold",
+			Handler:    _PortfolioService_ReleaseHold_Handler,
+		},
+		{
+			MethodName: "GetHold",
+			Handler:    _PortfolioService_GetHold_Handler,
+		},
+		{
+			MethodName: "ResizeHold",
+			Handler:    _PortfolioService_ResizeHold_Handler,
+		},
+		{
+			MethodName: "GetRiskExposure",
+			Handler:    _PortfolioService_GetRiskExposure_Handler,
+		},
+		{
+			MethodName: "SetLotMethod",
+			Handler:    _PortfolioService_SetLotMethod_Handler,
+		},
+		{
+			MethodName: "SelectTaxLots",
+			Handler:    _PortfolioService_SelectTaxLots_Handler,
+		},
+		{
+			MethodName: "GetTaxLots",
+			Handler:    _PortfolioService_GetTaxLots_Handler,
+		},
+		{
+			MethodName: "GetRealizedGains",
+			Handler:    _PortfolioService_GetRealizedGains_Handler,
+		},
+		{
+			MethodName: "GetPortfolioValuation",
+			Handler:    _PortfolioService_GetPortfolioValuation_Handler,
+		},
+		{
+			MethodName: "GetPortfolioPerformance",
+			Handler:    _PortfolioService_GetPortfolioPerformance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "portfolio.proto",
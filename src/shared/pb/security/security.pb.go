@@ -170,6 +170,102 @@ func (x *CheckPermissionResponse) GetCode() base.ErrorCode {
 	return base.ErrorCode(0)
 }
 
+type GetUserRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRolesRequest) Reset() {
+	*x = GetUserRolesRequest{}
+	mi := &file_security_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRolesRequest) ProtoMessage() {}
+
+func (x *GetUserRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_security_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRolesRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRolesRequest) Descriptor() ([]byte, []int) {
+	return file_security_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetUserRolesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []string               `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	Code          base.ErrorCode         `protobuf:"varint,2,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRolesResponse) Reset() {
+	*x = GetUserRolesResponse{}
+	mi := &file_security_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRolesResponse) ProtoMessage() {}
+
+func (x *GetUserRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_security_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRolesResponse.ProtoReflect.Descriptor instead.
+func (*GetUserRolesResponse) Descriptor() ([]byte, []int) {
+	return file_security_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetUserRolesResponse) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *GetUserRolesResponse) GetCode() base.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return base.ErrorCode(0)
+}
+
 var File_security_proto protoreflect.FileDescriptor
 
 const file_security_proto_rawDesc = "" +
@@ -187,9 +283,15 @@ const file_security_proto_rawDesc = "" +
 	"\n" +
 	"permission\x18\x01 \x01(\v2\x1c.security.SecurityPermissionR\n" +
 	"permission\x12#\n" +
-	"\x04code\x18\x02 \x01(\x0e2\x0f.base.ErrorCodeR\x04code2i\n" +
+	"\x04code\x18\x02 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\".\n" +
+	"\x13GetUserRolesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"Q\n" +
+	"\x14GetUserRolesResponse\x12\x14\n" +
+	"\x05roles\x18\x01 \x03(\tR\x05roles\x12#\n" +
+	"\x04code\x18\x02 \x01(\x0e2\x0f.base.ErrorCodeR\x04code2\xb8\x01\n" +
 	"\x0fSecurityService\x12V\n" +
-	"\x0fCheckPermission\x12 .security.CheckPermissionRequest\x1a!.security.CheckPermissionResponseB\x1eZ\x1cfafnir/shared/pb/security;pbb\x06proto3"
+	"\x0fCheckPermission\x12 .security.CheckPermissionRequest\x1a!.security.CheckPermissionResponse\x12M\n" +
+	"\fGetUserRoles\x12\x1d.security.GetUserRolesRequest\x1a\x1e.security.GetUserRolesResponseB\x1eZ\x1cfafnir/shared/pb/security;pbb\x06proto3"
 
 var (
 	file_security_proto_rawDescOnce sync.Once
@@ -203,23 +305,28 @@ func file_security_proto_rawDescGZIP() []byte {
 	return file_security_proto_rawDescData
 }
 
-var file_security_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_security_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_security_proto_goTypes = []any{
 	(*CheckPermissionRequest)(nil),  // 0: security.CheckPermissionRequest
 	(*SecurityPermission)(nil),      // 1: security.SecurityPermission
 	(*CheckPermissionResponse)(nil), // 2: security.CheckPermissionResponse
-	(base.ErrorCode)(0),             // 3: base.ErrorCode
+	(*GetUserRolesRequest)(nil),     // 3: security.GetUserRolesRequest
+	(*GetUserRolesResponse)(nil),    // 4: security.GetUserRolesResponse
+	(base.ErrorCode)(0),             // 5: base.ErrorCode
 }
 var file_security_proto_depIdxs = []int32{
 	1, // 0: security.CheckPermissionResponse.permission:type_name -> security.SecurityPermission
-	3, // 1: security.CheckPermissionResponse.code:type_name -> base.ErrorCode
-	0, // 2: security.SecurityService.CheckPermission:input_type -> security.CheckPermissionRequest
-	2, // 3: security.SecurityService.CheckPermission:output_type -> security.CheckPermissionResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5, // 1: security.CheckPermissionResponse.code:type_name -> base.ErrorCode
+	5, // 2: security.GetUserRolesResponse.code:type_name -> base.ErrorCode
+	0, // 3: security.SecurityService.CheckPermission:input_type -> security.CheckPermissionRequest
+	3, // 4: security.SecurityService.GetUserRoles:input_type -> security.GetUserRolesRequest
+	2, // 5: security.SecurityService.CheckPermission:output_type -> security.CheckPermissionResponse
+	4, // 6: security.SecurityService.GetUserRoles:output_type -> security.GetUserRolesResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_security_proto_init() }
@@ -233,7 +340,7 @@ func file_security_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_security_proto_rawDesc), len(file_security_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
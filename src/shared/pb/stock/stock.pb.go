@@ -23,6 +23,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MarketPhase int32
+
+const (
+	MarketPhase_MARKET_PHASE_UNSPECIFIED MarketPhase = 0
+	MarketPhase_MARKET_PHASE_CLOSED      MarketPhase = 1
+	MarketPhase_MARKET_PHASE_PRE_MARKET  MarketPhase = 2
+	MarketPhase_MARKET_PHASE_REGULAR     MarketPhase = 3
+	MarketPhase_MARKET_PHASE_POST_MARKET MarketPhase = 4
+)
+
+// Enum value maps for MarketPhase.
+var (
+	MarketPhase_name = map[int32]string{
+		0: "MARKET_PHASE_UNSPECIFIED",
+		1: "MARKET_PHASE_CLOSED",
+		2: "MARKET_PHASE_PRE_MARKET",
+		3: "MARKET_PHASE_REGULAR",
+		4: "MARKET_PHASE_POST_MARKET",
+	}
+	MarketPhase_value = map[string]int32{
+		"MARKET_PHASE_UNSPECIFIED": 0,
+		"MARKET_PHASE_CLOSED":      1,
+		"MARKET_PHASE_PRE_MARKET":  2,
+		"MARKET_PHASE_REGULAR":     3,
+		"MARKET_PHASE_POST_MARKET": 4,
+	}
+)
+
+func (x MarketPhase) Enum() *MarketPhase {
+	p := new(MarketPhase)
+	*p = x
+	return p
+}
+
+func (x MarketPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarketPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_stock_proto_enumTypes[0].Descriptor()
+}
+
+func (MarketPhase) Type() protoreflect.EnumType {
+	return &file_stock_proto_enumTypes[0]
+}
+
+func (x MarketPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarketPhase.Descriptor instead.
+func (MarketPhase) EnumDescriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{0}
+}
+
 type StockMetadata struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Symbol           string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
@@ -347,6 +402,170 @@ func (x *StockQuote) GetCurrency() string {
 	return ""
 }
 
+// published on quotes.<symbol> whenever stock-service refreshes a quote from a provider
+type QuoteUpdatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *StockQuote            `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteUpdatedEvent) Reset() {
+	*x = QuoteUpdatedEvent{}
+	mi := &file_stock_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteUpdatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteUpdatedEvent) ProtoMessage() {}
+
+func (x *QuoteUpdatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteUpdatedEvent.ProtoReflect.Descriptor instead.
+func (*QuoteUpdatedEvent) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QuoteUpdatedEvent) GetQuote() *StockQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+func (x *QuoteUpdatedEvent) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+// the trading session of an exchange at a point in time
+type MarketSession struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Exchange string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Calendar string                 `protobuf:"bytes,2,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	Timezone string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Phase    MarketPhase            `protobuf:"varint,4,opt,name=phase,proto3,enum=stock.MarketPhase" json:"phase,omitempty"`
+	// start of the next regular session and end of the current or next one
+	NextOpen  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=next_open,json=nextOpen,proto3" json:"next_open,omitempty"`
+	NextClose *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=next_close,json=nextClose,proto3" json:"next_close,omitempty"`
+	// when phase changes next; callers can cache the session until then
+	ChangesAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=changes_at,json=changesAt,proto3" json:"changes_at,omitempty"`
+	Holiday       string                 `protobuf:"bytes,8,opt,name=holiday,proto3" json:"holiday,omitempty"`
+	EarlyClose    bool                   `protobuf:"varint,9,opt,name=early_close,json=earlyClose,proto3" json:"early_close,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketSession) Reset() {
+	*x = MarketSession{}
+	mi := &file_stock_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketSession) ProtoMessage() {}
+
+func (x *MarketSession) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketSession.ProtoReflect.Descriptor instead.
+func (*MarketSession) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MarketSession) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *MarketSession) GetCalendar() string {
+	if x != nil {
+		return x.Calendar
+	}
+	return ""
+}
+
+func (x *MarketSession) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *MarketSession) GetPhase() MarketPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return MarketPhase_MARKET_PHASE_UNSPECIFIED
+}
+
+func (x *MarketSession) GetNextOpen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextOpen
+	}
+	return nil
+}
+
+func (x *MarketSession) GetNextClose() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextClose
+	}
+	return nil
+}
+
+func (x *MarketSession) GetChangesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangesAt
+	}
+	return nil
+}
+
+func (x *MarketSession) GetHoliday() string {
+	if x != nil {
+		return x.Holiday
+	}
+	return ""
+}
+
+func (x *MarketSession) GetEarlyClose() bool {
+	if x != nil {
+		return x.EarlyClose
+	}
+	return false
+}
+
 type StockHistoricalData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
@@ -364,7 +583,7 @@ type StockHistoricalData struct {
 
 func (x *StockHistoricalData) Reset() {
 	*x = StockHistoricalData{}
-	mi := &file_stock_proto_msgTypes[3]
+	mi := &file_stock_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -376,7 +595,7 @@ func (x *StockHistoricalData) String() string {
 func (*StockHistoricalData) ProtoMessage() {}
 
 func (x *StockHistoricalData) ProtoReflect() protoreflect.Message {
-	mi := &file_stock_proto_msgTypes[3]
+	mi := &file_stock_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -389,7 +608,7 @@ func (x *StockHistoricalData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StockHistoricalData.ProtoReflect.Descriptor instead.
 func (*StockHistoricalData) Descriptor() ([]byte, []int) {
-	return file_stock_proto_rawDescGZIP(), []int{3}
+	return file_stock_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *StockHistoricalData) GetSymbol() string {
@@ -464,7 +683,7 @@ type GetStockMetadataRequest struct {
 
 func (x *GetStockMetadataRequest) Reset() {
 	*x = GetStockMetadataRequest{}
-	mi := &file_stock_proto_msgTypes[4]
+	mi := &file_stock_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -476,7 +695,7 @@ func (x *GetStockMetadataRequest) String() string {
 func (*GetStockMetadataRequest) ProtoMessage() {}
 
 func (x *GetStockMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stock_proto_msgTypes[4]
+	mi := &file_stock_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -489,7 +708,7 @@ func (x *GetStockMetadataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStockMetadataRequest.ProtoReflect.Descriptor instead.
 func (*GetStockMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_stock_proto_rawDescGZIP(), []int{4}
+	return file_stock_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetStockMetadataRequest) GetSymbol() string {
@@ -509,7 +728,7 @@ type SearchStocksRequest struct {
 
 func (x *SearchStocksRequest) Reset() {
 	*x = SearchStocksRequest{}
-	mi := &file_stock_proto_msgTypes[5]
+	mi := &file_stock_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -521,7 +740,7 @@ func (x *SearchStocksRequest) String() string {
 func (*SearchStocksRequest) ProtoMessage() {}
 
 func (x *SearchStocksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stock_proto_msgTypes[5]
+	mi := &file_stock_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -534,7 +753,7 @@ func (x *SearchStocksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchStocksRequest.ProtoReflect.Descriptor instead.
 func (*SearchStocksRequest) Descriptor() ([]byte, []int) {
-	return file_stock_proto_rawDescGZIP(), []int{5}
+	return file_stock_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SearchStocksRequest) GetQuery() string {
@@ -561,7 +780,7 @@ type SearchStocksResponse struct {
 
 func (x *SearchStocksResponse) Reset() {
 	*x = SearchStocksResponse{}
-	mi := &file_stock_proto_msgTypes[6]
+	mi := &file_stock_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -573,7 +792,7 @@ func (x *SearchStocksResponse) String() string {
 func (*SearchStocksResponse) ProtoMessage() {}
 
 func (x *SearchStocksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stock_proto_msgTypes[6]
+	mi := &file_stock_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +805,7 @@ func (x *SearchStocksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchStocksResponse.ProtoReflect.Descriptor instead.
 func (*SearchStocksResponse) Descriptor() ([]byte, []int) {
-	return file_stock_proto_rawDescGZIP(), []int{6}
+	return file_stock_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SearchStocksResponse) GetData() []*StockSearchResult {
@@ -612,7 +831,7 @@ type GetStockQuoteRequest struct {
 
 func (x *GetStockQuoteRequest) Reset() {
 	*x = GetStockQuoteRequest{}
-	mi := &file_stock_proto_msgTypes[7]
+	mi := &file_stock_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -624,7 +843,7 @@ func (x *GetStockQuoteRequest) String() string {
 func (*GetStockQuoteRequest) ProtoMessage() {}
 
 func (x *GetStockQuoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stock_proto_msgTypes[7]
+	mi := &file_stock_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -637,7 +856,7 @@ func (x *GetStockQuoteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStockQuoteRequest.ProtoReflect.Descriptor instead.
 func (*GetStockQuoteRequest) Descriptor() ([]byte, []int) {
-	return file_stock_proto_rawDescGZIP(), []int{7}
+	return file_stock_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetStockQuoteRequest) GetSymbol() string {
@@ -657,7 +876,7 @@ type GetStockHistoricalDataRequest struct {
 
 func (x *GetStockHistoricalDataRequest) Reset() {
 	*x = GetStockHistoricalDataRequest{}
-	mi := &file_stock_proto_msgTypes[8]
+	mi := &file_stock_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -669,7 +888,7 @@ func (x *GetStockHistoricalDataRequest) String() string {
 func (*GetStockHistoricalDataRequest) ProtoMessage() {}
 
 func (x *GetStockHistoricalDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stock_proto_msgTypes[8]
+	mi := &file_stock_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -682,7 +901,7 @@ func (x *GetStockHistoricalDataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStockHistoricalDataRequest.ProtoReflect.Descriptor instead.
 func (*GetStockHistoricalDataRequest) Descriptor() ([]byte, []int) {
-	return file_stock_proto_rawDescGZIP(), []int{8}
+	return file_stock_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetStockHistoricalDataRequest) GetSymbol() string {
@@ -708,7 +927,7 @@ type GetStockQuoteBatchRequest struct {
 
 func (x *GetStockQuoteBatchRequest) Reset() {
 	*x = GetStockQuoteBatchRequest{}
-	mi := &file_stock_proto_msgTypes[9]
+	mi := &file_stock_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -720,7 +939,7 @@ func (x *GetStockQuoteBatchRequest) String() string {
 func (*GetStockQuoteBatchRequest) ProtoMessage() {}
 
 func (x *GetStockQuoteBatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stock_proto_msgTypes[9]
+	mi := &file_stock_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -733,7 +952,7 @@ func (x *GetStockQuoteBatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStockQuoteBatchRequest.ProtoReflect.Descriptor instead.
 func (*GetStockQuoteBatchRequest) Descriptor() ([]byte, []int) {
-	return file_stock_proto_rawDescGZIP(), []int{9}
+	return file_stock_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetStockQuoteBatchRequest) GetSymbols() []string {
@@ -743,6 +962,67 @@ func (x *GetStockQuoteBatchRequest) GetSymbols() []string {
 	return nil
 }
 
+// set either exchange or symbol; a symbol resolves to its listing exchange. at defaults to now
+type GetMarketSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarketSessionRequest) Reset() {
+	*x = GetMarketSessionRequest{}
+	mi := &file_stock_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketSessionRequest) ProtoMessage() {}
+
+func (x *GetMarketSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketSessionRequest) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetMarketSessionRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetMarketSessionRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetMarketSessionRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
 type GetStockMetadataResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Data          *StockMetadata         `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
@@ -753,7 +1033,7 @@ type GetStockMetadataResponse struct {
 
 func (x *GetStockMetadataResponse) Reset() {
 	*x = GetStockMetadataResponse{}
-	mi := &file_stock_proto_msgTypes[10]
+	mi := &file_stock_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -765,7 +1045,7 @@ func (x *GetStockMetadataResponse) String() string {
 func (*GetStockMetadataResponse) ProtoMessage() {}
 
 func (x *GetStockMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stock_proto_msgTypes[10]
+	mi := &file_stock_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -778,7 +1058,7 @@ func (x *GetStockMetadataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStockMetadataResponse.ProtoReflect.Descriptor instead.
 func (*GetStockMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_stock_proto_rawDescGZIP(), []int{10}
+	return file_stock_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetStockMetadataResponse) GetData() *StockMetadata {
@@ -805,7 +1085,7 @@ type GetStockQuoteResponse struct {
 
 func (x *GetStockQuoteResponse) Reset() {
 	*x = GetStockQuoteResponse{}
-	mi := &file_stock_proto_msgTypes[11]
+	mi := &file_stock_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -817,7 +1097,7 @@ func (x *GetStockQuoteResponse) String() string {
 func (*GetStockQuoteResponse) ProtoMessage() {}
 
 func (x *GetStockQuoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stock_proto_msgTypes[11]
+	mi := &file_stock_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -830,7 +1110,7 @@ func (x *GetStockQuoteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStockQuoteResponse.ProtoReflect.Descriptor instead.
 func (*GetStockQuoteResponse) Descriptor() ([]byte, []int) {
-	return file_stock_proto_rawDescGZIP(), []int{11}
+	return file_stock_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetStockQuoteResponse) GetData() *StockQuote {
@@ -857,7 +1137,7 @@ type GetStockHistoricalDataResponse struct {
 
 func (x *GetStockHistoricalDataResponse) Reset() {
 	*x = GetStockHistoricalDataResponse{}
-	mi := &file_stock_proto_msgTypes[12]
+	mi := &file_stock_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -869,7 +1149,7 @@ func (x *GetStockHistoricalDataResponse) String() string {
 func (*GetStockHistoricalDataResponse) ProtoMessage() {}
 
 func (x *GetStockHistoricalDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stock_proto_msgTypes[12]
+	mi := &file_stock_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -882,7 +1162,7 @@ func (x *GetStockHistoricalDataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStockHistoricalDataResponse.ProtoReflect.Descriptor instead.
 func (*GetStockHistoricalDataResponse) Descriptor() ([]byte, []int) {
-	return file_stock_proto_rawDescGZIP(), []int{12}
+	return file_stock_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetStockHistoricalDataResponse) GetData() []*StockHistoricalData {
@@ -909,7 +1189,7 @@ type GetStockQuoteBatchResponse struct {
 
 func (x *GetStockQuoteBatchResponse) Reset() {
 	*x = GetStockQuoteBatchResponse{}
-	mi := &file_stock_proto_msgTypes[13]
+	mi := &file_stock_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -921,7 +1201,7 @@ func (x *GetStockQuoteBatchResponse) String() string {
 func (*GetStockQuoteBatchResponse) ProtoMessage() {}
 
 func (x *GetStockQuoteBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stock_proto_msgTypes[13]
+	mi := &file_stock_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -934,7 +1214,7 @@ func (x *GetStockQuoteBatchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStockQuoteBatchResponse.ProtoReflect.Descriptor instead.
 func (*GetStockQuoteBatchResponse) Descriptor() ([]byte, []int) {
-	return file_stock_proto_rawDescGZIP(), []int{13}
+	return file_stock_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetStockQuoteBatchResponse) GetData() []*StockQuote {
@@ -951,6 +1231,58 @@ func (x *GetStockQuoteBatchResponse) GetCode() base.ErrorCode {
 	return base.ErrorCode(0)
 }
 
+type GetMarketSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *MarketSession         `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Code          base.ErrorCode         `protobuf:"varint,2,opt,name=code,proto3,enum=base.ErrorCode" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarketSessionResponse) Reset() {
+	*x = GetMarketSessionResponse{}
+	mi := &file_stock_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketSessionResponse) ProtoMessage() {}
+
+func (x *GetMarketSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketSessionResponse.ProtoReflect.Descriptor instead.
+func (*GetMarketSessionResponse) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetMarketSessionResponse) GetData() *MarketSession {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetMarketSessionResponse) GetCode() base.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return base.ErrorCode(0)
+}
+
 var File_stock_proto protoreflect.FileDescriptor
 
 const file_stock_proto_rawDesc = "" +
@@ -992,7 +1324,23 @@ const file_stock_proto_rawDesc = "" +
 	"\x06source\x18\r \x01(\tR\x06source\x12/\n" +
 	"\x05as_of\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12!\n" +
 	"\fmarket_state\x18\x0f \x01(\tR\vmarketState\x12\x1a\n" +
-	"\bcurrency\x18\x10 \x01(\tR\bcurrency\"\x8c\x02\n" +
+	"\bcurrency\x18\x10 \x01(\tR\bcurrency\"{\n" +
+	"\x11QuoteUpdatedEvent\x12'\n" +
+	"\x05quote\x18\x01 \x01(\v2\x11.stock.StockQuoteR\x05quote\x12=\n" +
+	"\fpublished_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\"\xf7\x02\n" +
+	"\rMarketSession\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1a\n" +
+	"\bcalendar\x18\x02 \x01(\tR\bcalendar\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12(\n" +
+	"\x05phase\x18\x04 \x01(\x0e2\x12.stock.MarketPhaseR\x05phase\x127\n" +
+	"\tnext_open\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bnextOpen\x129\n" +
+	"\n" +
+	"next_close\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tnextClose\x129\n" +
+	"\n" +
+	"changes_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tchangesAt\x12\x18\n" +
+	"\aholiday\x18\b \x01(\tR\aholiday\x12\x1f\n" +
+	"\vearly_close\x18\t \x01(\bR\n" +
+	"earlyClose\"\x8c\x02\n" +
 	"\x13StockHistoricalData\x12\x16\n" +
 	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x12\n" +
 	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1d\n" +
@@ -1021,7 +1369,11 @@ const file_stock_proto_rawDesc = "" +
 	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x16\n" +
 	"\x06period\x18\x02 \x01(\tR\x06period\"5\n" +
 	"\x19GetStockQuoteBatchRequest\x12\x18\n" +
-	"\asymbols\x18\x01 \x03(\tR\asymbols\"i\n" +
+	"\asymbols\x18\x01 \x03(\tR\asymbols\"y\n" +
+	"\x17GetMarketSessionRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12*\n" +
+	"\x02at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"i\n" +
 	"\x18GetStockMetadataResponse\x12(\n" +
 	"\x04data\x18\x01 \x01(\v2\x14.stock.StockMetadataR\x04data\x12#\n" +
 	"\x04code\x18\x02 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\"c\n" +
@@ -1033,13 +1385,23 @@ const file_stock_proto_rawDesc = "" +
 	"\x04code\x18\x02 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\"h\n" +
 	"\x1aGetStockQuoteBatchResponse\x12%\n" +
 	"\x04data\x18\x01 \x03(\v2\x11.stock.StockQuoteR\x04data\x12#\n" +
-	"\x04code\x18\x02 \x01(\x0e2\x0f.base.ErrorCodeR\x04code2\xba\x03\n" +
+	"\x04code\x18\x02 \x01(\x0e2\x0f.base.ErrorCodeR\x04code\"i\n" +
+	"\x18GetMarketSessionResponse\x12(\n" +
+	"\x04data\x18\x01 \x01(\v2\x14.stock.MarketSessionR\x04data\x12#\n" +
+	"\x04code\x18\x02 \x01(\x0e2\x0f.base.ErrorCodeR\x04code*\x99\x01\n" +
+	"\vMarketPhase\x12\x1c\n" +
+	"\x18MARKET_PHASE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13MARKET_PHASE_CLOSED\x10\x01\x12\x1b\n" +
+	"\x17MARKET_PHASE_PRE_MARKET\x10\x02\x12\x18\n" +
+	"\x14MARKET_PHASE_REGULAR\x10\x03\x12\x1c\n" +
+	"\x18MARKET_PHASE_POST_MARKET\x10\x042\x8f\x04\n" +
 	"\fStockService\x12G\n" +
 	"\fSearchStocks\x12\x1a.stock.SearchStocksRequest\x1a\x1b.stock.SearchStocksResponse\x12S\n" +
 	"\x10GetStockMetadata\x12\x1e.stock.GetStockMetadataRequest\x1a\x1f.stock.GetStockMetadataResponse\x12J\n" +
 	"\rGetStockQuote\x12\x1b.stock.GetStockQuoteRequest\x1a\x1c.stock.GetStockQuoteResponse\x12e\n" +
 	"\x16GetStockHistoricalData\x12$.stock.GetStockHistoricalDataRequest\x1a%.stock.GetStockHistoricalDataResponse\x12Y\n" +
-	"\x12GetStockQuoteBatch\x12 .stock.GetStockQuoteBatchRequest\x1a!.stock.GetStockQuoteBatchResponseB\x1bZ\x19fafnir/shared/pb/stock;pbb\x06proto3"
+	"\x12GetStockQuoteBatch\x12 .stock.GetStockQuoteBatchRequest\x1a!.stock.GetStockQuoteBatchResponse\x12S\n" +
+	"\x10GetMarketSession\x12\x1e.stock.GetMarketSessionRequest\x1a\x1f.stock.GetMarketSessionResponseB\x1bZ\x19fafnir/shared/pb/stock;pbb\x06proto3"
 
 var (
 	file_stock_proto_rawDescOnce sync.Once
@@ -1053,52 +1415,69 @@ func file_stock_proto_rawDescGZIP() []byte {
 	return file_stock_proto_rawDescData
 }
 
-var file_stock_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_stock_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_stock_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_stock_proto_goTypes = []any{
-	(*StockMetadata)(nil),                  // 0: stock.StockMetadata
-	(*StockSearchResult)(nil),              // 1: stock.StockSearchResult
-	(*StockQuote)(nil),                     // 2: stock.StockQuote
-	(*StockHistoricalData)(nil),            // 3: stock.StockHistoricalData
-	(*GetStockMetadataRequest)(nil),        // 4: stock.GetStockMetadataRequest
-	(*SearchStocksRequest)(nil),            // 5: stock.SearchStocksRequest
-	(*SearchStocksResponse)(nil),           // 6: stock.SearchStocksResponse
-	(*GetStockQuoteRequest)(nil),           // 7: stock.GetStockQuoteRequest
-	(*GetStockHistoricalDataRequest)(nil),  // 8: stock.GetStockHistoricalDataRequest
-	(*GetStockQuoteBatchRequest)(nil),      // 9: stock.GetStockQuoteBatchRequest
-	(*GetStockMetadataResponse)(nil),       // 10: stock.GetStockMetadataResponse
-	(*GetStockQuoteResponse)(nil),          // 11: stock.GetStockQuoteResponse
-	(*GetStockHistoricalDataResponse)(nil), // 12: stock.GetStockHistoricalDataResponse
-	(*GetStockQuoteBatchResponse)(nil),     // 13: stock.GetStockQuoteBatchResponse
-	(*timestamppb.Timestamp)(nil),          // 14: google.protobuf.Timestamp
-	(base.ErrorCode)(0),                    // 15: base.ErrorCode
+	(MarketPhase)(0),                       // 0: stock.MarketPhase
+	(*StockMetadata)(nil),                  // 1: stock.StockMetadata
+	(*StockSearchResult)(nil),              // 2: stock.StockSearchResult
+	(*StockQuote)(nil),                     // 3: stock.StockQuote
+	(*QuoteUpdatedEvent)(nil),              // 4: stock.QuoteUpdatedEvent
+	(*MarketSession)(nil),                  // 5: stock.MarketSession
+	(*StockHistoricalData)(nil),            // 6: stock.StockHistoricalData
+	(*GetStockMetadataRequest)(nil),        // 7: stock.GetStockMetadataRequest
+	(*SearchStocksRequest)(nil),            // 8: stock.SearchStocksRequest
+	(*SearchStocksResponse)(nil),           // 9: stock.SearchStocksResponse
+	(*GetStockQuoteRequest)(nil),           // 10: stock.GetStockQuoteRequest
+	(*GetStockHistoricalDataRequest)(nil),  // 11: stock.GetStockHistoricalDataRequest
+	(*GetStockQuoteBatchRequest)(nil),      // 12: stock.GetStockQuoteBatchRequest
+	(*GetMarketSessionRequest)(nil),        // 13: stock.GetMarketSessionRequest
+	(*GetStockMetadataResponse)(nil),       // 14: stock.GetStockMetadataResponse
+	(*GetStockQuoteResponse)(nil),          // 15: stock.GetStockQuoteResponse
+	(*GetStockHistoricalDataResponse)(nil), // 16: stock.GetStockHistoricalDataResponse
+	(*GetStockQuoteBatchResponse)(nil),     // 17: stock.GetStockQuoteBatchResponse
+	(*GetMarketSessionResponse)(nil),       // 18: stock.GetMarketSessionResponse
+	(*timestamppb.Timestamp)(nil),          // 19: google.protobuf.Timestamp
+	(base.ErrorCode)(0),                    // 20: base.ErrorCode
 }
 var file_stock_proto_depIdxs = []int32{
-	14, // 0: stock.StockQuote.as_of:type_name -> google.protobuf.Timestamp
-	1,  // 1: stock.SearchStocksResponse.data:type_name -> stock.StockSearchResult
-	15, // 2: stock.SearchStocksResponse.code:type_name -> base.ErrorCode
-	0,  // 3: stock.GetStockMetadataResponse.data:type_name -> stock.StockMetadata
-	15, // 4: stock.GetStockMetadataResponse.code:type_name -> base.ErrorCode
-	2,  // 5: stock.GetStockQuoteResponse.data:type_name -> stock.StockQuote
-	15, // 6: stock.GetStockQuoteResponse.code:type_name -> base.ErrorCode
-	3,  // 7: stock.GetStockHistoricalDataResponse.data:type_name -> stock.StockHistoricalData
-	15, // 8: stock.GetStockHistoricalDataResponse.code:type_name -> base.ErrorCode
-	2,  // 9: stock.GetStockQuoteBatchResponse.data:type_name -> stock.StockQuote
-	15, // 10: stock.GetStockQuoteBatchResponse.code:type_name -> base.ErrorCode
-	5,  // 11: stock.StockService.SearchStocks:input_type -> stock.SearchStocksRequest
-	4,  // 12: stock.StockService.GetStockMetadata:input_type -> stock.GetStockMetadataRequest
-	7,  // 13: stock.StockService.GetStockQuote:input_type -> stock.GetStockQuoteRequest
-	8,  // 14: stock.StockService.GetStockHistoricalData:input_type -> stock.GetStockHistoricalDataRequest
-	9,  // 15: stock.StockService.GetStockQuoteBatch:input_type -> stock.GetStockQuoteBatchRequest
-	6,  // 16: stock.StockService.SearchStocks:output_type -> stock.SearchStocksResponse
-	10, // 17: stock.StockService.GetStockMetadata:output_type -> stock.GetStockMetadataResponse
-	11, // 18: stock.StockService.GetStockQuote:output_type -> stock.GetStockQuoteResponse
-	12, // 19: stock.StockService.GetStockHistoricalData:output_type -> stock.GetStockHistoricalDataResponse
-	13, // 20: stock.StockService.GetStockQuoteBatch:output_type -> stock.GetStockQuoteBatchResponse
-	16, // [16:21] is the sub-list for method output_type
-	11, // [11:16] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	19, // 0: stock.StockQuote.as_of:type_name -> google.protobuf.Timestamp
+	3,  // 1: stock.QuoteUpdatedEvent.quote:type_name -> stock.StockQuote
+	19, // 2: stock.QuoteUpdatedEvent.published_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: stock.MarketSession.phase:type_name -> stock.MarketPhase
+	19, // 4: stock.MarketSession.next_open:type_name -> google.protobuf.Timestamp
+	19, // 5: stock.MarketSession.next_close:type_name -> google.protobuf.Timestamp
+	19, // 6: stock.MarketSession.changes_at:type_name -> google.protobuf.Timestamp
+	2,  // 7: stock.SearchStocksResponse.data:type_name -> stock.StockSearchResult
+	20, // 8: stock.SearchStocksResponse.code:type_name -> base.ErrorCode
+	19, // 9: stock.GetMarketSessionRequest.at:type_name -> google.protobuf.Timestamp
+	1,  // 10: stock.GetStockMetadataResponse.data:type_name -> stock.StockMetadata
+	20, // 11: stock.GetStockMetadataResponse.code:type_name -> base.ErrorCode
+	3,  // 12: stock.GetStockQuoteResponse.data:type_name -> stock.StockQuote
+	20, // 13: stock.GetStockQuoteResponse.code:type_name -> base.ErrorCode
+	6,  // 14: stock.GetStockHistoricalDataResponse.data:type_name -> stock.StockHistoricalData
+	20, // 15: stock.GetStockHistoricalDataResponse.code:type_name -> base.ErrorCode
+	3,  // 16: stock.GetStockQuoteBatchResponse.data:type_name -> stock.StockQuote
+	20, // 17: stock.GetStockQuoteBatchResponse.code:type_name -> base.ErrorCode
+	5,  // 18: stock.GetMarketSessionResponse.data:type_name -> stock.MarketSession
+	20, // 19: stock.GetMarketSessionResponse.code:type_name -> base.ErrorCode
+	8,  // 20: stock.StockService.SearchStocks:input_type -> stock.SearchStocksRequest
+	7,  // 21: stock.StockService.GetStockMetadata:input_type -> stock.GetStockMetadataRequest
+	10, // 22: stock.StockService.GetStockQuote:input_type -> stock.GetStockQuoteRequest
+	11, // 23: stock.StockService.GetStockHistoricalData:input_type -> stock.GetStockHistoricalDataRequest
+	12, // 24: stock.StockService.GetStockQuoteBatch:input_type -> stock.GetStockQuoteBatchRequest
+	13, // 25: stock.StockService.GetMarketSession:input_type -> stock.GetMarketSessionRequest
+	9,  // 26: stock.StockService.SearchStocks:output_type -> stock.SearchStocksResponse
+	14, // 27: stock.StockService.GetStockMetadata:output_type -> stock.GetStockMetadataResponse
+	15, // 28: stock.StockService.GetStockQuote:output_type -> stock.GetStockQuoteResponse
+	16, // 29: stock.StockService.GetStockHistoricalData:output_type -> stock.GetStockHistoricalDataResponse
+	17, // 30: stock.StockService.GetStockQuoteBatch:output_type -> stock.GetStockQuoteBatchResponse
+	18, // 31: stock.StockService.GetMarketSession:output_type -> stock.GetMarketSessionResponse
+	26, // [26:32] is the sub-list for method output_type
+	20, // [20:26] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_stock_proto_init() }
@@ -1111,13 +1490,14 @@ func file_stock_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_stock_proto_rawDesc), len(file_stock_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   14,
+			NumEnums:      1,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_stock_proto_goTypes,
 		DependencyIndexes: file_stock_proto_depIdxs,
+		EnumInfos:         file_stock_proto_enumTypes,
 		MessageInfos:      file_stock_proto_msgTypes,
 	}.Build()
 	File_stock_proto = out.File
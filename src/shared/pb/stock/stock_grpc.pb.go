@@ -24,6 +24,7 @@ const (
 	StockService_GetStockQuote_FullMethodName          = "/stock.StockService/GetStockQuote"
 	StockService_GetStockHistoricalData_FullMethodName = "/stock.StockService/GetStockHistoricalData"
 	StockService_GetStockQuoteBatch_FullMethodName     = "/stock.StockService/GetStockQuoteBatch"
+	StockService_GetMarketSession_FullMethodName       = "/stock.StockService/GetMarketSession"
 )
 
 // StockServiceClient is the client API for StockService service.
@@ -35,6 +36,7 @@ type StockServiceClient interface {
 	GetStockQuote(ctx context.Context, in *GetStockQuoteRequest, opts ...grpc.CallOption) (*GetStockQuoteResponse, error)
 	GetStockHistoricalData(ctx context.Context, in *GetStockHistoricalDataRequest, opts ...grpc.CallOption) (*GetStockHistoricalDataResponse, error)
 	GetStockQuoteBatch(ctx context.Context, in *GetStockQuoteBatchRequest, opts ...grpc.CallOption) (*GetStockQuoteBatchResponse, error)
+	GetMarketSession(ctx context.Context, in *GetMarketSessionRequest, opts ...grpc.CallOption) (*GetMarketSessionResponse, error)
 }
 
 type stockServiceClient struct {
@@ -95,6 +97,16 @@ func (c *stockServiceClient) GetStockQuoteBatch(ctx context.Context, in *GetStoc
 	return out, nil
 }
 
+func (c *stockServiceClient) GetMarketSession(ctx context.Context, in *GetMarketSessionRequest, opts ...grpc.CallOption) (*GetMarketSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMarketSessionResponse)
+	err := c.cc.Invoke(ctx, StockService_GetMarketSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StockServiceServer is the server API for StockService service.
 // All implementations must embed UnimplementedStockServiceServer
 // for forward compatibility.
@@ -104,6 +116,7 @@ type StockServiceServer interface {
 	GetStockQuote(context.Context, *GetStockQuoteRequest) (*GetStockQuoteResponse, error)
 	GetStockHistoricalData(context.Context, *GetStockHistoricalDataRequest) (*GetStockHistoricalDataResponse, error)
 	GetStockQuoteBatch(context.Context, *GetStockQuoteBatchRequest) (*GetStockQuoteBatchResponse, error)
+	GetMarketSession(context.Context, *GetMarketSessionRequest) (*GetMarketSessionResponse, error)
 	mustEmbedUnimplementedStockServiceServer()
 }
 
@@ -129,6 +142,9 @@ func (UnimplementedStockServiceServer) GetStockHistoricalData(context.Context, *
 func (UnimplementedStockServiceServer) GetStockQuoteBatch(context.Context, *GetStockQuoteBatchRequest) (*GetStockQuoteBatchResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetStockQuoteBatch not implemented")
 }
+func (UnimplementedStockServiceServer) GetMarketSession(context.Context, *GetMarketSessionRequest) (*GetMarketSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMarketSession not implemented")
+}
 func (UnimplementedStockServiceServer) mustEmbedUnimplementedStockServiceServer() {}
 func (UnimplementedStockServiceServer) testEmbeddedByValue()                      {}
 
@@ -240,6 +256,24 @@ func _StockService_GetStockQuoteBatch_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StockService_GetMarketSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).GetMarketSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_GetMarketSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).GetMarketSession(ctx, req.(*GetMarketSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StockService_ServiceDesc is the grpc.ServiceDesc for StockService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +301,10 @@ var StockService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStockQuoteBatch",
 			Handler:    _StockService_GetStockQuoteBatch_Handler,
 		},
+		{
+			MethodName: "GetMarketSession",
+			Handler:    _StockService_GetMarketSession_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stock.proto",
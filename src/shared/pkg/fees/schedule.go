@@ -0,0 +1,75 @@
+// Package fees prices the commissions and charges of a fill. Every amount is in the currency of the
+// account the fill settles in.
+package fees
+
+import (
+	"math"
+	"strings"
+)
+
+// Schedule lists what a broker charges for trading; a zero entry is not charged
+type Schedule struct {
+	// flat commission per order, charged with its first fill
+	PerOrder float64
+	// commission per share, clamped per order to PerShareMin and PerShareMax (0 = no bound)
+	PerShare    float64
+	PerShareMin float64
+	PerShareMax float64
+	// commission as a share of the fill's notional
+	Percent float64
+	// spread on the converted notional when the instrument trades in another currency than the account
+	FXSpread float64
+}
+
+// Fill is what the schedule needs to know about one fill of an order
+type Fill struct {
+	Quantity float64
+	// quantity of the order filled before this fill
+	PriorQuantity float64
+	Price         float64
+	// rate from the instrument's currency into the account's
+	ExchangeRate       float64
+	InstrumentCurrency string
+	AccountCurrency    string
+}
+
+// Fee is the total charge for fill. Order level charges (the flat commission and the per share
+// bounds) are spread across fills so that an order pays them once, however often it fills.
+func (s Schedule) Fee(fill Fill) float64 {
+	var fee float64
+	if fill.PriorQuantity == 0 {
+		fee += s.PerOrder
+	}
+	fee += s.perShare(fill.PriorQuantity+fill.Quantity) - s.perShare(fill.PriorQuantity)
+
+	notional := fill.Quantity * fill.Price * fill.ExchangeRate
+	fee += notional * s.Percent
+	if !strings.EqualFold(fill.InstrumentCurrency, fill.AccountCurrency) {
+		fee += notional * s.FXSpread
+	}
+
+	// fees are settled in cents
+	return math.Round(fee*100) / 100
+}
+
+// Hold is the cash a buy reserves for fill: its notional converted into the account's currency plus the fees
+// its fills are charged. Every cash hold is sized with it, so a hold always covers what settling it costs.
+func (s Schedule) Hold(fill Fill) float64 {
+	return fill.Quantity*fill.Price*fill.ExchangeRate + s.Fee(fill)
+}
+
+// perShare is the per share commission of an order once quantity of it has filled
+func (s Schedule) perShare(quantity float64) float64 {
+	if s.PerShare == 0 || quantity <= 0 {
+		return 0
+	}
+
+	commission := quantity * s.PerShare
+	if s.PerShareMin > 0 {
+		commission = math.Max(commission, s.PerShareMin)
+	}
+	if s.PerShareMax > 0 {
+		commission = math.Min(commission, s.PerShareMax)
+	}
+	return commission
+}
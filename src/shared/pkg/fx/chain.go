@@ -0,0 +1,71 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Chain asks its providers in order and returns the first rate one of them serves
+type Chain struct {
+	providers []Provider
+}
+
+var errNoProviders = errors.New("no FX providers configured")
+
+func NewChain(providers ...Provider) *Chain {
+	return &Chain{
+		providers: providers,
+	}
+}
+
+func (c *Chain) Name() string {
+	return "provider-chain"
+}
+
+func (c *Chain) Rate(ctx context.Context, from string, to string) (float64, error) {
+	return firstRate(ctx, c.providers, from, to, func(provider Provider) (float64, error) {
+		return provider.Rate(ctx, from, to)
+	})
+}
+
+func (c *Chain) RateAt(ctx context.Context, from string, to string, date time.Time) (float64, error) {
+	return firstRate(ctx, c.providers, from, to, func(provider Provider) (float64, error) {
+		return provider.RateAt(ctx, from, to, date)
+	})
+}
+
+func (c *Chain) Close() error {
+	var errs []error
+	for _, provider := range c.providers {
+		if err := provider.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", provider.Name(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func firstRate(ctx context.Context, providers []Provider, from string, to string, fetch func(Provider) (float64, error)) (float64, error) {
+	if len(providers) == 0 {
+		return 0, errNoProviders
+	}
+
+	var errs []error
+
+	for _, provider := range providers {
+		rate, err := fetch(provider)
+		if err == nil {
+			return rate, nil
+		}
+
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %w", provider.Name(), err))
+	}
+
+	return 0, fmt.Errorf("no %s/%s FX rate available: %w", from, to, errors.Join(errs...))
+}
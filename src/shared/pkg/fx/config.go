@@ -0,0 +1,46 @@
+package fx
+
+import (
+	"fmt"
+	"time"
+)
+
+// Config selects where a service gets its FX rates from
+type Config struct {
+	BaseURL string
+	Timeout time.Duration
+	TTL     time.Duration
+	// JSON rate table used when the FX API is unavailable, or instead of it when Offline is set
+	RatesFile string
+	Offline   bool
+	// oldest last known rate served while the FX API is failing
+	MaxRateAge time.Duration
+}
+
+// New asks the FX API first, falling back to the last rates it served and then to the rate file, if any;
+// offline it only uses the rate file, which it cannot run without
+func New(cfg Config, store RateStore) (Provider, error) {
+	var staticRates *Static
+	if cfg.RatesFile != "" {
+		rates, err := LoadStaticFile(cfg.RatesFile)
+		if err != nil {
+			return nil, fmt.Errorf("load FX rates: %w", err)
+		}
+		staticRates = rates
+	}
+
+	if cfg.Offline {
+		if staticRates == nil {
+			return nil, fmt.Errorf("offline FX needs a rates file")
+		}
+		return staticRates, nil
+	}
+
+	providers := []Provider{
+		NewLastKnownGood(NewFrankfurter(cfg.BaseURL, cfg.Timeout, cfg.TTL), store, cfg.MaxRateAge),
+	}
+	if staticRates != nil {
+		providers = append(providers, staticRates)
+	}
+	return NewChain(providers...), nil
+}
@@ -0,0 +1,117 @@
+package fx
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"resty.dev/v3"
+)
+
+type cachedRate struct {
+	rate      float64
+	fetchedAt time.Time
+	expiresAt time.Time
+}
+
+type Frankfurter struct {
+	client *resty.Client
+	ttl    time.Duration
+
+	mu    sync.RWMutex
+	cache map[string]cachedRate
+}
+
+func NewFrankfurter(baseURL string, timeout time.Duration, ttl time.Duration) *Frankfurter {
+	client := resty.New().
+		SetBaseURL(strings.TrimRight(baseURL, "/")).
+		SetTimeout(timeout)
+
+	return &Frankfurter{
+		client: client,
+		ttl:    ttl,
+		cache:  make(map[string]cachedRate),
+	}
+}
+
+func (f *Frankfurter) Name() string {
+	return "frankfurter"
+}
+
+func (f *Frankfurter) Close() error {
+	return f.client.Close()
+}
+
+func (f *Frankfurter) Rate(ctx context.Context, from string, to string) (float64, error) {
+	rate, _, err := f.fetch(ctx, from, to, "")
+	return rate, err
+}
+
+// RateAsOf returns the latest rate and when it was fetched, which is earlier than now when it is served from the cache
+func (f *Frankfurter) RateAsOf(ctx context.Context, from string, to string) (float64, time.Time, error) {
+	return f.fetch(ctx, from, to, "")
+}
+
+func (f *Frankfurter) RateAt(ctx context.Context, from string, to string, date time.Time) (float64, error) {
+	rate, _, err := f.fetch(ctx, from, to, date.Format(time.DateOnly))
+	return rate, err
+}
+
+// fetch returns the latest rate, or the rate published for date when it is set, and when it was fetched
+func (f *Frankfurter) fetch(ctx context.Context, from string, to string, date string) (float64, time.Time, error) {
+	from, to, err := normalizePair(from, to)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	if from == to {
+		return 1, time.Now(), nil
+	}
+
+	key := pairKey(from, to)
+	if date != "" {
+		key += "@" + date
+	}
+	if entry, ok := f.cached(key); ok {
+		return entry.rate, entry.fetchedAt, nil
+	}
+
+	var payload struct {
+		Rate float64 `json:"rate"`
+	}
+
+	request := f.client.R().
+		SetContext(ctx).
+		SetResult(&payload)
+	if date != "" {
+		request.SetQueryParam("date", date)
+	}
+	endpoint := fmt.Sprintf("/v2/rate/%s/%s", url.PathEscape(from), url.PathEscape(to))
+	resp, err := request.Get(endpoint)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("fetch %s/%s FX rate: %w", from, to, err)
+	}
+	if resp.IsError() {
+		return 0, time.Time{}, fmt.Errorf("fetch %s/%s FX rate: unexpected status %d", from, to, resp.StatusCode())
+	}
+	if payload.Rate <= 0 {
+		return 0, time.Time{}, fmt.Errorf("FX provider returned an invalid %s/%s rate", from, to)
+	}
+
+	fetchedAt := time.Now()
+	f.mu.Lock()
+	f.cache[key] = cachedRate{rate: payload.Rate, fetchedAt: fetchedAt, expiresAt: fetchedAt.Add(f.ttl)}
+	f.mu.Unlock()
+
+	return payload.Rate, fetchedAt, nil
+}
+
+func (f *Frankfurter) cached(key string) (cachedRate, bool) {
+	f.mu.RLock()
+	entry, ok := f.cache[key]
+	f.mu.RUnlock()
+
+	return entry, ok && time.Now().Before(entry.expiresAt)
+}
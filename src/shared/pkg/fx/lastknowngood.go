@@ -0,0 +1,79 @@
+package fx
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// LastKnownGood persists every rate its provider serves and falls back to the stored one while the
+// provider is failing, for as long as the stored rate is younger than maxAge. A rate is as old as the
+// provider's fetch of it when the provider is a TimedProvider, and as old as the moment it was served otherwise.
+type LastKnownGood struct {
+	provider Provider
+	store    RateStore
+	maxAge   time.Duration
+}
+
+func NewLastKnownGood(provider Provider, store RateStore, maxAge time.Duration) *LastKnownGood {
+	return &LastKnownGood{
+		provider: provider,
+		store:    store,
+		maxAge:   maxAge,
+	}
+}
+
+func (l *LastKnownGood) Name() string {
+	return l.provider.Name() + "+last-known-good"
+}
+
+func (l *LastKnownGood) Close() error {
+	return l.provider.Close()
+}
+
+func (l *LastKnownGood) Rate(ctx context.Context, from string, to string) (float64, error) {
+	from, to, err := normalizePair(from, to)
+	if err != nil {
+		return 0, err
+	}
+	if from == to {
+		return 1, nil
+	}
+	key := pairKey(from, to)
+
+	rate, asOf, err := l.latest(ctx, from, to)
+	if err == nil {
+		// a rate that could not be stored only weakens the fallback, so the fill goes ahead
+		_ = l.store.SaveRate(ctx, key, rate, asOf)
+		return rate, nil
+	}
+
+	stored, asOf, loadErr := l.store.LoadRate(ctx, key)
+	if loadErr != nil {
+		return 0, fmt.Errorf("%w (last known rate unavailable: %v)", err, loadErr)
+	}
+	if stored <= 0 {
+		return 0, err
+	}
+	if age := time.Since(asOf); age > l.maxAge {
+		return 0, fmt.Errorf("%w (last known rate is %s old)", err, age.Round(time.Minute))
+	}
+
+	return stored, nil
+}
+
+// latest asks the provider for its latest rate, with the time the rate dates from; a cached rate keeps the
+// time it was fetched, so serving it again does not make it any younger
+func (l *LastKnownGood) latest(ctx context.Context, from string, to string) (float64, time.Time, error) {
+	if timed, ok := l.provider.(TimedProvider); ok {
+		return timed.RateAsOf(ctx, from, to)
+	}
+
+	rate, err := l.provider.Rate(ctx, from, to)
+	return rate, time.Now(), err
+}
+
+// RateAt asks the provider only: a past date's rate does not change, so there is nothing to fall back to
+func (l *LastKnownGood) RateAt(ctx context.Context, from string, to string, date time.Time) (float64, error) {
+	return l.provider.RateAt(ctx, from, to, date)
+}
@@ -0,0 +1,43 @@
+package fx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type Provider interface {
+	Name() string
+	Rate(context.Context, string, string) (float64, error)
+	// RateAt returns the rate that applied on a past date, for converting historical transactions
+	RateAt(context.Context, string, string, time.Time) (float64, error)
+	Close() error
+}
+
+// TimedProvider is a Provider that knows when the latest rate it serves was fetched from its source
+type TimedProvider interface {
+	Provider
+	RateAsOf(context.Context, string, string) (float64, time.Time, error)
+}
+
+// RateStore persists the last rate each provider served per currency pair
+type RateStore interface {
+	SaveRate(ctx context.Context, pair string, rate float64, asOf time.Time) error
+	// LoadRate returns a zero rate when none is stored for pair
+	LoadRate(ctx context.Context, pair string) (float64, time.Time, error)
+}
+
+// normalizePair upper-cases both currency codes and rejects empty ones
+func normalizePair(from string, to string) (string, string, error) {
+	from = strings.ToUpper(strings.TrimSpace(from))
+	to = strings.ToUpper(strings.TrimSpace(to))
+	if from == "" || to == "" {
+		return "", "", fmt.Errorf("currency codes must not be empty")
+	}
+	return from, to, nil
+}
+
+func pairKey(from string, to string) string {
+	return from + ":" + to
+}
@@ -0,0 +1,58 @@
+package fx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"fafnir/shared/pkg/redis"
+)
+
+// RedisStore keeps the last rate served per currency pair in one hash keyed by "FROM:TO", so every replica
+// of a service can fall back to it while the FX provider is down
+type RedisStore struct {
+	client *redis.Cache
+	key    string
+}
+
+type storedRate struct {
+	Rate float64   `json:"rate"`
+	AsOf time.Time `json:"asOf"`
+}
+
+func NewRedisStore(client *redis.Cache, key string) *RedisStore {
+	return &RedisStore{client: client, key: key}
+}
+
+// SaveRate records rate as the last known rate of pair, as of when it was fetched
+func (r *RedisStore) SaveRate(ctx context.Context, pair string, rate float64, asOf time.Time) error {
+	data, err := json.Marshal(storedRate{Rate: rate, AsOf: asOf})
+	if err != nil {
+		return fmt.Errorf("marshal %s FX rate: %w", pair, err)
+	}
+
+	if err := r.client.HSet(ctx, r.key, pair, string(data)); err != nil {
+		return fmt.Errorf("save %s FX rate: %w", pair, err)
+	}
+
+	return nil
+}
+
+// LoadRate returns the last known rate of pair and when it was fetched, or a zero rate if there is none
+func (r *RedisStore) LoadRate(ctx context.Context, pair string) (float64, time.Time, error) {
+	raw, err := r.client.HGet(ctx, r.key, pair)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("load %s FX rate: %w", pair, err)
+	}
+	if raw == "" {
+		return 0, time.Time{}, nil
+	}
+
+	var stored storedRate
+	if err := json.Unmarshal([]byte(raw), &stored); err != nil {
+		return 0, time.Time{}, fmt.Errorf("unmarshal %s FX rate: %w", pair, err)
+	}
+
+	return stored.Rate, stored.AsOf, nil
+}
@@ -0,0 +1,134 @@
+package fx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Static serves rates from a fixed table, for offline development and tests. Rates are quoted against one
+// base currency and crossed through it. Historical tables apply from their date until the next one; dates
+// before the first (or any date, without history) use the current table.
+type Static struct {
+	base    string
+	rates   map[string]float64
+	history []datedRates
+}
+
+type datedRates struct {
+	date  time.Time
+	rates map[string]float64
+}
+
+// StaticTable is the rate file format: units of each currency per one unit of base
+type StaticTable struct {
+	Base    string                        `json:"base"`
+	Rates   map[string]float64            `json:"rates"`
+	History map[string]map[string]float64 `json:"history,omitempty"`
+}
+
+func NewStatic(table StaticTable) (*Static, error) {
+	base := strings.ToUpper(strings.TrimSpace(table.Base))
+	if base == "" {
+		return nil, fmt.Errorf("static FX table has no base currency")
+	}
+
+	rates, err := normalizeRates(base, table.Rates)
+	if err != nil {
+		return nil, err
+	}
+
+	static := &Static{base: base, rates: rates}
+	for rawDate, rawRates := range table.History {
+		date, err := time.Parse(time.DateOnly, rawDate)
+		if err != nil {
+			return nil, fmt.Errorf("static FX table has an invalid history date %q: %w", rawDate, err)
+		}
+		rates, err := normalizeRates(base, rawRates)
+		if err != nil {
+			return nil, fmt.Errorf("static FX rates for %s: %w", rawDate, err)
+		}
+		static.history = append(static.history, datedRates{date: date, rates: rates})
+	}
+	sort.Slice(static.history, func(i, j int) bool {
+		return static.history[i].date.Before(static.history[j].date)
+	})
+
+	return static, nil
+}
+
+// LoadStaticFile reads a StaticTable from a JSON file
+func LoadStaticFile(path string) (*Static, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read FX rates file: %w", err)
+	}
+
+	var table StaticTable
+	if err := json.Unmarshal(data, &table); err != nil {
+		return nil, fmt.Errorf("parse FX rates file: %w", err)
+	}
+
+	return NewStatic(table)
+}
+
+func normalizeRates(base string, raw map[string]float64) (map[string]float64, error) {
+	rates := map[string]float64{base: 1}
+	for currency, rate := range raw {
+		if rate <= 0 {
+			return nil, fmt.Errorf("rate for %s must be greater than zero", currency)
+		}
+		rates[strings.ToUpper(strings.TrimSpace(currency))] = rate
+	}
+	return rates, nil
+}
+
+func (s *Static) Name() string {
+	return "static"
+}
+
+func (s *Static) Close() error {
+	return nil
+}
+
+func (s *Static) Rate(_ context.Context, from string, to string) (float64, error) {
+	return s.cross(s.rates, from, to)
+}
+
+func (s *Static) RateAt(_ context.Context, from string, to string, date time.Time) (float64, error) {
+	rates := s.rates
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	for _, dated := range s.history {
+		if dated.date.After(day) {
+			break
+		}
+		rates = dated.rates
+	}
+
+	return s.cross(rates, from, to)
+}
+
+func (s *Static) cross(rates map[string]float64, from string, to string) (float64, error) {
+	from, to, err := normalizePair(from, to)
+	if err != nil {
+		return 0, err
+	}
+	if from == to {
+		return 1, nil
+	}
+
+	fromRate, ok := rates[from]
+	if !ok {
+		return 0, fmt.Errorf("no static rate for %s", from)
+	}
+	toRate, ok := rates[to]
+	if !ok {
+		return 0, fmt.Errorf("no static rate for %s", to)
+	}
+
+	return toRate / fromRate, nil
+}
@@ -90,6 +90,22 @@ func (c *NatsClient) QueueSubscribe(subject, queue, durable string, handler nats
 	return c.js.QueueSubscribe(subject, queue, handler, nats.Durable(durable), nats.ManualAck())
 }
 
+// PublishEphemeral publishes on core NATS instead of JetStream: nothing is stored, so subscribers
+// that are offline miss the message (meant for frequently refreshed state like quotes)
+func (c *NatsClient) PublishEphemeral(subject string, data []byte) error {
+	return c.nc.Publish(subject, data)
+}
+
+// SubscribeEphemeral receives core NATS messages on a subject (fan-out, no acks or redelivery)
+func (c *NatsClient) SubscribeEphemeral(subject string, handler nats.MsgHandler) (*nats.Subscription, error) {
+	return c.nc.Subscribe(subject, handler)
+}
+
+// Request sends a core NATS message and waits for a single reply (point-to-point, nothing is stored)
+func (c *NatsClient) Request(subject string, data []byte, timeout time.Duration) (*nats.Msg, error) {
+	return c.nc.Request(subject, data, timeout)
+}
+
 // Close the NATS connection
 func (c *NatsClient) Close() {
 	if c.nc != nil {
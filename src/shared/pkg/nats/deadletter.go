@@ -0,0 +1,135 @@
+package nats
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+// DeadLetterStream holds the messages consumers gave up on, as dlq.<service>.<subject>. Replays go out on
+// replay.<service>.<subject>, which the stream also stores, so that only the service that failed receives them.
+const DeadLetterStream = "dlq"
+
+// headers a dead letter carries next to the original message
+const (
+	HeaderDeadLetterService    = "Dlq-Service"
+	HeaderDeadLetterSubject    = "Dlq-Subject"
+	HeaderDeadLetterError      = "Dlq-Error"
+	HeaderDeadLetterDeliveries = "Dlq-Deliveries"
+	HeaderDeadLetterSequence   = "Dlq-Stream-Sequence"
+	HeaderDeadLetterFailedAt   = "Dlq-Failed-At"
+)
+
+// DeadLetter is a message parked in the dead letter stream
+type DeadLetter struct {
+	Sequence   uint64
+	Service    string
+	Subject    string
+	Error      string
+	Deliveries int
+	FailedAt   time.Time
+	Data       []byte
+}
+
+func DeadLetterSubject(service, subject string) string {
+	return "dlq." + service + "." + subject
+}
+
+func ReplaySubject(service, subject string) string {
+	return "replay." + service + "." + subject
+}
+
+// DeadLetter parks a message the service could not process, with the error and how often it was delivered.
+// The caller still has to Term the message once it is parked.
+func (c *NatsClient) DeadLetter(msg *nats.Msg, service string, cause error) error {
+	subject := msg.Subject
+	// a replay that failed again keeps the subject it was first published on
+	if msg.Header != nil && msg.Header.Get(HeaderDeadLetterSubject) != "" {
+		subject = msg.Header.Get(HeaderDeadLetterSubject)
+	}
+
+	parked := nats.NewMsg(DeadLetterSubject(service, subject))
+	parked.Data = msg.Data
+	parked.Header.Set(HeaderDeadLetterService, service)
+	parked.Header.Set(HeaderDeadLetterSubject, subject)
+	parked.Header.Set(HeaderDeadLetterError, cause.Error())
+	parked.Header.Set(HeaderDeadLetterFailedAt, time.Now().UTC().Format(time.RFC3339))
+
+	opts := []nats.PubOpt{}
+	if meta, err := msg.Metadata(); err == nil {
+		parked.Header.Set(HeaderDeadLetterDeliveries, strconv.FormatUint(meta.NumDelivered, 10))
+		parked.Header.Set(HeaderDeadLetterSequence, strconv.FormatUint(meta.Sequence.Stream, 10))
+		// parking the same delivery twice, e.g. after a failed Term, keeps one copy
+		opts = append(opts, nats.MsgId(fmt.Sprintf("%s:%s:%d", service, meta.Stream, meta.Sequence.Stream)))
+	}
+
+	if _, err := c.js.PublishMsg(parked, opts...); err != nil {
+		return fmt.Errorf("park message from %s: %w", msg.Subject, err)
+	}
+	return nil
+}
+
+// DeadLetters lists the parked messages, oldest first
+func (c *NatsClient) DeadLetters() ([]DeadLetter, error) {
+	info, err := c.js.StreamInfo(DeadLetterStream)
+	if err != nil {
+		return nil, fmt.Errorf("get %s stream: %w", DeadLetterStream, err)
+	}
+
+	var letters []DeadLetter
+	for seq := info.State.FirstSeq; seq > 0 && seq <= info.State.LastSeq; seq++ {
+		raw, err := c.js.GetMsg(DeadLetterStream, seq)
+		if errors.Is(err, nats.ErrMsgNotFound) {
+			// replayed and removed
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get dead letter %d: %w", seq, err)
+		}
+		if raw.Header.Get(HeaderDeadLetterService) == "" || raw.Subject != DeadLetterSubject(raw.Header.Get(HeaderDeadLetterService), raw.Header.Get(HeaderDeadLetterSubject)) {
+			// replays in transit live in the same stream
+			continue
+		}
+
+		deliveries, _ := strconv.Atoi(raw.Header.Get(HeaderDeadLetterDeliveries))
+		failedAt, _ := time.Parse(time.RFC3339, raw.Header.Get(HeaderDeadLetterFailedAt))
+		letters = append(letters, DeadLetter{
+			Sequence:   seq,
+			Service:    raw.Header.Get(HeaderDeadLetterService),
+			Subject:    raw.Header.Get(HeaderDeadLetterSubject),
+			Error:      raw.Header.Get(HeaderDeadLetterError),
+			Deliveries: deliveries,
+			FailedAt:   failedAt,
+			Data:       raw.Data,
+		})
+	}
+
+	return letters, nil
+}
+
+// ReplayDeadLetter hands a parked message back to the service that parked it and removes it from the stream
+func (c *NatsClient) ReplayDeadLetter(seq uint64) error {
+	raw, err := c.js.GetMsg(DeadLetterStream, seq)
+	if err != nil {
+		return fmt.Errorf("get dead letter %d: %w", seq, err)
+	}
+	service, subject := raw.Header.Get(HeaderDeadLetterService), raw.Header.Get(HeaderDeadLetterSubject)
+	if service == "" || subject == "" {
+		return fmt.Errorf("message %d is not a dead letter", seq)
+	}
+
+	replay := nats.NewMsg(ReplaySubject(service, subject))
+	replay.Data = raw.Data
+	replay.Header.Set(HeaderDeadLetterSubject, subject)
+	if _, err := c.js.PublishMsg(replay, nats.MsgId(fmt.Sprintf("replay:%d", seq))); err != nil {
+		return fmt.Errorf("replay dead letter %d: %w", seq, err)
+	}
+
+	if err := c.js.DeleteMsg(DeadLetterStream, seq); err != nil {
+		return fmt.Errorf("remove replayed dead letter %d: %w", seq, err)
+	}
+	return nil
+}
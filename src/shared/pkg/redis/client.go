@@ -72,6 +72,11 @@ func (c *Cache) Set(ctx context.Context, key string, value string) error {
 	return c.client.Set(ctx, key, value, c.ttl).Err()
 }
 
+// SetPersistent: to set a value for a key that does not expire
+func (c *Cache) SetPersistent(ctx context.Context, key string, value string) error {
+	return c.client.Set(ctx, key, value, 0).Err()
+}
+
 // Get: to get the value for a key
 func (c *Cache) Get(ctx context.Context, key string) (string, error) {
 	return c.client.Get(ctx, key).Result()
@@ -82,6 +87,12 @@ func (c *Cache) Del(ctx context.Context, key string) error {
 	return c.client.Del(ctx, key).Err()
 }
 
+// Exists: to check whether a key exists
+func (c *Cache) Exists(ctx context.Context, key string) (bool, error) {
+	count, err := c.client.Exists(ctx, key).Result()
+	return count > 0, err
+}
+
 // MGet: to get multiple values for multiple keys
 func (c *Cache) MGet(ctx context.Context, keys []string) ([]interface{}, error) {
 	if len(keys) == 0 {
@@ -110,6 +121,35 @@ func (c *Cache) LRange(ctx context.Context, key string, start, stop int64) ([]in
 	return interfaces, nil
 }
 
+// HSet: set fields of a hash, given as field, value pairs
+func (c *Cache) HSet(ctx context.Context, key string, values ...interface{}) error {
+	return c.client.HSet(ctx, key, values...).Err()
+}
+
+// HGet: get the value of a hash field, or "" if the field does not exist
+func (c *Cache) HGet(ctx context.Context, key string, field string) (string, error) {
+	value, err := c.client.HGet(ctx, key, field).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+	return value, err
+}
+
+// HExists: check whether a hash field exists
+func (c *Cache) HExists(ctx context.Context, key string, field string) (bool, error) {
+	return c.client.HExists(ctx, key, field).Result()
+}
+
+// HMGet: get the values of several hash fields, nil for the ones that do not exist
+func (c *Cache) HMGet(ctx context.Context, key string, fields ...string) ([]interface{}, error) {
+	return c.client.HMGet(ctx, key, fields...).Result()
+}
+
+// HDel: delete fields of a hash, returning how many existed
+func (c *Cache) HDel(ctx context.Context, key string, fields ...string) (int64, error) {
+	return c.client.HDel(ctx, key, fields...).Result()
+}
+
 func (c *Cache) HGetAll(ctx context.Context, key string) (map[string]string, error) {
 	return c.client.HGetAll(ctx, key).Result()
 }
@@ -133,6 +173,17 @@ func (c *Cache) SRem(ctx context.Context, key string, members ...interface{}) er
 	return c.client.SRem(ctx, key, members...).Err()
 }
 
+// ZRangeByScore: get members of a sorted set whose score falls within [min, max], skipping offset of them
+// and returning at most count (all of them when count is 0)
+func (c *Cache) ZRangeByScore(ctx context.Context, key string, min string, max string, offset int64, count int64) ([]string, error) {
+	return c.client.ZRangeByScore(ctx, key, &redis.ZRangeBy{Min: min, Max: max, Offset: offset, Count: count}).Result()
+}
+
+// ZRem: remove members from a sorted set
+func (c *Cache) ZRem(ctx context.Context, key string, members ...interface{}) error {
+	return c.client.ZRem(ctx, key, members...).Err()
+}
+
 func (c *Cache) Close() error {
 	if c.client != nil {
 		return c.client.Close()
@@ -0,0 +1,110 @@
+package risk
+
+import (
+	"fmt"
+	"math"
+
+	orderpb "fafnir/shared/pb/order"
+)
+
+// CheckPriceCollar refuses limit prices that would trade too far through the market: a buy above the last price,
+// or a sell below it, by more than the collar. A stop limit is measured against its stop price, where it becomes
+// a limit order.
+func CheckPriceCollar(limits Limits, order Order, exposure Exposure) *Violation {
+	if limits.PriceCollar <= 0 || order.Price <= 0 {
+		return nil
+	}
+
+	reference := exposure.LastPrice
+	if order.Type == orderpb.OrderType_ORDER_TYPE_STOP_LIMIT {
+		reference = order.StopPrice
+	}
+	if reference <= 0 {
+		return nil
+	}
+
+	deviation := (order.Price - reference) / reference
+	if order.Side == orderpb.OrderSide_ORDER_SIDE_SELL {
+		deviation = -deviation
+	}
+	if deviation <= limits.PriceCollar {
+		return nil
+	}
+
+	return &Violation{
+		Code:   orderpb.RejectCode_REJECT_CODE_PRICE_COLLAR,
+		Reason: fmt.Sprintf("Limit price %.2f is more than %.0f%% through the reference price %.2f", order.Price, limits.PriceCollar*100, reference),
+	}
+}
+
+// CheckOrderNotional refuses orders worth more than the maximum order notional
+func CheckOrderNotional(limits Limits, order Order, exposure Exposure) *Violation {
+	if limits.MaxOrderNotional <= 0 || exposure.Rate <= 0 {
+		return nil
+	}
+
+	notional := order.Quantity * referencePrice(order, exposure) * exposure.Rate
+	if notional <= limits.MaxOrderNotional {
+		return nil
+	}
+
+	return &Violation{
+		Code:   orderpb.RejectCode_REJECT_CODE_MAX_ORDER_NOTIONAL,
+		Reason: fmt.Sprintf("Order notional %.2f exceeds the limit of %.2f", notional, limits.MaxOrderNotional),
+	}
+}
+
+// CheckPositionSize refuses buys that would make one symbol more than the maximum share of the account's value
+func CheckPositionSize(limits Limits, order Order, exposure Exposure) *Violation {
+	if limits.MaxPositionShare <= 0 || order.Side != orderpb.OrderSide_ORDER_SIDE_BUY || exposure.Rate <= 0 || exposure.AccountValue <= 0 {
+		return nil
+	}
+
+	position := (exposure.Position + order.Quantity) * referencePrice(order, exposure) * exposure.Rate
+	share := position / exposure.AccountValue
+	if share <= limits.MaxPositionShare {
+		return nil
+	}
+
+	return &Violation{
+		Code:   orderpb.RejectCode_REJECT_CODE_MAX_POSITION_SIZE,
+		Reason: fmt.Sprintf("Position in %s would be %.0f%% of the account, above the limit of %.0f%%", order.Symbol, share*100, limits.MaxPositionShare*100),
+	}
+}
+
+// CheckOpenOrders refuses an order when the user already has the maximum number of open orders
+func CheckOpenOrders(limits Limits, order Order, exposure Exposure) *Violation {
+	if limits.MaxOpenOrders <= 0 || exposure.OpenOrders < limits.MaxOpenOrders {
+		return nil
+	}
+
+	return &Violation{
+		Code:   orderpb.RejectCode_REJECT_CODE_MAX_OPEN_ORDERS,
+		Reason: fmt.Sprintf("Already %d open orders, the limit is %d", exposure.OpenOrders, limits.MaxOpenOrders),
+	}
+}
+
+// CheckDailyLoss refuses new risk once the day's realized losses reach the limit. Sells that only reduce
+// a position stay allowed, so the user can still get out.
+func CheckDailyLoss(limits Limits, order Order, exposure Exposure) *Violation {
+	if limits.MaxDailyLoss <= 0 || -exposure.RealizedPnlToday < limits.MaxDailyLoss {
+		return nil
+	}
+	if order.Side == orderpb.OrderSide_ORDER_SIDE_SELL && order.Quantity <= exposure.Position {
+		return nil
+	}
+
+	return &Violation{
+		Code:   orderpb.RejectCode_REJECT_CODE_DAILY_LOSS_LIMIT,
+		Reason: fmt.Sprintf("Realized loss today of %.2f has reached the daily limit of %.2f", -exposure.RealizedPnlToday, limits.MaxDailyLoss),
+	}
+}
+
+// referencePrice is the price an order is valued at: its limit price when it has one,
+// else the higher of the last price and its stop price
+func referencePrice(order Order, exposure Exposure) float64 {
+	if order.Price > 0 {
+		return order.Price
+	}
+	return math.Max(exposure.LastPrice, order.StopPrice)
+}
@@ -0,0 +1,22 @@
+package risk
+
+import (
+	"math"
+
+	orderpb "fafnir/shared/pb/order"
+)
+
+// MarketHoldBuffer pads the cash held for buys whose fill price is only known once they trade (market,
+// stop and trailing stop orders): the hold still covers the price moving this far against the buyer
+const MarketHoldBuffer = 0.05
+
+// HoldPrice is the per-share price a buy's cash hold is sized at: its limit if it has one, otherwise the worst
+// of its stop and the last price, padded by MarketHoldBuffer
+func HoldPrice(order Order, lastPrice float64) float64 {
+	switch order.Type {
+	case orderpb.OrderType_ORDER_TYPE_LIMIT, orderpb.OrderType_ORDER_TYPE_STOP_LIMIT:
+		return order.Price
+	default:
+		return math.Max(order.StopPrice, lastPrice) * (1 + MarketHoldBuffer)
+	}
+}
@@ -0,0 +1,121 @@
+package risk
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Limits are the thresholds the checks enforce; a zero limit is not enforced
+type Limits struct {
+	// largest order value in the account's currency
+	MaxOrderNotional float64
+	// largest share of the account's value one symbol may take up after a buy (0 < share <= 1)
+	MaxPositionShare float64
+	// most orders a user may have open at once
+	MaxOpenOrders int
+	// realized loss in the account's currency after which only position reducing sells are accepted for the day
+	MaxDailyLoss float64
+	// how far, as a share of the reference price, a limit price may trade through the market
+	PriceCollar float64
+}
+
+// Policy holds the default limits and the overrides for roles and single users. A user's own overrides win
+// over those of their roles, and of several roles the one granted first applies. Overrides only replace the
+// limits they set.
+type Policy struct {
+	Default Limits
+	Roles   map[string]Overrides
+	Users   map[string]Overrides
+}
+
+// Overrides replace the limits they set; a limit set to 0 turns it off
+type Overrides struct {
+	MaxOrderNotional *float64 `json:"max_order_notional"`
+	MaxPositionShare *float64 `json:"max_position_share"`
+	MaxOpenOrders    *int     `json:"max_open_orders"`
+	MaxDailyLoss     *float64 `json:"max_daily_loss"`
+	PriceCollar      *float64 `json:"price_collar"`
+}
+
+// DefaultPolicy applies when no policy file is configured: a 10% price collar and at most 100 open orders
+func DefaultPolicy() *Policy {
+	return &Policy{
+		Default: Limits{
+			MaxOpenOrders: 100,
+			PriceCollar:   0.1,
+		},
+	}
+}
+
+// LoadPolicy reads a policy from a JSON file of the form
+//
+//	{"default": {...}, "roles": {"<role>": {...}}, "users": {"<user id>": {...}}}
+//
+// whose objects set any of the Overrides fields. Limits missing from "default" keep the DefaultPolicy values.
+// An empty path returns the DefaultPolicy.
+func LoadPolicy(path string) (*Policy, error) {
+	policy := DefaultPolicy()
+	if path == "" {
+		return policy, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read risk policy: %w", err)
+	}
+
+	var file struct {
+		Default Overrides            `json:"default"`
+		Roles   map[string]Overrides `json:"roles"`
+		Users   map[string]Overrides `json:"users"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse risk policy: %w", err)
+	}
+
+	policy.Default = file.Default.apply(policy.Default)
+	policy.Roles = file.Roles
+	policy.Users = file.Users
+	return policy, nil
+}
+
+// For returns the limits of a user holding roles, in the order they were granted
+func (p *Policy) For(userID string, roles []string) Limits {
+	limits := p.Default
+	for _, role := range roles {
+		if overrides, ok := p.Roles[role]; ok {
+			limits = overrides.apply(limits)
+			break
+		}
+	}
+	if overrides, ok := p.Users[userID]; ok {
+		limits = overrides.apply(limits)
+	}
+
+	return limits
+}
+
+func (p *Policy) hasRoleLimits() bool {
+	return len(p.Roles) > 0
+}
+
+func (o Overrides) apply(limits Limits) Limits {
+	if o.MaxOrderNotional != nil {
+		limits.MaxOrderNotional = *o.MaxOrderNotional
+	}
+	if o.MaxPositionShare != nil {
+		limits.MaxPositionShare = *o.MaxPositionShare
+	}
+	if o.MaxOpenOrders != nil {
+		limits.MaxOpenOrders = *o.MaxOpenOrders
+	}
+	if o.MaxDailyLoss != nil {
+		limits.MaxDailyLoss = *o.MaxDailyLoss
+	}
+	if o.PriceCollar != nil {
+		limits.PriceCollar = *o.PriceCollar
+	}
+
+	return limits
+}
@@ -0,0 +1,106 @@
+// Package risk runs pre-trade risk checks. order-service consults it when an order is placed and the
+// trade engine again when it accepts the order, each with the exposure it can see at that point.
+package risk
+
+import (
+	"context"
+	"fmt"
+
+	orderpb "fafnir/shared/pb/order"
+)
+
+// Order is the part of an order the checks look at
+type Order struct {
+	UserID    string
+	Symbol    string
+	Side      orderpb.OrderSide
+	Type      orderpb.OrderType
+	Quantity  float64
+	Price     float64
+	StopPrice float64
+}
+
+// Exposure is what the checks know about the account an order settles in. Amounts are in the account's
+// currency; Rate converts the instrument's currency into it, and is 0 when the caller cannot convert,
+// which skips the checks that compare prices with account amounts.
+type Exposure struct {
+	LastPrice        float64
+	Rate             float64
+	AccountValue     float64
+	Position         float64
+	OpenOrders       int
+	RealizedPnlToday float64
+}
+
+// Violation is a check refusing an order
+type Violation struct {
+	Code   orderpb.RejectCode
+	Reason string
+}
+
+func (v *Violation) Error() string {
+	return v.Reason
+}
+
+// Check evaluates one rule and returns a violation when the order breaks it
+type Check func(limits Limits, order Order, exposure Exposure) *Violation
+
+// DefaultChecks are the checks a Checker runs unless it is given its own
+func DefaultChecks() []Check {
+	return []Check{
+		CheckPriceCollar,
+		CheckOrderNotional,
+		CheckPositionSize,
+		CheckOpenOrders,
+		CheckDailyLoss,
+	}
+}
+
+type Checker struct {
+	policy *Policy
+	roles  RoleSource
+	checks []Check
+}
+
+// NewChecker checks orders against the limits policy sets for their user. roles may be nil when the policy
+// has no role limits.
+func NewChecker(policy *Policy, roles RoleSource, checks ...Check) *Checker {
+	if len(checks) == 0 {
+		checks = DefaultChecks()
+	}
+
+	return &Checker{
+		policy: policy,
+		roles:  roles,
+		checks: checks,
+	}
+}
+
+// Check returns the first violation of order, or nil if it may trade
+func (c *Checker) Check(ctx context.Context, order Order, exposure Exposure) (*Violation, error) {
+	limits, err := c.limitsFor(ctx, order.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, check := range c.checks {
+		if violation := check(limits, order, exposure); violation != nil {
+			return violation, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *Checker) limitsFor(ctx context.Context, userID string) (Limits, error) {
+	var roles []string
+	if c.policy.hasRoleLimits() && c.roles != nil {
+		var err error
+		roles, err = c.roles.Roles(ctx, userID)
+		if err != nil {
+			return Limits{}, fmt.Errorf("get roles of user %s: %w", userID, err)
+		}
+	}
+
+	return c.policy.For(userID, roles), nil
+}
@@ -0,0 +1,35 @@
+package risk
+
+import (
+	"context"
+	"fmt"
+
+	basepb "fafnir/shared/pb/base"
+	securitypb "fafnir/shared/pb/security"
+)
+
+// RoleSource looks up the roles of a user, in the order they were granted
+type RoleSource interface {
+	Roles(ctx context.Context, userID string) ([]string, error)
+}
+
+// SecurityRoles reads roles from security-service
+type SecurityRoles struct {
+	client securitypb.SecurityServiceClient
+}
+
+func NewSecurityRoles(client securitypb.SecurityServiceClient) *SecurityRoles {
+	return &SecurityRoles{client: client}
+}
+
+func (s *SecurityRoles) Roles(ctx context.Context, userID string) ([]string, error) {
+	resp, err := s.client.GetUserRoles(ctx, &securitypb.GetUserRolesRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetCode() != basepb.ErrorCode_OK {
+		return nil, fmt.Errorf("security service returned %s", resp.GetCode().String())
+	}
+
+	return resp.Roles, nil
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"fafnir/shared/pkg/logger"
+	"fafnir/shared/pkg/nats"
 	"fafnir/shared/pkg/redis"
 	"fafnir/stock-service/internal/api"
 	"fafnir/stock-service/internal/config"
@@ -43,6 +44,22 @@ func main() {
 	}
 	defer redisCache.Close()
 
+	// the trade engine's order book lists the symbols with resting orders, whose quotes are kept fresh
+	orderBookCache, err := redis.New(cfg.OrderBook, logger)
+	if err != nil {
+		logger.Error(ctx, "Failed to connect to the order book redis", "error", err)
+		os.Exit(1)
+	}
+	defer orderBookCache.Close()
+
+	// quote refreshes are announced on NATS so the trade engine doesn't have to poll for them
+	natsClient, err := nats.New(cfg.NATS.URL, logger)
+	if err != nil {
+		logger.Error(ctx, "Failed to connect to NATS", "error", err)
+		os.Exit(1)
+	}
+	defer natsClient.Close()
+
 	fmpProvider := provider.NewFMP(cfg.FMP.APIKey, cfg.FMP.Timeout)
 	defer func() {
 		if err := fmpProvider.Close(); err != nil {
@@ -61,7 +78,7 @@ func main() {
 		fmpProvider,
 	)
 
-	stockService := api.NewStockService(db, redisCache, marketData, yahooProvider, cfg.QuoteTTL)
+	stockService := api.NewStockService(db, redisCache, natsClient, marketData, yahooProvider, cfg.QuoteTTL)
 	stockHandler := api.NewStockHandler(stockService, logger)
 
 	server := api.NewServer(cfg, logger, stockHandler)
@@ -79,6 +96,11 @@ func main() {
 		return server.RunMetricsServer()
 	})
 
+	// refresh and publish the quotes the trade engine's resting orders depend on
+	g.Go(func() error {
+		return stockService.RunQuoteRefresher(ctx, orderBookCache, cfg.QuoteRefreshInterval)
+	})
+
 	// wait for shutdown signal
 	g.Go(func() error {
 		<-ctx.Done()
@@ -2,11 +2,14 @@ package api
 
 import (
 	"context"
+	"time"
 
 	basepb "fafnir/shared/pb/base"
 	pb "fafnir/shared/pb/stock"
 	"fafnir/shared/pkg/errors"
 	"fafnir/shared/pkg/logger"
+	"fafnir/stock-service/internal/calendar"
+	"fafnir/stock-service/internal/utils"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -105,24 +108,7 @@ func (h *StockHandler) GetStockQuote(ctx context.Context, req *pb.GetStockQuoteR
 	}
 
 	return &pb.GetStockQuoteResponse{
-		Data: &pb.StockQuote{
-			Symbol:        quote.Symbol,
-			LastPrice:     quote.LastPrice,
-			OpenPrice:     quote.OpenPrice,
-			PreviousClose: quote.PreviousClose,
-			DayLow:        quote.DayLow,
-			DayHigh:       quote.DayHigh,
-			YearLow:       quote.YearLow,
-			YearHigh:      quote.YearHigh,
-			Volume:        quote.Volume,
-			MarketCap:     quote.MarketCap,
-			Change:        quote.Change,
-			ChangePct:     quote.ChangePct,
-			Source:        quote.Source,
-			AsOf:          timestamppb.New(quote.AsOf),
-			MarketState:   quote.MarketState,
-			Currency:      quote.Currency,
-		},
+		Data: utils.ConvertStockQuoteToProto(quote),
 		Code: basepb.ErrorCode_OK,
 	}, nil
 }
@@ -148,24 +134,7 @@ func (h *StockHandler) GetStockQuoteBatch(ctx context.Context, req *pb.GetStockQ
 
 	var pbQuotes []*pb.StockQuote
 	for _, quote := range quotes {
-		pbQuotes = append(pbQuotes, &pb.StockQuote{
-			Symbol:        quote.Symbol,
-			LastPrice:     quote.LastPrice,
-			OpenPrice:     quote.OpenPrice,
-			PreviousClose: quote.PreviousClose,
-			DayLow:        quote.DayLow,
-			DayHigh:       quote.DayHigh,
-			YearLow:       quote.YearLow,
-			YearHigh:      quote.YearHigh,
-			Volume:        quote.Volume,
-			MarketCap:     quote.MarketCap,
-			Change:        quote.Change,
-			ChangePct:     quote.ChangePct,
-			Source:        quote.Source,
-			AsOf:          timestamppb.New(quote.AsOf),
-			MarketState:   quote.MarketState,
-			Currency:      quote.Currency,
-		})
+		pbQuotes = append(pbQuotes, utils.ConvertStockQuoteToProto(quote))
 	}
 
 	return &pb.GetStockQuoteBatchResponse{
@@ -213,3 +182,52 @@ func (h *StockHandler) GetStockHistoricalData(ctx context.Context, req *pb.GetSt
 		Code: basepb.ErrorCode_OK,
 	}, nil
 }
+
+// GetMarketSession implements the gRPC GetMarketSession method
+func (h *StockHandler) GetMarketSession(ctx context.Context, req *pb.GetMarketSessionRequest) (*pb.GetMarketSessionResponse, error) {
+	var at time.Time
+	if req.At != nil {
+		at = req.At.AsTime()
+	}
+
+	exchange, session, err := h.stockService.GetMarketSession(ctx, req.Exchange, req.Symbol, at)
+	if err != nil {
+		if errors.Is(err, errors.BadRequestError("")) {
+			return &pb.GetMarketSessionResponse{Code: basepb.ErrorCode_INVALID_ARGUMENT}, nil
+		} else if errors.Is(err, errors.NotFoundError("")) {
+			return &pb.GetMarketSessionResponse{Code: basepb.ErrorCode_NOT_FOUND}, nil
+		} else if errors.Is(err, errors.InternalError("")) {
+			return &pb.GetMarketSessionResponse{Code: basepb.ErrorCode_INTERNAL}, nil
+		}
+
+		return nil, err
+	}
+
+	return &pb.GetMarketSessionResponse{
+		Data: &pb.MarketSession{
+			Exchange:   exchange,
+			Calendar:   session.Calendar.Name,
+			Timezone:   session.Calendar.Location.String(),
+			Phase:      convertMarketPhase(session.Phase),
+			NextOpen:   timestamppb.New(session.NextOpen),
+			NextClose:  timestamppb.New(session.NextClose),
+			ChangesAt:  timestamppb.New(session.ChangesAt),
+			Holiday:    session.Holiday,
+			EarlyClose: session.EarlyClose,
+		},
+		Code: basepb.ErrorCode_OK,
+	}, nil
+}
+
+func convertMarketPhase(phase calendar.Phase) pb.MarketPhase {
+	switch phase {
+	case calendar.PhasePreMarket:
+		return pb.MarketPhase_MARKET_PHASE_PRE_MARKET
+	case calendar.PhaseRegular:
+		return pb.MarketPhase_MARKET_PHASE_REGULAR
+	case calendar.PhasePostMarket:
+		return pb.MarketPhase_MARKET_PHASE_POST_MARKET
+	default:
+		return pb.MarketPhase_MARKET_PHASE_CLOSED
+	}
+}
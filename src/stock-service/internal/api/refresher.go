@@ -0,0 +1,45 @@
+package api
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"fafnir/shared/pkg/redis"
+)
+
+// the trade engine's set of symbols that currently have resting orders
+const activeSymbolsKey = "orderbook:v3:active_symbols"
+
+// RunQuoteRefresher keeps the quotes of every symbol with resting orders fresh, even when no client asks for
+// them. Each tick re-reads those quotes, so the stale ones are fetched from the provider and published on
+// quotes.<symbol>, which is what drives the trade engine's evaluation of the symbol's orders
+func (s *Service) RunQuoteRefresher(ctx context.Context, orderBook *redis.Cache, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			s.refreshActiveQuotes(ctx, orderBook)
+		}
+	}
+}
+
+func (s *Service) refreshActiveQuotes(ctx context.Context, orderBook *redis.Cache) {
+	symbols, err := orderBook.SMembers(ctx, activeSymbolsKey)
+	if err != nil {
+		log.Printf("Warning: Failed to list symbols with resting orders: %v", err)
+		return
+	}
+	if len(symbols) == 0 {
+		return
+	}
+
+	// fresh quotes are served from the cache, only the stale ones reach the provider and get published
+	if _, err := s.GetStockQuoteBatch(ctx, symbols); err != nil {
+		log.Printf("Warning: Failed to refresh quotes for symbols with resting orders: %v", err)
+	}
+}
@@ -9,8 +9,11 @@ import (
 	"sync"
 	"time"
 
+	pb "fafnir/shared/pb/stock"
 	"fafnir/shared/pkg/errors"
+	natsC "fafnir/shared/pkg/nats"
 	"fafnir/shared/pkg/redis"
+	"fafnir/stock-service/internal/calendar"
 	"fafnir/stock-service/internal/db"
 	"fafnir/stock-service/internal/db/generated"
 	"fafnir/stock-service/internal/dto"
@@ -20,21 +23,25 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/sync/singleflight"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Service struct {
 	db           *db.Database
 	redis        *redis.Cache
+	nats         *natsC.NatsClient
 	marketData   provider.MarketData
 	symbolSearch provider.SymbolSearcher
 	quoteTTL     time.Duration
 	requestGroup singleflight.Group
 }
 
-func NewStockService(database *db.Database, redis *redis.Cache, marketData provider.MarketData, symbolSearch provider.SymbolSearcher, quoteTTL time.Duration) *Service {
+func NewStockService(database *db.Database, redis *redis.Cache, nats *natsC.NatsClient, marketData provider.MarketData, symbolSearch provider.SymbolSearcher, quoteTTL time.Duration) *Service {
 	return &Service{
 		db:           database,
 		redis:        redis,
+		nats:         nats,
 		marketData:   marketData,
 		symbolSearch: symbolSearch,
 		quoteTTL:     quoteTTL,
@@ -205,6 +212,7 @@ func (s *Service) getStockQuoteInternal(ctx context.Context, symbol string) (*dt
 	}
 
 	s.cacheQuote(ctx, symbol, providerQuote)
+	s.publishQuote(providerQuote)
 
 	return providerQuote, nil
 }
@@ -357,6 +365,36 @@ func (s *Service) GetStockHistoricalData(ctx context.Context, symbol string, per
 	return providerHistory, nil
 }
 
+// GetMarketSession returns the trading session of an exchange at a point in time. Given a symbol instead
+// of an exchange, it uses the exchange the symbol is listed on.
+func (s *Service) GetMarketSession(ctx context.Context, exchange string, symbol string, at time.Time) (string, *calendar.Session, error) {
+	exchange = strings.ToUpper(strings.TrimSpace(exchange))
+	if exchange == "" {
+		if strings.TrimSpace(symbol) == "" {
+			return "", nil, errors.BadRequestError("Invalid market session request").
+				WithDetails("Either an exchange or a symbol is required")
+		}
+
+		metadata, err := s.GetStockMetadata(ctx, symbol)
+		if err != nil {
+			return "", nil, err
+		}
+		exchange = strings.ToUpper(metadata.Exchange)
+	}
+
+	exchangeCalendar, ok := calendar.ForExchange(exchange)
+	if !ok {
+		return exchange, nil, errors.NotFoundError("Market calendar").
+			WithDetails("No trading calendar is known for exchange " + exchange)
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	session := exchangeCalendar.SessionAt(at)
+	return exchange, &session, nil
+}
+
 func (s *Service) getStockMetadataFromProvider(ctx context.Context, symbol string) (*dto.StockMetadataResponse, error) {
 	providerMetadata, err := s.marketData.GetStockMetadata(ctx, symbol)
 	if err != nil {
@@ -414,3 +452,18 @@ func (s *Service) cacheQuote(ctx context.Context, symbol string, quote *dto.Stoc
 		log.Printf("Warning: Failed to cache %s quote: %v", symbol, err)
 	}
 }
+
+// publishQuote announces a refreshed quote on quotes.<symbol>; subscribers that miss it catch up on their next poll
+func (s *Service) publishQuote(quote *dto.StockQuoteResponse) {
+	data, err := proto.Marshal(&pb.QuoteUpdatedEvent{
+		Quote:       utils.ConvertStockQuoteToProto(quote),
+		PublishedAt: timestamppb.Now(),
+	})
+	if err != nil {
+		log.Printf("Warning: Failed to encode %s quote update: %v", quote.Symbol, err)
+		return
+	}
+	if err := s.nats.PublishEphemeral("quotes."+quote.Symbol, data); err != nil {
+		log.Printf("Warning: Failed to publish %s quote update: %v", quote.Symbol, err)
+	}
+}
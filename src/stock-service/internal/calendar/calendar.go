@@ -0,0 +1,225 @@
+// Package calendar knows when the exchanges in stock metadata trade: their regular session, the pre and
+// post market around it, holidays and early closes. Holidays follow each market's rules rather than a
+// published list, so every year is covered.
+package calendar
+
+import (
+	"strings"
+	"time"
+
+	// embed the time zone database so sessions resolve in images without one
+	_ "time/tzdata"
+)
+
+type Phase int
+
+const (
+	PhaseClosed Phase = iota
+	PhasePreMarket
+	PhaseRegular
+	PhasePostMarket
+)
+
+func (p Phase) String() string {
+	switch p {
+	case PhasePreMarket:
+		return "pre_market"
+	case PhaseRegular:
+		return "regular"
+	case PhasePostMarket:
+		return "post_market"
+	default:
+		return "closed"
+	}
+}
+
+// clock is a time of day in minutes after midnight, local to the exchange
+type clock int
+
+func at(hour, minute int) clock {
+	return clock(hour*60 + minute)
+}
+
+// Calendar is the trading schedule of one market. PreOpen and PostClose equal Open and Close
+// when the market has no extended hours.
+type Calendar struct {
+	Name      string
+	Location  *time.Location
+	PreOpen   clock
+	Open      clock
+	Close     clock
+	PostClose clock
+	// early close of the regular session (the post market closes as usual), 0 for none
+	EarlyClose clock
+	rules      func(year int) holidays
+}
+
+// holidays of a year by date ("2006-01-02"): full closures and early closes
+type holidays struct {
+	closed map[string]string
+	early  map[string]string
+}
+
+// Session is where a market stands at a point in time
+type Session struct {
+	Calendar *Calendar
+	Phase    Phase
+	// the holiday the market is closed or closes early for, if any
+	Holiday    string
+	EarlyClose bool
+	// start of the next regular session after the current one, and the end of the current or next one
+	NextOpen  time.Time
+	NextClose time.Time
+	// when Phase changes next
+	ChangesAt time.Time
+}
+
+// exchanges maps the exchange codes providers use in stock metadata onto calendars
+var exchanges = map[string]*Calendar{}
+
+func init() {
+	newYork := mustLoad("America/New_York")
+	toronto := mustLoad("America/Toronto")
+
+	us := &Calendar{
+		Name:       "US",
+		Location:   newYork,
+		PreOpen:    at(4, 0),
+		Open:       at(9, 30),
+		Close:      at(16, 0),
+		PostClose:  at(20, 0),
+		EarlyClose: at(13, 0),
+		rules:      usHolidays,
+	}
+	canada := &Calendar{
+		Name:       "TSX",
+		Location:   toronto,
+		PreOpen:    at(9, 30),
+		Open:       at(9, 30),
+		Close:      at(16, 0),
+		PostClose:  at(16, 0),
+		EarlyClose: at(13, 0),
+		rules:      canadaHolidays,
+	}
+
+	// FMP codes, then Yahoo's
+	for _, code := range []string{"NASDAQ", "NYSE", "AMEX", "NYSEARCA", "CBOE", "BATS", "NMS", "NGM", "NCM", "NYQ", "ASE", "PCX", "BTS"} {
+		exchanges[code] = us
+	}
+	for _, code := range []string{"TSX", "TSXV", "NEO", "TOR", "VAN"} {
+		exchanges[code] = canada
+	}
+}
+
+func mustLoad(name string) *time.Location {
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return location
+}
+
+// ForExchange returns the calendar of an exchange code from stock metadata
+func ForExchange(exchange string) (*Calendar, bool) {
+	calendar, ok := exchanges[strings.ToUpper(strings.TrimSpace(exchange))]
+	return calendar, ok
+}
+
+// SessionAt returns the session of the market at t
+func (c *Calendar) SessionAt(t time.Time) Session {
+	local := t.In(c.Location)
+	session := Session{Calendar: c}
+
+	day := midnight(local)
+	hours, trading := c.hours(day)
+	session.Holiday = c.holiday(day)
+	session.EarlyClose = trading && hours.close != c.Close
+
+	minute := clock(local.Hour()*60 + local.Minute())
+	switch {
+	case !trading || minute >= hours.postClose:
+		session.Phase = PhaseClosed
+	case minute < hours.preOpen:
+		session.Phase = PhaseClosed
+		session.ChangesAt = c.timeOn(day, hours.preOpen)
+	case minute < hours.open:
+		session.Phase = PhasePreMarket
+		session.ChangesAt = c.timeOn(day, hours.open)
+	case minute < hours.close:
+		session.Phase = PhaseRegular
+		session.ChangesAt = c.timeOn(day, hours.close)
+	default:
+		session.Phase = PhasePostMarket
+		session.ChangesAt = c.timeOn(day, hours.postClose)
+	}
+
+	// the regular session this one belongs to, or the next one when it is over
+	if trading && minute < hours.close {
+		session.NextClose = c.timeOn(day, hours.close)
+	}
+	next := day
+	if trading && minute < hours.open {
+		session.NextOpen = c.timeOn(day, hours.open)
+	}
+	for session.NextOpen.IsZero() || session.NextClose.IsZero() {
+		next = next.AddDate(0, 0, 1)
+		nextHours, nextTrading := c.hours(next)
+		if !nextTrading {
+			continue
+		}
+		if session.NextOpen.IsZero() {
+			session.NextOpen = c.timeOn(next, nextHours.open)
+		}
+		if session.NextClose.IsZero() {
+			session.NextClose = c.timeOn(next, nextHours.close)
+		}
+		if session.ChangesAt.IsZero() {
+			session.ChangesAt = c.timeOn(next, nextHours.preOpen)
+		}
+	}
+
+	return session
+}
+
+type dayHours struct {
+	preOpen, open, close, postClose clock
+}
+
+// hours returns the hours of day, and false when the market does not trade that day
+func (c *Calendar) hours(day time.Time) (dayHours, bool) {
+	if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		return dayHours{}, false
+	}
+
+	rules := c.rules(day.Year())
+	key := day.Format(time.DateOnly)
+	if _, closed := rules.closed[key]; closed {
+		return dayHours{}, false
+	}
+
+	hours := dayHours{preOpen: c.PreOpen, open: c.Open, close: c.Close, postClose: c.PostClose}
+	if _, early := rules.early[key]; early {
+		hours.close = c.EarlyClose
+		if hours.postClose < hours.close || c.PostClose == c.Close {
+			hours.postClose = hours.close
+		}
+	}
+	return hours, true
+}
+
+func (c *Calendar) holiday(day time.Time) string {
+	rules := c.rules(day.Year())
+	key := day.Format(time.DateOnly)
+	if name, ok := rules.closed[key]; ok {
+		return name
+	}
+	return rules.early[key]
+}
+
+func (c *Calendar) timeOn(day time.Time, at clock) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), int(at)/60, int(at)%60, 0, 0, c.Location)
+}
+
+func midnight(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,135 @@
+package calendar
+
+import "time"
+
+// usHolidays are the NYSE and Nasdaq closures: a holiday on a Saturday is observed the Friday before
+// (except New Year's Day, which is then not observed) and one on a Sunday the Monday after.
+// The market closes at 13:00 the day after Thanksgiving, on Christmas Eve and on July 3rd.
+func usHolidays(year int) holidays {
+	h := holidays{closed: map[string]string{}, early: map[string]string{}}
+
+	if newYear := date(year, time.January, 1); newYear.Weekday() != time.Saturday {
+		h.close(observed(newYear), "New Year's Day")
+	}
+	h.close(nthWeekday(year, time.January, time.Monday, 3), "Martin Luther King Jr. Day")
+	h.close(nthWeekday(year, time.February, time.Monday, 3), "Washington's Birthday")
+	h.close(easter(year).AddDate(0, 0, -2), "Good Friday")
+	h.close(lastWeekday(year, time.May, time.Monday), "Memorial Day")
+	if year >= 2022 {
+		h.close(observed(date(year, time.June, 19)), "Juneteenth")
+	}
+	h.close(observed(date(year, time.July, 4)), "Independence Day")
+	h.close(nthWeekday(year, time.September, time.Monday, 1), "Labor Day")
+	thanksgiving := nthWeekday(year, time.November, time.Thursday, 4)
+	h.close(thanksgiving, "Thanksgiving Day")
+	h.close(observed(date(year, time.December, 25)), "Christmas Day")
+
+	h.closeEarly(date(year, time.July, 3), "Independence Day")
+	h.closeEarly(thanksgiving.AddDate(0, 0, 1), "Thanksgiving Day")
+	h.closeEarly(date(year, time.December, 24), "Christmas Eve")
+
+	return h
+}
+
+// canadaHolidays are the TSX closures: weekend holidays move to the following Monday (Boxing Day to the
+// Tuesday when Christmas took the Monday). The market closes at 13:00 on Christmas Eve.
+func canadaHolidays(year int) holidays {
+	h := holidays{closed: map[string]string{}, early: map[string]string{}}
+
+	h.close(following(date(year, time.January, 1)), "New Year's Day")
+	h.close(nthWeekday(year, time.February, time.Monday, 3), "Family Day")
+	h.close(easter(year).AddDate(0, 0, -2), "Good Friday")
+	// the Monday before May 25th
+	h.close(lastWeekdayBefore(date(year, time.May, 25), time.Monday), "Victoria Day")
+	h.close(following(date(year, time.July, 1)), "Canada Day")
+	h.close(nthWeekday(year, time.August, time.Monday, 1), "Civic Holiday")
+	h.close(nthWeekday(year, time.September, time.Monday, 1), "Labour Day")
+	h.close(nthWeekday(year, time.October, time.Monday, 2), "Thanksgiving Day")
+	christmas := following(date(year, time.December, 25))
+	h.close(christmas, "Christmas Day")
+	boxingDay := following(date(year, time.December, 26))
+	if !boxingDay.After(christmas) {
+		boxingDay = christmas.AddDate(0, 0, 1)
+	}
+	h.close(boxingDay, "Boxing Day")
+
+	h.closeEarly(date(year, time.December, 24), "Christmas Eve")
+
+	return h
+}
+
+func (h holidays) close(day time.Time, name string) {
+	h.closed[day.Format(time.DateOnly)] = name
+}
+
+// closeEarly marks an early close on a weekday the market is otherwise open
+func (h holidays) closeEarly(day time.Time, name string) {
+	key := day.Format(time.DateOnly)
+	if _, closed := h.closed[key]; closed || day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		return
+	}
+	h.early[key] = name
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// observed moves a Saturday holiday to the Friday before and a Sunday one to the Monday after
+func observed(day time.Time) time.Time {
+	switch day.Weekday() {
+	case time.Saturday:
+		return day.AddDate(0, 0, -1)
+	case time.Sunday:
+		return day.AddDate(0, 0, 1)
+	default:
+		return day
+	}
+}
+
+// following moves a weekend holiday to the Monday after
+func following(day time.Time) time.Time {
+	switch day.Weekday() {
+	case time.Saturday:
+		return day.AddDate(0, 0, 2)
+	case time.Sunday:
+		return day.AddDate(0, 0, 1)
+	default:
+		return day
+	}
+}
+
+func nthWeekday(year int, month time.Month, weekday time.Weekday, n int) time.Time {
+	first := date(year, month, 1)
+	offset := (int(weekday) - int(first.Weekday()) + 7) % 7
+	return first.AddDate(0, 0, offset+7*(n-1))
+}
+
+func lastWeekday(year int, month time.Month, weekday time.Weekday) time.Time {
+	return lastWeekdayBefore(date(year, month+1, 1), weekday)
+}
+
+// lastWeekdayBefore returns the last weekday strictly before day
+func lastWeekdayBefore(day time.Time, weekday time.Weekday) time.Time {
+	offset := (int(day.Weekday()) - int(weekday) + 7) % 7
+	if offset == 0 {
+		offset = 7
+	}
+	return day.AddDate(0, 0, -offset)
+}
+
+// easter returns Easter Sunday of year (anonymous Gregorian algorithm)
+func easter(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return date(year, time.Month(month), day)
+}
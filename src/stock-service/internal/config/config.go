@@ -13,8 +13,13 @@ type Config struct {
 	DB           PostgresConfig
 	FMP          FMPConfig
 	Cache        redis.CacheConfig
+	NATS         NatsConfig
 	QuoteTTL     time.Duration
 	YahooTimeout time.Duration
+	// the trade engine's order book, read for the symbols that have resting orders
+	OrderBook redis.CacheConfig
+	// how often the quotes of symbols with resting orders are checked; stale ones are fetched and published
+	QuoteRefreshInterval time.Duration
 }
 
 type PostgresConfig struct {
@@ -26,6 +31,12 @@ type PostgresConfig struct {
 	URL      string
 }
 
+type NatsConfig struct {
+	Host string
+	Port string
+	URL  string
+}
+
 type FMPConfig struct {
 	APIKey  string
 	Timeout time.Duration
@@ -37,8 +48,12 @@ func NewConfig() *Config {
 		DB:           newPostgresConfig(),
 		FMP:          newFMPConfig(),
 		Cache:        newRedisConfig(),
+		NATS:         newNatsConfig(),
 		QuoteTTL:     durationFromEnv("QUOTE_TTL", time.Minute),
 		YahooTimeout: durationFromEnv("YAHOO_TIMEOUT", 10*time.Second),
+
+		OrderBook:            newOrderBookRedisConfig(),
+		QuoteRefreshInterval: durationFromEnv("QUOTE_REFRESH_INTERVAL", 15*time.Second),
 	}
 }
 
@@ -88,6 +103,17 @@ func newFMPConfig() FMPConfig {
 	}
 }
 
+func newNatsConfig() NatsConfig {
+	host := os.Getenv("NATS_HOST")
+	port := os.Getenv("NATS_PORT")
+
+	return NatsConfig{
+		Host: host,
+		Port: port,
+		URL:  fmt.Sprintf("nats://%s:%s", host, port),
+	}
+}
+
 func newRedisConfig() redis.CacheConfig {
 	host := os.Getenv("REDIS_HOST")
 	port := os.Getenv("REDIS_PORT")
@@ -101,3 +127,11 @@ func newRedisConfig() redis.CacheConfig {
 		DB:       db,
 	}
 }
+
+func newOrderBookRedisConfig() redis.CacheConfig {
+	config := newRedisConfig()
+	// the trade engine keeps its order book in redis db 1
+	config.DB = 1
+
+	return config
+}
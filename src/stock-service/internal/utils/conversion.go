@@ -1,8 +1,11 @@
 package utils
 
 import (
+	pb "fafnir/shared/pb/stock"
 	"fafnir/stock-service/internal/db/generated"
 	"fafnir/stock-service/internal/dto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func ConvertStockQuoteToDTO(dbQuote generated.StockQuote) dto.StockQuoteResponse {
@@ -26,6 +29,27 @@ func ConvertStockQuoteToDTO(dbQuote generated.StockQuote) dto.StockQuoteResponse
 	}
 }
 
+func ConvertStockQuoteToProto(quote *dto.StockQuoteResponse) *pb.StockQuote {
+	return &pb.StockQuote{
+		Symbol:        quote.Symbol,
+		LastPrice:     quote.LastPrice,
+		OpenPrice:     quote.OpenPrice,
+		PreviousClose: quote.PreviousClose,
+		DayLow:        quote.DayLow,
+		DayHigh:       quote.DayHigh,
+		YearLow:       quote.YearLow,
+		YearHigh:      quote.YearHigh,
+		Volume:        quote.Volume,
+		MarketCap:     quote.MarketCap,
+		Change:        quote.Change,
+		ChangePct:     quote.ChangePct,
+		Source:        quote.Source,
+		AsOf:          timestamppb.New(quote.AsOf),
+		MarketState:   quote.MarketState,
+		Currency:      quote.Currency,
+	}
+}
+
 func ConvertStockMetadataToDTO(dbMetadata generated.StockMetadatum) *dto.StockMetadataResponse {
 	return &dto.StockMetadataResponse{
 		Symbol:           dbMetadata.Symbol,
@@ -32,7 +32,8 @@ func main() {
 		os.Exit(1)
 	}
 
-	srv := api.NewServer(cfg, logger)
+	adminHandler := api.NewAdminHandler(eng.Halts(), cfg.Admin.Token, logger)
+	srv := api.NewServer(cfg, logger, adminHandler)
 	// use errgroup to manage the lifecycle of the server and handle graceful shutdown
 	g, ctx := errgroup.WithContext(ctx)
 
@@ -0,0 +1,151 @@
+package api
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	apperrors "fafnir/shared/pkg/errors"
+	"fafnir/shared/pkg/logger"
+	"fafnir/shared/pkg/utils"
+	"fafnir/trade-engine/internal/cache"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type AdminHandler struct {
+	halts  *cache.Halts
+	token  string
+	logger *logger.Logger
+}
+
+func NewAdminHandler(halts *cache.Halts, token string, logger *logger.Logger) *AdminHandler {
+	return &AdminHandler{
+		halts:  halts,
+		token:  token,
+		logger: logger,
+	}
+}
+
+// ServeAdminRoutes halts and resumes trading for one symbol or, without a symbol, for the whole engine
+func (h *AdminHandler) ServeAdminRoutes() chi.Router {
+	r := chi.NewRouter()
+	r.Use(h.checkToken)
+
+	r.Get("/halts", h.listHalts)
+	r.Put("/halts", h.haltAll)
+	r.Delete("/halts", h.resumeAll)
+	r.Put("/halts/{symbol}", h.haltSymbol)
+	r.Delete("/halts/{symbol}", h.resumeSymbol)
+	return r
+}
+
+func (h *AdminHandler) checkToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(h.token)) != 1 {
+			utils.HandleError(w, apperrors.UnauthorizedError().WithDetails("A valid admin bearer token is required"))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (h *AdminHandler) listHalts(w http.ResponseWriter, r *http.Request) {
+	halts, err := h.halts.List(r.Context(), time.Now())
+	if err != nil {
+		h.logger.Error(r.Context(), "Failed to list halts", "error", err)
+		utils.HandleError(w, apperrors.InternalError("Failed to list halts"))
+		return
+	}
+
+	sort.Slice(halts, func(i, j int) bool { return halts[i].Scope < halts[j].Scope })
+
+	resp := HaltsResponse{Halts: make([]HaltResponse, 0, len(halts))}
+	for _, halt := range halts {
+		resp.Halts = append(resp.Halts, toHaltResponse(halt))
+	}
+
+	utils.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *AdminHandler) haltAll(w http.ResponseWriter, r *http.Request) {
+	h.halt(w, r, cache.GlobalHaltScope)
+}
+
+func (h *AdminHandler) haltSymbol(w http.ResponseWriter, r *http.Request) {
+	h.halt(w, r, strings.ToUpper(chi.URLParam(r, "symbol")))
+}
+
+func (h *AdminHandler) resumeAll(w http.ResponseWriter, r *http.Request) {
+	h.resume(w, r, cache.GlobalHaltScope)
+}
+
+func (h *AdminHandler) resumeSymbol(w http.ResponseWriter, r *http.Request) {
+	h.resume(w, r, strings.ToUpper(chi.URLParam(r, "symbol")))
+}
+
+func (h *AdminHandler) halt(w http.ResponseWriter, r *http.Request, scope string) {
+	var haltRequest HaltRequest
+	if err := utils.DecodeJSON(r, &haltRequest); err != nil {
+		h.logger.Debug(r.Context(), "Failed to decode halt request", "error", err)
+		utils.HandleError(w, err)
+		return
+	}
+
+	haltRequest.Reason = strings.TrimSpace(haltRequest.Reason)
+	if haltRequest.Reason == "" {
+		utils.HandleError(w, apperrors.BadRequestError("Validation error. Missing required field.").
+			WithDetails("Field 'reason' failed on the 'required' tag"))
+		return
+	}
+
+	halt := cache.Halt{
+		Scope:    scope,
+		Reason:   haltRequest.Reason,
+		Source:   cache.HaltSourceManual,
+		HaltedAt: time.Now().UTC(),
+	}
+	if err := h.halts.Set(r.Context(), halt); err != nil {
+		h.logger.Error(r.Context(), "Failed to halt trading", "scope", scope, "error", err)
+		utils.HandleError(w, apperrors.InternalError("Failed to halt trading"))
+		return
+	}
+
+	h.logger.Warn(r.Context(), "Trading halted by operator", "scope", scope, "reason", halt.Reason)
+	utils.WriteJSON(w, http.StatusOK, toHaltResponse(halt))
+}
+
+func (h *AdminHandler) resume(w http.ResponseWriter, r *http.Request, scope string) {
+	cleared, err := h.halts.Clear(r.Context(), scope)
+	if err != nil {
+		h.logger.Error(r.Context(), "Failed to resume trading", "scope", scope, "error", err)
+		utils.HandleError(w, apperrors.InternalError("Failed to resume trading"))
+		return
+	}
+	if !cleared {
+		utils.HandleError(w, apperrors.NotFoundError("Halt not found").WithDetails("Trading is not halted for "+scope))
+		return
+	}
+
+	h.logger.Warn(r.Context(), "Trading resumed by operator", "scope", scope)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toHaltResponse(halt cache.Halt) HaltResponse {
+	resp := HaltResponse{
+		Scope:    halt.Scope,
+		Reason:   halt.Reason,
+		Source:   halt.Source,
+		HaltedAt: halt.HaltedAt.UTC().Format(time.RFC3339),
+	}
+	if halt.Until != nil {
+		until := halt.Until.UTC().Format(time.RFC3339)
+		resp.Until = &until
+	}
+
+	return resp
+}
@@ -16,7 +16,7 @@ type Server struct {
 	Logger *logger.Logger
 }
 
-func NewServer(cfg *config.Config, logger *logger.Logger) *Server {
+func NewServer(cfg *config.Config, logger *logger.Logger, adminHandler *AdminHandler) *Server {
 	r := chi.NewRouter()
 
 	r.Use(
@@ -26,6 +26,13 @@ func NewServer(cfg *config.Config, logger *logger.Logger) *Server {
 		middleware.Heartbeat("/health"), // health check endpoint given by chi middleware
 	)
 
+	// the admin API stays unreachable unless a token is configured
+	if cfg.Admin.Token != "" {
+		r.Mount("/admin", adminHandler.ServeAdminRoutes())
+	} else {
+		logger.Warn(context.Background(), "ENGINE_ADMIN_TOKEN is not set; admin API disabled")
+	}
+
 	return &Server{
 		HTTP: &http.Server{
 			Addr:    cfg.PORT,
@@ -0,0 +1,17 @@
+package api
+
+type HaltRequest struct {
+	Reason string `json:"reason"`
+}
+
+type HaltsResponse struct {
+	Halts []HaltResponse `json:"halts"`
+}
+
+type HaltResponse struct {
+	Scope    string  `json:"scope"`
+	Reason   string  `json:"reason"`
+	Source   string  `json:"source"`
+	HaltedAt string  `json:"haltedAt"`
+	Until    *string `json:"until,omitempty"`
+}
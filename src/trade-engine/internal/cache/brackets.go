@@ -0,0 +1,63 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	orderpb "fafnir/shared/pb/order"
+	"fafnir/shared/pkg/redis"
+)
+
+// Bracket entries are staged in one hash keyed by order ID while their exits wait to be armed.
+// The entry's event carries the exits, so staging it keeps everything arming needs in one place.
+const bracketsKey = "orderbook:v3:brackets"
+
+type Brackets struct {
+	client *redis.Cache
+}
+
+func NewBrackets(client *redis.Cache) *Brackets {
+	return &Brackets{client: client}
+}
+
+// Stage keeps a bracket entry until its exits are armed or cancelled.
+func (b *Brackets) Stage(ctx context.Context, entry *orderpb.OrderCreatedEvent) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal bracket %s: %w", entry.OrderId, err)
+	}
+
+	if err := b.client.HSet(ctx, bracketsKey, entry.OrderId, string(data)); err != nil {
+		return fmt.Errorf("stage bracket %s: %w", entry.OrderId, err)
+	}
+
+	return nil
+}
+
+// Staged returns the staged entry with the given ID, or nil if it has no exits waiting.
+func (b *Brackets) Staged(ctx context.Context, orderID string) (*orderpb.OrderCreatedEvent, error) {
+	data, err := b.client.HGet(ctx, bracketsKey, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("read bracket %s: %w", orderID, err)
+	}
+	if data == "" {
+		return nil, nil
+	}
+
+	var entry orderpb.OrderCreatedEvent
+	if err := json.Unmarshal([]byte(data), &entry); err != nil {
+		return nil, fmt.Errorf("unmarshal bracket %s: %w", orderID, err)
+	}
+
+	return &entry, nil
+}
+
+// Unstage forgets an entry once its exits were armed or cancelled.
+func (b *Brackets) Unstage(ctx context.Context, orderID string) error {
+	if _, err := b.client.HDel(ctx, bracketsKey, orderID); err != nil {
+		return fmt.Errorf("unstage bracket %s: %w", orderID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"fafnir/shared/pkg/redis"
+)
+
+// A cancel asked for an order the engine has not accepted yet is kept in one hash keyed by order ID until the
+// order's created event arrives, which then confirms the cancel instead of working the order. Tombstones whose
+// order never shows up, such as one that closed while its cancel was on the way, are swept after a while.
+const cancelsKey = "orderbook:v3:cancels"
+
+type Cancel struct {
+	OrderID     string    `json:"order_id"`
+	Reason      string    `json:"reason"`
+	RequestedAt time.Time `json:"requested_at"`
+}
+
+type Cancels struct {
+	client *redis.Cache
+}
+
+func NewCancels(client *redis.Cache) *Cancels {
+	return &Cancels{client: client}
+}
+
+// Record keeps a cancel until the order it is for is accepted.
+func (c *Cancels) Record(ctx context.Context, cancel *Cancel) error {
+	data, err := json.Marshal(cancel)
+	if err != nil {
+		return fmt.Errorf("marshal cancel of %s: %w", cancel.OrderID, err)
+	}
+
+	if err := c.client.HSet(ctx, cancelsKey, cancel.OrderID, string(data)); err != nil {
+		return fmt.Errorf("record cancel of %s: %w", cancel.OrderID, err)
+	}
+
+	return nil
+}
+
+// Pending returns the cancel recorded for orderID, or nil if there is none.
+func (c *Cancels) Pending(ctx context.Context, orderID string) (*Cancel, error) {
+	data, err := c.client.HGet(ctx, cancelsKey, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("read cancel of %s: %w", orderID, err)
+	}
+	if data == "" {
+		return nil, nil
+	}
+
+	var cancel Cancel
+	if err := json.Unmarshal([]byte(data), &cancel); err != nil {
+		return nil, fmt.Errorf("unmarshal cancel of %s: %w", orderID, err)
+	}
+
+	return &cancel, nil
+}
+
+// Done forgets the cancel of orderID once it is confirmed.
+func (c *Cancels) Done(ctx context.Context, orderID string) error {
+	if _, err := c.client.HDel(ctx, cancelsKey, orderID); err != nil {
+		return fmt.Errorf("drop cancel of %s: %w", orderID, err)
+	}
+
+	return nil
+}
+
+// Sweep forgets the cancels requested before cutoff and returns how many it dropped.
+func (c *Cancels) Sweep(ctx context.Context, cutoff time.Time) (int, error) {
+	rawCancels, err := c.client.HGetAll(ctx, cancelsKey)
+	if err != nil {
+		return 0, fmt.Errorf("list cancels: %w", err)
+	}
+
+	var stale []string
+	for orderID, rawCancel := range rawCancels {
+		var cancel Cancel
+		if err := json.Unmarshal([]byte(rawCancel), &cancel); err != nil || cancel.RequestedAt.Before(cutoff) {
+			stale = append(stale, orderID)
+		}
+	}
+	if len(stale) == 0 {
+		return 0, nil
+	}
+
+	if _, err := c.client.HDel(ctx, cancelsKey, stale...); err != nil {
+		return 0, fmt.Errorf("sweep cancels: %w", err)
+	}
+
+	return len(stale), nil
+}
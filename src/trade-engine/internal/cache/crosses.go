@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	orderpb "fafnir/shared/pb/order"
+	"fafnir/shared/pkg/redis"
+)
+
+// An internal trade is recorded in one hash keyed by trade ID before either of its fills is published. The record
+// is what commits the trade: both fills go out under their own message IDs, so publishing them again is harmless,
+// and a record left behind by a failed publish or a crash is replayed until both are out.
+const crossesKey = "orderbook:v3:crosses"
+
+// CrossLeg is one side of an internal trade: the order that trades and the fill published for it.
+type CrossLeg struct {
+	Order *orderpb.OrderCreatedEvent `json:"order"`
+	Fill  *orderpb.OrderFilledEvent  `json:"fill"`
+}
+
+type Cross struct {
+	TradeID    string     `json:"trade_id"`
+	Legs       []CrossLeg `json:"legs"`
+	RecordedAt time.Time  `json:"recorded_at"`
+}
+
+type Crosses struct {
+	client *redis.Cache
+}
+
+func NewCrosses(client *redis.Cache) *Crosses {
+	return &Crosses{client: client}
+}
+
+// Record keeps an internal trade until both of its fills are published.
+func (c *Crosses) Record(ctx context.Context, cross *Cross) error {
+	data, err := json.Marshal(cross)
+	if err != nil {
+		return fmt.Errorf("marshal trade %s: %w", cross.TradeID, err)
+	}
+
+	if err := c.client.HSet(ctx, crossesKey, cross.TradeID, string(data)); err != nil {
+		return fmt.Errorf("record trade %s: %w", cross.TradeID, err)
+	}
+
+	return nil
+}
+
+// Pending returns the internal trades recorded at or before cutoff whose fills may not all be published.
+func (c *Crosses) Pending(ctx context.Context, cutoff time.Time) ([]*Cross, error) {
+	rawCrosses, err := c.client.HGetAll(ctx, crossesKey)
+	if err != nil {
+		return nil, fmt.Errorf("list pending trades: %w", err)
+	}
+
+	crosses := make([]*Cross, 0, len(rawCrosses))
+	for tradeID, rawCross := range rawCrosses {
+		var cross Cross
+		if err := json.Unmarshal([]byte(rawCross), &cross); err != nil {
+			return nil, fmt.Errorf("unmarshal trade %s: %w", tradeID, err)
+		}
+		if cross.RecordedAt.After(cutoff) {
+			continue
+		}
+		crosses = append(crosses, &cross)
+	}
+
+	return crosses, nil
+}
+
+// Done forgets an internal trade once both of its fills are published.
+func (c *Crosses) Done(ctx context.Context, tradeID string) error {
+	if _, err := c.client.HDel(ctx, crossesKey, tradeID); err != nil {
+		return fmt.Errorf("drop trade %s: %w", tradeID, err)
+	}
+
+	return nil
+}
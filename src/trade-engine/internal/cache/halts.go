@@ -0,0 +1,177 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"fafnir/shared/pkg/redis"
+)
+
+// Halts are shared by every engine through one hash keyed by symbol, with "*" standing for all symbols.
+// Recent prices per symbol are kept in a sorted set scored by arrival time (unix ms) so the engine can
+// tell how far a price has moved within the circuit breaker window.
+const (
+	haltsKey = "engine:v1:halts"
+
+	// GlobalHaltScope halts every symbol
+	GlobalHaltScope = "*"
+
+	HaltSourceManual     = "manual"
+	HaltSourceVolatility = "volatility"
+
+	// KEYS: prices; ARGV[1]: now (unix ms), ARGV[2]: price, ARGV[3]: window (ms)
+	// returns the lowest and highest price seen within the window, including this one
+	recordPriceScript = `
+redis.call("ZADD", KEYS[1], ARGV[1], ARGV[1] .. ":" .. ARGV[2])
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", "(" .. (tonumber(ARGV[1]) - tonumber(ARGV[3])))
+redis.call("PEXPIRE", KEYS[1], ARGV[3])
+local low, high
+for _, member in ipairs(redis.call("ZRANGE", KEYS[1], 0, -1)) do
+    local sep = string.find(member, ":", 1, true)
+    local price = tonumber(string.sub(member, sep + 1))
+    if not low or price < low then
+        low = price
+    end
+    if not high or price > high then
+        high = price
+    end
+end
+return {tostring(low), tostring(high)}
+`
+)
+
+type Halt struct {
+	Scope    string     `json:"scope"`
+	Reason   string     `json:"reason"`
+	Source   string     `json:"source"`
+	HaltedAt time.Time  `json:"haltedAt"`
+	Until    *time.Time `json:"until,omitempty"`
+}
+
+// Expired reports whether an automatic halt has run its course
+func (h *Halt) Expired(now time.Time) bool {
+	return h.Until != nil && !now.Before(*h.Until)
+}
+
+type Halts struct {
+	client *redis.Cache
+}
+
+func NewHalts(client *redis.Cache) *Halts {
+	return &Halts{client: client}
+}
+
+// Set halts trading for the halt's scope, replacing any halt already in place for it.
+func (h *Halts) Set(ctx context.Context, halt Halt) error {
+	data, err := json.Marshal(halt)
+	if err != nil {
+		return fmt.Errorf("marshal halt for %s: %w", halt.Scope, err)
+	}
+
+	if err := h.client.HSet(ctx, haltsKey, halt.Scope, string(data)); err != nil {
+		return fmt.Errorf("halt %s: %w", halt.Scope, err)
+	}
+
+	return nil
+}
+
+// Clear resumes trading for scope, reporting whether it was halted.
+func (h *Halts) Clear(ctx context.Context, scope string) (bool, error) {
+	cleared, err := h.client.HDel(ctx, haltsKey, scope)
+	if err != nil {
+		return false, fmt.Errorf("resume %s: %w", scope, err)
+	}
+
+	return cleared == 1, nil
+}
+
+// List returns the halts in force at now.
+func (h *Halts) List(ctx context.Context, now time.Time) ([]Halt, error) {
+	rawHalts, err := h.client.HGetAll(ctx, haltsKey)
+	if err != nil {
+		return nil, fmt.Errorf("list halts: %w", err)
+	}
+
+	halts := make([]Halt, 0, len(rawHalts))
+	for scope, rawHalt := range rawHalts {
+		var halt Halt
+		if err := json.Unmarshal([]byte(rawHalt), &halt); err != nil {
+			return nil, fmt.Errorf("unmarshal halt for %s: %w", scope, err)
+		}
+		if halt.Expired(now) {
+			continue
+		}
+		halts = append(halts, halt)
+	}
+
+	return halts, nil
+}
+
+// Active returns the halt that stops trading in symbol at now, preferring a global halt, or nil.
+func (h *Halts) Active(ctx context.Context, symbol string, now time.Time) (*Halt, error) {
+	rawHalts, err := h.client.HMGet(ctx, haltsKey, GlobalHaltScope, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("look up halts for %s: %w", symbol, err)
+	}
+
+	for _, rawHalt := range rawHalts {
+		data, _ := rawHalt.(string)
+		if data == "" {
+			continue
+		}
+
+		var halt Halt
+		if err := json.Unmarshal([]byte(data), &halt); err != nil {
+			return nil, fmt.Errorf("unmarshal halt for %s: %w", symbol, err)
+		}
+		if !halt.Expired(now) {
+			return &halt, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ResetPrices forgets the prices recorded for symbol.
+func (h *Halts) ResetPrices(ctx context.Context, symbol string) error {
+	if err := h.client.Del(ctx, pricesKey(symbol)); err != nil {
+		return fmt.Errorf("reset prices for %s: %w", symbol, err)
+	}
+
+	return nil
+}
+
+// RecordPrice adds a price observed at now and returns the lowest and highest price seen within window.
+func (h *Halts) RecordPrice(ctx context.Context, symbol string, price float64, now time.Time, window time.Duration) (float64, float64, error) {
+	result, err := h.client.Eval(
+		ctx,
+		recordPriceScript,
+		[]string{pricesKey(symbol)},
+		now.UnixMilli(),
+		formatScore(price),
+		window.Milliseconds(),
+	)
+	if err != nil {
+		return 0, 0, fmt.Errorf("record price for %s: %w", symbol, err)
+	}
+
+	bounds, ok := result.([]interface{})
+	if !ok || len(bounds) != 2 {
+		return 0, 0, fmt.Errorf("record price for %s: unexpected Redis result %T", symbol, result)
+	}
+
+	low, lowErr := strconv.ParseFloat(fmt.Sprint(bounds[0]), 64)
+	high, highErr := strconv.ParseFloat(fmt.Sprint(bounds[1]), 64)
+	if lowErr != nil || highErr != nil {
+		return 0, 0, fmt.Errorf("record price for %s: unexpected price range %v", symbol, bounds)
+	}
+
+	return low, high, nil
+}
+
+func pricesKey(symbol string) string {
+	return fmt.Sprintf("engine:v1:prices:%s", symbol)
+}
@@ -0,0 +1,76 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fafnir/shared/pkg/redis"
+)
+
+// Running engines register in a sorted set scored by the deadline of their last heartbeat. An engine that
+// stops heartbeating (crash, network split) drops out once its deadline passes.
+const (
+	membersKey = "engine:v1:members"
+
+	// KEYS: members; ARGV[1]: engine id, ARGV[2]: deadline (unix ms), ARGV[3]: now (unix ms)
+	heartbeatScript = `
+redis.call("ZADD", KEYS[1], ARGV[2], ARGV[1])
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", "(" .. ARGV[3])
+return redis.call("ZRANGE", KEYS[1], 0, -1)
+`
+)
+
+type Membership struct {
+	client   *redis.Cache
+	engineID string
+	ttl      time.Duration
+}
+
+func NewMembership(client *redis.Cache, engineID string, ttl time.Duration) *Membership {
+	return &Membership{
+		client:   client,
+		engineID: engineID,
+		ttl:      ttl,
+	}
+}
+
+// Heartbeat renews this engine's registration, drops engines whose registration lapsed and returns the live ones.
+func (m *Membership) Heartbeat(ctx context.Context, now time.Time) ([]string, error) {
+	result, err := m.client.Eval(
+		ctx,
+		heartbeatScript,
+		[]string{membersKey},
+		m.engineID,
+		now.Add(m.ttl).UnixMilli(),
+		now.UnixMilli(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("renew engine membership: %w", err)
+	}
+
+	rawMembers, ok := result.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("renew engine membership: unexpected Redis result %T", result)
+	}
+
+	members := make([]string, 0, len(rawMembers))
+	for _, rawMember := range rawMembers {
+		member, ok := rawMember.(string)
+		if !ok {
+			return nil, fmt.Errorf("renew engine membership: unexpected Redis member %T", rawMember)
+		}
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+// Leave deregisters this engine so the others take over its symbols without waiting for it to time out.
+func (m *Membership) Leave(ctx context.Context) error {
+	if err := m.client.ZRem(ctx, membersKey, m.engineID); err != nil {
+		return fmt.Errorf("leave engine membership: %w", err)
+	}
+
+	return nil
+}
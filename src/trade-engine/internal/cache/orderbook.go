@@ -3,52 +3,403 @@ package cache
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	orderpb "fafnir/shared/pb/order"
 	"fafnir/shared/pkg/redis"
 )
 
+// Each symbol keeps its orders in a hash and indexes them in sorted sets so a quote only touches the
+// orders it can match:
+//
+//	buy        limit buys scored by the negated limit price (best bid first)
+//	sell       limit sells scored by the limit price (best offer first)
+//	buy_stop   buy stops scored by the stop price
+//	sell_stop  sell stops scored by the stop price
+//	market     unfilled market remainders, all scored 0
+//
+// Trailing stops rest in the stop indexes scored by their current trigger. Their trail state
+// ("<side>|amount or percent|<trail>|<watermark>") lives in a per-symbol hash, and a per-symbol sorted set for each
+// side scores them by watermark. Every quote ratchets the trails whose watermark it beats before claiming, which
+// moves the trigger along with the best price. The state outlives claims so that an order put back keeps its
+// watermark.
+//
+// Legs of a one-cancels-other group are linked per symbol ("<order id>" -> "<other leg ids, comma separated>").
+// Taking a leg out of the book suspends the other legs in the same step: they leave the indexes, keeping their
+// score in the suspended hash, and cannot be claimed until the leg comes back to the book or its lease is released
+// without a fill. Before the first fill of a leg is published the group is resolved, which drops the other legs
+// from the book and queues them for cancellation, so two legs can never both execute.
+//
+// Members are "<queued unix nanos, zero padded>:<order id>", so orders at the same price sort by time. An order is
+// queued when it is created, and again when an amendment reprices it or raises its quantity.
+//
+// Claimed orders are not dropped: they move into the claiming engine's in-flight hash under a lease
+// ("<engine>|<symbol>:<order id>" scored by the lease deadline) until the engine releases them or puts them
+// back. Leases left behind by a crashed engine are restored into the book. Each symbol also maps its leased
+// orders to the engine holding them ("<order id>" -> "<engine>"), so one order is looked up without the leases.
 const (
-	activeSymbolsKey = "orderbook:v2:active_symbols"
-	addOrderScript   = `
-redis.call("HSET", KEYS[1], ARGV[1], ARGV[2])
-redis.call("SADD", KEYS[2], ARGV[3])
+	activeSymbolsKey = "orderbook:v3:active_symbols"
+	expiriesKey      = "orderbook:v3:expiries"
+	leasesKey        = "orderbook:v3:leases"
+	initializedKey   = "orderbook:v3:initialized"
+
+	legacyActiveSymbolsKey = "orderbook:v2:active_symbols"
+	legacyExpiriesKey      = "orderbook:v2:expiries"
+
+	resolvedLegsKey = "orderbook:v3:resolved_legs"
+
+	// KEYS: orders, refs, buy, sell, buy_stop, sell_stop, market, active symbols, expiries, in flight, leases, trails,
+	// oco links, suspended, resolved legs, buy trail marks, sell trail marks, lease owners
+	// ARGV[1]: symbol, ARGV[2]: engine id, ARGV[3]: lease deadline (unix ms)
+	bookScriptPrelude = `
+local indexes = {buy = KEYS[3], sell = KEYS[4], buy_stop = KEYS[5], sell_stop = KEYS[6], market = KEYS[7]}
+
+local function is_suspended(id)
+    return redis.call("HEXISTS", KEYS[14], id) == 1
+end
+
+local function oco_legs(id)
+    local legs = {}
+    local linked = redis.call("HGET", KEYS[13], id)
+    if linked then
+        for leg in string.gmatch(linked, "[^,]+") do
+            table.insert(legs, leg)
+        end
+    end
+    return legs
+end
+
+local function unindex(id)
+    local ref = redis.call("HGET", KEYS[2], id)
+    if ref then
+        local sep = string.find(ref, "|", 1, true)
+        redis.call("ZREM", indexes[string.sub(ref, 1, sep - 1)], string.sub(ref, sep + 1))
+        redis.call("HDEL", KEYS[2], id)
+    end
+end
+
+local function lease_member(id)
+    return ARGV[2] .. "|" .. ARGV[1] .. ":" .. id
+end
+
+local function release(id)
+    redis.call("HDEL", KEYS[10], id)
+    redis.call("ZREM", KEYS[11], lease_member(id))
+    if redis.call("HGET", KEYS[18], id) == ARGV[2] then
+        redis.call("HDEL", KEYS[18], id)
+    end
+end
+
+local function pop(id)
+    unindex(id)
+    redis.call("ZREM", KEYS[9], ARGV[1] .. ":" .. id)
+    local data = redis.call("HGET", KEYS[1], id)
+    if data then
+        redis.call("HDEL", KEYS[1], id)
+    end
+    return data
+end
+
+local function parse_trail(state)
+    local side, kind, trail, mark = string.match(state, "^(%a+)|(%a+)|([^|]+)|([^|]+)$")
+    return side, kind, tonumber(trail), tonumber(mark)
+end
+
+local trail_marks = {buy = KEYS[16], sell = KEYS[17]}
+
+local function set_trail(id, state)
+    redis.call("HSET", KEYS[12], id, state)
+    local side, _, _, mark = parse_trail(state)
+    redis.call("ZADD", trail_marks[side], mark, id)
+end
+
+local function drop_trail(id)
+    redis.call("HDEL", KEYS[12], id)
+    redis.call("ZREM", KEYS[16], id)
+    redis.call("ZREM", KEYS[17], id)
+end
+
+local function trail_stop(side, kind, trail, mark)
+    local offset = trail
+    if kind == "percent" then
+        offset = mark * trail / 100
+    end
+    if side == "buy" then
+        return mark + offset
+    end
+    return mark - offset
+end
+
+local function suspend_legs(id)
+    for _, leg in ipairs(oco_legs(id)) do
+        local ref = redis.call("HGET", KEYS[2], leg)
+        if ref and not is_suspended(leg) then
+            local sep = string.find(ref, "|", 1, true)
+            local index, member = indexes[string.sub(ref, 1, sep - 1)], string.sub(ref, sep + 1)
+            local score = redis.call("ZSCORE", index, member)
+            if score then
+                redis.call("ZREM", index, member)
+                redis.call("HSET", KEYS[14], leg, score)
+            end
+        end
+    end
+end
+
+local function resume_legs(id)
+    for _, leg in ipairs(oco_legs(id)) do
+        local score = redis.call("HGET", KEYS[14], leg)
+        if score then
+            redis.call("HDEL", KEYS[14], leg)
+            local ref = redis.call("HGET", KEYS[2], leg)
+            if ref then
+                -- a trailing leg's trigger kept moving while it was suspended
+                local state = redis.call("HGET", KEYS[12], leg)
+                if state then
+                    score = trail_stop(parse_trail(state))
+                end
+                local sep = string.find(ref, "|", 1, true)
+                redis.call("ZADD", indexes[string.sub(ref, 1, sep - 1)], score, string.sub(ref, sep + 1))
+            end
+        end
+    end
+end
+
+local function take(id)
+    if is_suspended(id) then
+        return nil
+    end
+    local data = pop(id)
+    if data then
+        redis.call("HSET", KEYS[10], id, data)
+        redis.call("ZADD", KEYS[11], ARGV[3], lease_member(id))
+        redis.call("HSET", KEYS[18], id, ARGV[2])
+        suspend_legs(id)
+    end
+    return data
+end
+
+local function put(id, data, index, score, member, expiry, trail, legs)
+    unindex(id)
+    if trail ~= "" then
+        local state = redis.call("HGET", KEYS[12], id)
+        if state then
+            score = trail_stop(parse_trail(state))
+        else
+            set_trail(id, trail)
+        end
+    else
+        drop_trail(id)
+    end
+    redis.call("HSET", KEYS[1], id, data)
+    redis.call("HSET", KEYS[2], id, index .. "|" .. member)
+    if is_suspended(id) then
+        -- another leg of its group is in flight; the order returns to the index once that leg is back
+        redis.call("HSET", KEYS[14], id, score)
+    else
+        redis.call("ZADD", indexes[index], score, member)
+    end
+    if legs ~= "" then
+        redis.call("HSET", KEYS[13], id, legs)
+        resume_legs(id)
+    else
+        redis.call("HDEL", KEYS[13], id)
+    end
+    redis.call("SADD", KEYS[8], ARGV[1])
+    if expiry ~= "" then
+        redis.call("ZADD", KEYS[9], expiry, ARGV[1] .. ":" .. id)
+    else
+        redis.call("ZREM", KEYS[9], ARGV[1] .. ":" .. id)
+    end
+end
+
+local function release_symbol()
+    if redis.call("HLEN", KEYS[1]) == 0 then
+        redis.call("SREM", KEYS[8], ARGV[1])
+    end
+end
+`
+	// ARGV[4..]: order id, data, index, score, member, expiry score, trail state, oco legs
+	addOrderScript = bookScriptPrelude + `
+release(ARGV[4])
+put(ARGV[4], ARGV[5], ARGV[6], ARGV[7], ARGV[8], ARGV[9], ARGV[10], ARGV[11])
 return 1
 `
-	removeOrderScript = `
-local removed = redis.call("HDEL", KEYS[1], ARGV[1])
-if redis.call("HLEN", KEYS[1]) == 0 then
-    redis.call("SREM", KEYS[2], ARGV[2])
+	// same arguments as addOrderScript, with ARGV[2] naming the engine that holds the lease;
+	// does nothing once that engine has released or requeued the order itself
+	restoreLeaseScript = bookScriptPrelude + `
+if not redis.call("ZSCORE", KEYS[11], lease_member(ARGV[4])) then
+    return 0
 end
-return removed
+release(ARGV[4])
+put(ARGV[4], ARGV[5], ARGV[6], ARGV[7], ARGV[8], ARGV[9], ARGV[10], ARGV[11])
+return 1
 `
+	// ARGV[4]: order id, ARGV[5]: the data the order was read with, ARGV[6..]: as addOrderScript from the data on;
+	// only replaces an order that is still resting unchanged, so one claimed or updated meanwhile is left alone
+	amendOrderScript = bookScriptPrelude + `
+if redis.call("HGET", KEYS[1], ARGV[4]) ~= ARGV[5] then
+    return 0
+end
+put(ARGV[4], ARGV[6], ARGV[7], ARGV[8], ARGV[9], ARGV[10], ARGV[11], ARGV[12])
+return 1
+`
+	// ARGV[4]: order id
+	removeOrderScript = bookScriptPrelude + `
+local data = pop(ARGV[4])
+drop_trail(ARGV[4])
+redis.call("HDEL", KEYS[13], ARGV[4])
+redis.call("HDEL", KEYS[14], ARGV[4])
+release_symbol()
+if data then
+    return 1
+end
+return 0
+`
+	// ARGV[4]: order id; like removeOrderScript, but returns the order data if it was resting,
+	// "in_flight" while some engine holds a lease on it, and "" otherwise
+	cancelOrderScript = bookScriptPrelude + `
+local data = pop(ARGV[4])
+if data then
+    drop_trail(ARGV[4])
+    redis.call("HDEL", KEYS[13], ARGV[4])
+    redis.call("HDEL", KEYS[14], ARGV[4])
+    release_symbol()
+    return data
+end
+if redis.call("HEXISTS", KEYS[18], ARGV[4]) == 1 then
+    return "in_flight"
+end
+return ""
+`
+	// ARGV[4]: order id; returns the order data, or "" if it was already taken
+	claimOrderScript = bookScriptPrelude + `
+local data = take(ARGV[4])
+release_symbol()
+return data or ""
+`
+	// ARGV[4]: last price, ARGV[5]: negated last price, ARGV[6]: most orders to claim
+	claimMatchedScript = bookScriptPrelude + `
+local last = tonumber(ARGV[4])
+-- only the trails this quote moves are read: sells marked below it, buys marked above it, and buys without
+-- a watermark yet (one of 0 comes from a book rebuilt from order-service and starts at this quote)
+local moved = redis.call("ZRANGEBYSCORE", KEYS[17], "-inf", "(" .. ARGV[4])
+for _, id in ipairs(redis.call("ZRANGEBYSCORE", KEYS[16], "(" .. ARGV[4], "+inf")) do
+    table.insert(moved, id)
+end
+for _, id in ipairs(redis.call("ZRANGEBYSCORE", KEYS[16], "-inf", 0)) do
+    table.insert(moved, id)
+end
+for _, id in ipairs(moved) do
+    local state = redis.call("HGET", KEYS[12], id)
+    if state then
+        local side, kind, trail = parse_trail(state)
+        set_trail(id, (string.gsub(state, "[^|]+$", ARGV[4])))
+        local ref = redis.call("HGET", KEYS[2], id)
+        if ref and not is_suspended(id) then
+            local sep = string.find(ref, "|", 1, true)
+            redis.call("ZADD", indexes[string.sub(ref, 1, sep - 1)], trail_stop(side, kind, trail, last), string.sub(ref, sep + 1))
+        end
+    end
+end
+
+local claimed = {}
+local limit = tonumber(ARGV[6])
+local function claim_range(key, min, max)
+    if #claimed >= limit then
+        return
+    end
+    for _, member in ipairs(redis.call("ZRANGEBYSCORE", key, min, max, "LIMIT", 0, limit - #claimed)) do
+        local sep = string.find(member, ":", 1, true)
+        local data = take(string.sub(member, sep + 1))
+        if data then
+            table.insert(claimed, data)
+        end
+    end
+end
+
+claim_range(KEYS[7], "-inf", "+inf")
+claim_range(KEYS[3], "-inf", ARGV[5])
+claim_range(KEYS[4], "-inf", ARGV[4])
+claim_range(KEYS[5], "-inf", ARGV[4])
+claim_range(KEYS[6], ARGV[4], "+inf")
+release_symbol()
+return claimed
+`
+	// ARGV[4]: order id; the other legs of a group come back unless the group was resolved by a fill
+	releaseLeaseScript = bookScriptPrelude + `
+release(ARGV[4])
+drop_trail(ARGV[4])
+resume_legs(ARGV[4])
+redis.call("HDEL", KEYS[13], ARGV[4])
+return 1
+`
+	// ARGV[4]: order id of the leg that fills; moves the other legs of its group out of the book
+	// and into the resolved legs hash, where they wait to be cancelled
+	resolveGroupScript = bookScriptPrelude + `
+for _, leg in ipairs(oco_legs(ARGV[4])) do
+    local data = pop(leg)
+    drop_trail(leg)
+    redis.call("HDEL", KEYS[13], leg)
+    redis.call("HDEL", KEYS[14], leg)
+    if data then
+        redis.call("HSET", KEYS[15], ARGV[1] .. ":" .. leg, data)
+    end
+end
+redis.call("HDEL", KEYS[13], ARGV[4])
+release_symbol()
+return 1
+`
+	// ARGV: symbol, max score; read only
+	rangeOrdersScript = `
+local members = redis.call("ZRANGEBYSCORE", KEYS[2], "-inf", ARGV[2])
+if #members == 0 then
+    return {}
+end
+local ids = {}
+for i, member in ipairs(members) do
+    local sep = string.find(member, ":", 1, true)
+    ids[i] = string.sub(member, sep + 1)
+end
+local orders = {}
+for _, data in ipairs(redis.call("HMGET", KEYS[1], unpack(ids))) do
+    if data then
+        table.insert(orders, data)
+    end
+end
+return orders
+`
+	expiryClaimBatchSize = 100
+	// the most orders a single quote claims; the rest stay marketable and are claimed by the next quote
+	matchClaimBatchSize = 100
 )
 
 type OrderBook struct {
-	client *redis.Cache
+	client   *redis.Cache
+	engineID string
+	leaseTTL time.Duration
 }
 
-func NewOrderBook(client *redis.Cache) *OrderBook {
-	return &OrderBook{client: client}
+func NewOrderBook(client *redis.Cache, engineID string, leaseTTL time.Duration) *OrderBook {
+	return &OrderBook{
+		client:   client,
+		engineID: engineID,
+		leaseTTL: leaseTTL,
+	}
 }
 
+// Add puts an order into the book, releasing this engine's lease on it if it was in flight.
 func (o *OrderBook) Add(ctx context.Context, order *orderpb.OrderCreatedEvent) error {
-	data, err := json.Marshal(order)
+	args, err := putArgs(order)
 	if err != nil {
-		return fmt.Errorf("marshal order %s: %w", order.OrderId, err)
+		return err
 	}
 
-	key := ordersKey(order.Symbol)
-	if _, err := o.client.Eval(
-		ctx,
-		addOrderScript,
-		[]string{key, activeSymbolsKey},
-		order.OrderId,
-		string(data),
-		order.Symbol,
-	); err != nil {
+	if _, err := o.client.Eval(ctx, addOrderScript, o.bookKeys(order.Symbol, o.engineID), o.scriptArgs(order.Symbol, o.engineID, args...)...); err != nil {
 		return fmt.Errorf("store order %s: %w", order.OrderId, err)
 	}
 
@@ -64,79 +415,552 @@ func (o *OrderBook) Symbols(ctx context.Context) ([]string, error) {
 	return symbols, nil
 }
 
+// ClaimMatched atomically moves the orders the last price makes executable into flight and returns them:
+// market remainders, then crossed buys (highest limit first), crossed sells (lowest limit first) and triggered stops.
+// At most matchClaimBatchSize orders are claimed per call.
 func (o *OrderBook) ClaimMatched(ctx context.Context, symbol string, currentPrice float64) ([]*orderpb.OrderCreatedEvent, error) {
-	key := ordersKey(symbol)
-	rawOrders, err := o.client.HGetAll(ctx, key)
+	result, err := o.client.Eval(
+		ctx,
+		claimMatchedScript,
+		o.bookKeys(symbol, o.engineID),
+		o.scriptArgs(symbol, o.engineID, formatScore(currentPrice), formatScore(-currentPrice), matchClaimBatchSize)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("claim orders for %s: %w", symbol, err)
+	}
+
+	return decodeOrders(result)
+}
+
+// LimitOrders returns, without claiming them, the resting limit orders on side that trade at price,
+// best price first and oldest first within a price level.
+func (o *OrderBook) LimitOrders(ctx context.Context, symbol string, side orderpb.OrderSide, price float64) ([]*orderpb.OrderCreatedEvent, error) {
+	index, maxScore := "sell", price
+	if side == orderpb.OrderSide_ORDER_SIDE_BUY {
+		index, maxScore = "buy", -price
+	}
+
+	result, err := o.client.Eval(
+		ctx,
+		rangeOrdersScript,
+		[]string{ordersKey(symbol), indexKey(symbol, index)},
+		symbol,
+		formatScore(maxScore),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list %s orders for %s: %w", index, symbol, err)
+	}
+
+	return decodeOrders(result)
+}
+
+// Claim moves a single order into flight, reporting whether this caller was the one to take it.
+func (o *OrderBook) Claim(ctx context.Context, symbol string, orderID string) (bool, error) {
+	rawOrder, err := o.claim(ctx, symbol, orderID)
 	if err != nil {
-		return nil, fmt.Errorf("list orders for %s: %w", symbol, err)
+		return false, fmt.Errorf("claim order %s: %w", orderID, err)
 	}
 
-	matched := make([]*orderpb.OrderCreatedEvent, 0)
-	for orderID, rawOrder := range rawOrders {
+	return rawOrder != "", nil
+}
+
+// ClaimExpired moves orders of the symbols owns accepts whose expiry is at or before now into flight and returns them,
+// at most expiryClaimBatchSize per call. The expiries are read a page at a time, so a sweep never loads them all.
+func (o *OrderBook) ClaimExpired(ctx context.Context, now time.Time, owns func(symbol string) bool) ([]*orderpb.OrderCreatedEvent, error) {
+	expired := make([]*orderpb.OrderCreatedEvent, 0, expiryClaimBatchSize)
+	// claimed orders leave the expiries, so only the ones passed over move the next page along
+	var skipped int64
+	for len(expired) < expiryClaimBatchSize {
+		members, err := o.client.ZRangeByScore(ctx, expiriesKey, "-inf", strconv.FormatInt(now.Unix(), 10), skipped, expiryClaimBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("list expired orders: %w", err)
+		}
+
+		for _, member := range members {
+			if len(expired) == expiryClaimBatchSize {
+				break
+			}
+
+			symbol, orderID, _ := strings.Cut(member, ":")
+			if !owns(symbol) {
+				skipped++
+				continue
+			}
+
+			rawOrder, err := o.claim(ctx, symbol, orderID)
+			if err != nil {
+				return nil, fmt.Errorf("claim expired order %s: %w", orderID, err)
+			}
+			if rawOrder == "" {
+				// already claimed by a fill or cancellation
+				skipped++
+				continue
+			}
+
+			var order orderpb.OrderCreatedEvent
+			if err := json.Unmarshal([]byte(rawOrder), &order); err != nil {
+				return nil, fmt.Errorf("unmarshal order %s: %w", orderID, err)
+			}
+			expired = append(expired, &order)
+		}
+
+		if len(members) < expiryClaimBatchSize {
+			break
+		}
+	}
+
+	return expired, nil
+}
+
+// Release ends this engine's lease on a claimed order once the order no longer needs the book.
+func (o *OrderBook) Release(ctx context.Context, order *orderpb.OrderCreatedEvent) error {
+	if _, err := o.client.Eval(ctx, releaseLeaseScript, o.bookKeys(order.Symbol, o.engineID), o.scriptArgs(order.Symbol, o.engineID, order.OrderId)...); err != nil {
+		return fmt.Errorf("release order %s: %w", order.OrderId, err)
+	}
+
+	return nil
+}
+
+// ResolveGroup settles a one-cancels-other group in favour of order, which is about to fill. The other legs
+// leave the book for good and wait in the resolved legs until their cancellation is published.
+func (o *OrderBook) ResolveGroup(ctx context.Context, order *orderpb.OrderCreatedEvent) error {
+	if _, err := o.client.Eval(ctx, resolveGroupScript, o.bookKeys(order.Symbol, o.engineID), o.scriptArgs(order.Symbol, o.engineID, order.OrderId)...); err != nil {
+		return fmt.Errorf("resolve group of order %s: %w", order.OrderId, err)
+	}
+
+	return nil
+}
+
+// ResolvedLegs returns the legs whose group was resolved by another leg's fill and that still need cancelling.
+func (o *OrderBook) ResolvedLegs(ctx context.Context) ([]*orderpb.OrderCreatedEvent, error) {
+	rawOrders, err := o.client.HGetAll(ctx, resolvedLegsKey)
+	if err != nil {
+		return nil, fmt.Errorf("list resolved legs: %w", err)
+	}
+
+	legs := make([]*orderpb.OrderCreatedEvent, 0, len(rawOrders))
+	for member, rawOrder := range rawOrders {
 		var order orderpb.OrderCreatedEvent
 		if err := json.Unmarshal([]byte(rawOrder), &order); err != nil {
-			_, removeErr := o.remove(ctx, symbol, orderID)
-			return nil, errors.Join(
-				fmt.Errorf("unmarshal order %s: %w", orderID, err),
-				removeErr,
-			)
+			return nil, fmt.Errorf("unmarshal resolved leg %s: %w", member, err)
+		}
+		legs = append(legs, &order)
+	}
+
+	return legs, nil
+}
+
+// DropResolvedLeg forgets a resolved leg once its cancellation went out.
+func (o *OrderBook) DropResolvedLeg(ctx context.Context, order *orderpb.OrderCreatedEvent) error {
+	if _, err := o.client.HDel(ctx, resolvedLegsKey, order.Symbol+":"+order.OrderId); err != nil {
+		return fmt.Errorf("drop resolved leg %s: %w", order.OrderId, err)
+	}
+
+	return nil
+}
+
+// RecoverLeases puts in-flight orders of the symbols owns accepts whose lease ran out back into the book. With
+// includeOwn, every lease held under this engine's ID is recovered regardless of its deadline or symbol, which is
+// what a restarted engine wants for the claims its previous process left behind.
+func (o *OrderBook) RecoverLeases(ctx context.Context, now time.Time, owns func(symbol string) bool, includeOwn bool) (int, error) {
+	members, err := o.client.ZRangeByScore(ctx, leasesKey, "-inf", strconv.FormatInt(now.UnixMilli(), 10), 0, 0)
+	if err != nil {
+		return 0, fmt.Errorf("list expired leases: %w", err)
+	}
+	members = slices.DeleteFunc(members, func(member string) bool {
+		engineID, claim, _ := strings.Cut(member, "|")
+		symbol, _, _ := strings.Cut(claim, ":")
+		return !owns(symbol) && !(includeOwn && engineID == o.engineID)
+	})
+	if includeOwn {
+		live, err := o.client.ZRangeByScore(ctx, leasesKey, "("+strconv.FormatInt(now.UnixMilli(), 10), "+inf", 0, 0)
+		if err != nil {
+			return 0, fmt.Errorf("list live leases: %w", err)
+		}
+		for _, member := range live {
+			if strings.HasPrefix(member, o.engineID+"|") {
+				members = append(members, member)
+			}
 		}
+	}
+
+	recovered := 0
+	for _, member := range members {
+		engineID, claim, _ := strings.Cut(member, "|")
+		symbol, orderID, _ := strings.Cut(claim, ":")
 
-		if !matchesLimit(&order, currentPrice) {
+		rawOrder, err := o.client.HGet(ctx, inFlightKey(engineID), orderID)
+		if err != nil {
+			return recovered, fmt.Errorf("read in-flight order %s: %w", orderID, err)
+		}
+		if rawOrder == "" {
+			// the order data is gone, so there is nothing left to recover
+			if err := o.client.ZRem(ctx, leasesKey, member); err != nil {
+				return recovered, fmt.Errorf("drop lease %s: %w", member, err)
+			}
+			if err := o.dropLeaseOwner(ctx, symbol, orderID, engineID); err != nil {
+				return recovered, err
+			}
 			continue
 		}
 
-		removed, err := o.remove(ctx, symbol, orderID)
+		var order orderpb.OrderCreatedEvent
+		if err := json.Unmarshal([]byte(rawOrder), &order); err != nil {
+			return recovered, fmt.Errorf("unmarshal in-flight order %s: %w", orderID, err)
+		}
+		args, err := putArgs(&order)
+		if err != nil {
+			return recovered, err
+		}
+
+		result, err := o.client.Eval(ctx, restoreLeaseScript, o.bookKeys(symbol, engineID), o.scriptArgs(symbol, engineID, args...)...)
 		if err != nil {
-			return nil, fmt.Errorf("claim order %s: %w", orderID, err)
+			return recovered, fmt.Errorf("restore order %s: %w", orderID, err)
 		}
-		if removed == 1 {
-			matched = append(matched, &order)
+		if restored, _ := result.(int64); restored == 1 {
+			recovered++
 		}
 	}
 
-	return matched, nil
+	return recovered, nil
+}
+
+// dropLeaseOwner forgets that engineID holds orderID, unless another engine has claimed the order since
+func (o *OrderBook) dropLeaseOwner(ctx context.Context, symbol string, orderID string, engineID string) error {
+	owner, err := o.client.HGet(ctx, leaseOwnersKey(symbol), orderID)
+	if err != nil {
+		return fmt.Errorf("read lease owner of %s: %w", orderID, err)
+	}
+	if owner != engineID {
+		return nil
+	}
+	if _, err := o.client.HDel(ctx, leaseOwnersKey(symbol), orderID); err != nil {
+		return fmt.Errorf("drop lease owner of %s: %w", orderID, err)
+	}
+
+	return nil
+}
+
+// InFlight returns the orders some engine holds a lease on, as "<symbol>:<order id>", whether or not the lease
+// ran out. They return to the book through RecoverLeases.
+func (o *OrderBook) InFlight(ctx context.Context) (map[string]bool, error) {
+	members, err := o.client.ZRangeByScore(ctx, leasesKey, "-inf", "+inf", 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("list leases: %w", err)
+	}
+
+	inFlight := make(map[string]bool, len(members))
+	for _, member := range members {
+		_, claim, _ := strings.Cut(member, "|")
+		inFlight[claim] = true
+	}
+
+	return inFlight, nil
+}
+
+// Contains reports whether an order is resting in the book.
+func (o *OrderBook) Contains(ctx context.Context, symbol string, orderID string) (bool, error) {
+	exists, err := o.client.HExists(ctx, ordersKey(symbol), orderID)
+	if err != nil {
+		return false, fmt.Errorf("look up order %s: %w", orderID, err)
+	}
+
+	return exists, nil
+}
+
+// Resting returns an order resting in the book together with the data Amend checks it against,
+// or nil if the order is in flight or not in the book.
+func (o *OrderBook) Resting(ctx context.Context, symbol string, orderID string) (*orderpb.OrderCreatedEvent, string, error) {
+	rawOrder, err := o.client.HGet(ctx, ordersKey(symbol), orderID)
+	if err != nil {
+		return nil, "", fmt.Errorf("read order %s: %w", orderID, err)
+	}
+	if rawOrder == "" {
+		return nil, "", nil
+	}
+
+	var order orderpb.OrderCreatedEvent
+	if err := json.Unmarshal([]byte(rawOrder), &order); err != nil {
+		return nil, "", fmt.Errorf("unmarshal order %s: %w", orderID, err)
+	}
+	return &order, rawOrder, nil
+}
+
+// Amend replaces a resting order with its amended version, at the place among the orders at its price that its
+// queue time gives it. It reports false and changes nothing if the order was claimed or changed since Resting read it.
+func (o *OrderBook) Amend(ctx context.Context, order *orderpb.OrderCreatedEvent, previous string) (bool, error) {
+	args, err := putArgs(order)
+	if err != nil {
+		return false, err
+	}
+	args = append([]interface{}{args[0], previous}, args[1:]...)
+
+	result, err := o.client.Eval(ctx, amendOrderScript, o.bookKeys(order.Symbol, o.engineID), o.scriptArgs(order.Symbol, o.engineID, args...)...)
+	if err != nil {
+		return false, fmt.Errorf("amend order %s: %w", order.OrderId, err)
+	}
+
+	amended, _ := result.(int64)
+	return amended == 1, nil
+}
+
+// Initialized reports whether the book has been built since Redis last started empty.
+func (o *OrderBook) Initialized(ctx context.Context) (bool, error) {
+	exists, err := o.client.Exists(ctx, initializedKey)
+	if err != nil {
+		return false, fmt.Errorf("check order book marker: %w", err)
+	}
+
+	return exists, nil
+}
+
+func (o *OrderBook) MarkInitialized(ctx context.Context, at time.Time) error {
+	if err := o.client.SetPersistent(ctx, initializedKey, at.UTC().Format(time.RFC3339)); err != nil {
+		return fmt.Errorf("set order book marker: %w", err)
+	}
+
+	return nil
 }
 
 func (o *OrderBook) Remove(ctx context.Context, symbol string, orderID string) error {
-	if _, err := o.remove(ctx, symbol, orderID); err != nil {
+	result, err := o.client.Eval(ctx, removeOrderScript, o.bookKeys(symbol, o.engineID), o.scriptArgs(symbol, o.engineID, orderID)...)
+	if err != nil {
 		return fmt.Errorf("remove order %s: %w", orderID, err)
 	}
+	if _, ok := result.(int64); !ok {
+		return fmt.Errorf("remove order %s: unexpected Redis result %T", orderID, result)
+	}
 
 	return nil
 }
 
-func (o *OrderBook) remove(ctx context.Context, symbol string, orderID string) (int64, error) {
-	result, err := o.client.Eval(
-		ctx,
-		removeOrderScript,
-		[]string{ordersKey(symbol), activeSymbolsKey},
-		orderID,
-		symbol,
-	)
+// Cancel takes an order out of the book and returns it. It returns nil if the order is not resting, and
+// reports whether that is because an engine is executing it, in which case it may come back to the book.
+func (o *OrderBook) Cancel(ctx context.Context, symbol string, orderID string) (*orderpb.OrderCreatedEvent, bool, error) {
+	result, err := o.client.Eval(ctx, cancelOrderScript, o.bookKeys(symbol, o.engineID), o.scriptArgs(symbol, o.engineID, orderID)...)
 	if err != nil {
-		return 0, err
+		return nil, false, fmt.Errorf("cancel order %s: %w", orderID, err)
 	}
 
-	removed, ok := result.(int64)
+	rawOrder, ok := result.(string)
 	if !ok {
-		return 0, fmt.Errorf("unexpected Redis result %T", result)
+		return nil, false, fmt.Errorf("cancel order %s: unexpected Redis result %T", orderID, result)
+	}
+	switch rawOrder {
+	case "":
+		return nil, false, nil
+	case "in_flight":
+		return nil, true, nil
 	}
 
-	return removed, nil
+	var order orderpb.OrderCreatedEvent
+	if err := json.Unmarshal([]byte(rawOrder), &order); err != nil {
+		return nil, false, fmt.Errorf("unmarshal order %s: %w", orderID, err)
+	}
+	return &order, false, nil
+}
+
+// MigrateLegacy moves orders from the hash-only v2 book into the indexed book and drops the v2 keys.
+func (o *OrderBook) MigrateLegacy(ctx context.Context) (int, error) {
+	symbols, err := o.client.SMembers(ctx, legacyActiveSymbolsKey)
+	if err != nil {
+		return 0, fmt.Errorf("list legacy symbols: %w", err)
+	}
+
+	migrated := 0
+	for _, symbol := range symbols {
+		legacyKey := fmt.Sprintf("orderbook:v2:orders:%s", symbol)
+		rawOrders, err := o.client.HGetAll(ctx, legacyKey)
+		if err != nil {
+			return migrated, fmt.Errorf("list legacy orders for %s: %w", symbol, err)
+		}
+
+		for orderID, rawOrder := range rawOrders {
+			var order orderpb.OrderCreatedEvent
+			if err := json.Unmarshal([]byte(rawOrder), &order); err != nil {
+				return migrated, fmt.Errorf("unmarshal legacy order %s: %w", orderID, err)
+			}
+			if err := o.Add(ctx, &order); err != nil {
+				return migrated, err
+			}
+			migrated++
+		}
+
+		if err := o.client.Del(ctx, legacyKey); err != nil {
+			return migrated, fmt.Errorf("drop legacy orders for %s: %w", symbol, err)
+		}
+	}
+
+	if err := o.client.Del(ctx, legacyExpiriesKey); err != nil {
+		return migrated, fmt.Errorf("drop legacy expiries: %w", err)
+	}
+	if err := o.client.Del(ctx, legacyActiveSymbolsKey); err != nil {
+		return migrated, fmt.Errorf("drop legacy symbols: %w", err)
+	}
+
+	return migrated, nil
+}
+
+func (o *OrderBook) claim(ctx context.Context, symbol string, orderID string) (string, error) {
+	result, err := o.client.Eval(ctx, claimOrderScript, o.bookKeys(symbol, o.engineID), o.scriptArgs(symbol, o.engineID, orderID)...)
+	if err != nil {
+		return "", err
+	}
+
+	rawOrder, ok := result.(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected Redis result %T", result)
+	}
+
+	return rawOrder, nil
+}
+
+func (o *OrderBook) scriptArgs(symbol string, engineID string, args ...interface{}) []interface{} {
+	deadline := time.Now().Add(o.leaseTTL).UnixMilli()
+	return append([]interface{}{symbol, engineID, deadline}, args...)
+}
+
+func (o *OrderBook) bookKeys(symbol string, engineID string) []string {
+	return []string{
+		ordersKey(symbol),
+		fmt.Sprintf("orderbook:v3:refs:%s", symbol),
+		indexKey(symbol, "buy"),
+		indexKey(symbol, "sell"),
+		indexKey(symbol, "buy_stop"),
+		indexKey(symbol, "sell_stop"),
+		indexKey(symbol, "market"),
+		activeSymbolsKey,
+		expiriesKey,
+		inFlightKey(engineID),
+		leasesKey,
+		trailsKey(symbol),
+		fmt.Sprintf("orderbook:v3:oco:%s", symbol),
+		fmt.Sprintf("orderbook:v3:suspended:%s", symbol),
+		resolvedLegsKey,
+		trailMarksKey(symbol, "buy"),
+		trailMarksKey(symbol, "sell"),
+		leaseOwnersKey(symbol),
+	}
+}
+
+func putArgs(order *orderpb.OrderCreatedEvent) ([]interface{}, error) {
+	data, err := json.Marshal(order)
+	if err != nil {
+		return nil, fmt.Errorf("marshal order %s: %w", order.OrderId, err)
+	}
+
+	index, score := indexFor(order)
+	expiry := ""
+	if order.ExpiresAt != nil {
+		expiry = strconv.FormatInt(order.ExpiresAt.AsTime().Unix(), 10)
+	}
+
+	return []interface{}{order.OrderId, string(data), index, formatScore(score), indexMember(order), expiry, trailState(order), strings.Join(order.OcoOrderIds, ",")}, nil
+}
+
+// trailState is the initial trail of a trailing stop, or "" for every other order
+func trailState(order *orderpb.OrderCreatedEvent) string {
+	if order.Type != orderpb.OrderType_ORDER_TYPE_TRAILING_STOP {
+		return ""
+	}
+
+	side := "sell"
+	if order.Side == orderpb.OrderSide_ORDER_SIDE_BUY {
+		side = "buy"
+	}
+	kind, trail := "amount", order.TrailAmount
+	if order.TrailPercent != 0 {
+		kind, trail = "percent", order.TrailPercent
+	}
+
+	return fmt.Sprintf("%s|%s|%s|%s", side, kind, formatScore(trail), formatScore(order.TrailWatermark))
+}
+
+func decodeOrders(result interface{}) ([]*orderpb.OrderCreatedEvent, error) {
+	rawOrders, ok := result.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected Redis result %T", result)
+	}
+
+	orders := make([]*orderpb.OrderCreatedEvent, 0, len(rawOrders))
+	for _, rawOrder := range rawOrders {
+		data, ok := rawOrder.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected Redis order %T", rawOrder)
+		}
+
+		var order orderpb.OrderCreatedEvent
+		if err := json.Unmarshal([]byte(data), &order); err != nil {
+			return nil, fmt.Errorf("unmarshal order: %w", err)
+		}
+		orders = append(orders, &order)
+	}
+
+	return orders, nil
+}
+
+func inFlightKey(engineID string) string {
+	return fmt.Sprintf("orderbook:v3:inflight:%s", engineID)
+}
+
+func leaseOwnersKey(symbol string) string {
+	return fmt.Sprintf("orderbook:v3:lease_owners:%s", symbol)
+}
+
+func trailsKey(symbol string) string {
+	return fmt.Sprintf("orderbook:v3:trails:%s", symbol)
+}
+
+// trailMarksKey indexes the trailing stops on side by their watermark, so a quote reads only the ones it moves
+func trailMarksKey(symbol string, side string) string {
+	return fmt.Sprintf("orderbook:v3:trail_marks:%s:%s", side, symbol)
 }
 
 func ordersKey(symbol string) string {
-	return fmt.Sprintf("orderbook:v2:orders:%s", symbol)
+	return fmt.Sprintf("orderbook:v3:orders:%s", symbol)
+}
+
+func indexKey(symbol string, index string) string {
+	return fmt.Sprintf("orderbook:v3:%s:%s", index, symbol)
+}
+
+func indexFor(order *orderpb.OrderCreatedEvent) (string, float64) {
+	buy := order.Side == orderpb.OrderSide_ORDER_SIDE_BUY
+	switch order.Type {
+	case orderpb.OrderType_ORDER_TYPE_MARKET:
+		return "market", 0
+	case orderpb.OrderType_ORDER_TYPE_STOP, orderpb.OrderType_ORDER_TYPE_STOP_LIMIT, orderpb.OrderType_ORDER_TYPE_TRAILING_STOP:
+		if buy {
+			return "buy_stop", order.StopPrice
+		}
+		return "sell_stop", order.StopPrice
+	default:
+		if buy {
+			return "buy", -order.Price
+		}
+		return "sell", order.Price
+	}
+}
+
+// indexMember orders the orders at one price by when they joined the queue there
+func indexMember(order *orderpb.OrderCreatedEvent) string {
+	var queuedAt int64
+	switch {
+	case order.QueuedAt != nil:
+		queuedAt = order.QueuedAt.AsTime().UnixNano()
+	case order.CreatedAt != nil:
+		queuedAt = order.CreatedAt.AsTime().UnixNano()
+	}
+	return fmt.Sprintf("%020d:%s", queuedAt, order.OrderId)
 }
 
-func matchesLimit(order *orderpb.OrderCreatedEvent, currentPrice float64) bool {
-	switch order.Side {
-	case orderpb.OrderSide_ORDER_SIDE_BUY:
-		return currentPrice <= order.Price
-	case orderpb.OrderSide_ORDER_SIDE_SELL:
-		return currentPrice >= order.Price
+func formatScore(score float64) string {
+	switch {
+	case math.IsInf(score, 1):
+		return "+inf"
+	case math.IsInf(score, -1):
+		return "-inf"
 	default:
-		return false
+		return strconv.FormatFloat(score, 'g', -1, 64)
 	}
 }
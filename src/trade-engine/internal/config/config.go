@@ -3,8 +3,11 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"time"
 
+	"fafnir/shared/pkg/fees"
+	"fafnir/shared/pkg/fx"
 	"fafnir/shared/pkg/redis"
 )
 
@@ -13,8 +16,17 @@ type Config struct {
 	NATS         NatsConfig
 	StockService StockServiceConfig
 	Portfolio    PortfolioServiceConfig
+	OrderService OrderServiceConfig
+	Security     SecurityServiceConfig
 	Cache        redis.CacheConfig
-	FX           FXConfig
+	FX           fx.Config
+	Execution    ExecutionConfig
+	Recovery     RecoveryConfig
+	Sharding     ShardingConfig
+	Breaker      CircuitBreakerConfig
+	Admin        AdminConfig
+	Risk         RiskConfig
+	Fees         fees.Schedule
 }
 
 type NatsConfig struct {
@@ -31,10 +43,70 @@ type PortfolioServiceConfig struct {
 	URL string
 }
 
-type FXConfig struct {
-	BaseURL string
-	Timeout time.Duration
-	TTL     time.Duration
+type OrderServiceConfig struct {
+	URL string
+}
+
+type SecurityServiceConfig struct {
+	URL string
+}
+
+type RiskConfig struct {
+	// JSON file with the pre-trade risk limits; the defaults apply when it is empty
+	PolicyPath string
+}
+
+type RecoveryConfig struct {
+	// identifies this engine's in-flight claims; must stay stable across restarts of the same instance
+	EngineID string
+	// how long a claimed order may stay in flight before another engine puts it back in the book
+	LeaseTTL time.Duration
+	// rebuild the Redis book from order-service on startup even if it looks intact
+	RebuildOnStart bool
+}
+
+type CircuitBreakerConfig struct {
+	// largest move, as a share of the lowest price in the window, before a symbol is halted automatically
+	Band float64
+	// how far back prices are compared
+	Window time.Duration
+	// how long an automatic halt lasts unless an operator resumes the symbol earlier
+	HaltDuration time.Duration
+}
+
+type AdminConfig struct {
+	// bearer token for the admin API; the API is disabled when it is empty
+	Token string
+}
+
+type ShardingConfig struct {
+	// how often an engine renews its membership and picks up engines that joined or left
+	HeartbeatInterval time.Duration
+	// how long a membership lasts without a heartbeat before the engine's symbols move to the others
+	MemberTTL time.Duration
+}
+
+type ExecutionConfig struct {
+	// share of the quote's reported volume a single evaluation may fill (0 < rate <= 1)
+	VolumeParticipation float64
+	// how often every symbol in the book is checked against a fresh quote batch. Stock-service refreshes
+	// and publishes the quotes of every symbol with resting orders, and those events drive evaluation,
+	// so this is only a safety net for missed ones
+	PollInterval time.Duration
+	// how market orders are priced against a quote: "last", "spread" or "spread_impact" (the default);
+	// the engine does not start with any other value
+	PriceModel string
+	// smallest bid/ask spread assumed, as a share of the last price
+	MinSpread float64
+	// estimated spread as a share of the day's high-low range
+	SpreadRangeShare float64
+	// scales the square root market impact of an order's share of the volume
+	ImpactCoefficient float64
+	// trade around the clock instead of only in the exchange's regular session, for local testing
+	IgnoreMarketHours bool
+	// oldest quote the engine fills against; orders wait in the book for a fresher one. 0 turns the
+	// check off, which trading outside the session needs since those quotes date from the close
+	MaxQuoteAge time.Duration
 }
 
 func New() *Config {
@@ -43,21 +115,110 @@ func New() *Config {
 		NATS:         newNatsConfig(),
 		StockService: newStockServiceConfig(),
 		Portfolio:    newPortfolioServiceConfig(),
+		OrderService: newOrderServiceConfig(),
+		Security:     newSecurityServiceConfig(),
 		Cache:        newRedisConfig(),
 		FX:           newFXConfig(),
+		Execution:    newExecutionConfig(),
+		Recovery:     newRecoveryConfig(),
+		Sharding:     newShardingConfig(),
+		Breaker:      newCircuitBreakerConfig(),
+		Admin:        AdminConfig{Token: os.Getenv("ENGINE_ADMIN_TOKEN")},
+		Risk:         RiskConfig{PolicyPath: os.Getenv("RISK_POLICY_PATH")},
+		Fees:         newFeeConfig(),
+	}
+}
+
+func newRecoveryConfig() RecoveryConfig {
+	engineID := os.Getenv("ENGINE_ID")
+	if engineID == "" {
+		engineID, _ = os.Hostname()
+	}
+	if engineID == "" {
+		engineID = "trade-engine"
+	}
+
+	rebuild, _ := strconv.ParseBool(os.Getenv("REBUILD_ORDER_BOOK"))
+
+	return RecoveryConfig{
+		EngineID:       engineID,
+		LeaseTTL:       durationFromEnv("CLAIM_LEASE_TTL", 2*time.Minute),
+		RebuildOnStart: rebuild,
+	}
+}
+
+func newCircuitBreakerConfig() CircuitBreakerConfig {
+	return CircuitBreakerConfig{
+		Band:         floatFromEnv("VOLATILITY_BAND", 0.1),
+		Window:       durationFromEnv("VOLATILITY_WINDOW", 5*time.Minute),
+		HaltDuration: durationFromEnv("VOLATILITY_HALT_DURATION", 5*time.Minute),
+	}
+}
+
+func newShardingConfig() ShardingConfig {
+	heartbeat := durationFromEnv("ENGINE_HEARTBEAT_INTERVAL", 5*time.Second)
+	ttl := durationFromEnv("ENGINE_MEMBER_TTL", 15*time.Second)
+	if ttl <= heartbeat {
+		// a single late heartbeat must not hand the engine's symbols away
+		ttl = 3 * heartbeat
+	}
+
+	return ShardingConfig{
+		HeartbeatInterval: heartbeat,
+		MemberTTL:         ttl,
 	}
 }
 
-func newFXConfig() FXConfig {
+func newExecutionConfig() ExecutionConfig {
+	participation := floatFromEnv("FILL_VOLUME_PARTICIPATION", 0.01)
+	if participation > 1 {
+		participation = 1
+	}
+	ignoreMarketHours, _ := strconv.ParseBool(os.Getenv("EXECUTION_IGNORE_MARKET_HOURS"))
+	maxQuoteAge := durationFromEnv("EXECUTION_MAX_QUOTE_AGE", 5*time.Minute)
+	if age, err := time.ParseDuration(os.Getenv("EXECUTION_MAX_QUOTE_AGE")); err == nil && age == 0 {
+		maxQuoteAge = 0
+	}
+
+	return ExecutionConfig{
+		VolumeParticipation: participation,
+		PollInterval:        durationFromEnv("ORDER_POLL_INTERVAL", time.Minute),
+		PriceModel:          os.Getenv("EXECUTION_PRICE_MODEL"),
+		MinSpread:           floatFromEnv("EXECUTION_MIN_SPREAD", 0.0002),
+		SpreadRangeShare:    floatFromEnv("EXECUTION_SPREAD_RANGE_SHARE", 0.02),
+		ImpactCoefficient:   floatFromEnv("EXECUTION_IMPACT_COEFFICIENT", 0.5),
+		IgnoreMarketHours:   ignoreMarketHours,
+		MaxQuoteAge:         maxQuoteAge,
+	}
+}
+
+// newFeeConfig reads the fee schedule; nothing is charged unless it is configured
+func newFeeConfig() fees.Schedule {
+	return fees.Schedule{
+		PerOrder:    floatFromEnv("FEE_PER_ORDER", 0),
+		PerShare:    floatFromEnv("FEE_PER_SHARE", 0),
+		PerShareMin: floatFromEnv("FEE_PER_SHARE_MIN", 0),
+		PerShareMax: floatFromEnv("FEE_PER_SHARE_MAX", 0),
+		Percent:     floatFromEnv("FEE_PERCENT", 0),
+		FXSpread:    floatFromEnv("FEE_FX_SPREAD", 0),
+	}
+}
+
+func newFXConfig() fx.Config {
 	baseURL := os.Getenv("FX_API_URL")
 	if baseURL == "" {
 		baseURL = "https://api.frankfurter.dev"
 	}
 
-	return FXConfig{
-		BaseURL: baseURL,
-		Timeout: durationFromEnv("FX_TIMEOUT", 5*time.Second),
-		TTL:     durationFromEnv("FX_CACHE_TTL", 12*time.Hour),
+	offline, _ := strconv.ParseBool(os.Getenv("FX_OFFLINE"))
+
+	return fx.Config{
+		BaseURL:    baseURL,
+		Timeout:    durationFromEnv("FX_TIMEOUT", 5*time.Second),
+		TTL:        durationFromEnv("FX_CACHE_TTL", 12*time.Hour),
+		RatesFile:  os.Getenv("FX_RATES_FILE"),
+		Offline:    offline,
+		MaxRateAge: durationFromEnv("FX_MAX_RATE_AGE", 72*time.Hour),
 	}
 }
 
@@ -75,6 +236,20 @@ func durationFromEnv(name string, fallback time.Duration) time.Duration {
 	return duration
 }
 
+func floatFromEnv(name string, fallback float64) float64 {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil || parsed <= 0 {
+		return fallback
+	}
+
+	return parsed
+}
+
 func newNatsConfig() NatsConfig {
 	host := os.Getenv("NATS_HOST")
 	port := os.Getenv("NATS_PORT")
@@ -109,6 +284,15 @@ func newStockServiceConfig() StockServiceConfig {
 	}
 }
 
+func newSecurityServiceConfig() SecurityServiceConfig {
+	host := os.Getenv("SECURITY_SERVICE_HOST")
+	port := os.Getenv("SECURITY_SERVICE_PORT")
+
+	return SecurityServiceConfig{
+		URL: fmt.Sprintf("%s:%s", host, port),
+	}
+}
+
 func newPortfolioServiceConfig() PortfolioServiceConfig {
 	host := os.Getenv("PORTFOLIO_SERVICE_HOST")
 	port := os.Getenv("PORTFOLIO_SERVICE_PORT")
@@ -117,3 +301,12 @@ func newPortfolioServiceConfig() PortfolioServiceConfig {
 		URL: fmt.Sprintf("%s:%s", host, port),
 	}
 }
+
+func newOrderServiceConfig() OrderServiceConfig {
+	host := os.Getenv("ORDER_SERVICE_HOST")
+	port := os.Getenv("ORDER_SERVICE_PORT")
+
+	return OrderServiceConfig{
+		URL: fmt.Sprintf("%s:%s", host, port),
+	}
+}
@@ -0,0 +1,213 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+
+	basepb "fafnir/shared/pb/base"
+	orderpb "fafnir/shared/pb/order"
+	portfoliopb "fafnir/shared/pb/portfolio"
+
+	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const notRestingReason = "Order is being executed or is no longer resting"
+
+// subscribeToAmendedOrders applies amendments to resting orders. The book is shared by all engines, so
+// whichever engine receives an amendment applies it; one that finds the order in flight rejects it.
+func (e *Engine) subscribeToAmendedOrders() error {
+	_, err := e.natsClient.QueueSubscribe("orders.amended", "trade-engine", "trade-engine-amended", func(msg *nats.Msg) {
+		var event orderpb.OrderAmendedEvent
+		if err := proto.Unmarshal(msg.Data, &event); err != nil {
+			e.logger.Error(context.Background(), "Discarding malformed amendment event", "error", err)
+			_ = msg.Term()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		defer cancel()
+
+		if err := e.amendOrder(ctx, &event); err != nil {
+			e.logger.Error(ctx, "Amendment failed; scheduling retry", "order_id", event.OrderId, "amendment_id", event.AmendmentId, "error", err)
+			_ = msg.NakWithDelay(retryDelay)
+			return
+		}
+
+		_ = msg.Ack()
+	})
+
+	return err
+}
+
+// amendOrder changes the quantity and limit price of a resting order once the amended order passes the risk
+// checks and its hold could be resized to match. The hold grows before the book changes and shrinks after it,
+// so the order never rests with more than its hold covers. A new price or a larger quantity sends the order to
+// the back of the queue at its price; a smaller quantity keeps its place.
+func (e *Engine) amendOrder(ctx context.Context, event *orderpb.OrderAmendedEvent) error {
+	order, previous, err := e.orderBook.Resting(ctx, event.Symbol, event.OrderId)
+	if err != nil {
+		return err
+	}
+	if order == nil {
+		return e.publishAmendmentResolved(ctx, event, notRestingReason)
+	}
+
+	amended := proto.Clone(order).(*orderpb.OrderCreatedEvent)
+	amended.Quantity = roundQuantity(event.Quantity)
+	if hasLimitPrice(order) {
+		amended.Price = event.Price
+	}
+	if proto.Equal(amended, order) {
+		// redelivered after the amendment was applied
+		return e.publishAmendmentResolved(ctx, event, "")
+	}
+	if remainingQuantity(amended) <= 0 {
+		return e.publishAmendmentResolved(ctx, event, "Quantity must stay above the filled quantity")
+	}
+	if err := validateOrder(amended); err != nil {
+		return e.publishAmendmentResolved(ctx, event, err.Error())
+	}
+
+	quote, err := e.getQuote(ctx, order.Symbol)
+	if err != nil {
+		return err
+	}
+	violation, err := e.checkRisk(ctx, amended, quote)
+	if err != nil {
+		return err
+	}
+	if violation != nil {
+		return e.publishAmendmentResolved(ctx, event, violation.Reason)
+	}
+
+	hold, currency, reason, err := e.holdRequest(ctx, amended, quote)
+	if err != nil {
+		return err
+	}
+	if reason != "" {
+		return e.publishAmendmentResolved(ctx, event, reason)
+	}
+	// the hold moves by the difference to what the order reserves as it rests, sized against the same quote
+	current, _, reason, err := e.holdRequest(ctx, order, quote)
+	if err != nil {
+		return err
+	}
+	if reason != "" {
+		return e.publishAmendmentResolved(ctx, event, reason)
+	}
+	change := &portfoliopb.ResizeHoldRequest{
+		OrderId:        order.OrderId,
+		QuantityChange: hold.Quantity - current.Quantity,
+		AmountChange:   hold.Amount - current.Amount,
+	}
+
+	if amended.Price != order.Price || remainingQuantity(amended) > remainingQuantity(order) {
+		amended.QueuedAt = timestamppb.Now()
+	}
+
+	if change.QuantityChange <= 0 && change.AmountChange <= 0 {
+		return e.shrinkAmended(ctx, event, amended, previous, change, hold)
+	}
+
+	reason, err = e.resizeHold(ctx, change, hold, currency)
+	if err != nil {
+		return err
+	}
+	if reason != "" {
+		return e.publishAmendmentResolved(ctx, event, reason)
+	}
+
+	applied, err := e.orderBook.Amend(ctx, amended, previous)
+	if err != nil || !applied {
+		// the order was claimed after it was read, so what the resize added goes again; fills may have
+		// consumed from the hold since, which taking off the same change leaves alone
+		revert := &portfoliopb.ResizeHoldRequest{
+			OrderId:        change.OrderId,
+			QuantityChange: -change.QuantityChange,
+			AmountChange:   -change.AmountChange,
+		}
+		if _, revertErr := e.resizeHold(ctx, revert, hold, currency); revertErr != nil {
+			e.logger.Error(ctx, "Failed to restore hold of unamended order", "order_id", order.OrderId, "error", revertErr)
+		}
+		if err != nil {
+			return err
+		}
+		return e.publishAmendmentResolved(ctx, event, notRestingReason)
+	}
+
+	return e.publishAmendmentResolved(ctx, event, "")
+}
+
+// shrinkAmended applies an amendment that reserves less than the order does now
+func (e *Engine) shrinkAmended(ctx context.Context, event *orderpb.OrderAmendedEvent, amended *orderpb.OrderCreatedEvent, previous string, change *portfoliopb.ResizeHoldRequest, hold *portfoliopb.ReserveHoldRequest) error {
+	applied, err := e.orderBook.Amend(ctx, amended, previous)
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return e.publishAmendmentResolved(ctx, event, notRestingReason)
+	}
+
+	// a hold left larger than the order is only released later, so the amendment stands either way
+	if reason, err := e.resizeHold(ctx, change, hold, ""); err != nil || reason != "" {
+		e.logger.Error(ctx, "Failed to shrink hold of amended order", "order_id", amended.OrderId, "reason", reason, "error", err)
+	}
+
+	return e.publishAmendmentResolved(ctx, event, "")
+}
+
+// resizeHold moves what the order's hold reserves by change, towards the size of hold; a non-empty reason means
+// it cannot grow that far
+func (e *Engine) resizeHold(ctx context.Context, change *portfoliopb.ResizeHoldRequest, hold *portfoliopb.ReserveHoldRequest, currency string) (string, error) {
+	resp, err := e.portfolioClient.ResizeHold(ctx, change)
+	if err != nil {
+		return "", fmt.Errorf("resize hold: %w", err)
+	}
+
+	switch resp.GetCode() {
+	case basepb.ErrorCode_OK:
+		return "", nil
+	case basepb.ErrorCode_NOT_FOUND:
+		return notRestingReason, nil
+	case basepb.ErrorCode_FAILED_PRECONDITION:
+		return insufficientHoldReason(hold, currency), nil
+	default:
+		return "", fmt.Errorf("resize hold: portfolio service returned %s", resp.GetCode().String())
+	}
+}
+
+func hasLimitPrice(order *orderpb.OrderCreatedEvent) bool {
+	return order.Type == orderpb.OrderType_ORDER_TYPE_LIMIT || order.Type == orderpb.OrderType_ORDER_TYPE_STOP_LIMIT
+}
+
+// publishAmendmentResolved answers an amendment; an empty reason means it was applied
+func (e *Engine) publishAmendmentResolved(ctx context.Context, event *orderpb.OrderAmendedEvent, reason string) error {
+	resolved := &orderpb.OrderAmendmentResolvedEvent{
+		AmendmentId: event.AmendmentId,
+		OrderId:     event.OrderId,
+		UserId:      event.UserId,
+		Symbol:      event.Symbol,
+		Quantity:    event.Quantity,
+		Price:       event.Price,
+		Applied:     reason == "",
+		Reason:      reason,
+		ResolvedAt:  timestamppb.Now(),
+	}
+
+	data, err := proto.Marshal(resolved)
+	if err != nil {
+		return fmt.Errorf("marshal amendment resolved event: %w", err)
+	}
+	subject := "orders.amendment_applied"
+	if reason != "" {
+		subject = "orders.amendment_rejected"
+	}
+	if _, err := e.natsClient.PublishWithID(subject, event.AmendmentId+":resolved", data); err != nil {
+		return fmt.Errorf("publish amendment resolved event: %w", err)
+	}
+
+	e.logger.Info(ctx, "Order amendment resolved", "order_id", event.OrderId, "amendment_id", event.AmendmentId, "applied", reason == "", "reason", reason)
+	return nil
+}
@@ -0,0 +1,106 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	basepb "fafnir/shared/pb/base"
+	orderpb "fafnir/shared/pb/order"
+	"fafnir/trade-engine/internal/cache"
+)
+
+// how long a cancel waits for an order that has not reached the engine before it is given up
+const cancelTombstoneTTL = 24 * time.Hour
+
+// holdCancel keeps the cancel of an order that is neither in the book nor in flight until its created event
+// arrives. It keeps nothing and reports false for an order that has closed already, whose cancel is denied.
+func (e *Engine) holdCancel(ctx context.Context, request *orderpb.OrderCancelRequestedEvent) (bool, error) {
+	closed, err := e.orderClosed(ctx, request)
+	if err != nil || closed {
+		return false, err
+	}
+
+	if err := e.cancels.Record(ctx, &cache.Cancel{
+		OrderID:     request.OrderId,
+		Reason:      request.Reason,
+		RequestedAt: request.RequestedAt.AsTime(),
+	}); err != nil {
+		return false, err
+	}
+
+	e.logger.Info(ctx, "Order not accepted yet; keeping its cancel until it is", "order_id", request.OrderId)
+	return true, nil
+}
+
+// orderClosed asks order-service whether an order has reached a final status
+func (e *Engine) orderClosed(ctx context.Context, request *orderpb.OrderCancelRequestedEvent) (bool, error) {
+	resp, err := e.orderClient.GetOrderById(ctx, &orderpb.GetOrderByIdRequest{
+		OrderId: request.OrderId,
+		UserId:  request.UserId,
+	})
+	if err != nil {
+		return false, fmt.Errorf("get order %s: %w", request.OrderId, err)
+	}
+	if resp.GetCode() != basepb.ErrorCode_OK || resp.GetOrder() == nil {
+		return false, fmt.Errorf("get order %s: order service returned %s", request.OrderId, resp.GetCode().String())
+	}
+
+	switch resp.GetOrder().GetStatus() {
+	case orderpb.OrderStatus_ORDER_STATUS_FILLED, orderpb.OrderStatus_ORDER_STATUS_CANCELED,
+		orderpb.OrderStatus_ORDER_STATUS_REJECTED, orderpb.OrderStatus_ORDER_STATUS_EXPIRED:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// cancelBeforeAccepting confirms a cancel that reached the engine before the order did, in place of working the
+// order. The legs of a one-cancels-other group arrive together and are cancelled together.
+func (e *Engine) cancelBeforeAccepting(ctx context.Context, order *orderpb.OrderCreatedEvent) (bool, error) {
+	orders := []*orderpb.OrderCreatedEvent{order}
+	if len(order.OcoOrderIds) > 0 {
+		orders = append(orders, order.AttachedOrders...)
+	}
+
+	var pending *cache.Cancel
+	for _, leg := range orders {
+		cancel, err := e.cancels.Pending(ctx, leg.OrderId)
+		if err != nil {
+			return false, err
+		}
+		if cancel != nil {
+			pending = cancel
+			break
+		}
+	}
+	if pending == nil {
+		return false, nil
+	}
+
+	for _, leg := range orders {
+		if err := e.publishCancelledEvent(ctx, leg, pending.Reason); err != nil {
+			return false, err
+		}
+	}
+	for _, leg := range orders {
+		if err := e.cancels.Done(ctx, leg.OrderId); err != nil {
+			// the order is cancelled already, the sweep forgets the tombstone later
+			e.logger.Error(ctx, "Failed to drop confirmed cancel", "order_id", leg.OrderId, "error", err)
+		}
+	}
+
+	return true, nil
+}
+
+// sweepCancels forgets cancels whose order never reached the engine
+func (e *Engine) sweepCancels(ctx context.Context) {
+	swept, err := e.cancels.Sweep(ctx, time.Now().Add(-cancelTombstoneTTL))
+	if err != nil {
+		e.logger.Error(ctx, "Failed to sweep pending cancels", "error", err)
+		return
+	}
+	if swept > 0 {
+		e.logger.Info(ctx, "Gave up on cancels whose order never arrived", "cancels", swept)
+	}
+}
@@ -4,19 +4,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"sync"
 	"time"
 
 	basepb "fafnir/shared/pb/base"
 	orderpb "fafnir/shared/pb/order"
 	portfoliopb "fafnir/shared/pb/portfolio"
+	securitypb "fafnir/shared/pb/security"
 	stockpb "fafnir/shared/pb/stock"
+	"fafnir/shared/pkg/fees"
+	"fafnir/shared/pkg/fx"
 	"fafnir/shared/pkg/logger"
 	natsclient "fafnir/shared/pkg/nats"
 	"fafnir/shared/pkg/redis"
+	"fafnir/shared/pkg/risk"
 	"fafnir/trade-engine/internal/cache"
 	"fafnir/trade-engine/internal/config"
-	"fafnir/trade-engine/internal/fx"
 
 	"github.com/nats-io/nats.go"
 	"google.golang.org/grpc"
@@ -26,26 +30,62 @@ import (
 )
 
 const (
-	orderPollInterval = 5 * time.Second
-	requestTimeout    = 10 * time.Second
-	retryDelay        = 2 * time.Second
+	orderExpiryInterval = 15 * time.Second
+	requestTimeout      = 10 * time.Second
+	recoveryTimeout     = time.Minute
+	retryDelay          = 2 * time.Second
+	// how often a remainder that can no longer be retried from its event is offered back to the book
+	parkAttempts   = 3
+	parkRetryDelay = 250 * time.Millisecond
+	// the quote source recorded on fills against another user's resting order
+	internalQuoteSource = "internal"
+	// the Redis hash holding the last rate served per currency pair, shared by every engine
+	fxRatesKey = "engine:v1:fx-rates"
 )
 
+var errStaleQuote = errors.New("quote is too old to fill against")
+
 type Engine struct {
 	natsClient      *natsclient.NatsClient
 	stockClient     stockpb.StockServiceClient
 	portfolioClient portfoliopb.PortfolioServiceClient
+	orderClient     orderpb.OrderServiceClient
+	risk            *risk.Checker
 	fxProvider      fx.Provider
+	fees            fees.Schedule
+	pricing         priceModel
 	orderBook       *cache.OrderBook
+	membership      *cache.Membership
+	shards          *shardRing
+	halts           *cache.Halts
+	sessions        *marketSessions
+	brackets        *cache.Brackets
+	crosses         *cache.Crosses
+	cancels         *cache.Cancels
 	stockConn       *grpc.ClientConn
 	portfolioConn   *grpc.ClientConn
+	orderConn       *grpc.ClientConn
+	securityConn    *grpc.ClientConn
 	redisClient     *redis.Cache
+	execution       config.ExecutionConfig
+	recovery        config.RecoveryConfig
+	sharding        config.ShardingConfig
+	breaker         config.CircuitBreakerConfig
 	stopCh          chan struct{}
 	stopOnce        sync.Once
 	logger          *logger.Logger
 }
 
 func NewEngine(cfg *config.Config, log *logger.Logger) (*Engine, error) {
+	riskPolicy, err := risk.LoadPolicy(cfg.Risk.PolicyPath)
+	if err != nil {
+		return nil, err
+	}
+	pricing, err := newPriceModel(cfg.Execution)
+	if err != nil {
+		return nil, err
+	}
+
 	log.Info(context.Background(), "Engine connecting to NATS", "url", cfg.NATS.URL)
 	nc, err := natsclient.New(cfg.NATS.URL, log)
 	if err != nil {
@@ -65,37 +105,124 @@ func NewEngine(cfg *config.Config, log *logger.Logger) (*Engine, error) {
 		return nil, fmt.Errorf("connect to portfolio service: %w", err)
 	}
 
+	orderConn, err := grpc.NewClient(cfg.OrderService.URL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		portfolioConn.Close()
+		stockConn.Close()
+		nc.Close()
+		return nil, fmt.Errorf("connect to order service: %w", err)
+	}
+
+	securityConn, err := grpc.NewClient(cfg.Security.URL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		orderConn.Close()
+		portfolioConn.Close()
+		stockConn.Close()
+		nc.Close()
+		return nil, fmt.Errorf("connect to security service: %w", err)
+	}
+
 	redisClient, err := redis.New(cfg.Cache, log)
 	if err != nil {
+		securityConn.Close()
+		orderConn.Close()
 		portfolioConn.Close()
 		stockConn.Close()
 		nc.Close()
 		return nil, fmt.Errorf("connect to Redis: %w", err)
 	}
 
+	fxProvider, err := fx.New(cfg.FX, fx.NewRedisStore(redisClient, fxRatesKey))
+	if err != nil {
+		redisClient.Close()
+		securityConn.Close()
+		orderConn.Close()
+		portfolioConn.Close()
+		stockConn.Close()
+		nc.Close()
+		return nil, err
+	}
+
 	return &Engine{
 		natsClient:      nc,
 		stockClient:     stockpb.NewStockServiceClient(stockConn),
 		portfolioClient: portfoliopb.NewPortfolioServiceClient(portfolioConn),
-		fxProvider:      fx.NewFrankfurter(cfg.FX.BaseURL, cfg.FX.Timeout, cfg.FX.TTL),
-		orderBook:       cache.NewOrderBook(redisClient),
+		orderClient:     orderpb.NewOrderServiceClient(orderConn),
+		risk:            risk.NewChecker(riskPolicy, risk.NewSecurityRoles(securitypb.NewSecurityServiceClient(securityConn))),
+		fxProvider:      fxProvider,
+		fees:            cfg.Fees,
+		pricing:         pricing,
+		orderBook:       cache.NewOrderBook(redisClient, cfg.Recovery.EngineID, cfg.Recovery.LeaseTTL),
+		membership:      cache.NewMembership(redisClient, cfg.Recovery.EngineID, cfg.Sharding.MemberTTL),
+		shards:          newShardRing(cfg.Recovery.EngineID),
+		halts:           cache.NewHalts(redisClient),
+		sessions:        newMarketSessions(),
+		brackets:        cache.NewBrackets(redisClient),
+		crosses:         cache.NewCrosses(redisClient),
+		cancels:         cache.NewCancels(redisClient),
 		stockConn:       stockConn,
 		portfolioConn:   portfolioConn,
+		orderConn:       orderConn,
+		securityConn:    securityConn,
 		redisClient:     redisClient,
+		execution:       cfg.Execution,
+		recovery:        cfg.Recovery,
+		sharding:        cfg.Sharding,
+		breaker:         cfg.Breaker,
 		stopCh:          make(chan struct{}),
 		logger:          log,
 	}, nil
 }
 
 func (e *Engine) Start() error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	migrated, err := e.orderBook.MigrateLegacy(ctx)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("migrate legacy order book: %w", err)
+	}
+	if migrated > 0 {
+		e.logger.Info(context.Background(), "Migrated resting orders into the indexed order book", "orders", migrated)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), recoveryTimeout)
+	err = e.restoreOrderBook(ctx)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("restore order book: %w", err)
+	}
+
+	if err := e.joinShards(); err != nil {
+		return fmt.Errorf("join trade engine shards: %w", err)
+	}
+	if err := e.subscribeToShard(); err != nil {
+		return fmt.Errorf("subscribe to shard: %w", err)
+	}
 	if err := e.subscribeToCreatedOrders(); err != nil {
 		return fmt.Errorf("subscribe to created orders: %w", err)
 	}
 	if err := e.subscribeToCancelledOrders(); err != nil {
 		return fmt.Errorf("subscribe to cancelled orders: %w", err)
 	}
+	if err := e.subscribeToCancelRequests(); err != nil {
+		return fmt.Errorf("subscribe to cancel requests: %w", err)
+	}
+	if err := e.subscribeToAmendedOrders(); err != nil {
+		return fmt.Errorf("subscribe to amended orders: %w", err)
+	}
+	if err := e.subscribeToFilledOrders(); err != nil {
+		return fmt.Errorf("subscribe to filled orders: %w", err)
+	}
+	if err := e.subscribeToClosedOrders(); err != nil {
+		return fmt.Errorf("subscribe to closed orders: %w", err)
+	}
+	if err := e.subscribeToQuotes(); err != nil {
+		return fmt.Errorf("subscribe to quote updates: %w", err)
+	}
 
+	go e.heartbeat()
 	go e.pollOrders()
+	go e.expireOrders()
 	<-e.stopCh
 
 	return nil
@@ -105,12 +232,15 @@ func (e *Engine) Stop() error {
 	var closeErr error
 	e.stopOnce.Do(func() {
 		close(e.stopCh)
+		e.leaveShards()
 		e.natsClient.Close()
 
 		closeErr = errors.Join(
 			e.fxProvider.Close(),
 			e.stockConn.Close(),
 			e.portfolioConn.Close(),
+			e.orderConn.Close(),
+			e.securityConn.Close(),
 			e.redisClient.Close(),
 		)
 	})
@@ -118,6 +248,8 @@ func (e *Engine) Stop() error {
 	return closeErr
 }
 
+// subscribeToCreatedOrders load balances created orders across engines through the queue group and hands
+// each one to the engine that owns its symbol, acking only once the owner has processed it
 func (e *Engine) subscribeToCreatedOrders() error {
 	_, err := e.natsClient.QueueSubscribe("orders.created", "trade-engine", "trade-engine-durable", func(msg *nats.Msg) {
 		var event orderpb.OrderCreatedEvent
@@ -127,10 +259,21 @@ func (e *Engine) subscribeToCreatedOrders() error {
 			return
 		}
 
+		if owner := e.shards.owner(event.Symbol); owner != e.recovery.EngineID {
+			if err := e.forwardOrder(owner, msg.Data); err != nil {
+				e.logger.Error(context.Background(), "Failed to hand order to its shard; scheduling retry", "order_id", event.OrderId, "symbol", event.Symbol, "error", err)
+				_ = msg.NakWithDelay(retryDelay)
+				return
+			}
+
+			_ = msg.Ack()
+			return
+		}
+
 		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
 		defer cancel()
 
-		if err := e.processOrder(ctx, &event); err != nil {
+		if err := e.acceptOrder(ctx, &event); err != nil {
 			e.logger.Error(ctx, "Order processing failed; scheduling retry", "order_id", event.OrderId, "error", err)
 			_ = msg.NakWithDelay(retryDelay)
 			return
@@ -142,6 +285,21 @@ func (e *Engine) subscribeToCreatedOrders() error {
 	return err
 }
 
+// acceptOrder processes a created order on the engine that owns its symbol
+func (e *Engine) acceptOrder(ctx context.Context, event *orderpb.OrderCreatedEvent) error {
+	// after a rebuild the book can already hold orders whose created event is still queued
+	resting, err := e.orderBook.Contains(ctx, event.Symbol, event.OrderId)
+	if err != nil {
+		return fmt.Errorf("check order book: %w", err)
+	}
+	if resting {
+		e.logger.Info(ctx, "Order already in the book; skipping created event", "order_id", event.OrderId)
+		return nil
+	}
+
+	return e.processOrder(ctx, event)
+}
+
 func (e *Engine) subscribeToCancelledOrders() error {
 	_, err := e.natsClient.QueueSubscribe("orders.cancelled", "trade-engine", "trade-engine-cancelled", func(msg *nats.Msg) {
 		var event orderpb.OrderCancelledEvent
@@ -159,6 +317,97 @@ func (e *Engine) subscribeToCancelledOrders() error {
 			_ = msg.NakWithDelay(retryDelay)
 			return
 		}
+		// a bracket entry cancelled after filling in part still protects the filled part with its exits
+		if event.FilledQuantity >= minFillQuantity {
+			e.settleBracket(ctx, msg, event.OrderId, event.FilledQuantity)
+			return
+		}
+		// one cancelled without filling has its held exits cancelled by order-service
+		if err := e.brackets.Unstage(ctx, event.OrderId); err != nil {
+			e.logger.Error(ctx, "Failed to unstage cancelled bracket", "order_id", event.OrderId, "error", err)
+			_ = msg.NakWithDelay(retryDelay)
+			return
+		}
+
+		_ = msg.Ack()
+	})
+
+	return err
+}
+
+// subscribeToCancelRequests answers the cancels users ask for. An order resting in the book is taken out and the
+// cancel confirmed on orders.cancelled, after any fills it already had; an order in flight is retried until the
+// engine executing it is done; the cancel of an order the engine has not accepted yet is kept until it arrives;
+// and the cancel of an order that has closed is denied.
+func (e *Engine) subscribeToCancelRequests() error {
+	_, err := e.natsClient.QueueSubscribe("orders.cancel_requested", "trade-engine", "trade-engine-cancel-requested", func(msg *nats.Msg) {
+		var event orderpb.OrderCancelRequestedEvent
+		if err := proto.Unmarshal(msg.Data, &event); err != nil {
+			e.logger.Error(context.Background(), "Discarding malformed cancel request", "error", err)
+			_ = msg.Term()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		defer cancel()
+
+		order, inFlight, err := e.orderBook.Cancel(ctx, event.Symbol, event.OrderId)
+		if err != nil {
+			e.logger.Error(ctx, "Failed to cancel order", "order_id", event.OrderId, "error", err)
+			_ = msg.NakWithDelay(retryDelay)
+			return
+		}
+
+		switch {
+		case order != nil:
+			if err := e.publishCancelledEvent(ctx, order, event.Reason); err != nil {
+				// a redelivery would no longer find the order, so it goes back until the confirmation is out
+				e.logger.Error(ctx, "Failed to confirm cancel; returning order to the book", "order_id", event.OrderId, "error", err)
+				if err := e.orderBook.Add(ctx, order); err != nil {
+					e.logger.Error(ctx, "Failed to return order to the book", "order_id", event.OrderId, "error", err)
+				}
+				_ = msg.NakWithDelay(retryDelay)
+				return
+			}
+			// kept by an earlier delivery that missed the order on its way into the book
+			if err := e.cancels.Done(ctx, event.OrderId); err != nil {
+				e.logger.Error(ctx, "Failed to drop confirmed cancel", "order_id", event.OrderId, "error", err)
+			}
+		case inFlight:
+			e.logger.Info(ctx, "Order is executing; retrying cancel", "order_id", event.OrderId)
+			_ = msg.NakWithDelay(retryDelay)
+			return
+		default:
+			pending, err := e.cancels.Pending(ctx, event.OrderId)
+			if err != nil {
+				e.logger.Error(ctx, "Failed to read pending cancel", "order_id", event.OrderId, "error", err)
+				_ = msg.NakWithDelay(retryDelay)
+				return
+			}
+			if pending != nil {
+				// accepting the order confirms the cancel
+				break
+			}
+
+			held, err := e.holdCancel(ctx, &event)
+			if err != nil {
+				e.logger.Error(ctx, "Failed to keep cancel of unaccepted order", "order_id", event.OrderId, "error", err)
+				_ = msg.NakWithDelay(retryDelay)
+				return
+			}
+			if held {
+				// an order being accepted right now can reach the book after it looked for the cancel,
+				// so the request comes back once to look for the order again
+				_ = msg.NakWithDelay(retryDelay)
+				return
+			}
+
+			if err := e.publishCancelRejected(ctx, &event, "Order is already closed"); err != nil {
+				e.logger.Error(ctx, "Failed to deny cancel", "order_id", event.OrderId, "error", err)
+				_ = msg.NakWithDelay(retryDelay)
+				return
+			}
+		}
 
 		_ = msg.Ack()
 	})
@@ -166,17 +415,163 @@ func (e *Engine) subscribeToCancelledOrders() error {
 	return err
 }
 
+func (e *Engine) publishCancelRejected(ctx context.Context, request *orderpb.OrderCancelRequestedEvent, reason string) error {
+	event := &orderpb.OrderCancelRejectedEvent{
+		OrderId:    request.OrderId,
+		UserId:     request.UserId,
+		Symbol:     request.Symbol,
+		Reason:     reason,
+		RejectedAt: timestamppb.Now(),
+	}
+
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal cancel rejected event: %w", err)
+	}
+	// a user can ask again once a cancel is denied, so each request gets its own answer
+	msgID := fmt.Sprintf("%s:cancel_rejected:%d", request.OrderId, request.RequestedAt.AsTime().UnixNano())
+	if _, err := e.natsClient.PublishWithID("orders.cancel_rejected", msgID, data); err != nil {
+		return fmt.Errorf("publish cancel rejected event: %w", err)
+	}
+
+	e.logger.Info(ctx, "Cancel denied", "order_id", request.OrderId, "reason", reason)
+	return nil
+}
+
+// subscribeToQuotes evaluates the resting orders of this engine's symbols as soon as stock-service refreshes
+// their quote. Quote events are not persisted, so anything missed here is picked up by the next poll.
+func (e *Engine) subscribeToQuotes() error {
+	_, err := e.natsClient.SubscribeEphemeral("quotes.>", func(msg *nats.Msg) {
+		var event stockpb.QuoteUpdatedEvent
+		if err := proto.Unmarshal(msg.Data, &event); err != nil || event.Quote == nil {
+			e.logger.Error(context.Background(), "Discarding malformed quote event", "subject", msg.Subject, "error", err)
+			return
+		}
+		if !positiveFinite(event.Quote.LastPrice) || !e.shards.owns(event.Quote.Symbol) {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		defer cancel()
+
+		e.evaluateQuote(ctx, event.Quote)
+	})
+
+	return err
+}
+
 func (e *Engine) processOrder(ctx context.Context, order *orderpb.OrderCreatedEvent) error {
+	if cancelled, err := e.cancelBeforeAccepting(ctx, order); err != nil || cancelled {
+		return err
+	}
+	if len(order.OcoOrderIds) > 0 {
+		return e.acceptLinkedOrders(ctx, order)
+	}
+	if isBracketEntry(order) {
+		// the exits wait outside the book until the entry fills, expires or is rejected
+		if err := e.brackets.Stage(ctx, order); err != nil {
+			return err
+		}
+		order.AttachedOrders = nil
+	}
+
 	if err := validateOrder(order); err != nil {
 		return e.publishRejectedEvent(ctx, order, err.Error())
 	}
+	if isExpired(order, time.Now()) {
+		return e.publishExpiredEvent(ctx, order, "Order expired before it could be evaluated")
+	}
 
 	quote, err := e.getQuote(ctx, order.Symbol)
 	if err != nil {
 		return err
 	}
+	if order.Type == orderpb.OrderType_ORDER_TYPE_TRAILING_STOP {
+		order = trailFrom(order, quote.LastPrice)
+	}
+
+	halt, err := e.observeQuote(ctx, quote)
+	if err != nil {
+		return err
+	}
+	if halt != nil && !restsThroughHalt(order) {
+		return e.publishRejectedEvent(ctx, order, haltReason(halt, order.Symbol))
+	}
+
+	open, err := e.marketOpen(ctx, quote)
+	if err != nil {
+		return err
+	}
+	if !open && isImmediateOrder(order) {
+		return e.publishExpiredEvent(ctx, order, "Market is closed")
+	}
+
+	violation, err := e.checkRisk(ctx, order, quote)
+	if err != nil {
+		return err
+	}
+	if violation != nil {
+		return e.publishRejection(ctx, order, violation.Code, violation.Reason)
+	}
+
+	if halt != nil {
+		// the order waits in the book untouched until trading resumes
+		if err := e.orderBook.Add(ctx, order); err != nil {
+			return fmt.Errorf("queue order during halt: %w", err)
+		}
+
+		e.logger.Info(ctx, "Order queued while trading is halted", "order_id", order.OrderId, "symbol", order.Symbol, "halt", halt.Reason)
+		return nil
+	}
+	if !open {
+		// market orders included: the book works them against the first quote of the regular session
+		if err := e.orderBook.Add(ctx, order); err != nil {
+			return fmt.Errorf("queue order outside market hours: %w", err)
+		}
+
+		e.logger.Info(ctx, "Order queued until the market opens", "order_id", order.OrderId, "symbol", order.Symbol)
+		return nil
+	}
+
+	if isStopOrder(order) {
+		if !stopTriggered(order, quote.LastPrice) {
+			if err := e.orderBook.Add(ctx, order); err != nil {
+				return fmt.Errorf("queue stop order: %w", err)
+			}
+
+			e.logger.Info(ctx, "Stop order parked", "order_id", order.OrderId, "symbol", order.Symbol, "stop_price", order.StopPrice)
+			return nil
+		}
+
+		order = activateStop(order)
+		e.logger.Info(ctx, "Stop order triggered on arrival", "order_id", order.OrderId, "symbol", order.Symbol, "last_price", quote.LastPrice)
+	}
+
+	order, done, err := e.crossInternally(ctx, order)
+	if err != nil || done {
+		return err
+	}
 
+	if err := e.routeToQuote(ctx, order, quote); err != nil {
+		if order.FillCount == 0 {
+			return err
+		}
+
+		// part of the order already traded inside the book, and a redelivery would trade it again
+		e.logger.Error(ctx, "Order remainder failed after internal fills", "order_id", order.OrderId, "remaining", remainingQuantity(order), "error", err)
+		e.parkRemainder(ctx, order)
+	}
+
+	return nil
+}
+
+// routeToQuote fills an order against the external quote, or queues it until the quote makes it marketable
+// (or, for a stale quote, until a fresh one arrives)
+func (e *Engine) routeToQuote(ctx context.Context, order *orderpb.OrderCreatedEvent, quote *stockpb.StockQuote) error {
 	if evaluateOrder(order, quote.LastPrice) == decisionWait {
+		if isImmediateOrder(order) {
+			return e.publishExpiredEvent(ctx, order, "Limit price not marketable on arrival")
+		}
 		if err := e.orderBook.Add(ctx, order); err != nil {
 			return fmt.Errorf("queue limit order: %w", err)
 		}
@@ -185,11 +580,189 @@ func (e *Engine) processOrder(ctx context.Context, order *orderpb.OrderCreatedEv
 		return nil
 	}
 
-	return e.executeAtPrice(ctx, order, quote.LastPrice)
+	err := e.executeAtPrice(ctx, order, quote)
+	if !errors.Is(err, errStaleQuote) {
+		return err
+	}
+	if isImmediateOrder(order) {
+		return e.publishExpiredEvent(ctx, order, "No current quote to fill against")
+	}
+	if err := e.orderBook.Add(ctx, order); err != nil {
+		return fmt.Errorf("queue order on stale quote: %w", err)
+	}
+
+	e.logger.Info(ctx, "Quote is stale; order queued", "order_id", order.OrderId, "symbol", order.Symbol, "error", err)
+	return nil
+}
+
+// crossInternally trades an incoming order against other users' resting limit orders at the resting price.
+// It returns the order with those fills applied and whether the order needs no further handling.
+func (e *Engine) crossInternally(ctx context.Context, order *orderpb.OrderCreatedEvent) (*orderpb.OrderCreatedEvent, bool, error) {
+	if order.TimeInForce == orderpb.TimeInForce_TIME_IN_FORCE_FOK {
+		// fill or kill is an all-or-nothing decision made against the quote alone
+		return order, false, nil
+	}
+
+	book, err := e.orderBook.LimitOrders(ctx, order.Symbol, oppositeSide(order.Side), crossingLimit(order))
+	if err != nil {
+		return nil, false, err
+	}
+
+	traded := false
+	stop := func(err error) (*orderpb.OrderCreatedEvent, bool, error) {
+		if !traded {
+			return nil, false, err
+		}
+
+		e.logger.Error(ctx, "Internal matching stopped early", "order_id", order.OrderId, "filled", order.FilledQuantity, "error", err)
+		return order, false, nil
+	}
+
+	for _, resting := range crossingCandidates(order, book, time.Now()) {
+		claimed, err := e.orderBook.Claim(ctx, resting.Symbol, resting.OrderId)
+		if err != nil {
+			return stop(err)
+		}
+		if !claimed {
+			continue
+		}
+
+		quantity := math.Min(remainingQuantity(order), remainingQuantity(resting))
+		incomingFill, reason, err := e.prepareFill(ctx, order, quantity, resting.Price)
+		if err != nil || reason != "" {
+			e.parkRemainder(ctx, resting)
+			if err != nil {
+				return stop(err)
+			}
+			return order, true, e.publishRejectedEvent(ctx, order, reason)
+		}
+
+		restingFill, reason, err := e.prepareFill(ctx, resting, quantity, resting.Price)
+		if err != nil {
+			e.parkRemainder(ctx, resting)
+			return stop(err)
+		}
+		if reason != "" {
+			if err := e.publishRejectedEvent(ctx, resting, reason); err != nil {
+				e.logger.Error(ctx, "Failed to reject resting order", "order_id", resting.OrderId, "error", err)
+				e.parkRemainder(ctx, resting)
+			} else {
+				e.releaseClaim(ctx, resting)
+			}
+			continue
+		}
+
+		tradeID := fmt.Sprintf("%s:%d", order.OrderId, order.FillCount+1)
+		incomingFill.tradeID, incomingFill.counterpartyOrderID = tradeID, resting.OrderId
+		restingFill.tradeID, restingFill.counterpartyOrderID = tradeID, order.OrderId
+		tradedAt := time.Now()
+		incomingFill.quoteSource, incomingFill.quoteAsOf = internalQuoteSource, tradedAt
+		restingFill.quoteSource, restingFill.quoteAsOf = internalQuoteSource, tradedAt
+
+		cross := &cache.Cross{
+			TradeID: tradeID,
+			Legs: []cache.CrossLeg{
+				{Order: order, Fill: filledEvent(order, order.FillCount+1, incomingFill)},
+				{Order: resting, Fill: filledEvent(resting, resting.FillCount+1, restingFill)},
+			},
+			RecordedAt: tradedAt,
+		}
+		if err := e.crosses.Record(ctx, cross); err != nil {
+			e.parkRemainder(ctx, resting)
+			return stop(err)
+		}
+		// the trade is committed from here on: a fill that does not go out now is replayed from the record
+		e.publishCross(ctx, cross)
+		order = withFill(order, quantity)
+		traded = true
+
+		if rest := withFill(resting, quantity); remainingQuantity(rest) >= minFillQuantity {
+			e.parkRemainder(ctx, rest)
+		} else {
+			e.releaseClaim(ctx, resting)
+		}
+
+		e.logger.Info(ctx, "Orders crossed internally", "trade_id", tradeID, "order_id", order.OrderId, "counterparty_order_id", resting.OrderId, "quantity", quantity, "price", resting.Price)
+		if remainingQuantity(order) < minFillQuantity {
+			return order, true, nil
+		}
+	}
+
+	return order, false, nil
+}
+
+// publishCross publishes both fills of an internal trade and drops its record once they are out.
+// It reports whether they are; a record left behind is replayed by replayCrosses.
+func (e *Engine) publishCross(ctx context.Context, cross *cache.Cross) bool {
+	for _, leg := range cross.Legs {
+		if err := e.publishFill(ctx, leg.Order, leg.Fill); err != nil {
+			e.logger.Error(ctx, "Failed to publish internal trade; leaving it for replay", "trade_id", cross.TradeID, "order_id", leg.Order.OrderId, "error", err)
+			return false
+		}
+	}
+
+	if err := e.crosses.Done(ctx, cross.TradeID); err != nil {
+		e.logger.Error(ctx, "Failed to drop published internal trade", "trade_id", cross.TradeID, "error", err)
+	}
+	return true
+}
+
+// replayCrosses publishes the fills of internal trades of owned symbols that a failed publish or a crash left
+// behind. Trades recorded within the last request timeout may still be publishing and are left alone.
+func (e *Engine) replayCrosses(ctx context.Context) {
+	crosses, err := e.crosses.Pending(ctx, time.Now().Add(-requestTimeout))
+	if err != nil {
+		e.logger.Error(ctx, "Failed to list pending internal trades", "error", err)
+		return
+	}
+
+	for _, cross := range crosses {
+		if len(cross.Legs) == 0 || !e.shards.owns(cross.Legs[0].Order.Symbol) {
+			continue
+		}
+		if e.publishCross(ctx, cross) {
+			e.logger.Info(ctx, "Replayed internal trade", "trade_id", cross.TradeID)
+		}
+	}
+}
+
+// parkRemainder puts an order back into the book when it can no longer be retried from its event.
+// Immediate orders never rest, so their remainder expires instead.
+func (e *Engine) parkRemainder(ctx context.Context, order *orderpb.OrderCreatedEvent) {
+	if isImmediateOrder(order) {
+		if err := e.publishExpiredEvent(ctx, order, "Unfilled remainder of immediate-or-cancel order"); err != nil {
+			e.logger.Error(ctx, "Failed to expire remainder of immediate order", "order_id", order.OrderId, "remaining", remainingQuantity(order), "error", err)
+		}
+		return
+	}
+
+	var err error
+	for attempt := 1; attempt <= parkAttempts; attempt++ {
+		if err = e.orderBook.Add(ctx, order); err == nil {
+			return
+		}
+		e.logger.Warn(ctx, "Failed to return order to the book", "order_id", order.OrderId, "attempt", attempt, "error", err)
+
+		if attempt < parkAttempts {
+			select {
+			case <-ctx.Done():
+			case <-time.After(parkRetryDelay):
+			}
+		}
+	}
+
+	// nothing would ever work the remainder again, and a lease recovered later would bring the order back
+	// without its fills; end it instead, which releases its hold
+	e.logger.Error(ctx, "Giving up on returning order to the book; expiring the remainder", "order_id", order.OrderId, "remaining", remainingQuantity(order), "error", err)
+	if err := e.publishExpiredEvent(ctx, order, "Unfilled remainder could not be returned to the book"); err != nil {
+		e.logger.Error(ctx, "Failed to expire stranded remainder", "order_id", order.OrderId, "remaining", remainingQuantity(order), "error", err)
+		return
+	}
+	e.releaseClaim(ctx, order)
 }
 
 func (e *Engine) pollOrders() {
-	ticker := time.NewTicker(orderPollInterval)
+	ticker := time.NewTicker(e.execution.PollInterval)
 	defer ticker.Stop()
 
 	for {
@@ -206,11 +779,18 @@ func (e *Engine) pollOnce() {
 	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
 	defer cancel()
 
-	symbols, err := e.orderBook.Symbols(ctx)
+	active, err := e.orderBook.Symbols(ctx)
 	if err != nil {
 		e.logger.Error(ctx, "Failed to list queued order symbols", "error", err)
 		return
 	}
+
+	symbols := make([]string, 0, len(active))
+	for _, symbol := range active {
+		if e.shards.owns(symbol) {
+			symbols = append(symbols, symbol)
+		}
+	}
 	if len(symbols) == 0 {
 		return
 	}
@@ -226,72 +806,491 @@ func (e *Engine) pollOnce() {
 	}
 
 	for _, quote := range resp.Data {
-		orders, err := e.orderBook.ClaimMatched(ctx, quote.Symbol, quote.LastPrice)
+		e.evaluateQuote(ctx, quote)
+	}
+}
+
+// evaluateQuote claims and works the resting orders of one symbol that the quote makes marketable.
+// Nothing is claimed on a stale quote, while the symbol is halted or outside its exchange's regular session.
+func (e *Engine) evaluateQuote(ctx context.Context, quote *stockpb.StockQuote) {
+	if e.quoteStale(quote, time.Now()) {
+		e.logger.Debug(ctx, "Skipping stale quote", "symbol", quote.Symbol, "source", quote.Source, "as_of", quote.AsOf.AsTime())
+		return
+	}
+	open, err := e.marketOpen(ctx, quote)
+	if err != nil {
+		e.logger.Error(ctx, "Failed to check market hours", "symbol", quote.Symbol, "error", err)
+		return
+	}
+	if !open {
+		e.logger.Debug(ctx, "Skipping symbol outside market hours", "symbol", quote.Symbol)
+		return
+	}
+
+	halt, err := e.observeQuote(ctx, quote)
+	if err != nil {
+		e.logger.Error(ctx, "Failed to check trading halts", "symbol", quote.Symbol, "error", err)
+		return
+	}
+	if halt != nil {
+		e.logger.Debug(ctx, "Skipping halted symbol", "symbol", quote.Symbol, "halt", halt.Reason)
+		return
+	}
+
+	orders, err := e.orderBook.ClaimMatched(ctx, quote.Symbol, quote.LastPrice)
+	if err != nil {
+		e.logger.Error(ctx, "Failed to claim matching limit orders", "symbol", quote.Symbol, "error", err)
+		return
+	}
+
+	for _, order := range orders {
+		if e.handleClaimed(ctx, order, quote) {
+			e.releaseClaim(ctx, order)
+		}
+	}
+}
+
+// handleClaimed works an order claimed from the book and reports whether its lease can be released.
+// Orders that go back to the book release their lease themselves, and orders that could not be put back
+// keep it so that lease recovery returns them.
+func (e *Engine) handleClaimed(ctx context.Context, order *orderpb.OrderCreatedEvent, quote *stockpb.StockQuote) bool {
+	if isExpired(order, time.Now()) {
+		if err := e.publishExpiredEvent(ctx, order, "Time in force elapsed"); err != nil {
+			e.logger.Error(ctx, "Failed to expire claimed order; returning it to the queue", "order_id", order.OrderId, "error", err)
+			return e.requeue(ctx, order)
+		}
+		return true
+	}
+
+	if isStopOrder(order) {
+		order = activateStop(order)
+		e.logger.Info(ctx, "Stop order triggered", "order_id", order.OrderId, "symbol", order.Symbol, "last_price", quote.LastPrice)
+
+		crossed, done, err := e.crossInternally(ctx, order)
 		if err != nil {
-			e.logger.Error(ctx, "Failed to claim matching limit orders", "symbol", quote.Symbol, "error", err)
-			continue
+			e.logger.Error(ctx, "Failed to match triggered stop order internally; returning it to the queue", "order_id", order.OrderId, "error", err)
+			return e.requeue(ctx, order)
+		}
+		if done {
+			return true
 		}
+		order = crossed
 
-		for _, order := range orders {
-			if err := e.executeAtPrice(ctx, order, quote.LastPrice); err != nil {
-				e.logger.Error(ctx, "Matched limit order failed; returning it to the queue", "order_id", order.OrderId, "error", err)
-				if addErr := e.orderBook.Add(ctx, order); addErr != nil {
-					e.logger.Error(ctx, "Failed to requeue limit order", "order_id", order.OrderId, "error", addErr)
-				}
+		// a triggered stop limit whose limit is not yet marketable keeps resting as a plain limit order
+		if evaluateOrder(order, quote.LastPrice) == decisionWait {
+			return e.requeue(ctx, order)
+		}
+	}
+
+	if err := e.executeAtPrice(ctx, order, quote); err != nil {
+		if errors.Is(err, errStaleQuote) {
+			e.logger.Info(ctx, "Quote went stale; returning order to the queue", "order_id", order.OrderId, "error", err)
+		} else {
+			e.logger.Error(ctx, "Matched limit order failed; returning it to the queue", "order_id", order.OrderId, "error", err)
+		}
+		return e.requeue(ctx, order)
+	}
+	return true
+}
+
+// requeue puts a claimed order back into the book, which ends its lease in the same step
+func (e *Engine) requeue(ctx context.Context, order *orderpb.OrderCreatedEvent) bool {
+	if err := e.orderBook.Add(ctx, order); err != nil {
+		e.logger.Error(ctx, "Failed to requeue claimed order; leaving it leased for recovery", "order_id", order.OrderId, "error", err)
+	}
+	return false
+}
+
+func (e *Engine) releaseClaim(ctx context.Context, order *orderpb.OrderCreatedEvent) {
+	if err := e.orderBook.Release(ctx, order); err != nil {
+		e.logger.Error(ctx, "Failed to release claimed order", "order_id", order.OrderId, "error", err)
+	}
+}
+
+// restoreOrderBook returns orders a previous run of this engine left in flight, and rebuilds the book
+// from order-service when Redis has lost it (or a rebuild is forced). Orders other engines hold a lease on
+// are left to them, or to lease recovery once the lease runs out.
+func (e *Engine) restoreOrderBook(ctx context.Context) error {
+	recovered, err := e.orderBook.RecoverLeases(ctx, time.Now(), e.shards.owns, true)
+	if err != nil {
+		return err
+	}
+	if recovered > 0 {
+		e.logger.Info(ctx, "Returned in-flight orders to the book", "orders", recovered)
+	}
+
+	initialized, err := e.orderBook.Initialized(ctx)
+	if err != nil {
+		return err
+	}
+	if initialized && !e.recovery.RebuildOnStart {
+		return nil
+	}
+
+	resp, err := e.orderClient.ListOpenOrders(ctx, &orderpb.ListOpenOrdersRequest{})
+	if err != nil {
+		return fmt.Errorf("list open orders: %w", err)
+	}
+	if resp.GetCode() != basepb.ErrorCode_OK {
+		return fmt.Errorf("list open orders: order service returned %s", resp.GetCode().String())
+	}
+
+	var orders, held []*orderpb.OrderCreatedEvent
+	for _, open := range resp.Orders {
+		order := openOrderEvent(open)
+		if order.Status == orderpb.OrderStatus_ORDER_STATUS_HELD {
+			held = append(held, order)
+		} else if !isImmediateOrder(order) {
+			orders = append(orders, order)
+		}
+	}
+
+	// an order another engine is working comes back through lease recovery, with the fills it made
+	inFlight, err := e.orderBook.InFlight(ctx)
+	if err != nil {
+		return err
+	}
+
+	exits := linkOpenGroups(orders, held)
+	rebuilt := 0
+	for _, order := range orders {
+		if staged := exits[order.OrderId]; len(staged) > 0 {
+			entry := proto.Clone(order).(*orderpb.OrderCreatedEvent)
+			entry.AttachedOrders = staged
+			if err := e.brackets.Stage(ctx, entry); err != nil {
+				return err
 			}
 		}
+
+		// orders still in the book carry fill progress order-service may not have caught up with
+		resting, err := e.orderBook.Contains(ctx, order.Symbol, order.OrderId)
+		if err != nil {
+			return err
+		}
+		if resting || inFlight[order.Symbol+":"+order.OrderId] {
+			continue
+		}
+
+		if err := e.orderBook.Add(ctx, order); err != nil {
+			return err
+		}
+		rebuilt++
+	}
+
+	if err := e.orderBook.MarkInitialized(ctx, time.Now()); err != nil {
+		return err
+	}
+
+	e.logger.Info(ctx, "Rebuilt order book from order service", "open_orders", len(resp.Orders), "added", rebuilt)
+	return nil
+}
+
+func (e *Engine) expireOrders() {
+	ticker := time.NewTicker(orderExpiryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-e.stopCh:
+			return
+		case <-ticker.C:
+			e.expireOnce()
+			e.recoverLeasesOnce()
+		}
+	}
+}
+
+func (e *Engine) expireOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	e.cancelResolvedLegs(ctx)
+	e.replayCrosses(ctx)
+	e.sweepCancels(ctx)
+
+	orders, err := e.orderBook.ClaimExpired(ctx, time.Now(), e.shards.owns)
+	if err != nil {
+		e.logger.Error(ctx, "Failed to claim expired orders", "error", err)
+		return
+	}
+
+	for _, order := range orders {
+		if err := e.publishExpiredEvent(ctx, order, "Time in force elapsed"); err != nil {
+			e.logger.Error(ctx, "Failed to expire order; returning it to the queue", "order_id", order.OrderId, "error", err)
+			e.requeue(ctx, order)
+			continue
+		}
+		e.releaseClaim(ctx, order)
 	}
 }
 
-func (e *Engine) executeAtPrice(ctx context.Context, order *orderpb.OrderCreatedEvent, fillPrice float64) error {
-	if !positiveFinite(fillPrice) {
+// recoverLeasesOnce returns orders whose claiming engine stopped working on them, e.g. because it crashed
+func (e *Engine) recoverLeasesOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	recovered, err := e.orderBook.RecoverLeases(ctx, time.Now(), e.shards.owns, false)
+	if err != nil {
+		e.logger.Error(ctx, "Failed to recover expired order leases", "error", err)
+		return
+	}
+	if recovered > 0 {
+		e.logger.Info(ctx, "Returned orders with expired leases to the book", "orders", recovered)
+	}
+}
+
+func (e *Engine) executeAtPrice(ctx context.Context, order *orderpb.OrderCreatedEvent, quote *stockpb.StockQuote) error {
+	if !positiveFinite(quote.LastPrice) {
 		return fmt.Errorf("fill price must be greater than zero")
 	}
+	if e.quoteStale(quote, time.Now()) {
+		return fmt.Errorf("%w: %s quote from %s is as of %s", errStaleQuote, quote.Symbol, quote.Source, quote.AsOf.AsTime().Format(time.RFC3339))
+	}
 
-	metadata, err := e.getMetadata(ctx, order.Symbol)
+	remaining := remainingQuantity(order)
+	fillQuantity := fillableQuantity(remaining, quote.Volume, e.execution.VolumeParticipation)
+	if order.TimeInForce == orderpb.TimeInForce_TIME_IN_FORCE_FOK && fillQuantity < remaining {
+		return e.publishExpiredEvent(ctx, order, "Not enough volume to fill the whole order")
+	}
+	if fillQuantity <= 0 {
+		if isImmediateOrder(order) {
+			return e.publishExpiredEvent(ctx, order, "No volume available")
+		}
+		if err := e.orderBook.Add(ctx, order); err != nil {
+			return fmt.Errorf("queue order without available volume: %w", err)
+		}
+
+		e.logger.Info(ctx, "No volume available for order; queued", "order_id", order.OrderId, "symbol", order.Symbol, "volume", quote.Volume)
+		return nil
+	}
+
+	fillPrice, pricing := e.pricing.price(order, quote, fillQuantity)
+	fill, reason, err := e.prepareFill(ctx, order, fillQuantity, fillPrice)
 	if err != nil {
 		return err
 	}
+	if reason != "" {
+		return e.publishRejectedEvent(ctx, order, reason)
+	}
+	fill.pricing = pricing
+	fill.quoteSource, fill.quoteAsOf = quote.Source, quote.AsOf.AsTime()
+
+	if err := e.publishFilledEvent(ctx, order, order.FillCount+1, fill); err != nil {
+		return err
+	}
+
+	if remaining-fillQuantity < minFillQuantity {
+		return nil
+	}
+
+	// the fill is already published, so from here on the order must not be retried from the start;
+	// the remainder goes back to the book and fills on later evaluations
+	rest := withFill(order, fillQuantity)
+	e.parkRemainder(ctx, rest)
+	if !isImmediateOrder(rest) {
+		e.logger.Info(ctx, "Order partially filled; remainder queued", "order_id", order.OrderId, "filled", rest.FilledQuantity, "remaining", remainingQuantity(rest))
+	}
+	return nil
+}
+
+type fill struct {
+	quantity            float64
+	price               float64
+	exchangeRate        float64
+	settlementAmount    float64
+	fee                 float64
+	pricing             *orderpb.ExecutionPricing
+	quoteSource         string
+	quoteAsOf           time.Time
+	settlementCurrency  string
+	tradeID             string
+	counterpartyOrderID string
+}
+
+// prepareFill runs the instrument and account checks for filling quantity of order at price.
+// A non-empty reason means the order has to be rejected.
+func (e *Engine) prepareFill(ctx context.Context, order *orderpb.OrderCreatedEvent, quantity float64, price float64) (*fill, string, error) {
+	terms, reason, err := e.settlementTermsFor(ctx, order)
+	if err != nil || reason != "" {
+		return nil, reason, err
+	}
+
+	settlementAmount := price * quantity * terms.exchangeRate
+	if !positiveFinite(settlementAmount) {
+		return nil, "", fmt.Errorf("calculate settlement amount: result is invalid")
+	}
+	fee := e.fees.Fee(terms.feeFill(order, quantity, price))
+	if order.Side == orderpb.OrderSide_ORDER_SIDE_BUY {
+		available, err := e.buyingPower(ctx, order, terms.account)
+		if err != nil {
+			return nil, "", err
+		}
+		if available < settlementAmount+fee {
+			return nil, fmt.Sprintf("Insufficient funds: need %.2f %s", settlementAmount+fee, terms.currency), nil
+		}
+	}
+	if order.Side == orderpb.OrderSide_ORDER_SIDE_SELL {
+		sufficient, err := e.hasSufficientHoldings(ctx, terms.account.Id, order.Symbol, quantity)
+		if err != nil {
+			return nil, "", err
+		}
+		if !sufficient {
+			return nil, "Insufficient holdings", nil
+		}
+	}
+
+	return &fill{
+		quantity:           quantity,
+		price:              price,
+		exchangeRate:       terms.exchangeRate,
+		settlementAmount:   settlementAmount,
+		fee:                fee,
+		settlementCurrency: terms.currency,
+	}, "", nil
+}
+
+// buyingPower is the cash a fill of order can spend: what its hold still reserves, plus the account's balance that
+// no hold reserves. Cash other orders hold is theirs, even while it is still in the balance.
+func (e *Engine) buyingPower(ctx context.Context, order *orderpb.OrderCreatedEvent, account *portfoliopb.Account) (float64, error) {
+	resp, err := e.portfolioClient.GetHold(ctx, &portfoliopb.GetHoldRequest{OrderId: order.OrderId})
+	if err != nil {
+		return 0, fmt.Errorf("get hold: %w", err)
+	}
+
+	switch resp.GetCode() {
+	case basepb.ErrorCode_OK:
+		if resp.GetHold().GetStatus() != portfoliopb.HoldStatus_HOLD_STATUS_ACTIVE {
+			return account.AvailableBalance, nil
+		}
+		return account.AvailableBalance + resp.GetHold().GetRemainingAmount(), nil
+	case basepb.ErrorCode_NOT_FOUND:
+		return account.AvailableBalance, nil
+	default:
+		return 0, fmt.Errorf("get hold: portfolio service returned %s", resp.GetCode().String())
+	}
+}
+
+type settlementTerms struct {
+	account            *portfoliopb.Account
+	currency           string
+	instrumentCurrency string
+	exchangeRate       float64
+}
+
+// feeFill describes filling quantity of order at price for the fee schedule
+func (t *settlementTerms) feeFill(order *orderpb.OrderCreatedEvent, quantity float64, price float64) fees.Fill {
+	return fees.Fill{
+		Quantity:           quantity,
+		PriorQuantity:      order.FilledQuantity,
+		Price:              price,
+		ExchangeRate:       t.exchangeRate,
+		InstrumentCurrency: t.instrumentCurrency,
+		AccountCurrency:    t.currency,
+	}
+}
+
+// settlementTermsFor finds the account an order settles against and the rate from the instrument's currency into it.
+// A non-empty reason means the order has to be rejected.
+func (e *Engine) settlementTermsFor(ctx context.Context, order *orderpb.OrderCreatedEvent) (*settlementTerms, string, error) {
+	metadata, err := e.getMetadata(ctx, order.Symbol)
+	if err != nil {
+		return nil, "", err
+	}
 	if !isTradableInstrument(metadata.InstrumentType) {
-		return e.publishRejectedEvent(ctx, order, fmt.Sprintf("%s instruments are not supported for trading", metadata.InstrumentType))
+		return nil, fmt.Sprintf("%s instruments are not supported for trading", metadata.InstrumentType), nil
 	}
 
 	account, err := e.getInvestmentAccount(ctx, order.UserId)
 	if err != nil {
-		return e.publishRejectedEvent(ctx, order, "No investment account found")
+		return nil, "No investment account found", nil
 	}
 
 	accountCurrency, err := currencyCode(account.Currency)
 	if err != nil {
-		return e.publishRejectedEvent(ctx, order, err.Error())
+		return nil, err.Error(), nil
 	}
 
 	exchangeRate, err := e.fxProvider.Rate(ctx, metadata.Currency, accountCurrency)
 	if err != nil {
-		return fmt.Errorf("get %s/%s exchange rate: %w", metadata.Currency, accountCurrency, err)
+		return nil, "", fmt.Errorf("get %s/%s exchange rate: %w", metadata.Currency, accountCurrency, err)
 	}
 	if !positiveFinite(exchangeRate) {
-		return fmt.Errorf("get %s/%s exchange rate: provider returned an invalid rate", metadata.Currency, accountCurrency)
+		return nil, "", fmt.Errorf("get %s/%s exchange rate: provider returned an invalid rate", metadata.Currency, accountCurrency)
 	}
 
-	settlementAmount := fillPrice * order.Quantity * exchangeRate
-	if !positiveFinite(settlementAmount) {
-		return fmt.Errorf("calculate settlement amount: result is invalid")
+	return &settlementTerms{
+		account:            account,
+		currency:           accountCurrency,
+		instrumentCurrency: metadata.Currency,
+		exchangeRate:       exchangeRate,
+	}, "", nil
+}
+
+// reserveHold sets aside the cash a buy or the shares a sell can consume, so that
+// other open orders of the same user cannot spend them as well. Portfolio settles the
+// hold as fills arrive and releases the rest once the order is cancelled, rejected or expires.
+// Order-service reserves every order it accepts, so only bracket exits are reserved here, once they are armed.
+// A non-empty reason means the order has to be rejected.
+func (e *Engine) reserveHold(ctx context.Context, order *orderpb.OrderCreatedEvent, quote *stockpb.StockQuote) (string, error) {
+	req, currency, reason, err := e.holdRequest(ctx, order, quote)
+	if err != nil || reason != "" {
+		return reason, err
 	}
-	if order.Side == orderpb.OrderSide_ORDER_SIDE_BUY && account.Balance < settlementAmount {
-		return e.publishRejectedEvent(ctx, order, fmt.Sprintf("Insufficient funds: need %.2f %s", settlementAmount, accountCurrency))
+
+	resp, err := e.portfolioClient.ReserveHold(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("reserve hold: %w", err)
 	}
-	if order.Side == orderpb.OrderSide_ORDER_SIDE_SELL {
-		sufficient, err := e.hasSufficientHoldings(ctx, account.Id, order.Symbol, order.Quantity)
-		if err != nil {
-			return err
-		}
-		if !sufficient {
-			return e.publishRejectedEvent(ctx, order, "Insufficient holdings")
-		}
+
+	switch resp.GetCode() {
+	case basepb.ErrorCode_OK:
+		return "", nil
+	case basepb.ErrorCode_NOT_FOUND:
+		return "No investment account found", nil
+	case basepb.ErrorCode_FAILED_PRECONDITION:
+		return insufficientHoldReason(req, currency), nil
+	default:
+		return "", fmt.Errorf("reserve hold: portfolio service returned %s", resp.GetCode().String())
+	}
+}
+
+// holdRequest sizes the hold of the order's remaining quantity, returning the currency a cash hold is in
+func (e *Engine) holdRequest(ctx context.Context, order *orderpb.OrderCreatedEvent, quote *stockpb.StockQuote) (*portfoliopb.ReserveHoldRequest, string, string, error) {
+	req := &portfoliopb.ReserveHoldRequest{
+		OrderId:  order.OrderId,
+		UserId:   order.UserId,
+		Kind:     portfoliopb.HoldKind_HOLD_KIND_SHARES,
+		Symbol:   order.Symbol,
+		Quantity: remainingQuantity(order),
+	}
+	if len(order.OcoOrderIds) > 0 {
+		// only one leg of the group can execute, so the legs share a single reservation
+		req.GroupId = order.GroupId
+	}
+	if order.Side != orderpb.OrderSide_ORDER_SIDE_BUY {
+		return req, "", "", nil
+	}
+
+	terms, reason, err := e.settlementTermsFor(ctx, order)
+	if err != nil || reason != "" {
+		return nil, "", reason, err
 	}
 
-	return e.publishFilledEvent(ctx, order, fillPrice, exchangeRate, settlementAmount, accountCurrency)
+	req.Kind = portfoliopb.HoldKind_HOLD_KIND_CASH
+	// sized the way order-service sizes the holds it places at acceptance; portfolio settles the fees from it as well
+	req.Amount = e.fees.Hold(terms.feeFill(order, req.Quantity, holdPrice(order, quote.LastPrice)))
+	if !positiveFinite(req.Amount) {
+		return nil, "", "", fmt.Errorf("calculate hold amount: result is invalid")
+	}
+
+	return req, terms.currency, "", nil
+}
+
+func insufficientHoldReason(req *portfoliopb.ReserveHoldRequest, currency string) string {
+	if req.Kind == portfoliopb.HoldKind_HOLD_KIND_SHARES {
+		return "Insufficient holdings: shares are already reserved by other open orders"
+	}
+	return fmt.Sprintf("Insufficient buying power: need %.2f %s", req.Amount, currency)
 }
 
 func (e *Engine) getQuote(ctx context.Context, symbol string) (*stockpb.StockQuote, error) {
@@ -306,6 +1305,18 @@ func (e *Engine) getQuote(ctx context.Context, symbol string) (*stockpb.StockQuo
 	return resp.Data, nil
 }
 
+// quoteStale reports whether quote is older than the engine fills against; a quote without a timestamp always is,
+// unless the check is turned off
+func (e *Engine) quoteStale(quote *stockpb.StockQuote, now time.Time) bool {
+	if e.execution.MaxQuoteAge <= 0 {
+		return false
+	}
+	if quote.AsOf == nil {
+		return true
+	}
+	return now.Sub(quote.AsOf.AsTime()) > e.execution.MaxQuoteAge
+}
+
 func (e *Engine) getMetadata(ctx context.Context, symbol string) (*stockpb.StockMetadata, error) {
 	resp, err := e.stockClient.GetStockMetadata(ctx, &stockpb.GetStockMetadataRequest{Symbol: symbol})
 	if err != nil {
@@ -358,12 +1369,19 @@ func (e *Engine) hasSufficientHoldings(ctx context.Context, accountID string, sy
 }
 
 func (e *Engine) publishRejectedEvent(ctx context.Context, order *orderpb.OrderCreatedEvent, reason string) error {
+	return e.publishRejection(ctx, order, orderpb.RejectCode_REJECT_CODE_UNSPECIFIED, reason)
+}
+
+// publishRejection is publishRejectedEvent for rejections with a machine readable code, such as risk limits
+func (e *Engine) publishRejection(ctx context.Context, order *orderpb.OrderCreatedEvent, code orderpb.RejectCode, reason string) error {
 	event := &orderpb.OrderRejectedEvent{
-		OrderId:    order.OrderId,
-		UserId:     order.UserId,
-		Symbol:     order.Symbol,
-		Reason:     reason,
-		RejectedAt: timestamppb.Now(),
+		OrderId:        order.OrderId,
+		UserId:         order.UserId,
+		Symbol:         order.Symbol,
+		Reason:         reason,
+		RejectedAt:     timestamppb.Now(),
+		FilledQuantity: order.FilledQuantity,
+		ReasonCode:     code,
 	}
 
 	data, err := proto.Marshal(event)
@@ -374,32 +1392,78 @@ func (e *Engine) publishRejectedEvent(ctx context.Context, order *orderpb.OrderC
 		return fmt.Errorf("publish rejected event: %w", err)
 	}
 
-	e.logger.Info(ctx, "Order rejected", "order_id", order.OrderId, "reason", reason)
+	e.logger.Info(ctx, "Order rejected", "order_id", order.OrderId, "reason", reason, "reason_code", code.String())
+	return nil
+}
+
+func (e *Engine) publishExpiredEvent(ctx context.Context, order *orderpb.OrderCreatedEvent, reason string) error {
+	event := &orderpb.OrderExpiredEvent{
+		OrderId:        order.OrderId,
+		UserId:         order.UserId,
+		Symbol:         order.Symbol,
+		Side:           order.Side,
+		TimeInForce:    order.TimeInForce,
+		FilledQuantity: order.FilledQuantity,
+		Reason:         reason,
+		ExpiredAt:      timestamppb.Now(),
+	}
+
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal expired event: %w", err)
+	}
+	if _, err := e.natsClient.PublishWithID("orders.expired", order.OrderId+":expired", data); err != nil {
+		return fmt.Errorf("publish expired event: %w", err)
+	}
+
+	e.logger.Info(ctx, "Order expired", "order_id", order.OrderId, "time_in_force", order.TimeInForce.String(), "filled_quantity", order.FilledQuantity, "reason", reason)
 	return nil
 }
 
-func (e *Engine) publishFilledEvent(ctx context.Context, order *orderpb.OrderCreatedEvent, fillPrice float64, exchangeRate float64, settlementAmount float64, settlementCurrency string) error {
-	event := &orderpb.OrderFilledEvent{
-		OrderId:            order.OrderId,
-		UserId:             order.UserId,
-		Symbol:             order.Symbol,
-		Side:               order.Side,
-		FillQuantity:       order.Quantity,
-		FillPrice:          fillPrice,
-		ExchangeRate:       exchangeRate,
-		SettlementAmount:   settlementAmount,
-		SettlementCurrency: settlementCurrency,
-		FilledAt:           timestamppb.Now(),
+func (e *Engine) publishFilledEvent(ctx context.Context, order *orderpb.OrderCreatedEvent, sequence int32, fill *fill) error {
+	return e.publishFill(ctx, order, filledEvent(order, sequence, fill))
+}
+
+// filledEvent describes fill as the sequence'th fill of order
+func filledEvent(order *orderpb.OrderCreatedEvent, sequence int32, fill *fill) *orderpb.OrderFilledEvent {
+	return &orderpb.OrderFilledEvent{
+		OrderId:             order.OrderId,
+		UserId:              order.UserId,
+		Symbol:              order.Symbol,
+		Side:                order.Side,
+		FillQuantity:        fill.quantity,
+		FillPrice:           fill.price,
+		ExchangeRate:        fill.exchangeRate,
+		SettlementAmount:    fill.settlementAmount,
+		SettlementCurrency:  fill.settlementCurrency,
+		Fee:                 fill.fee,
+		Pricing:             fill.pricing,
+		QuoteSource:         fill.quoteSource,
+		QuoteAsOf:           timestamppb.New(fill.quoteAsOf),
+		FilledAt:            timestamppb.Now(),
+		FillSequence:        sequence,
+		TradeId:             fill.tradeID,
+		CounterpartyOrderId: fill.counterpartyOrderID,
+		RemainingQuantity:   roundQuantity(remainingQuantity(order) - fill.quantity),
 	}
+}
 
+// publishFill publishes a fill of order under an ID derived from its sequence, so publishing it again is harmless
+func (e *Engine) publishFill(ctx context.Context, order *orderpb.OrderCreatedEvent, event *orderpb.OrderFilledEvent) error {
 	data, err := proto.Marshal(event)
 	if err != nil {
 		return fmt.Errorf("marshal filled event: %w", err)
 	}
-	if _, err := e.natsClient.PublishWithID("orders.filled", order.OrderId+":filled", data); err != nil {
+	if err := e.resolveGroup(ctx, order); err != nil {
+		return fmt.Errorf("resolve one-cancels-other group: %w", err)
+	}
+	if _, err := e.natsClient.PublishWithID("orders.filled", fmt.Sprintf("%s:filled:%d", order.OrderId, event.FillSequence), data); err != nil {
 		return fmt.Errorf("publish filled event: %w", err)
 	}
+	if len(order.OcoOrderIds) > 0 {
+		e.cancelResolvedLegs(ctx)
+	}
 
-	e.logger.Info(ctx, "Order filled", "order_id", order.OrderId, "fill_price", fillPrice, "settlement_amount", settlementAmount, "settlement_currency", settlementCurrency)
+	e.logger.Info(ctx, "Order filled", "order_id", order.OrderId, "fill_sequence", event.FillSequence, "fill_quantity", event.FillQuantity, "fill_price", event.FillPrice, "settlement_amount", event.SettlementAmount, "fee", event.Fee, "settlement_currency", event.SettlementCurrency, "trade_id", event.TradeId)
 	return nil
 }
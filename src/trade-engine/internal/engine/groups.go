@@ -0,0 +1,383 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	orderpb "fafnir/shared/pb/order"
+
+	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// armAttempts bounds how often arming retries exits whose hold cannot be reserved yet: the entry's
+// fill reaches portfolio-service at the same time as the engine, so its shares or cash may still be settling
+const armAttempts = 5
+
+var errExitsUnsettled = errors.New("bracket exits cannot be reserved yet")
+
+// isBracketEntry reports whether order carries exits that are armed once it fills
+func isBracketEntry(order *orderpb.OrderCreatedEvent) bool {
+	return order.GroupType == orderpb.OrderGroupType_ORDER_GROUP_TYPE_BRACKET && order.ParentOrderId == "" && len(order.AttachedOrders) > 0
+}
+
+// acceptLinkedOrders accepts the legs of a one-cancels-other group together. Every leg rests in the book first,
+// and only then does the quote work the marketable ones, so the claim that takes a leg also suspends the others.
+func (e *Engine) acceptLinkedOrders(ctx context.Context, order *orderpb.OrderCreatedEvent) error {
+	primary := proto.Clone(order).(*orderpb.OrderCreatedEvent)
+	primary.AttachedOrders = nil
+	legs := append([]*orderpb.OrderCreatedEvent{primary}, order.AttachedOrders...)
+
+	for _, leg := range legs {
+		if err := validateOrder(leg); err != nil {
+			return e.rejectLegs(ctx, legs, err.Error())
+		}
+		if !restsThroughHalt(leg) {
+			return e.rejectLegs(ctx, legs, "One-cancels-other legs must be able to rest in the book")
+		}
+	}
+	if isExpired(primary, time.Now()) {
+		for _, leg := range legs {
+			if err := e.publishExpiredEvent(ctx, leg, "Order expired before it could be evaluated"); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	quote, err := e.getQuote(ctx, primary.Symbol)
+	if err != nil {
+		return err
+	}
+	for i, leg := range legs {
+		if leg.Type == orderpb.OrderType_ORDER_TYPE_TRAILING_STOP {
+			legs[i] = trailFrom(leg, quote.LastPrice)
+		}
+	}
+
+	for _, leg := range legs {
+		violation, err := e.checkRisk(ctx, leg, quote)
+		if err != nil {
+			return err
+		}
+		if violation != nil {
+			return e.rejectLegsWithCode(ctx, legs, violation.Code, violation.Reason)
+		}
+	}
+
+	for _, leg := range legs {
+		if err := e.orderBook.Add(ctx, leg); err != nil {
+			return fmt.Errorf("queue one-cancels-other leg: %w", err)
+		}
+	}
+	e.logger.Info(ctx, "One-cancels-other group queued", "group_id", primary.GroupId, "order_id", primary.OrderId, "legs", len(legs))
+
+	e.evaluateQuote(ctx, quote)
+	return nil
+}
+
+func (e *Engine) rejectLegs(ctx context.Context, legs []*orderpb.OrderCreatedEvent, reason string) error {
+	return e.rejectLegsWithCode(ctx, legs, orderpb.RejectCode_REJECT_CODE_UNSPECIFIED, reason)
+}
+
+func (e *Engine) rejectLegsWithCode(ctx context.Context, legs []*orderpb.OrderCreatedEvent, code orderpb.RejectCode, reason string) error {
+	for _, leg := range legs {
+		if err := e.publishRejection(ctx, leg, code, reason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveGroup takes the other legs of order's group out of the book before order's first fill is published
+func (e *Engine) resolveGroup(ctx context.Context, order *orderpb.OrderCreatedEvent) error {
+	if len(order.OcoOrderIds) == 0 || order.FillCount > 0 {
+		return nil
+	}
+
+	return e.orderBook.ResolveGroup(ctx, order)
+}
+
+// cancelResolvedLegs publishes the cancellation of legs whose group another leg won. Legs whose cancellation
+// fails to publish stay resolved and are retried on the next sweep.
+func (e *Engine) cancelResolvedLegs(ctx context.Context) {
+	legs, err := e.orderBook.ResolvedLegs(ctx)
+	if err != nil {
+		e.logger.Error(ctx, "Failed to list resolved one-cancels-other legs", "error", err)
+		return
+	}
+
+	for _, leg := range legs {
+		if err := e.publishCancelledEvent(ctx, leg, "Another order of its one-cancels-other group filled"); err != nil {
+			e.logger.Error(ctx, "Failed to cancel resolved leg", "order_id", leg.OrderId, "error", err)
+			continue
+		}
+		if err := e.orderBook.DropResolvedLeg(ctx, leg); err != nil {
+			e.logger.Error(ctx, "Failed to drop resolved leg", "order_id", leg.OrderId, "error", err)
+		}
+	}
+}
+
+// subscribeToFilledOrders arms the exits of a bracket once the fill that completes its entry is published
+func (e *Engine) subscribeToFilledOrders() error {
+	_, err := e.natsClient.QueueSubscribe("orders.filled", "trade-engine", "trade-engine-filled", func(msg *nats.Msg) {
+		var event orderpb.OrderFilledEvent
+		if err := proto.Unmarshal(msg.Data, &event); err != nil {
+			e.logger.Error(context.Background(), "Discarding malformed fill event", "error", err)
+			_ = msg.Term()
+			return
+		}
+		if event.RemainingQuantity >= minFillQuantity {
+			_ = msg.Ack()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		defer cancel()
+
+		e.settleBracket(ctx, msg, event.OrderId, 0)
+	})
+
+	return err
+}
+
+// subscribeToClosedOrders arms the exits of a bracket entry that ends with only part of it filled,
+// sized to what did fill, and cancels them if nothing filled
+func (e *Engine) subscribeToClosedOrders() error {
+	for _, subject := range []string{"orders.expired", "orders.rejected"} {
+		durable := "trade-engine-" + subject[len("orders."):]
+		_, err := e.natsClient.QueueSubscribe(subject, "trade-engine", durable, func(msg *nats.Msg) {
+			var orderID string
+			var filled float64
+			var err error
+			if msg.Subject == "orders.expired" {
+				var event orderpb.OrderExpiredEvent
+				err = proto.Unmarshal(msg.Data, &event)
+				orderID, filled = event.OrderId, event.FilledQuantity
+			} else {
+				var event orderpb.OrderRejectedEvent
+				err = proto.Unmarshal(msg.Data, &event)
+				orderID, filled = event.OrderId, event.FilledQuantity
+			}
+			if err != nil {
+				e.logger.Error(context.Background(), "Discarding malformed order event", "subject", msg.Subject, "error", err)
+				_ = msg.Term()
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+			defer cancel()
+
+			if filled < minFillQuantity {
+				if err := e.cancelExits(ctx, orderID, "Bracket entry closed without filling"); err != nil {
+					e.logger.Error(ctx, "Failed to cancel bracket exits; scheduling retry", "order_id", orderID, "error", err)
+					_ = msg.NakWithDelay(retryDelay)
+					return
+				}
+				_ = msg.Ack()
+				return
+			}
+
+			e.settleBracket(ctx, msg, orderID, filled)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// settleBracket arms the exits of a finished entry and acks msg once they are armed, rejected or there are none
+func (e *Engine) settleBracket(ctx context.Context, msg *nats.Msg, orderID string, quantity float64) {
+	err := e.armExits(ctx, orderID, quantity)
+	if errors.Is(err, errExitsUnsettled) {
+		if meta, metaErr := msg.Metadata(); metaErr == nil && meta.NumDelivered < armAttempts {
+			_ = msg.NakWithDelay(retryDelay)
+			return
+		}
+		err = e.rejectExits(ctx, orderID, "Bracket exits could not be reserved after the entry filled")
+	}
+	if err != nil {
+		e.logger.Error(ctx, "Failed to arm bracket exits; scheduling retry", "order_id", orderID, "error", err)
+		_ = msg.NakWithDelay(retryDelay)
+		return
+	}
+
+	_ = msg.Ack()
+}
+
+// armExits turns the exits of a staged entry into working orders for quantity shares (the entry's whole
+// quantity when 0). Their holds are reserved here, where a shortfall can still be retried; the created event
+// then takes the exits through the same path as any other order, which finds the holds already in place.
+func (e *Engine) armExits(ctx context.Context, orderID string, quantity float64) error {
+	entry, err := e.brackets.Staged(ctx, orderID)
+	if err != nil || entry == nil {
+		return err
+	}
+	if quantity <= 0 {
+		quantity = entry.Quantity
+	}
+
+	quote, err := e.getQuote(ctx, entry.Symbol)
+	if err != nil {
+		return err
+	}
+
+	exits := make([]*orderpb.OrderCreatedEvent, 0, len(entry.AttachedOrders))
+	for _, attached := range entry.AttachedOrders {
+		exit := proto.Clone(attached).(*orderpb.OrderCreatedEvent)
+		exit.Quantity = quantity
+		exit.Status = orderpb.OrderStatus_ORDER_STATUS_PENDING
+
+		reason, err := e.reserveHold(ctx, exit, quote)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			e.logger.Info(ctx, "Bracket exit hold not available yet", "order_id", exit.OrderId, "reason", reason)
+			return errExitsUnsettled
+		}
+		exits = append(exits, exit)
+	}
+
+	for _, exit := range exits {
+		if err := e.publishArmedEvent(ctx, exit); err != nil {
+			return err
+		}
+	}
+
+	created := exits[0]
+	created.AttachedOrders = exits[1:]
+	data, err := proto.Marshal(created)
+	if err != nil {
+		return fmt.Errorf("marshal armed exits: %w", err)
+	}
+	if _, err := e.natsClient.PublishWithID("orders.created", created.OrderId+":created", data); err != nil {
+		return fmt.Errorf("publish armed exits: %w", err)
+	}
+
+	e.logger.Info(ctx, "Bracket exits armed", "order_id", orderID, "exits", len(exits), "quantity", quantity)
+	return e.brackets.Unstage(ctx, orderID)
+}
+
+// cancelExits cancels the exits of a staged entry that will never fill
+func (e *Engine) cancelExits(ctx context.Context, orderID string, reason string) error {
+	entry, err := e.brackets.Staged(ctx, orderID)
+	if err != nil || entry == nil {
+		return err
+	}
+
+	for _, exit := range entry.AttachedOrders {
+		if err := e.publishCancelledEvent(ctx, exit, reason); err != nil {
+			return err
+		}
+	}
+
+	return e.brackets.Unstage(ctx, orderID)
+}
+
+// rejectExits gives up on exits whose holds could not be reserved, which releases whatever they did reserve
+func (e *Engine) rejectExits(ctx context.Context, orderID string, reason string) error {
+	entry, err := e.brackets.Staged(ctx, orderID)
+	if err != nil || entry == nil {
+		return err
+	}
+
+	if err := e.rejectLegs(ctx, entry.AttachedOrders, reason); err != nil {
+		return err
+	}
+
+	return e.brackets.Unstage(ctx, orderID)
+}
+
+func (e *Engine) publishArmedEvent(ctx context.Context, order *orderpb.OrderCreatedEvent) error {
+	event := &orderpb.OrderArmedEvent{
+		OrderId:       order.OrderId,
+		UserId:        order.UserId,
+		Symbol:        order.Symbol,
+		ParentOrderId: order.ParentOrderId,
+		Quantity:      order.Quantity,
+		ArmedAt:       timestamppb.Now(),
+	}
+
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal armed event: %w", err)
+	}
+	if _, err := e.natsClient.PublishWithID("orders.armed", order.OrderId+":armed", data); err != nil {
+		return fmt.Errorf("publish armed event: %w", err)
+	}
+
+	e.logger.Info(ctx, "Order armed", "order_id", order.OrderId, "parent_order_id", order.ParentOrderId, "quantity", order.Quantity)
+	return nil
+}
+
+func (e *Engine) publishCancelledEvent(ctx context.Context, order *orderpb.OrderCreatedEvent, reason string) error {
+	event := &orderpb.OrderCancelledEvent{
+		OrderId:        order.OrderId,
+		UserId:         order.UserId,
+		Symbol:         order.Symbol,
+		Side:           order.Side,
+		Status:         orderpb.OrderStatus_ORDER_STATUS_CANCELED,
+		CancelledAt:    timestamppb.Now(),
+		FilledQuantity: order.FilledQuantity,
+		Reason:         reason,
+	}
+
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal cancelled event: %w", err)
+	}
+	if _, err := e.natsClient.PublishWithID("orders.cancelled", order.OrderId+":cancelled", data); err != nil {
+		return fmt.Errorf("publish cancelled event: %w", err)
+	}
+
+	e.logger.Info(ctx, "Order cancelled", "order_id", order.OrderId, "reason", reason)
+	return nil
+}
+
+// linkOpenGroups restores the links between open orders rebuilt from order-service: held exits are attached to
+// their entry, and the legs of a one-cancels-other group (the armed exits of one bracket, or the legs of an OCO
+// group) point at each other again. It returns the held exits by entry.
+func linkOpenGroups(orders []*orderpb.OrderCreatedEvent, held []*orderpb.OrderCreatedEvent) map[string][]*orderpb.OrderCreatedEvent {
+	linked := make(map[string][]*orderpb.OrderCreatedEvent)
+	for _, order := range orders {
+		switch {
+		case order.FillCount > 0:
+			// a leg that has filled already resolved its group
+		case order.GroupType == orderpb.OrderGroupType_ORDER_GROUP_TYPE_OCO:
+			linked[order.GroupId] = append(linked[order.GroupId], order)
+		case order.ParentOrderId != "":
+			linked[order.ParentOrderId] = append(linked[order.ParentOrderId], order)
+		}
+	}
+	for _, legs := range linked {
+		for _, leg := range legs {
+			for _, other := range legs {
+				if other != leg {
+					leg.OcoOrderIds = append(leg.OcoOrderIds, other.OrderId)
+				}
+			}
+		}
+	}
+
+	exits := make(map[string][]*orderpb.OrderCreatedEvent)
+	for _, exit := range held {
+		exits[exit.ParentOrderId] = append(exits[exit.ParentOrderId], exit)
+	}
+	for _, siblings := range exits {
+		for _, exit := range siblings {
+			for _, other := range siblings {
+				if other != exit {
+					exit.OcoOrderIds = append(exit.OcoOrderIds, other.OrderId)
+				}
+			}
+		}
+	}
+
+	return exits
+}
@@ -0,0 +1,59 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	stockpb "fafnir/shared/pb/stock"
+	"fafnir/trade-engine/internal/cache"
+)
+
+// Halts returns the shared halt state so the admin API can change it
+func (e *Engine) Halts() *cache.Halts {
+	return e.halts
+}
+
+// observeQuote feeds a quote to the circuit breaker and returns the halt in force for its symbol, if any.
+// A price that moved further than the band within the window halts the symbol for the configured duration.
+func (e *Engine) observeQuote(ctx context.Context, quote *stockpb.StockQuote) (*cache.Halt, error) {
+	now := time.Now()
+	halt, err := e.halts.Active(ctx, quote.Symbol, now)
+	if err != nil || halt != nil {
+		return halt, err
+	}
+
+	low, high, err := e.halts.RecordPrice(ctx, quote.Symbol, quote.LastPrice, now, e.breaker.Window)
+	if err != nil {
+		return nil, err
+	}
+	if !bandBreached(low, high, e.breaker.Band) {
+		return nil, nil
+	}
+
+	until := now.Add(e.breaker.HaltDuration)
+	halt = &cache.Halt{
+		Scope:    quote.Symbol,
+		Reason:   fmt.Sprintf("Price moved between %.2f and %.2f within %s", low, high, e.breaker.Window),
+		Source:   cache.HaltSourceVolatility,
+		HaltedAt: now,
+		Until:    &until,
+	}
+	if err := e.halts.Set(ctx, *halt); err != nil {
+		return nil, err
+	}
+	// trading resumes against a fresh window instead of re-tripping on the move that caused the halt
+	if err := e.halts.ResetPrices(ctx, quote.Symbol); err != nil {
+		e.logger.Error(ctx, "Failed to reset circuit breaker window", "symbol", quote.Symbol, "error", err)
+	}
+
+	e.logger.Warn(ctx, "Circuit breaker halted trading", "symbol", quote.Symbol, "low", low, "high", high, "until", until)
+	return halt, nil
+}
+
+func haltReason(halt *cache.Halt, symbol string) string {
+	if halt.Scope == cache.GlobalHaltScope {
+		return fmt.Sprintf("Trading is halted: %s", halt.Reason)
+	}
+	return fmt.Sprintf("Trading in %s is halted: %s", symbol, halt.Reason)
+}
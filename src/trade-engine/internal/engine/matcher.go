@@ -4,9 +4,13 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
 	orderpb "fafnir/shared/pb/order"
 	portfoliopb "fafnir/shared/pb/portfolio"
+	"fafnir/shared/pkg/risk"
+
+	"google.golang.org/protobuf/proto"
 )
 
 func isTradableInstrument(instrumentType string) bool {
@@ -18,6 +22,9 @@ func isTradableInstrument(instrumentType string) bool {
 	}
 }
 
+// quantities are stored as NUMERIC(20, 6) downstream, so anything smaller is not a fill
+const minFillQuantity = 0.000001
+
 type decision uint8
 
 const (
@@ -36,6 +43,10 @@ func validateOrder(order *orderpb.OrderCreatedEvent) error {
 		return fmt.Errorf("unsupported order side")
 	}
 
+	if isImmediateOrder(order) && isStopOrder(order) {
+		return fmt.Errorf("IOC and FOK are not supported for stop orders")
+	}
+
 	switch order.Type {
 	case orderpb.OrderType_ORDER_TYPE_MARKET:
 		return nil
@@ -44,8 +55,32 @@ func validateOrder(order *orderpb.OrderCreatedEvent) error {
 			return fmt.Errorf("limit price must be greater than zero")
 		}
 		return nil
+	case orderpb.OrderType_ORDER_TYPE_STOP:
+		if !positiveFinite(order.StopPrice) {
+			return fmt.Errorf("stop price must be greater than zero")
+		}
+		return nil
+	case orderpb.OrderType_ORDER_TYPE_STOP_LIMIT:
+		if !positiveFinite(order.StopPrice) {
+			return fmt.Errorf("stop price must be greater than zero")
+		}
+		if !positiveFinite(order.Price) {
+			return fmt.Errorf("limit price must be greater than zero")
+		}
+		return nil
+	case orderpb.OrderType_ORDER_TYPE_TRAILING_STOP:
+		if (order.TrailAmount != 0) == (order.TrailPercent != 0) {
+			return fmt.Errorf("trailing stops need exactly one of a trail amount or a trail percent")
+		}
+		if order.TrailAmount != 0 && !positiveFinite(order.TrailAmount) {
+			return fmt.Errorf("trail amount must be greater than zero")
+		}
+		if order.TrailPercent != 0 && (!positiveFinite(order.TrailPercent) || order.TrailPercent >= 100) {
+			return fmt.Errorf("trail percent must be greater than zero and below 100")
+		}
+		return nil
 	default:
-		return fmt.Errorf("only market and limit orders are supported")
+		return fmt.Errorf("only market, limit, stop, stop limit and trailing stop orders are supported")
 	}
 }
 
@@ -68,6 +103,187 @@ func evaluateOrder(order *orderpb.OrderCreatedEvent, currentPrice float64) decis
 	return decisionWait
 }
 
+func remainingQuantity(order *orderpb.OrderCreatedEvent) float64 {
+	return roundQuantity(order.Quantity - order.FilledQuantity)
+}
+
+// fillableQuantity caps a fill at a share of the quote's volume; quotes without volume data fill in full
+func fillableQuantity(remaining float64, volume int64, participation float64) float64 {
+	if volume <= 0 || participation <= 0 {
+		return remaining
+	}
+
+	return math.Min(remaining, roundQuantity(float64(volume)*participation))
+}
+
+// withFill returns a copy of order with quantity more filled and the fill counted
+func withFill(order *orderpb.OrderCreatedEvent, quantity float64) *orderpb.OrderCreatedEvent {
+	filled := proto.Clone(order).(*orderpb.OrderCreatedEvent)
+	filled.FilledQuantity = roundQuantity(order.FilledQuantity + quantity)
+	filled.FillCount = order.FillCount + 1
+	// a leg that has filled has resolved its group, so its remainder no longer cancels anything
+	filled.OcoOrderIds = nil
+	return filled
+}
+
+func roundQuantity(quantity float64) float64 {
+	return math.Floor(quantity/minFillQuantity+0.5) * minFillQuantity
+}
+
+// holdPrice is the per-share price a buy order's cash hold is sized at, the same way order-service sizes
+// the hold it places at acceptance
+func holdPrice(order *orderpb.OrderCreatedEvent, lastPrice float64) float64 {
+	return risk.HoldPrice(risk.Order{Type: order.Type, Price: order.Price, StopPrice: order.StopPrice}, lastPrice)
+}
+
+func isStopOrder(order *orderpb.OrderCreatedEvent) bool {
+	switch order.Type {
+	case orderpb.OrderType_ORDER_TYPE_STOP, orderpb.OrderType_ORDER_TYPE_STOP_LIMIT, orderpb.OrderType_ORDER_TYPE_TRAILING_STOP:
+		return true
+	default:
+		return false
+	}
+}
+
+// trailFrom anchors a trailing stop at watermark: a sell stop trails below the highest price seen,
+// a buy stop above the lowest. The book ratchets both on later quotes.
+func trailFrom(order *orderpb.OrderCreatedEvent, watermark float64) *orderpb.OrderCreatedEvent {
+	trailed := proto.Clone(order).(*orderpb.OrderCreatedEvent)
+	offset := order.TrailAmount
+	if order.TrailPercent != 0 {
+		offset = watermark * order.TrailPercent / 100
+	}
+
+	trailed.TrailWatermark = watermark
+	if order.Side == orderpb.OrderSide_ORDER_SIDE_BUY {
+		trailed.StopPrice = watermark + offset
+	} else {
+		trailed.StopPrice = watermark - offset
+	}
+
+	return trailed
+}
+
+// buy stops trigger once the price rises to the stop, sell stops (stop-loss) once it falls to it
+func stopTriggered(order *orderpb.OrderCreatedEvent, currentPrice float64) bool {
+	switch order.Side {
+	case orderpb.OrderSide_ORDER_SIDE_BUY:
+		return currentPrice >= order.StopPrice
+	case orderpb.OrderSide_ORDER_SIDE_SELL:
+		return currentPrice <= order.StopPrice
+	default:
+		return false
+	}
+}
+
+// activateStop converts a triggered stop into the order it becomes: STOP_LIMIT -> LIMIT,
+// STOP and TRAILING_STOP -> MARKET
+func activateStop(order *orderpb.OrderCreatedEvent) *orderpb.OrderCreatedEvent {
+	activated := proto.Clone(order).(*orderpb.OrderCreatedEvent)
+	if order.Type == orderpb.OrderType_ORDER_TYPE_STOP_LIMIT {
+		activated.Type = orderpb.OrderType_ORDER_TYPE_LIMIT
+	} else {
+		activated.Type = orderpb.OrderType_ORDER_TYPE_MARKET
+	}
+
+	return activated
+}
+
+// immediate orders (IOC, FOK) never rest in the book
+func isImmediateOrder(order *orderpb.OrderCreatedEvent) bool {
+	return order.TimeInForce == orderpb.TimeInForce_TIME_IN_FORCE_IOC || order.TimeInForce == orderpb.TimeInForce_TIME_IN_FORCE_FOK
+}
+
+func isExpired(order *orderpb.OrderCreatedEvent, now time.Time) bool {
+	return order.ExpiresAt != nil && !order.ExpiresAt.AsTime().After(now)
+}
+
+// limit and stop orders can wait out a trading halt in the book; market and immediate orders cannot
+func restsThroughHalt(order *orderpb.OrderCreatedEvent) bool {
+	return order.Type != orderpb.OrderType_ORDER_TYPE_MARKET && !isImmediateOrder(order)
+}
+
+// bandBreached reports whether prices spread further than band, as a share of the lowest price
+func bandBreached(low float64, high float64, band float64) bool {
+	return low > 0 && (high-low)/low > band
+}
+
+// crossingCandidates picks the resting limit orders of other users that an incoming order trades against;
+// book comes from the index in price-time priority and keeps that order
+func crossingCandidates(incoming *orderpb.OrderCreatedEvent, book []*orderpb.OrderCreatedEvent, now time.Time) []*orderpb.OrderCreatedEvent {
+	candidates := make([]*orderpb.OrderCreatedEvent, 0)
+	for _, resting := range book {
+		if resting.OrderId == incoming.OrderId || resting.UserId == incoming.UserId || resting.Side == incoming.Side {
+			continue
+		}
+		if resting.Type != orderpb.OrderType_ORDER_TYPE_LIMIT || isExpired(resting, now) {
+			continue
+		}
+		if !crosses(incoming, resting.Price) {
+			continue
+		}
+		candidates = append(candidates, resting)
+	}
+
+	return candidates
+}
+
+func oppositeSide(side orderpb.OrderSide) orderpb.OrderSide {
+	if side == orderpb.OrderSide_ORDER_SIDE_BUY {
+		return orderpb.OrderSide_ORDER_SIDE_SELL
+	}
+	return orderpb.OrderSide_ORDER_SIDE_BUY
+}
+
+// crossingLimit is the worst resting price an incoming order accepts; market orders accept any
+func crossingLimit(incoming *orderpb.OrderCreatedEvent) float64 {
+	if incoming.Type != orderpb.OrderType_ORDER_TYPE_MARKET {
+		return incoming.Price
+	}
+	if incoming.Side == orderpb.OrderSide_ORDER_SIDE_BUY {
+		return math.Inf(1)
+	}
+	return 0
+}
+
+func crosses(incoming *orderpb.OrderCreatedEvent, price float64) bool {
+	switch {
+	case incoming.Type == orderpb.OrderType_ORDER_TYPE_MARKET:
+		return true
+	case incoming.Side == orderpb.OrderSide_ORDER_SIDE_BUY:
+		return price <= incoming.Price
+	case incoming.Side == orderpb.OrderSide_ORDER_SIDE_SELL:
+		return price >= incoming.Price
+	default:
+		return false
+	}
+}
+
+// openOrderEvent rebuilds the event an open order rests in the book as
+func openOrderEvent(order *orderpb.Order) *orderpb.OrderCreatedEvent {
+	return &orderpb.OrderCreatedEvent{
+		OrderId:        order.Id,
+		UserId:         order.UserId,
+		Symbol:         order.Symbol,
+		Side:           order.Side,
+		Type:           order.Type,
+		Status:         order.Status,
+		Quantity:       order.Quantity,
+		Price:          order.Price,
+		StopPrice:      order.StopPrice,
+		CreatedAt:      order.CreatedAt,
+		FilledQuantity: order.FilledQuantity,
+		FillCount:      order.FillCount,
+		TimeInForce:    order.TimeInForce,
+		ExpiresAt:      order.ExpiresAt,
+		TrailAmount:    order.TrailAmount,
+		TrailPercent:   order.TrailPercent,
+		GroupId:        order.GroupId,
+		GroupType:      order.GroupType,
+		ParentOrderId:  order.ParentOrderId,
+	}
+}
+
 func currencyCode(currency portfoliopb.CurrencyType) (string, error) {
 	switch currency {
 	case portfoliopb.CurrencyType_CURRENCY_TYPE_USD:
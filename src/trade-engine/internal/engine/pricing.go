@@ -0,0 +1,96 @@
+package engine
+
+import (
+	"fmt"
+	"math"
+
+	orderpb "fafnir/shared/pb/order"
+	stockpb "fafnir/shared/pb/stock"
+	"fafnir/trade-engine/internal/config"
+)
+
+const (
+	priceModelLast         = "last"
+	priceModelSpread       = "spread"
+	priceModelSpreadImpact = "spread_impact"
+)
+
+// priceModel prices market orders against a quote, which carries no bid or ask: the spread is estimated from
+// the day's range, never below MinSpread, and the impact follows the square root of the order's share of the
+// volume, scaled by the same range as a proxy for volatility. Buys pay the slippage above the last price,
+// sells receive that much less.
+type priceModel struct {
+	name              string
+	minSpread         float64
+	spreadRangeShare  float64
+	impactCoefficient float64
+}
+
+// newPriceModel builds the configured model, spread_impact when none is set; an unknown model is an error
+// rather than a silent change in what market orders pay
+func newPriceModel(cfg config.ExecutionConfig) (priceModel, error) {
+	model := priceModel{
+		name:              cfg.PriceModel,
+		minSpread:         cfg.MinSpread,
+		spreadRangeShare:  cfg.SpreadRangeShare,
+		impactCoefficient: cfg.ImpactCoefficient,
+	}
+	switch model.name {
+	case priceModelLast, priceModelSpread, priceModelSpreadImpact:
+	case "":
+		model.name = priceModelSpreadImpact
+	default:
+		return priceModel{}, fmt.Errorf("unknown execution price model %q, expected %q, %q or %q", cfg.PriceModel, priceModelLast, priceModelSpread, priceModelSpreadImpact)
+	}
+
+	return model, nil
+}
+
+// price returns what quantity of order fills at against quote, and how that price came about.
+// Only market orders slip; a limit order that is marketable fills at the last price as before.
+func (m priceModel) price(order *orderpb.OrderCreatedEvent, quote *stockpb.StockQuote, quantity float64) (float64, *orderpb.ExecutionPricing) {
+	if order.Type != orderpb.OrderType_ORDER_TYPE_MARKET {
+		return quote.LastPrice, &orderpb.ExecutionPricing{
+			Model:          priceModelLast,
+			ReferencePrice: quote.LastPrice,
+		}
+	}
+
+	return m.slip(order.Side, quote, quantity)
+}
+
+// slip prices a market order of quantity on side against quote
+func (m priceModel) slip(side orderpb.OrderSide, quote *stockpb.StockQuote, quantity float64) (float64, *orderpb.ExecutionPricing) {
+	pricing := &orderpb.ExecutionPricing{
+		Model:          priceModelLast,
+		ReferencePrice: quote.LastPrice,
+	}
+	if m.name == priceModelLast || !positiveFinite(quote.LastPrice) {
+		return quote.LastPrice, pricing
+	}
+
+	dayRange := 0.0
+	if quote.DayHigh > quote.DayLow && quote.DayLow > 0 {
+		dayRange = (quote.DayHigh - quote.DayLow) / quote.LastPrice
+	}
+
+	pricing.Model = m.name
+	pricing.MinSpread = m.minSpread
+	pricing.SpreadRangeShare = m.spreadRangeShare
+	pricing.Spread = math.Max(m.minSpread, m.spreadRangeShare*dayRange)
+	if m.name == priceModelSpreadImpact && quote.Volume > 0 {
+		pricing.ImpactCoefficient = m.impactCoefficient
+		pricing.Participation = quantity / float64(quote.Volume)
+		pricing.Impact = m.impactCoefficient * dayRange * math.Sqrt(pricing.Participation)
+	}
+
+	slippage := pricing.Spread/2 + pricing.Impact
+	if side == orderpb.OrderSide_ORDER_SIDE_SELL {
+		// a sell can slip all the way down, but never to nothing
+		return quote.LastPrice * math.Max(1-slippage, minSlippedPriceShare), pricing
+	}
+	return quote.LastPrice * (1 + slippage), pricing
+}
+
+// minSlippedPriceShare keeps a sell in a thin, volatile market from filling at a price of zero
+const minSlippedPriceShare = 0.5
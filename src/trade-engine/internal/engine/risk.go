@@ -0,0 +1,53 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+
+	basepb "fafnir/shared/pb/base"
+	orderpb "fafnir/shared/pb/order"
+	portfoliopb "fafnir/shared/pb/portfolio"
+	stockpb "fafnir/shared/pb/stock"
+	"fafnir/shared/pkg/risk"
+)
+
+// checkRisk runs the pre-trade risk checks again at acceptance, now with the exchange rate into the
+// account's currency and the holds of the user's other open orders. The order's own hold, and those of
+// the one-cancels-other legs working alongside it, were reserved when order-service accepted it, so they
+// are left out of the count. Orders that cannot settle are left alone here, reserving their hold rejects
+// them with the reason.
+func (e *Engine) checkRisk(ctx context.Context, order *orderpb.OrderCreatedEvent, quote *stockpb.StockQuote) (*risk.Violation, error) {
+	terms, reason, err := e.settlementTermsFor(ctx, order)
+	if err != nil || reason != "" {
+		return nil, err
+	}
+
+	resp, err := e.portfolioClient.GetRiskExposure(ctx, &portfoliopb.GetRiskExposureRequest{
+		UserId:           order.UserId,
+		Symbol:           order.Symbol,
+		ExcludedOrderIds: append([]string{order.OrderId}, order.OcoOrderIds...),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get risk exposure: %w", err)
+	}
+	if resp.Code != basepb.ErrorCode_OK || resp.Exposure == nil {
+		return nil, fmt.Errorf("get risk exposure: portfolio service returned %s", resp.Code.String())
+	}
+
+	return e.risk.Check(ctx, risk.Order{
+		UserID:    order.UserId,
+		Symbol:    order.Symbol,
+		Side:      order.Side,
+		Type:      order.Type,
+		Quantity:  remainingQuantity(order),
+		Price:     order.Price,
+		StopPrice: order.StopPrice,
+	}, risk.Exposure{
+		LastPrice:        quote.LastPrice,
+		Rate:             terms.exchangeRate,
+		AccountValue:     resp.Exposure.AccountValue,
+		Position:         resp.Exposure.PositionQuantity,
+		OpenOrders:       int(resp.Exposure.OpenOrders),
+		RealizedPnlToday: resp.Exposure.RealizedPnlToday,
+	})
+}
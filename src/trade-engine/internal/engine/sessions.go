@@ -0,0 +1,95 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	basepb "fafnir/shared/pb/base"
+	stockpb "fafnir/shared/pb/stock"
+)
+
+// how long a symbol whose exchange has no calendar in stock-service is trusted to the quote's market state
+const unknownCalendarTTL = time.Hour
+
+// marketSessions caches the trading session of each symbol until its phase changes
+type marketSessions struct {
+	mu      sync.Mutex
+	entries map[string]cachedSession
+}
+
+type cachedSession struct {
+	// nil when the symbol's exchange has no calendar
+	session *stockpb.MarketSession
+	until   time.Time
+}
+
+func newMarketSessions() *marketSessions {
+	return &marketSessions{entries: make(map[string]cachedSession)}
+}
+
+func (s *marketSessions) get(symbol string, now time.Time) (cachedSession, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[symbol]
+	if !ok || !now.Before(entry.until) {
+		return cachedSession{}, false
+	}
+	return entry, true
+}
+
+func (s *marketSessions) put(symbol string, entry cachedSession) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[symbol] = entry
+}
+
+// marketOpen reports whether the quote's symbol is in its exchange's regular session. Outside it quotes
+// are stale or thin, so orders wait in the book for the open. Symbols without a known calendar fall back
+// to the market state the quote provider reports.
+func (e *Engine) marketOpen(ctx context.Context, quote *stockpb.StockQuote) (bool, error) {
+	if e.execution.IgnoreMarketHours {
+		return true, nil
+	}
+
+	session, err := e.marketSession(ctx, quote.Symbol)
+	if err != nil {
+		return false, err
+	}
+	if session == nil {
+		return quote.MarketState == "" || strings.EqualFold(quote.MarketState, "REGULAR"), nil
+	}
+
+	return session.Phase == stockpb.MarketPhase_MARKET_PHASE_REGULAR, nil
+}
+
+func (e *Engine) marketSession(ctx context.Context, symbol string) (*stockpb.MarketSession, error) {
+	now := time.Now()
+	if entry, ok := e.sessions.get(symbol, now); ok {
+		return entry.session, nil
+	}
+
+	resp, err := e.stockClient.GetMarketSession(ctx, &stockpb.GetMarketSessionRequest{Symbol: symbol})
+	if err != nil {
+		return nil, fmt.Errorf("get market session for %s: %w", symbol, err)
+	}
+
+	switch resp.GetCode() {
+	case basepb.ErrorCode_OK:
+	case basepb.ErrorCode_NOT_FOUND:
+		e.sessions.put(symbol, cachedSession{until: now.Add(unknownCalendarTTL)})
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("get market session for %s: stock service returned %s", symbol, resp.GetCode().String())
+	}
+	if resp.Data == nil || resp.Data.ChangesAt == nil {
+		return nil, fmt.Errorf("get market session for %s: stock service returned an incomplete session", symbol)
+	}
+
+	e.sessions.put(symbol, cachedSession{session: resp.Data, until: resp.Data.ChangesAt.AsTime()})
+	return resp.Data, nil
+}
@@ -0,0 +1,186 @@
+package engine
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"sync"
+	"time"
+
+	orderpb "fafnir/shared/pb/order"
+
+	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
+)
+
+// forwardTimeout leaves the owner a full request timeout to process a handed-over order
+const forwardTimeout = requestTimeout + 5*time.Second
+
+// shardRing assigns every symbol to exactly one live engine by rendezvous hashing: each engine scores
+// hash(engine, symbol) and the highest score owns the symbol. When an engine joins or leaves, only the
+// symbols it wins or held move, so the other engines keep their books warm.
+//
+// Engines see membership changes one heartbeat apart, so two of them may briefly both work a symbol;
+// the book's atomic claims keep that safe, it only costs a duplicate quote check.
+type shardRing struct {
+	self    string
+	mu      sync.RWMutex
+	members []string
+}
+
+func newShardRing(self string) *shardRing {
+	return &shardRing{self: self, members: []string{self}}
+}
+
+// update replaces the live membership and reports whether it changed. The engine always counts itself
+// as a member so it keeps working while its own registration is being renewed.
+func (r *shardRing) update(members []string) bool {
+	members = slices.Clone(members)
+	if !slices.Contains(members, r.self) {
+		members = append(members, r.self)
+	}
+	slices.Sort(members)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if slices.Equal(r.members, members) {
+		return false
+	}
+	r.members = members
+	return true
+}
+
+func (r *shardRing) owner(symbol string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var owner string
+	var best uint64
+	for _, member := range r.members {
+		if score := shardScore(member, symbol); owner == "" || score > best {
+			owner, best = member, score
+		}
+	}
+	return owner
+}
+
+func (r *shardRing) owns(symbol string) bool {
+	return r.owner(symbol) == r.self
+}
+
+func (r *shardRing) snapshot() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return slices.Clone(r.members)
+}
+
+func shardScore(member string, symbol string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(member))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(symbol))
+	return h.Sum64()
+}
+
+// shardSubject is the core NATS subject an engine receives orders.created events for its symbols on. The engine
+// ID is hex encoded, which keeps it a single token whatever it contains and never maps two IDs to the same subject.
+func shardSubject(engineID string) string {
+	return "trade-engine.shards." + hex.EncodeToString([]byte(engineID)) + ".orders.created"
+}
+
+// joinShards registers this engine and loads the current membership before it starts taking orders
+func (e *Engine) joinShards() error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	members, err := e.membership.Heartbeat(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	e.shards.update(members)
+
+	e.logger.Info(ctx, "Joined trade engine shards", "engine_id", e.recovery.EngineID, "members", e.shards.snapshot())
+	return nil
+}
+
+func (e *Engine) heartbeat() {
+	ticker := time.NewTicker(e.sharding.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-e.stopCh:
+			return
+		case <-ticker.C:
+			e.heartbeatOnce()
+		}
+	}
+}
+
+func (e *Engine) heartbeatOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	members, err := e.membership.Heartbeat(ctx, time.Now())
+	if err != nil {
+		e.logger.Error(ctx, "Failed to renew shard membership", "error", err)
+		return
+	}
+
+	if e.shards.update(members) {
+		e.logger.Info(ctx, "Trade engine shards rebalanced", "members", e.shards.snapshot())
+	}
+}
+
+func (e *Engine) leaveShards() {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	if err := e.membership.Leave(ctx); err != nil {
+		e.logger.Error(ctx, "Failed to leave trade engine shards", "error", err)
+	}
+}
+
+// subscribeToShard takes orders.created events other engines hand over for the symbols this engine owns.
+// The reply is empty on success and carries the error otherwise, so the forwarding engine knows whether
+// to ack its JetStream message or have it redelivered.
+func (e *Engine) subscribeToShard() error {
+	_, err := e.natsClient.SubscribeEphemeral(shardSubject(e.recovery.EngineID), func(msg *nats.Msg) {
+		var event orderpb.OrderCreatedEvent
+		if err := proto.Unmarshal(msg.Data, &event); err != nil {
+			e.logger.Error(context.Background(), "Discarding malformed forwarded order", "error", err)
+			_ = msg.Respond(nil)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		defer cancel()
+
+		var reply []byte
+		if err := e.acceptOrder(ctx, &event); err != nil {
+			e.logger.Error(ctx, "Forwarded order processing failed", "order_id", event.OrderId, "error", err)
+			reply = []byte(err.Error())
+		}
+		_ = msg.Respond(reply)
+	})
+
+	return err
+}
+
+// forwardOrder hands a created order to the engine that owns its symbol and waits for it to be processed
+func (e *Engine) forwardOrder(owner string, data []byte) error {
+	resp, err := e.natsClient.Request(shardSubject(owner), data, forwardTimeout)
+	if err != nil {
+		return fmt.Errorf("forward to %s: %w", owner, err)
+	}
+	if len(resp.Data) > 0 {
+		return fmt.Errorf("forward to %s: %w", owner, errors.New(string(resp.Data)))
+	}
+
+	return nil
+}
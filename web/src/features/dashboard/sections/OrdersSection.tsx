@@ -35,7 +35,11 @@ export function OrdersSection({ orders }: { orders: Order[] }) {
             </Group>
             <SimpleGrid cols={2}>
               <OrderMetric label="Quantity" value={String(order.quantity)} />
-              <OrderMetric label="Requested price" value={order.price ? formatDecimal(order.price) : "Market"} />
+              {order.type === "TRAILING_STOP" ? (
+                <OrderMetric label="Trail" value={order.trailPercent ? `${formatDecimal(order.trailPercent)}%` : formatDecimal(order.trailAmount)} />
+              ) : (
+                <OrderMetric label="Requested price" value={order.price ? formatDecimal(order.price) : "Market"} />
+              )}
               <OrderMetric label="Filled quantity" value={String(order.filledQuantity)} />
               <OrderMetric label="Average fill" value={order.avgFillPrice ? formatDecimal(order.avgFillPrice) : "—"} />
               <OrderMetric label="Submitted" value={formatDate(order.createdAt)} />
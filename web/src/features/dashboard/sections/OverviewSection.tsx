@@ -37,7 +37,7 @@ export function OverviewSection({
 }: OverviewSectionProps) {
   const accountBalances = formatAccountBalances(accounts);
   const openOrders = orders.filter(
-    (order) => !["FILLED", "CANCELED", "REJECTED"].includes(order.status),
+    (order) => !["FILLED", "CANCELED", "REJECTED", "EXPIRED"].includes(order.status),
   ).length;
 
   return (
@@ -108,6 +108,9 @@ export function PortfolioSection({ accounts, loading }: PortfolioSectionProps) {
             </Group>
             <Text tt="capitalize" c="dimmed" size="sm" mt="xl">{account.type.toLowerCase()}</Text>
             <Text fz={28} fw={650} mt={3}>{formatMoney(account.balance, account.currency)}</Text>
+            {account.availableBalance < account.balance ? (
+              <Text c="dimmed" size="xs">{formatMoney(account.availableBalance, account.currency)} available · rest held for open orders</Text>
+            ) : null}
             <Text c="dimmed" size="xs" mt="sm">•••• {account.accountNumber.slice(-4)}</Text>
           </Paper>
         ))}
@@ -204,6 +207,7 @@ export function PortfolioSection({ accounts, loading }: PortfolioSectionProps) {
         ) : holdingDetails.data?.getHolding.data ? (
           <SimpleGrid cols={2}>
             <HoldingMetric label="Quantity" value={String(holdingDetails.data.getHolding.data.quantity)} />
+            <HoldingMetric label="Available to sell" value={String(holdingDetails.data.getHolding.data.availableQuantity)} />
             <HoldingMetric label="Average cost" value={formatMoney(holdingDetails.data.getHolding.data.avgCost, selectedAccount?.currency)} />
             <HoldingMetric label="Opened" value={formatDate(holdingDetails.data.getHolding.data.createdAt)} />
             <HoldingMetric label="Updated" value={formatDate(holdingDetails.data.getHolding.data.updatedAt)} />
@@ -73,5 +73,6 @@ export function OrdersTable({ orders, onSelect }: { orders: Order[]; onSelect?:
 function getStatusColor(status: string) {
   if (status === "FILLED") return "lime";
   if (status === "CANCELED" || status === "REJECTED") return "red";
+  if (status === "EXPIRED") return "gray";
   return "yellow";
 }
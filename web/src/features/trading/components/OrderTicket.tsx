@@ -25,12 +25,36 @@ export function OrderTicket({ defaultSymbol, currency, onComplete }: OrderTicket
   const [type, setType] = useState("MARKET");
   const [quantity, setQuantity] = useState<number | string>(1);
   const [price, setPrice] = useState<number | string>("");
+  const [stopPrice, setStopPrice] = useState<number | string>("");
+  const [trailBy, setTrailBy] = useState("PERCENT");
+  const [trail, setTrail] = useState<number | string>("");
+  const [timeInForce, setTimeInForce] = useState("GTC");
   const createOrder = useCreateOrder({ onSuccess: onComplete });
 
+  const hasLimitPrice = type === "LIMIT" || type === "STOP_LIMIT";
+  const hasStopPrice = type === "STOP" || type === "STOP_LIMIT";
+  const hasTrail = type === "TRAILING_STOP";
+  const trailsByPercent = trailBy === "PERCENT";
+  const timeInForceOptions = [
+    { value: "GTC", label: "Good till cancelled" },
+    { value: "DAY", label: "Day" },
+    ...(hasStopPrice || hasTrail
+      ? []
+      : [
+          { value: "IOC", label: "Immediate or cancel" },
+          { value: "FOK", label: "Fill or kill" },
+        ]),
+  ];
+  const effectiveTimeInForce = timeInForceOptions.some((option) => option.value === timeInForce)
+    ? timeInForce
+    : "GTC";
+
   const canSubmit =
     defaultSymbol.length > 0 &&
     Number(quantity) > 0 &&
-    (type === "MARKET" || Number(price) > 0);
+    (!hasLimitPrice || Number(price) > 0) &&
+    (!hasStopPrice || Number(stopPrice) > 0) &&
+    (!hasTrail || (Number(trail) > 0 && (!trailsByPercent || Number(trail) < 100)));
 
   const submit = () => {
     createOrder.mutate({
@@ -38,7 +62,14 @@ export function OrderTicket({ defaultSymbol, currency, onComplete }: OrderTicket
       side,
       type,
       quantity: Number(quantity),
-      ...(type !== "MARKET" && price ? { price: Number(price) } : {}),
+      timeInForce: effectiveTimeInForce,
+      ...(hasLimitPrice && price ? { price: Number(price) } : {}),
+      ...(hasStopPrice && stopPrice ? { stopPrice: Number(stopPrice) } : {}),
+      ...(hasTrail && trail
+        ? trailsByPercent
+          ? { trailPercent: Number(trail) }
+          : { trailAmount: Number(trail) }
+        : {}),
     });
   };
 
@@ -71,8 +102,17 @@ export function OrderTicket({ defaultSymbol, currency, onComplete }: OrderTicket
         data={[
           { value: "MARKET", label: "Market" },
           { value: "LIMIT", label: "Limit" },
+          { value: "STOP", label: "Stop" },
+          { value: "STOP_LIMIT", label: "Stop limit" },
+          { value: "TRAILING_STOP", label: "Trailing stop" },
         ]}
       />
+      <Select
+        label="Time in force"
+        value={effectiveTimeInForce}
+        onChange={(value) => setTimeInForce(value ?? "GTC")}
+        data={timeInForceOptions}
+      />
       <NumberInput
         label="Quantity"
         min={0.0001}
@@ -80,7 +120,45 @@ export function OrderTicket({ defaultSymbol, currency, onComplete }: OrderTicket
         value={quantity}
         onChange={setQuantity}
       />
-      {type !== "MARKET" && (
+      {hasStopPrice && (
+        <NumberInput
+          label="Stop price"
+          prefix={currency ? `${currency} ` : undefined}
+          min={0.01}
+          decimalScale={2}
+          value={stopPrice}
+          onChange={setStopPrice}
+        />
+      )}
+      {hasTrail && (
+        <Stack gap="xs">
+          <SegmentedControl
+            fullWidth
+            value={trailBy}
+            onChange={setTrailBy}
+            data={[
+              { value: "PERCENT", label: "Trail by %" },
+              { value: "AMOUNT", label: "Trail by amount" },
+            ]}
+          />
+          <NumberInput
+            label={trailsByPercent ? "Trail percent" : "Trail amount"}
+            description={
+              side === "BUY"
+                ? "Buys at market once the price rises this far above its lowest point"
+                : "Sells at market once the price falls this far below its highest point"
+            }
+            prefix={!trailsByPercent && currency ? `${currency} ` : undefined}
+            suffix={trailsByPercent ? "%" : undefined}
+            min={0.01}
+            max={trailsByPercent ? 99.99 : undefined}
+            decimalScale={2}
+            value={trail}
+            onChange={setTrail}
+          />
+        </Stack>
+      )}
+      {hasLimitPrice && (
         <NumberInput
           label="Limit price"
           prefix={currency ? `${currency} ` : undefined}